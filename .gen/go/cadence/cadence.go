@@ -84,7 +84,10 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   //  - StartRequest
   StartWorkflowExecution(startRequest *shared.StartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
   // Returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
-  // execution in unknown to the service.
+  // execution in unknown to the service.  When waitForNewEvent is set, the call long polls for events past the end of
+  // the history read so far, and the returned nextPageToken can be used to wait for more events until the execution
+  // closes.  The CLOSE_EVENT filter only returns the close event of the execution, waiting for it when
+  // waitForNewEvent is set.
   // 
   // 
   // Parameters:
@@ -110,6 +113,15 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - CompleteRequest
   RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) (err error)
+  // RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in
+  // DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to
+  // report any failures like non-determinism or panics during DecisionTask processing.  Use the 'taskToken' provided as
+  // response of PollForDecisionTask API call for failing the DecisionTask.
+  // 
+  // 
+  // Parameters:
+  //  - FailedRequest
+  RespondDecisionTaskFailed(failedRequest *shared.RespondDecisionTaskFailedRequest) (err error)
   // PollForActivityTask is called by application worker to process ActivityTask from a specific taskList.  ActivityTask
   // is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
   // Application is expected to call 'RespondActivityTaskCompleted' or 'RespondActivityTaskFailed' once it is done
@@ -132,6 +144,14 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - HeartbeatRequest
   RecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error)
+  // RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  It is
+  // same as 'RecordActivityTaskHeartbeat' but identifies the ActivityTask by the domain, workflowId, runId and
+  // activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  // 
+  // 
+  // Parameters:
+  //  - HeartbeatRequest
+  RecordActivityTaskHeartbeatByID(heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error)
   // RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
   // result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
   // created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
@@ -142,6 +162,14 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - CompleteRequest
   RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) (err error)
+  // RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.  It is
+  // same as 'RespondActivityTaskCompleted' but identifies the ActivityTask by the domain, workflowId, runId and
+  // activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  // 
+  // 
+  // Parameters:
+  //  - CompleteRequest
+  RespondActivityTaskCompletedByID(completeRequest *shared.RespondActivityTaskCompletedByIDRequest) (err error)
   // RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
   // result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
   // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
@@ -152,6 +180,14 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - FailRequest
   RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) (err error)
+  // RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.  It is
+  // same as 'RespondActivityTaskFailed' but identifies the ActivityTask by the domain, workflowId, runId and
+  // activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  // 
+  // 
+  // Parameters:
+  //  - FailRequest
+  RespondActivityTaskFailedByID(failRequest *shared.RespondActivityTaskFailedByIDRequest) (err error)
   // RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
   // result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
   // created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
@@ -162,6 +198,14 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - CanceledRequest
   RespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest) (err error)
+  // RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.
+  // It is same as 'RespondActivityTaskCanceled' but identifies the ActivityTask by the domain, workflowId, runId and
+  // activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  // 
+  // 
+  // Parameters:
+  //  - CanceledRequest
+  RespondActivityTaskCanceledByID(canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) (err error)
   // RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   // It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
   // created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
@@ -178,6 +222,15 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - SignalRequest
   SignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest) (err error)
+  // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+  // is running, this results in WorkflowExecutionSignaled event recorded in the history and a decision task being
+  // created for the execution.  If the workflow is not running, a new workflow execution is started with the
+  // WorkflowExecutionSignaled event recorded right after its WorkflowExecutionStarted event.
+  // 
+  // 
+  // Parameters:
+  //  - SignalWithStartRequest
+  SignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
   // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
   // in the history and immediately terminating the execution instance.
   // 
@@ -185,6 +238,14 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - TerminateRequest
   TerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest) (err error)
+  // ResetWorkflowExecution resets an existing workflow execution to the point right before the given
+  // DecisionTaskCompleted event.  A new run is started with a copy of the history up to that point, the signals received
+  // after it are reapplied and the decision is retried, and the current run is terminated with the reset reason.
+  // 
+  // 
+  // Parameters:
+  //  - ResetRequest
+  ResetWorkflowExecution(resetRequest *shared.ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error)
   // ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
   // 
   // 
@@ -197,6 +258,51 @@ type WorkflowService interface {  //WorkflowService API is exposed to provide su
   // Parameters:
   //  - ListRequest
   ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (r *shared.ListClosedWorkflowExecutionsResponse, err error)
+  // ListWorkflowExecutions is a visibility API to list the open and closed executions in a specific domain which
+  // match a query.  The query is a SQL like WHERE clause over WorkflowId, WorkflowType, CloseStatus, ExecutionStatus,
+  // StartTime, CloseTime and the search attributes of the executions, e.g.
+  // WorkflowType = 'orderProcessing' AND CloseStatus = 'FAILED' AND StartTime > '2018-01-01T00:00:00Z'
+  // 
+  // 
+  // Parameters:
+  //  - ListRequest
+  ListWorkflowExecutions(listRequest *shared.ListWorkflowExecutionsRequest) (r *shared.ListWorkflowExecutionsResponse, err error)
+  // CountWorkflowExecutions is a visibility API to count the open and closed executions in a specific domain which
+  // match a query.  The query has the same syntax as the query of ListWorkflowExecutions.
+  // 
+  // 
+  // Parameters:
+  //  - CountRequest
+  CountWorkflowExecutions(countRequest *shared.CountWorkflowExecutionsRequest) (r *shared.CountWorkflowExecutionsResponse, err error)
+  // RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)
+  // as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'
+  // API and return the query result to client as a response to 'QueryWorkflow' API call.
+  // 
+  // 
+  // Parameters:
+  //  - CompleteRequest
+  RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) (err error)
+  // QueryWorkflow returns query result for a specified workflow execution.  The query is dispatched as a QueryTask to a
+  // worker polling on the decision task list of the workflow, and the call blocks until the worker answers it.
+  // 
+  // 
+  // Parameters:
+  //  - QueryRequest
+  QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error)
+  // DescribeWorkflowExecution returns information about the specified workflow execution, including its configuration
+  // and what it is currently waiting on: pending activities, timers, child executions and the outstanding decision.
+  // 
+  // 
+  // Parameters:
+  //  - DescribeRequest
+  DescribeWorkflowExecution(describeRequest *shared.DescribeWorkflowExecutionRequest) (r *shared.DescribeWorkflowExecutionResponse, err error)
+  // DescribeTaskList returns the pollers which polled the target task list in the last few minutes, along with its ack
+  // level, read levels and approximate backlog.
+  // 
+  // 
+  // Parameters:
+  //  - Request
+  DescribeTaskList(request *shared.DescribeTaskListRequest) (r *shared.DescribeTaskListResponse, err error)
 }
 
 //WorkflowService API is exposed to provide support for long running applications.  Application is expected to call
@@ -678,13 +784,19 @@ func (p *WorkflowServiceClient) recvStartWorkflowExecution() (value *shared.Star
   } else   if result.SessionAlreadyExistError != nil {
     err = result.SessionAlreadyExistError
     return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
   }
   value = result.GetSuccess()
   return
 }
 
 // Returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
-// execution in unknown to the service.
+// execution in unknown to the service.  When waitForNewEvent is set, the call long polls for events past the end of
+// the history read so far, and the returned nextPageToken can be used to wait for more events until the execution
+// closes.  The CLOSE_EVENT filter only returns the close event of the execution, waiting for it when
+// waitForNewEvent is set.
 // 
 // 
 // Parameters:
@@ -858,6 +970,9 @@ func (p *WorkflowServiceClient) recvPollForDecisionTask() (value *shared.PollFor
   } else   if result.InternalServiceError != nil {
     err = result.InternalServiceError
     return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
   }
   value = result.GetSuccess()
   return
@@ -955,34 +1070,31 @@ func (p *WorkflowServiceClient) recvRespondDecisionTaskCompleted() (err error) {
   return
 }
 
-// PollForActivityTask is called by application worker to process ActivityTask from a specific taskList.  ActivityTask
-// is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
-// Application is expected to call 'RespondActivityTaskCompleted' or 'RespondActivityTaskFailed' once it is done
-// processing the task.
-// Application also needs to call 'RecordActivityTaskHeartbeat' API within 'heartbeatTimeoutSeconds' interval to
-// prevent the task from getting timed out.  An event 'ActivityTaskStarted' event is also written to workflow execution
-// history before the ActivityTask is dispatched to application worker.
+// RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in
+// DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to
+// report any failures like non-determinism or panics during DecisionTask processing.  Use the 'taskToken' provided as
+// response of PollForDecisionTask API call for failing the DecisionTask.
 // 
 // 
 // Parameters:
-//  - PollRequest
-func (p *WorkflowServiceClient) PollForActivityTask(pollRequest *shared.PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error) {
-  if err = p.sendPollForActivityTask(pollRequest); err != nil { return }
-  return p.recvPollForActivityTask()
+//  - FailedRequest
+func (p *WorkflowServiceClient) RespondDecisionTaskFailed(failedRequest *shared.RespondDecisionTaskFailedRequest) (err error) {
+  if err = p.sendRespondDecisionTaskFailed(failedRequest); err != nil { return }
+  return p.recvRespondDecisionTaskFailed()
 }
 
-func (p *WorkflowServiceClient) sendPollForActivityTask(pollRequest *shared.PollForActivityTaskRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondDecisionTaskFailed(failedRequest *shared.RespondDecisionTaskFailedRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("PollForActivityTask", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServicePollForActivityTaskArgs{
-  PollRequest : pollRequest,
+  args := WorkflowServiceRespondDecisionTaskFailedArgs{
+  FailedRequest : failedRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -994,7 +1106,7 @@ func (p *WorkflowServiceClient) sendPollForActivityTask(pollRequest *shared.Poll
 }
 
 
-func (p *WorkflowServiceClient) recvPollForActivityTask() (value *shared.PollForActivityTaskResponse, err error) {
+func (p *WorkflowServiceClient) recvRespondDecisionTaskFailed() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1004,12 +1116,12 @@ func (p *WorkflowServiceClient) recvPollForActivityTask() (value *shared.PollFor
   if err != nil {
     return
   }
-  if method != "PollForActivityTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForActivityTask failed: wrong method name")
+  if method != "RespondDecisionTaskFailed" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondDecisionTaskFailed failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForActivityTask failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondDecisionTaskFailed failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1026,10 +1138,10 @@ func (p *WorkflowServiceClient) recvPollForActivityTask() (value *shared.PollFor
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForActivityTask failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondDecisionTaskFailed failed: invalid message type")
     return
   }
-  result := WorkflowServicePollForActivityTaskResult{}
+  result := WorkflowServiceRespondDecisionTaskFailedResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1042,37 +1154,41 @@ func (p *WorkflowServiceClient) recvPollForActivityTask() (value *shared.PollFor
   } else   if result.InternalServiceError != nil {
     err = result.InternalServiceError
     return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
   }
-  value = result.GetSuccess()
   return
 }
 
-// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
-// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
-// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
-// fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for heartbeating.
+// PollForActivityTask is called by application worker to process ActivityTask from a specific taskList.  ActivityTask
+// is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
+// Application is expected to call 'RespondActivityTaskCompleted' or 'RespondActivityTaskFailed' once it is done
+// processing the task.
+// Application also needs to call 'RecordActivityTaskHeartbeat' API within 'heartbeatTimeoutSeconds' interval to
+// prevent the task from getting timed out.  An event 'ActivityTaskStarted' event is also written to workflow execution
+// history before the ActivityTask is dispatched to application worker.
 // 
 // 
 // Parameters:
-//  - HeartbeatRequest
-func (p *WorkflowServiceClient) RecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
-  if err = p.sendRecordActivityTaskHeartbeat(heartbeatRequest); err != nil { return }
-  return p.recvRecordActivityTaskHeartbeat()
+//  - PollRequest
+func (p *WorkflowServiceClient) PollForActivityTask(pollRequest *shared.PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error) {
+  if err = p.sendPollForActivityTask(pollRequest); err != nil { return }
+  return p.recvPollForActivityTask()
 }
 
-func (p *WorkflowServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest)(err error) {
+func (p *WorkflowServiceClient) sendPollForActivityTask(pollRequest *shared.PollForActivityTaskRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("PollForActivityTask", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{
-  HeartbeatRequest : heartbeatRequest,
+  args := WorkflowServicePollForActivityTaskArgs{
+  PollRequest : pollRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1084,7 +1200,7 @@ func (p *WorkflowServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
+func (p *WorkflowServiceClient) recvPollForActivityTask() (value *shared.PollForActivityTaskResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1094,12 +1210,12 @@ func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeat() (value *shared
   if err != nil {
     return
   }
-  if method != "RecordActivityTaskHeartbeat" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeat failed: wrong method name")
+  if method != "PollForActivityTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForActivityTask failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeat failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForActivityTask failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1116,10 +1232,10 @@ func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeat() (value *shared
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeat failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForActivityTask failed: invalid message type")
     return
   }
-  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
+  result := WorkflowServicePollForActivityTaskResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1132,40 +1248,40 @@ func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeat() (value *shared
   } else   if result.InternalServiceError != nil {
     err = result.InternalServiceError
     return 
-  } else   if result.EntityNotExistError != nil {
-    err = result.EntityNotExistError
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
     return 
   }
   value = result.GetSuccess()
   return
 }
 
-// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
-// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
-// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
+// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
+// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
+// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
+// fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for heartbeating.
 // 
 // 
 // Parameters:
-//  - CompleteRequest
-func (p *WorkflowServiceClient) RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) (err error) {
-  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
-  return p.recvRespondActivityTaskCompleted()
+//  - HeartbeatRequest
+func (p *WorkflowServiceClient) RecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
+  if err = p.sendRecordActivityTaskHeartbeat(heartbeatRequest); err != nil { return }
+  return p.recvRecordActivityTaskHeartbeat()
 }
 
-func (p *WorkflowServiceClient) sendRespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest)(err error) {
+func (p *WorkflowServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRespondActivityTaskCompletedArgs{
-  CompleteRequest : completeRequest,
+  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{
+  HeartbeatRequest : heartbeatRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1177,7 +1293,7 @@ func (p *WorkflowServiceClient) sendRespondActivityTaskCompleted(completeRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
+func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1187,12 +1303,12 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
   if err != nil {
     return
   }
-  if method != "RespondActivityTaskCompleted" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompleted failed: wrong method name")
+  if method != "RecordActivityTaskHeartbeat" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeat failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompleted failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeat failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1209,10 +1325,10 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompleted failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeat failed: invalid message type")
     return
   }
-  result := WorkflowServiceRespondActivityTaskCompletedResult{}
+  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1228,36 +1344,38 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
   }
+  value = result.GetSuccess()
   return
 }
 
-// RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
-// result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
+// RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  It is
+// same as 'RecordActivityTaskHeartbeat' but identifies the ActivityTask by the domain, workflowId, runId and
+// activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
 // 
 // 
 // Parameters:
-//  - FailRequest
-func (p *WorkflowServiceClient) RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) (err error) {
-  if err = p.sendRespondActivityTaskFailed(failRequest); err != nil { return }
-  return p.recvRespondActivityTaskFailed()
+//  - HeartbeatRequest
+func (p *WorkflowServiceClient) RecordActivityTaskHeartbeatByID(heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
+  if err = p.sendRecordActivityTaskHeartbeatByID(heartbeatRequest); err != nil { return }
+  return p.recvRecordActivityTaskHeartbeatByID()
 }
 
-func (p *WorkflowServiceClient) sendRespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest)(err error) {
+func (p *WorkflowServiceClient) sendRecordActivityTaskHeartbeatByID(heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRespondActivityTaskFailedArgs{
-  FailRequest : failRequest,
+  args := WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{
+  HeartbeatRequest : heartbeatRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1269,7 +1387,7 @@ func (p *WorkflowServiceClient) sendRespondActivityTaskFailed(failRequest *share
 }
 
 
-func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
+func (p *WorkflowServiceClient) recvRecordActivityTaskHeartbeatByID() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1279,12 +1397,12 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
   if err != nil {
     return
   }
-  if method != "RespondActivityTaskFailed" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailed failed: wrong method name")
+  if method != "RecordActivityTaskHeartbeatByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeatByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailed failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeatByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1301,10 +1419,10 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailed failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeatByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceRespondActivityTaskFailedResult{}
+  result := WorkflowServiceRecordActivityTaskHeartbeatByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1320,36 +1438,40 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
   } else   if result.EntityNotExistError != nil {
     err = result.EntityNotExistError
     return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
   }
+  value = result.GetSuccess()
   return
 }
 
-// RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
-// result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
+// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
 // PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
 // anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - CanceledRequest
-func (p *WorkflowServiceClient) RespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest) (err error) {
-  if err = p.sendRespondActivityTaskCanceled(canceledRequest); err != nil { return }
-  return p.recvRespondActivityTaskCanceled()
+//  - CompleteRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) (err error) {
+  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondActivityTaskCompleted()
 }
 
-func (p *WorkflowServiceClient) sendRespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRespondActivityTaskCanceledArgs{
-  CanceledRequest : canceledRequest,
+  args := WorkflowServiceRespondActivityTaskCompletedArgs{
+  CompleteRequest : completeRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1361,7 +1483,7 @@ func (p *WorkflowServiceClient) sendRespondActivityTaskCanceled(canceledRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCompleted() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1371,12 +1493,12 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
   if err != nil {
     return
   }
-  if method != "RespondActivityTaskCanceled" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceled failed: wrong method name")
+  if method != "RespondActivityTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompleted failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceled failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompleted failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1393,10 +1515,10 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceled failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompleted failed: invalid message type")
     return
   }
-  result := WorkflowServiceRespondActivityTaskCanceledResult{}
+  result := WorkflowServiceRespondActivityTaskCompletedResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1416,31 +1538,30 @@ func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
   return
 }
 
-// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
-// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
-// created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
-// anymore due to completion or doesn't exist.
+// RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.  It is
+// same as 'RespondActivityTaskCompleted' but identifies the ActivityTask by the domain, workflowId, runId and
+// activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
 // 
 // 
 // Parameters:
-//  - CancelRequest
-func (p *WorkflowServiceClient) RequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest) (err error) {
-  if err = p.sendRequestCancelWorkflowExecution(cancelRequest); err != nil { return }
-  return p.recvRequestCancelWorkflowExecution()
+//  - CompleteRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCompletedByID(completeRequest *shared.RespondActivityTaskCompletedByIDRequest) (err error) {
+  if err = p.sendRespondActivityTaskCompletedByID(completeRequest); err != nil { return }
+  return p.recvRespondActivityTaskCompletedByID()
 }
 
-func (p *WorkflowServiceClient) sendRequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCompletedByID(completeRequest *shared.RespondActivityTaskCompletedByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{
-  CancelRequest : cancelRequest,
+  args := WorkflowServiceRespondActivityTaskCompletedByIDArgs{
+  CompleteRequest : completeRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1452,7 +1573,7 @@ func (p *WorkflowServiceClient) sendRequestCancelWorkflowExecution(cancelRequest
 }
 
 
-func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCompletedByID() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1462,12 +1583,12 @@ func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error)
   if err != nil {
     return
   }
-  if method != "RequestCancelWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RequestCancelWorkflowExecution failed: wrong method name")
+  if method != "RespondActivityTaskCompletedByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCompletedByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RequestCancelWorkflowExecution failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCompletedByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1484,10 +1605,10 @@ func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error)
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RequestCancelWorkflowExecution failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCompletedByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskCompletedByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1507,29 +1628,32 @@ func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error)
   return
 }
 
-// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
-// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+// RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
+// result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - SignalRequest
-func (p *WorkflowServiceClient) SignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest) (err error) {
-  if err = p.sendSignalWorkflowExecution(signalRequest); err != nil { return }
-  return p.recvSignalWorkflowExecution()
+//  - FailRequest
+func (p *WorkflowServiceClient) RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) (err error) {
+  if err = p.sendRespondActivityTaskFailed(failRequest); err != nil { return }
+  return p.recvRespondActivityTaskFailed()
 }
 
-func (p *WorkflowServiceClient) sendSignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceSignalWorkflowExecutionArgs{
-  SignalRequest : signalRequest,
+  args := WorkflowServiceRespondActivityTaskFailedArgs{
+  FailRequest : failRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1541,7 +1665,7 @@ func (p *WorkflowServiceClient) sendSignalWorkflowExecution(signalRequest *share
 }
 
 
-func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskFailed() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1551,12 +1675,12 @@ func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
   if err != nil {
     return
   }
-  if method != "SignalWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWorkflowExecution failed: wrong method name")
+  if method != "RespondActivityTaskFailed" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailed failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWorkflowExecution failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailed failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1573,10 +1697,10 @@ func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWorkflowExecution failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailed failed: invalid message type")
     return
   }
-  result := WorkflowServiceSignalWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskFailedResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1596,29 +1720,30 @@ func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
   return
 }
 
-// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
-// in the history and immediately terminating the execution instance.
+// RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.  It is
+// same as 'RespondActivityTaskFailed' but identifies the ActivityTask by the domain, workflowId, runId and
+// activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
 // 
 // 
 // Parameters:
-//  - TerminateRequest
-func (p *WorkflowServiceClient) TerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest) (err error) {
-  if err = p.sendTerminateWorkflowExecution(terminateRequest); err != nil { return }
-  return p.recvTerminateWorkflowExecution()
+//  - FailRequest
+func (p *WorkflowServiceClient) RespondActivityTaskFailedByID(failRequest *shared.RespondActivityTaskFailedByIDRequest) (err error) {
+  if err = p.sendRespondActivityTaskFailedByID(failRequest); err != nil { return }
+  return p.recvRespondActivityTaskFailedByID()
 }
 
-func (p *WorkflowServiceClient) sendTerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskFailedByID(failRequest *shared.RespondActivityTaskFailedByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceTerminateWorkflowExecutionArgs{
-  TerminateRequest : terminateRequest,
+  args := WorkflowServiceRespondActivityTaskFailedByIDArgs{
+  FailRequest : failRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1630,7 +1755,7 @@ func (p *WorkflowServiceClient) sendTerminateWorkflowExecution(terminateRequest
 }
 
 
-func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskFailedByID() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1640,12 +1765,12 @@ func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
   if err != nil {
     return
   }
-  if method != "TerminateWorkflowExecution" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "TerminateWorkflowExecution failed: wrong method name")
+  if method != "RespondActivityTaskFailedByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskFailedByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "TerminateWorkflowExecution failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskFailedByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1662,10 +1787,10 @@ func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "TerminateWorkflowExecution failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskFailedByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  result := WorkflowServiceRespondActivityTaskFailedByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1685,28 +1810,32 @@ func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
   return
 }
 
-// ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
+// RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
+// result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
 // 
 // 
 // Parameters:
-//  - ListRequest
-func (p *WorkflowServiceClient) ListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest) (r *shared.ListOpenWorkflowExecutionsResponse, err error) {
-  if err = p.sendListOpenWorkflowExecutions(listRequest); err != nil { return }
-  return p.recvListOpenWorkflowExecutions()
+//  - CanceledRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest) (err error) {
+  if err = p.sendRespondActivityTaskCanceled(canceledRequest); err != nil { return }
+  return p.recvRespondActivityTaskCanceled()
 }
 
-func (p *WorkflowServiceClient) sendListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCanceled(canceledRequest *shared.RespondActivityTaskCanceledRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceListOpenWorkflowExecutionsArgs{
-  ListRequest : listRequest,
+  args := WorkflowServiceRespondActivityTaskCanceledArgs{
+  CanceledRequest : canceledRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1718,7 +1847,7 @@ func (p *WorkflowServiceClient) sendListOpenWorkflowExecutions(listRequest *shar
 }
 
 
-func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.ListOpenWorkflowExecutionsResponse, err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCanceled() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1728,12 +1857,12 @@ func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.
   if err != nil {
     return
   }
-  if method != "ListOpenWorkflowExecutions" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListOpenWorkflowExecutions failed: wrong method name")
+  if method != "RespondActivityTaskCanceled" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceled failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListOpenWorkflowExecutions failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceled failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1750,10 +1879,10 @@ func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListOpenWorkflowExecutions failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceled failed: invalid message type")
     return
   }
-  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
+  result := WorkflowServiceRespondActivityTaskCanceledResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1770,32 +1899,33 @@ func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.
     err = result.EntityNotExistError
     return 
   }
-  value = result.GetSuccess()
   return
 }
 
-// ListClosedWorkflowExecutions is a visibility API to list the closed executions in a specific domain.
+// RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.
+// It is same as 'RespondActivityTaskCanceled' but identifies the ActivityTask by the domain, workflowId, runId and
+// activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
 // 
 // 
 // Parameters:
-//  - ListRequest
-func (p *WorkflowServiceClient) ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (r *shared.ListClosedWorkflowExecutionsResponse, err error) {
-  if err = p.sendListClosedWorkflowExecutions(listRequest); err != nil { return }
-  return p.recvListClosedWorkflowExecutions()
+//  - CanceledRequest
+func (p *WorkflowServiceClient) RespondActivityTaskCanceledByID(canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) (err error) {
+  if err = p.sendRespondActivityTaskCanceledByID(canceledRequest); err != nil { return }
+  return p.recvRespondActivityTaskCanceledByID()
 }
 
-func (p *WorkflowServiceClient) sendListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest)(err error) {
+func (p *WorkflowServiceClient) sendRespondActivityTaskCanceledByID(canceledRequest *shared.RespondActivityTaskCanceledByIDRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := WorkflowServiceListClosedWorkflowExecutionsArgs{
-  ListRequest : listRequest,
+  args := WorkflowServiceRespondActivityTaskCanceledByIDArgs{
+  CanceledRequest : canceledRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -1807,7 +1937,7 @@ func (p *WorkflowServiceClient) sendListClosedWorkflowExecutions(listRequest *sh
 }
 
 
-func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *shared.ListClosedWorkflowExecutionsResponse, err error) {
+func (p *WorkflowServiceClient) recvRespondActivityTaskCanceledByID() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -1817,12 +1947,12 @@ func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *share
   if err != nil {
     return
   }
-  if method != "ListClosedWorkflowExecutions" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListClosedWorkflowExecutions failed: wrong method name")
+  if method != "RespondActivityTaskCanceledByID" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondActivityTaskCanceledByID failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListClosedWorkflowExecutions failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondActivityTaskCanceledByID failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -1839,10 +1969,10 @@ func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *share
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListClosedWorkflowExecutions failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondActivityTaskCanceledByID failed: invalid message type")
     return
   }
-  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
+  result := WorkflowServiceRespondActivityTaskCanceledByIDResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -1859,973 +1989,1351 @@ func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *share
     err = result.EntityNotExistError
     return 
   }
-  value = result.GetSuccess()
   return
 }
 
-
-type WorkflowServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler WorkflowService
-}
-
-func (p *WorkflowServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
-}
-
-func (p *WorkflowServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
-}
-
-func (p *WorkflowServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
-}
-
-func NewWorkflowServiceProcessor(handler WorkflowService) *WorkflowServiceProcessor {
-
-  self36 := &WorkflowServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self36.processorMap["RegisterDomain"] = &workflowServiceProcessorRegisterDomain{handler:handler}
-  self36.processorMap["DescribeDomain"] = &workflowServiceProcessorDescribeDomain{handler:handler}
-  self36.processorMap["UpdateDomain"] = &workflowServiceProcessorUpdateDomain{handler:handler}
-  self36.processorMap["DeprecateDomain"] = &workflowServiceProcessorDeprecateDomain{handler:handler}
-  self36.processorMap["StartWorkflowExecution"] = &workflowServiceProcessorStartWorkflowExecution{handler:handler}
-  self36.processorMap["GetWorkflowExecutionHistory"] = &workflowServiceProcessorGetWorkflowExecutionHistory{handler:handler}
-  self36.processorMap["PollForDecisionTask"] = &workflowServiceProcessorPollForDecisionTask{handler:handler}
-  self36.processorMap["RespondDecisionTaskCompleted"] = &workflowServiceProcessorRespondDecisionTaskCompleted{handler:handler}
-  self36.processorMap["PollForActivityTask"] = &workflowServiceProcessorPollForActivityTask{handler:handler}
-  self36.processorMap["RecordActivityTaskHeartbeat"] = &workflowServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
-  self36.processorMap["RespondActivityTaskCompleted"] = &workflowServiceProcessorRespondActivityTaskCompleted{handler:handler}
-  self36.processorMap["RespondActivityTaskFailed"] = &workflowServiceProcessorRespondActivityTaskFailed{handler:handler}
-  self36.processorMap["RespondActivityTaskCanceled"] = &workflowServiceProcessorRespondActivityTaskCanceled{handler:handler}
-  self36.processorMap["RequestCancelWorkflowExecution"] = &workflowServiceProcessorRequestCancelWorkflowExecution{handler:handler}
-  self36.processorMap["SignalWorkflowExecution"] = &workflowServiceProcessorSignalWorkflowExecution{handler:handler}
-  self36.processorMap["TerminateWorkflowExecution"] = &workflowServiceProcessorTerminateWorkflowExecution{handler:handler}
-  self36.processorMap["ListOpenWorkflowExecutions"] = &workflowServiceProcessorListOpenWorkflowExecutions{handler:handler}
-  self36.processorMap["ListClosedWorkflowExecutions"] = &workflowServiceProcessorListClosedWorkflowExecutions{handler:handler}
-return self36
+// RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
+// It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
+// created for the workflow instance so new decisions could be made. It fails with 'EntityNotExistsError' if the workflow is not valid
+// anymore due to completion or doesn't exist.
+// 
+// 
+// Parameters:
+//  - CancelRequest
+func (p *WorkflowServiceClient) RequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest) (err error) {
+  if err = p.sendRequestCancelWorkflowExecution(cancelRequest); err != nil { return }
+  return p.recvRequestCancelWorkflowExecution()
 }
 
-func (p *WorkflowServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err := iprot.ReadMessageBegin()
-  if err != nil { return false, err }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(seqId, iprot, oprot)
+func (p *WorkflowServiceClient) sendRequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  iprot.Skip(thrift.STRUCT)
-  iprot.ReadMessageEnd()
-  x37 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x37.Write(oprot)
-  oprot.WriteMessageEnd()
-  oprot.Flush()
-  return false, x37
-
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{
+  CancelRequest : cancelRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorRegisterDomain struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorRegisterDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRegisterDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvRequestCancelWorkflowExecution() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRegisterDomainResult{}
-  var err2 error
-  if err2 = p.handler.RegisterDomain(args.RegisterRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.DomainAlreadyExistsError:
-  result.DomainExistsError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegisterDomain: " + err2.Error())
-    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "RequestCancelWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RequestCancelWorkflowExecution failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RegisterDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RequestCancelWorkflowExecution failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error36 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error37 error
+    error37, err = error36.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error37
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RequestCancelWorkflowExecution failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
 }
 
-type workflowServiceProcessorDescribeDomain struct {
-  handler WorkflowService
+// SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
+// WorkflowExecutionSignaled event recorded in the history and a decision task being created for the execution.
+// 
+// 
+// Parameters:
+//  - SignalRequest
+func (p *WorkflowServiceClient) SignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest) (err error) {
+  if err = p.sendSignalWorkflowExecution(signalRequest); err != nil { return }
+  return p.recvSignalWorkflowExecution()
 }
 
-func (p *workflowServiceProcessorDescribeDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceDescribeDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendSignalWorkflowExecution(signalRequest *shared.SignalWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceDescribeDomainResult{}
-var retval *shared.DescribeDomainResponse
-  var err2 error
-  if retval, err2 = p.handler.DescribeDomain(args.DescribeRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeDomain: " + err2.Error())
-    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("DescribeDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceSignalWorkflowExecutionArgs{
+  SignalRequest : signalRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorUpdateDomain struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorUpdateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceUpdateDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvSignalWorkflowExecution() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceUpdateDomainResult{}
-var retval *shared.UpdateDomainResponse
-  var err2 error
-  if retval, err2 = p.handler.UpdateDomain(args.UpdateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateDomain: " + err2.Error())
-    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("UpdateDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "SignalWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWorkflowExecution failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWorkflowExecution failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error38 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error39 error
+    error39, err = error38.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error39
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWorkflowExecution failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceSignalWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
 }
 
-type workflowServiceProcessorDeprecateDomain struct {
-  handler WorkflowService
+// SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+// is running, this results in WorkflowExecutionSignaled event recorded in the history and a decision task being
+// created for the execution.  If the workflow is not running, a new workflow execution is started with the
+// WorkflowExecutionSignaled event recorded right after its WorkflowExecutionStarted event.
+// 
+// 
+// Parameters:
+//  - SignalWithStartRequest
+func (p *WorkflowServiceClient) SignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error) {
+  if err = p.sendSignalWithStartWorkflowExecution(signalWithStartRequest); err != nil { return }
+  return p.recvSignalWithStartWorkflowExecution()
 }
 
-func (p *workflowServiceProcessorDeprecateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceDeprecateDomainArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceDeprecateDomainResult{}
-  var err2 error
-  if err2 = p.handler.DeprecateDomain(args.DeprecateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeprecateDomain: " + err2.Error())
-    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("DeprecateDomain", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendSignalWithStartWorkflowExecution(signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{
+  SignalWithStartRequest : signalWithStartRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorStartWorkflowExecution struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceStartWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvSignalWithStartWorkflowExecution() (value *shared.StartWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceStartWorkflowExecutionResult{}
-var retval *shared.StartWorkflowExecutionResponse
-  var err2 error
-  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.WorkflowExecutionAlreadyStartedError:
-  result.SessionAlreadyExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "SignalWithStartWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWithStartWorkflowExecution failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWithStartWorkflowExecution failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error40 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error41 error
+    error41, err = error40.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error41
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWithStartWorkflowExecution failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceSignalWithStartWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorGetWorkflowExecutionHistory struct {
-  handler WorkflowService
+// TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
+// in the history and immediately terminating the execution instance.
+// 
+// 
+// Parameters:
+//  - TerminateRequest
+func (p *WorkflowServiceClient) TerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest) (err error) {
+  if err = p.sendTerminateWorkflowExecution(terminateRequest); err != nil { return }
+  return p.recvTerminateWorkflowExecution()
 }
 
-func (p *workflowServiceProcessorGetWorkflowExecutionHistory) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceGetWorkflowExecutionHistoryArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceGetWorkflowExecutionHistoryResult{}
-var retval *shared.GetWorkflowExecutionHistoryResponse
-  var err2 error
-  if retval, err2 = p.handler.GetWorkflowExecutionHistory(args.GetRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionHistory: " + err2.Error())
-    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendTerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceTerminateWorkflowExecutionArgs{
+  TerminateRequest : terminateRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorPollForDecisionTask struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServicePollForDecisionTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvTerminateWorkflowExecution() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServicePollForDecisionTaskResult{}
-var retval *shared.PollForDecisionTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "TerminateWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "TerminateWorkflowExecution failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "TerminateWorkflowExecution failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error42 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error43 error
+    error43, err = error42.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error43
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "TerminateWorkflowExecution failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
 }
 
-type workflowServiceProcessorRespondDecisionTaskCompleted struct {
-  handler WorkflowService
+// ResetWorkflowExecution resets an existing workflow execution to the point right before the given
+// DecisionTaskCompleted event.  A new run is started with a copy of the history up to that point, the signals received
+// after it are reapplied and the decision is retried, and the current run is terminated with the reset reason.
+// 
+// 
+// Parameters:
+//  - ResetRequest
+func (p *WorkflowServiceClient) ResetWorkflowExecution(resetRequest *shared.ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error) {
+  if err = p.sendResetWorkflowExecution(resetRequest); err != nil { return }
+  return p.recvResetWorkflowExecution()
 }
 
-func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondDecisionTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondDecisionTaskCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+func (p *WorkflowServiceClient) sendResetWorkflowExecution(resetRequest *shared.ResetWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  args := WorkflowServiceResetWorkflowExecutionArgs{
+  ResetRequest : resetRequest,
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvResetWorkflowExecution() (value *shared.ResetWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
   if err != nil {
     return
   }
-  return true, err
-}
-
-type workflowServiceProcessorPollForActivityTask struct {
-  handler WorkflowService
-}
-
-func (p *workflowServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServicePollForActivityTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+  if method != "ResetWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ResetWorkflowExecution failed: wrong method name")
+    return
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServicePollForActivityTaskResult{}
-var retval *shared.PollForActivityTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ResetWorkflowExecution failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error44 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error45 error
+    error45, err = error44.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error45
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ResetWorkflowExecution failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceResetWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorRecordActivityTaskHeartbeat struct {
-  handler WorkflowService
+// ListOpenWorkflowExecutions is a visibility API to list the open executions in a specific domain.
+// 
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) ListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest) (r *shared.ListOpenWorkflowExecutionsResponse, err error) {
+  if err = p.sendListOpenWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvListOpenWorkflowExecutions()
 }
 
-func (p *workflowServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
-var retval *shared.RecordActivityTaskHeartbeatResponse
-  var err2 error
-  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
-    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceListOpenWorkflowExecutionsArgs{
+  ListRequest : listRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorRespondActivityTaskCompleted struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskCompletedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvListOpenWorkflowExecutions() (value *shared.ListOpenWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskCompletedResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "ListOpenWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListOpenWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListOpenWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error46 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error47 error
+    error47, err = error46.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error47
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListOpenWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorRespondActivityTaskFailed struct {
-  handler WorkflowService
+// ListClosedWorkflowExecutions is a visibility API to list the closed executions in a specific domain.
+// 
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (r *shared.ListClosedWorkflowExecutionsResponse, err error) {
+  if err = p.sendListClosedWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvListClosedWorkflowExecutions()
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskFailedArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceListClosedWorkflowExecutionsArgs{
+  ListRequest : listRequest,
   }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskFailedResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+
+func (p *WorkflowServiceClient) recvListClosedWorkflowExecutions() (value *shared.ListClosedWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "ListClosedWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListClosedWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListClosedWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error48 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error49 error
+    error49, err = error48.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error49
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListClosedWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err != nil {
+  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
     return
   }
-  return true, err
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorRespondActivityTaskCanceled struct {
-  handler WorkflowService
+// ListWorkflowExecutions is a visibility API to list the open and closed executions in a specific domain which
+// match a query.  The query is a SQL like WHERE clause over WorkflowId, WorkflowType, CloseStatus, ExecutionStatus,
+// StartTime, CloseTime and the search attributes of the executions, e.g.
+// WorkflowType = 'orderProcessing' AND CloseStatus = 'FAILED' AND StartTime > '2018-01-01T00:00:00Z'
+// 
+// 
+// Parameters:
+//  - ListRequest
+func (p *WorkflowServiceClient) ListWorkflowExecutions(listRequest *shared.ListWorkflowExecutionsRequest) (r *shared.ListWorkflowExecutionsResponse, err error) {
+  if err = p.sendListWorkflowExecutions(listRequest); err != nil { return }
+  return p.recvListWorkflowExecutions()
 }
 
-func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRespondActivityTaskCanceledArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRespondActivityTaskCanceledResult{}
-  var err2 error
-  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
-    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+func (p *WorkflowServiceClient) sendListWorkflowExecutions(listRequest *shared.ListWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ListWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  args := WorkflowServiceListWorkflowExecutionsArgs{
+  ListRequest : listRequest,
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err != nil {
-    return
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  return true, err
+  return oprot.Flush()
 }
 
-type workflowServiceProcessorRequestCancelWorkflowExecution struct {
-  handler WorkflowService
-}
 
-func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) recvListWorkflowExecutions() (value *shared.ListWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.RequestCancelWorkflowExecution(args.CancelRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
+  if method != "ListWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ListWorkflowExecutions failed: wrong method name")
+    return
   }
-  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ListWorkflowExecutions failed: out of sequence response")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error50 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error51 error
+    error51, err = error50.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error51
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ListWorkflowExecutions failed: invalid message type")
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  result := WorkflowServiceListWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
+    return
   }
-  if err != nil {
+  if err = iprot.ReadMessageEnd(); err != nil {
     return
   }
-  return true, err
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorSignalWorkflowExecution struct {
-  handler WorkflowService
+// CountWorkflowExecutions is a visibility API to count the open and closed executions in a specific domain which
+// match a query.  The query has the same syntax as the query of ListWorkflowExecutions.
+// 
+// 
+// Parameters:
+//  - CountRequest
+func (p *WorkflowServiceClient) CountWorkflowExecutions(countRequest *shared.CountWorkflowExecutionsRequest) (r *shared.CountWorkflowExecutionsResponse, err error) {
+  if err = p.sendCountWorkflowExecutions(countRequest); err != nil { return }
+  return p.recvCountWorkflowExecutions()
 }
 
-func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceSignalWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceSignalWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+func (p *WorkflowServiceClient) sendCountWorkflowExecutions(countRequest *shared.CountWorkflowExecutionsRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("CountWorkflowExecutions", thrift.CALL, p.SeqId); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  args := WorkflowServiceCountWorkflowExecutionsArgs{
+  CountRequest : countRequest,
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err = args.Write(oprot); err != nil {
+      return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvCountWorkflowExecutions() (value *shared.CountWorkflowExecutionsResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
   if err != nil {
     return
   }
-  return true, err
+  if method != "CountWorkflowExecutions" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "CountWorkflowExecutions failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "CountWorkflowExecutions failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error52 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error53 error
+    error53, err = error52.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error53
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "CountWorkflowExecutions failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceCountWorkflowExecutionsResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorTerminateWorkflowExecution struct {
-  handler WorkflowService
+// RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)
+// as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'
+// API and return the query result to client as a response to 'QueryWorkflow' API call.
+// 
+// 
+// Parameters:
+//  - CompleteRequest
+func (p *WorkflowServiceClient) RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) (err error) {
+  if err = p.sendRespondQueryTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondQueryTaskCompleted()
 }
 
-func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceTerminateWorkflowExecutionArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendRespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceRespondQueryTaskCompletedArgs{
+  CompleteRequest : completeRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
   }
+  return oprot.Flush()
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceTerminateWorkflowExecutionResult{}
-  var err2 error
-  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
-    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+
+func (p *WorkflowServiceClient) recvRespondQueryTaskCompleted() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
   }
-  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if method != "RespondQueryTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondQueryTaskCompleted failed: wrong method name")
+    return
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondQueryTaskCompleted failed: out of sequence response")
+    return
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId == thrift.EXCEPTION {
+    error54 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error55 error
+    error55, err = error54.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error55
+    return
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondQueryTaskCompleted failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceRespondQueryTaskCompletedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  return
+}
+
+// QueryWorkflow returns query result for a specified workflow execution.  The query is dispatched as a QueryTask to a
+// worker polling on the decision task list of the workflow, and the call blocks until the worker answers it.
+// 
+// 
+// Parameters:
+//  - QueryRequest
+func (p *WorkflowServiceClient) QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error) {
+  if err = p.sendQueryWorkflow(queryRequest); err != nil { return }
+  return p.recvQueryWorkflow()
+}
+
+func (p *WorkflowServiceClient) sendQueryWorkflow(queryRequest *shared.QueryWorkflowRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
   }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("QueryWorkflow", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceQueryWorkflowArgs{
+  QueryRequest : queryRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflowResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
   if err != nil {
     return
   }
-  return true, err
+  if method != "QueryWorkflow" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "QueryWorkflow failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "QueryWorkflow failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error56 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error57 error
+    error57, err = error56.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error57
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "QueryWorkflow failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceQueryWorkflowResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.QueryFailedError != nil {
+    err = result.QueryFailedError
+    return 
+  }
+  value = result.GetSuccess()
+  return
 }
 
-type workflowServiceProcessorListOpenWorkflowExecutions struct {
-  handler WorkflowService
+// DescribeWorkflowExecution returns information about the specified workflow execution, including its configuration
+// and what it is currently waiting on: pending activities, timers, child executions and the outstanding decision.
+// 
+// 
+// Parameters:
+//  - DescribeRequest
+func (p *WorkflowServiceClient) DescribeWorkflowExecution(describeRequest *shared.DescribeWorkflowExecutionRequest) (r *shared.DescribeWorkflowExecutionResponse, err error) {
+  if err = p.sendDescribeWorkflowExecution(describeRequest); err != nil { return }
+  return p.recvDescribeWorkflowExecution()
 }
 
-func (p *workflowServiceProcessorListOpenWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceListOpenWorkflowExecutionsArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceClient) sendDescribeWorkflowExecution(describeRequest *shared.DescribeWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
   }
+  args := WorkflowServiceDescribeWorkflowExecutionArgs{
+  DescribeRequest : describeRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
-var retval *shared.ListOpenWorkflowExecutionsResponse
-  var err2 error
-  if retval, err2 = p.handler.ListOpenWorkflowExecutions(args.ListRequest); err2 != nil {
-  switch v := err2.(type) {
+
+func (p *WorkflowServiceClient) recvDescribeWorkflowExecution() (value *shared.DescribeWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "DescribeWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "DescribeWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "DescribeWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error58 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error59 error
+    error59, err = error58.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error59
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "DescribeWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceDescribeWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// DescribeTaskList returns the pollers which polled the target task list in the last few minutes, along with its ack
+// level, read levels and approximate backlog.
+// 
+// 
+// Parameters:
+//  - Request
+func (p *WorkflowServiceClient) DescribeTaskList(request *shared.DescribeTaskListRequest) (r *shared.DescribeTaskListResponse, err error) {
+  if err = p.sendDescribeTaskList(request); err != nil { return }
+  return p.recvDescribeTaskList()
+}
+
+func (p *WorkflowServiceClient) sendDescribeTaskList(request *shared.DescribeTaskListRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("DescribeTaskList", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := WorkflowServiceDescribeTaskListArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *WorkflowServiceClient) recvDescribeTaskList() (value *shared.DescribeTaskListResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "DescribeTaskList" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "DescribeTaskList failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "DescribeTaskList failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error60 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error61 error
+    error61, err = error60.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error61
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "DescribeTaskList failed: invalid message type")
+    return
+  }
+  result := WorkflowServiceDescribeTaskListResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+
+type WorkflowServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler WorkflowService
+}
+
+func (p *WorkflowServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *WorkflowServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *WorkflowServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewWorkflowServiceProcessor(handler WorkflowService) *WorkflowServiceProcessor {
+
+  self62 := &WorkflowServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self62.processorMap["RegisterDomain"] = &workflowServiceProcessorRegisterDomain{handler:handler}
+  self62.processorMap["DescribeDomain"] = &workflowServiceProcessorDescribeDomain{handler:handler}
+  self62.processorMap["UpdateDomain"] = &workflowServiceProcessorUpdateDomain{handler:handler}
+  self62.processorMap["DeprecateDomain"] = &workflowServiceProcessorDeprecateDomain{handler:handler}
+  self62.processorMap["StartWorkflowExecution"] = &workflowServiceProcessorStartWorkflowExecution{handler:handler}
+  self62.processorMap["GetWorkflowExecutionHistory"] = &workflowServiceProcessorGetWorkflowExecutionHistory{handler:handler}
+  self62.processorMap["PollForDecisionTask"] = &workflowServiceProcessorPollForDecisionTask{handler:handler}
+  self62.processorMap["RespondDecisionTaskCompleted"] = &workflowServiceProcessorRespondDecisionTaskCompleted{handler:handler}
+  self62.processorMap["RespondDecisionTaskFailed"] = &workflowServiceProcessorRespondDecisionTaskFailed{handler:handler}
+  self62.processorMap["PollForActivityTask"] = &workflowServiceProcessorPollForActivityTask{handler:handler}
+  self62.processorMap["RecordActivityTaskHeartbeat"] = &workflowServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
+  self62.processorMap["RecordActivityTaskHeartbeatByID"] = &workflowServiceProcessorRecordActivityTaskHeartbeatByID{handler:handler}
+  self62.processorMap["RespondActivityTaskCompleted"] = &workflowServiceProcessorRespondActivityTaskCompleted{handler:handler}
+  self62.processorMap["RespondActivityTaskCompletedByID"] = &workflowServiceProcessorRespondActivityTaskCompletedByID{handler:handler}
+  self62.processorMap["RespondActivityTaskFailed"] = &workflowServiceProcessorRespondActivityTaskFailed{handler:handler}
+  self62.processorMap["RespondActivityTaskFailedByID"] = &workflowServiceProcessorRespondActivityTaskFailedByID{handler:handler}
+  self62.processorMap["RespondActivityTaskCanceled"] = &workflowServiceProcessorRespondActivityTaskCanceled{handler:handler}
+  self62.processorMap["RespondActivityTaskCanceledByID"] = &workflowServiceProcessorRespondActivityTaskCanceledByID{handler:handler}
+  self62.processorMap["RequestCancelWorkflowExecution"] = &workflowServiceProcessorRequestCancelWorkflowExecution{handler:handler}
+  self62.processorMap["SignalWorkflowExecution"] = &workflowServiceProcessorSignalWorkflowExecution{handler:handler}
+  self62.processorMap["SignalWithStartWorkflowExecution"] = &workflowServiceProcessorSignalWithStartWorkflowExecution{handler:handler}
+  self62.processorMap["TerminateWorkflowExecution"] = &workflowServiceProcessorTerminateWorkflowExecution{handler:handler}
+  self62.processorMap["ResetWorkflowExecution"] = &workflowServiceProcessorResetWorkflowExecution{handler:handler}
+  self62.processorMap["ListOpenWorkflowExecutions"] = &workflowServiceProcessorListOpenWorkflowExecutions{handler:handler}
+  self62.processorMap["ListClosedWorkflowExecutions"] = &workflowServiceProcessorListClosedWorkflowExecutions{handler:handler}
+  self62.processorMap["ListWorkflowExecutions"] = &workflowServiceProcessorListWorkflowExecutions{handler:handler}
+  self62.processorMap["CountWorkflowExecutions"] = &workflowServiceProcessorCountWorkflowExecutions{handler:handler}
+  self62.processorMap["RespondQueryTaskCompleted"] = &workflowServiceProcessorRespondQueryTaskCompleted{handler:handler}
+  self62.processorMap["QueryWorkflow"] = &workflowServiceProcessorQueryWorkflow{handler:handler}
+  self62.processorMap["DescribeWorkflowExecution"] = &workflowServiceProcessorDescribeWorkflowExecution{handler:handler}
+  self62.processorMap["DescribeTaskList"] = &workflowServiceProcessorDescribeTaskList{handler:handler}
+return self62
+}
+
+func (p *WorkflowServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err := iprot.ReadMessageBegin()
+  if err != nil { return false, err }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(seqId, iprot, oprot)
+  }
+  iprot.Skip(thrift.STRUCT)
+  iprot.ReadMessageEnd()
+  x63 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+  x63.Write(oprot)
+  oprot.WriteMessageEnd()
+  oprot.Flush()
+  return false, x63
+
+}
+
+type workflowServiceProcessorRegisterDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRegisterDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRegisterDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRegisterDomainResult{}
+  var err2 error
+  if err2 = p.handler.RegisterDomain(args.RegisterRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.DomainAlreadyExistsError:
+  result.DomainExistsError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RegisterDomain: " + err2.Error())
+    oprot.WriteMessageBegin("RegisterDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RegisterDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorDescribeDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorDescribeDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDescribeDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceDescribeDomainResult{}
+var retval *shared.DescribeDomainResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeDomain(args.DescribeRequest); err2 != nil {
+  switch v := err2.(type) {
     case *shared.BadRequestError:
   result.BadRequestError = v
     case *shared.InternalServiceError:
@@ -2833,116 +3341,5554 @@ var retval *shared.ListOpenWorkflowExecutionsResponse
     case *shared.EntityNotExistsError:
   result.EntityNotExistError = v
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOpenWorkflowExecutions: " + err2.Error())
-    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeDomain: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeDomain", thrift.EXCEPTION, seqId)
     x.Write(oprot)
     oprot.WriteMessageEnd()
     oprot.Flush()
     return true, err2
   }
-  } else {
-    result.Success = retval
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorUpdateDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorUpdateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceUpdateDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceUpdateDomainResult{}
+var retval *shared.UpdateDomainResponse
+  var err2 error
+  if retval, err2 = p.handler.UpdateDomain(args.UpdateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UpdateDomain: " + err2.Error())
+    oprot.WriteMessageBegin("UpdateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("UpdateDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorDeprecateDomain struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorDeprecateDomain) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDeprecateDomainArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceDeprecateDomainResult{}
+  var err2 error
+  if err2 = p.handler.DeprecateDomain(args.DeprecateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DeprecateDomain: " + err2.Error())
+    oprot.WriteMessageBegin("DeprecateDomain", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("DeprecateDomain", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorStartWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.StartWorkflowExecution(args.StartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing StartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("StartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("StartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorGetWorkflowExecutionHistory struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorGetWorkflowExecutionHistory) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceGetWorkflowExecutionHistoryResult{}
+var retval *shared.GetWorkflowExecutionHistoryResponse
+  var err2 error
+  if retval, err2 = p.handler.GetWorkflowExecutionHistory(args.GetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetWorkflowExecutionHistory: " + err2.Error())
+    oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetWorkflowExecutionHistory", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorPollForDecisionTask struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServicePollForDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServicePollForDecisionTaskResult{}
+var retval *shared.PollForDecisionTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondDecisionTaskCompleted struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondDecisionTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondDecisionTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondDecisionTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondDecisionTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondDecisionTaskFailed struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondDecisionTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondDecisionTaskFailedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondDecisionTaskFailedResult{}
+  var err2 error
+  if err2 = p.handler.RespondDecisionTaskFailed(args.FailedRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskFailed: " + err2.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorPollForActivityTask struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServicePollForActivityTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServicePollForActivityTaskResult{}
+var retval *shared.PollForActivityTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRecordActivityTaskHeartbeat struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRecordActivityTaskHeartbeat) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRecordActivityTaskHeartbeatResult{}
+var retval *shared.RecordActivityTaskHeartbeatResponse
+  var err2 error
+  if retval, err2 = p.handler.RecordActivityTaskHeartbeat(args.HeartbeatRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeat: " + err2.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRecordActivityTaskHeartbeatByID struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRecordActivityTaskHeartbeatByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRecordActivityTaskHeartbeatByIDResult{}
+var retval *shared.RecordActivityTaskHeartbeatResponse
+  var err2 error
+  if retval, err2 = p.handler.RecordActivityTaskHeartbeatByID(args.HeartbeatRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RecordActivityTaskHeartbeatByID: " + err2.Error())
+    oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("RecordActivityTaskHeartbeatByID", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCompleted struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCompletedByID struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCompletedByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCompletedByIDArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCompletedByIDResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCompletedByID(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCompletedByID: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCompletedByID", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskFailed struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskFailedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskFailedResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskFailed(args.FailRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailed: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailed", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskFailedByID struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskFailedByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskFailedByIDArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskFailedByIDResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskFailedByID(args.FailRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskFailedByID: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskFailedByID", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCanceled struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCanceled) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCanceledArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCanceledResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCanceled(args.CanceledRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceled: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceled", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondActivityTaskCanceledByID struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondActivityTaskCanceledByID) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondActivityTaskCanceledByIDArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondActivityTaskCanceledByIDResult{}
+  var err2 error
+  if err2 = p.handler.RespondActivityTaskCanceledByID(args.CanceledRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondActivityTaskCanceledByID: " + err2.Error())
+    oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondActivityTaskCanceledByID", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRequestCancelWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRequestCancelWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRequestCancelWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.RequestCancelWorkflowExecution(args.CancelRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RequestCancelWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RequestCancelWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorSignalWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorSignalWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceSignalWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceSignalWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.SignalWorkflowExecution(args.SignalRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("SignalWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorSignalWithStartWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorSignalWithStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceSignalWithStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.SignalWithStartWorkflowExecution(args.SignalWithStartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWithStartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorTerminateWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorTerminateWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceTerminateWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceTerminateWorkflowExecutionResult{}
+  var err2 error
+  if err2 = p.handler.TerminateWorkflowExecution(args.TerminateRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TerminateWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("TerminateWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorResetWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorResetWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceResetWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceResetWorkflowExecutionResult{}
+var retval *shared.ResetWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.ResetWorkflowExecution(args.ResetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResetWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListOpenWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListOpenWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListOpenWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListOpenWorkflowExecutionsResult{}
+var retval *shared.ListOpenWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListOpenWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListOpenWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListClosedWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListClosedWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListClosedWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
+var retval *shared.ListClosedWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListClosedWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListClosedWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorListWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorListWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceListWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceListWorkflowExecutionsResult{}
+var retval *shared.ListWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.ListWorkflowExecutions(args.ListRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("ListWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorCountWorkflowExecutions struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorCountWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceCountWorkflowExecutionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("CountWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceCountWorkflowExecutionsResult{}
+var retval *shared.CountWorkflowExecutionsResponse
+  var err2 error
+  if retval, err2 = p.handler.CountWorkflowExecutions(args.CountRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CountWorkflowExecutions: " + err2.Error())
+    oprot.WriteMessageBegin("CountWorkflowExecutions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("CountWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorRespondQueryTaskCompleted struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorRespondQueryTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceRespondQueryTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceRespondQueryTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondQueryTaskCompleted(args.CompleteRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondQueryTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorQueryWorkflow struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorQueryWorkflow) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceQueryWorkflowArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceQueryWorkflowResult{}
+var retval *shared.QueryWorkflowResponse
+  var err2 error
+  if retval, err2 = p.handler.QueryWorkflow(args.QueryRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.QueryFailedError:
+  result.QueryFailedError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryWorkflow: " + err2.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("QueryWorkflow", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorDescribeWorkflowExecution struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorDescribeWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDescribeWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceDescribeWorkflowExecutionResult{}
+var retval *shared.DescribeWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeWorkflowExecution(args.DescribeRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type workflowServiceProcessorDescribeTaskList struct {
+  handler WorkflowService
+}
+
+func (p *workflowServiceProcessorDescribeTaskList) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := WorkflowServiceDescribeTaskListArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeTaskList", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := WorkflowServiceDescribeTaskListResult{}
+var retval *shared.DescribeTaskListResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeTaskList(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeTaskList: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeTaskList", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeTaskList", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - RegisterRequest
+type WorkflowServiceRegisterDomainArgs struct {
+  RegisterRequest *shared.RegisterDomainRequest `thrift:"registerRequest,1" db:"registerRequest" json:"registerRequest"`
+}
+
+func NewWorkflowServiceRegisterDomainArgs() *WorkflowServiceRegisterDomainArgs {
+  return &WorkflowServiceRegisterDomainArgs{}
+}
+
+var WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT *shared.RegisterDomainRequest
+func (p *WorkflowServiceRegisterDomainArgs) GetRegisterRequest() *shared.RegisterDomainRequest {
+  if !p.IsSetRegisterRequest() {
+    return WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT
+  }
+return p.RegisterRequest
+}
+func (p *WorkflowServiceRegisterDomainArgs) IsSetRegisterRequest() bool {
+  return p.RegisterRequest != nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.RegisterRequest = &shared.RegisterDomainRequest{}
+  if err := p.RegisterRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RegisterRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RegisterDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("registerRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:registerRequest: ", p), err) }
+  if err := p.RegisterRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RegisterRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:registerRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRegisterDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - DomainExistsError
+type WorkflowServiceRegisterDomainResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  DomainExistsError *shared.DomainAlreadyExistsError `thrift:"domainExistsError,3" db:"domainExistsError" json:"domainExistsError,omitempty"`
+}
+
+func NewWorkflowServiceRegisterDomainResult() *WorkflowServiceRegisterDomainResult {
+  return &WorkflowServiceRegisterDomainResult{}
+}
+
+var WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRegisterDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRegisterDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT *shared.DomainAlreadyExistsError
+func (p *WorkflowServiceRegisterDomainResult) GetDomainExistsError() *shared.DomainAlreadyExistsError {
+  if !p.IsSetDomainExistsError() {
+    return WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT
+  }
+return p.DomainExistsError
+}
+func (p *WorkflowServiceRegisterDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) IsSetDomainExistsError() bool {
+  return p.DomainExistsError != nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.DomainExistsError = &shared.DomainAlreadyExistsError{}
+  if err := p.DomainExistsError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainExistsError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RegisterDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainExistsError() {
+    if err := oprot.WriteFieldBegin("domainExistsError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:domainExistsError: ", p), err) }
+    if err := p.DomainExistsError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainExistsError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:domainExistsError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRegisterDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRegisterDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - DescribeRequest
+type WorkflowServiceDescribeDomainArgs struct {
+  DescribeRequest *shared.DescribeDomainRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
+}
+
+func NewWorkflowServiceDescribeDomainArgs() *WorkflowServiceDescribeDomainArgs {
+  return &WorkflowServiceDescribeDomainArgs{}
+}
+
+var WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT *shared.DescribeDomainRequest
+func (p *WorkflowServiceDescribeDomainArgs) GetDescribeRequest() *shared.DescribeDomainRequest {
+  if !p.IsSetDescribeRequest() {
+    return WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT
+  }
+return p.DescribeRequest
+}
+func (p *WorkflowServiceDescribeDomainArgs) IsSetDescribeRequest() bool {
+  return p.DescribeRequest != nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DescribeRequest = &shared.DescribeDomainRequest{}
+  if err := p.DescribeRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
+  if err := p.DescribeRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDescribeDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceDescribeDomainResult struct {
+  Success *shared.DescribeDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceDescribeDomainResult() *WorkflowServiceDescribeDomainResult {
+  return &WorkflowServiceDescribeDomainResult{}
+}
+
+var WorkflowServiceDescribeDomainResult_Success_DEFAULT *shared.DescribeDomainResponse
+func (p *WorkflowServiceDescribeDomainResult) GetSuccess() *shared.DescribeDomainResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceDescribeDomainResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDescribeDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDescribeDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDescribeDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceDescribeDomainResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeDomainResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDescribeDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDescribeDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - UpdateRequest
+type WorkflowServiceUpdateDomainArgs struct {
+  UpdateRequest *shared.UpdateDomainRequest `thrift:"updateRequest,1" db:"updateRequest" json:"updateRequest"`
+}
+
+func NewWorkflowServiceUpdateDomainArgs() *WorkflowServiceUpdateDomainArgs {
+  return &WorkflowServiceUpdateDomainArgs{}
+}
+
+var WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT *shared.UpdateDomainRequest
+func (p *WorkflowServiceUpdateDomainArgs) GetUpdateRequest() *shared.UpdateDomainRequest {
+  if !p.IsSetUpdateRequest() {
+    return WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT
+  }
+return p.UpdateRequest
+}
+func (p *WorkflowServiceUpdateDomainArgs) IsSetUpdateRequest() bool {
+  return p.UpdateRequest != nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.UpdateRequest = &shared.UpdateDomainRequest{}
+  if err := p.UpdateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdateRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("updateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:updateRequest: ", p), err) }
+  if err := p.UpdateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdateRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:updateRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceUpdateDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceUpdateDomainResult struct {
+  Success *shared.UpdateDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceUpdateDomainResult() *WorkflowServiceUpdateDomainResult {
+  return &WorkflowServiceUpdateDomainResult{}
+}
+
+var WorkflowServiceUpdateDomainResult_Success_DEFAULT *shared.UpdateDomainResponse
+func (p *WorkflowServiceUpdateDomainResult) GetSuccess() *shared.UpdateDomainResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceUpdateDomainResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceUpdateDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceUpdateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceUpdateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceUpdateDomainResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.UpdateDomainResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UpdateDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceUpdateDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceUpdateDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - DeprecateRequest
+type WorkflowServiceDeprecateDomainArgs struct {
+  DeprecateRequest *shared.DeprecateDomainRequest `thrift:"deprecateRequest,1" db:"deprecateRequest" json:"deprecateRequest"`
+}
+
+func NewWorkflowServiceDeprecateDomainArgs() *WorkflowServiceDeprecateDomainArgs {
+  return &WorkflowServiceDeprecateDomainArgs{}
+}
+
+var WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT *shared.DeprecateDomainRequest
+func (p *WorkflowServiceDeprecateDomainArgs) GetDeprecateRequest() *shared.DeprecateDomainRequest {
+  if !p.IsSetDeprecateRequest() {
+    return WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT
+  }
+return p.DeprecateRequest
+}
+func (p *WorkflowServiceDeprecateDomainArgs) IsSetDeprecateRequest() bool {
+  return p.DeprecateRequest != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DeprecateRequest = &shared.DeprecateDomainRequest{}
+  if err := p.DeprecateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DeprecateRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DeprecateDomain_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("deprecateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:deprecateRequest: ", p), err) }
+  if err := p.DeprecateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DeprecateRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:deprecateRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDeprecateDomainArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceDeprecateDomainResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceDeprecateDomainResult() *WorkflowServiceDeprecateDomainResult {
+  return &WorkflowServiceDeprecateDomainResult{}
+}
+
+var WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDeprecateDomainResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDeprecateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDeprecateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceDeprecateDomainResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DeprecateDomain_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceDeprecateDomainResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceDeprecateDomainResult(%+v)", *p)
+}
+
+// Attributes:
+//  - StartRequest
+type WorkflowServiceStartWorkflowExecutionArgs struct {
+  StartRequest *shared.StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+}
+
+func NewWorkflowServiceStartWorkflowExecutionArgs() *WorkflowServiceStartWorkflowExecutionArgs {
+  return &WorkflowServiceStartWorkflowExecutionArgs{}
+}
+
+var WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *shared.StartWorkflowExecutionRequest
+func (p *WorkflowServiceStartWorkflowExecutionArgs) GetStartRequest() *shared.StartWorkflowExecutionRequest {
+  if !p.IsSetStartRequest() {
+    return WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+  }
+return p.StartRequest
+}
+func (p *WorkflowServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
+  return p.StartRequest != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.StartRequest = &shared.StartWorkflowExecutionRequest{}
+  if err := p.StartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
+  if err := p.StartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - SessionAlreadyExistError
+//  - ServiceBusyError
+type WorkflowServiceStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+}
+
+func NewWorkflowServiceStartWorkflowExecutionResult() *WorkflowServiceStartWorkflowExecutionResult {
+  return &WorkflowServiceStartWorkflowExecutionResult{}
+}
+
+var WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+  }
+return p.SessionAlreadyExistError
+}
+var WorkflowServiceStartWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceStartWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceStartWorkflowExecutionResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceStartWorkflowExecutionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionResult(%+v)", *p)
+}
+
+// Attributes:
+//  - GetRequest
+type WorkflowServiceGetWorkflowExecutionHistoryArgs struct {
+  GetRequest *shared.GetWorkflowExecutionHistoryRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
+}
+
+func NewWorkflowServiceGetWorkflowExecutionHistoryArgs() *WorkflowServiceGetWorkflowExecutionHistoryArgs {
+  return &WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+}
+
+var WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT *shared.GetWorkflowExecutionHistoryRequest
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) GetGetRequest() *shared.GetWorkflowExecutionHistoryRequest {
+  if !p.IsSetGetRequest() {
+    return WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT
+  }
+return p.GetRequest
+}
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) IsSetGetRequest() bool {
+  return p.GetRequest != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.GetRequest = &shared.GetWorkflowExecutionHistoryRequest{}
+  if err := p.GetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
+  if err := p.GetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceGetWorkflowExecutionHistoryResult struct {
+  Success *shared.GetWorkflowExecutionHistoryResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceGetWorkflowExecutionHistoryResult() *WorkflowServiceGetWorkflowExecutionHistoryResult {
+  return &WorkflowServiceGetWorkflowExecutionHistoryResult{}
+}
+
+var WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT *shared.GetWorkflowExecutionHistoryResponse
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetSuccess() *shared.GetWorkflowExecutionHistoryResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.GetWorkflowExecutionHistoryResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryResult(%+v)", *p)
+}
+
+// Attributes:
+//  - PollRequest
+type WorkflowServicePollForDecisionTaskArgs struct {
+  PollRequest *shared.PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewWorkflowServicePollForDecisionTaskArgs() *WorkflowServicePollForDecisionTaskArgs {
+  return &WorkflowServicePollForDecisionTaskArgs{}
+}
+
+var WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT *shared.PollForDecisionTaskRequest
+func (p *WorkflowServicePollForDecisionTaskArgs) GetPollRequest() *shared.PollForDecisionTaskRequest {
+  if !p.IsSetPollRequest() {
+    return WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *WorkflowServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &shared.PollForDecisionTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForDecisionTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - ServiceBusyError
+type WorkflowServicePollForDecisionTaskResult struct {
+  Success *shared.PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+}
+
+func NewWorkflowServicePollForDecisionTaskResult() *WorkflowServicePollForDecisionTaskResult {
+  return &WorkflowServicePollForDecisionTaskResult{}
+}
+
+var WorkflowServicePollForDecisionTaskResult_Success_DEFAULT *shared.PollForDecisionTaskResponse
+func (p *WorkflowServicePollForDecisionTaskResult) GetSuccess() *shared.PollForDecisionTaskResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServicePollForDecisionTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServicePollForDecisionTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServicePollForDecisionTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServicePollForDecisionTaskResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForDecisionTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForDecisionTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForDecisionTaskResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CompleteRequest
+type WorkflowServiceRespondDecisionTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+}
+
+func NewWorkflowServiceRespondDecisionTaskCompletedArgs() *WorkflowServiceRespondDecisionTaskCompletedArgs {
+  return &WorkflowServiceRespondDecisionTaskCompletedArgs{}
+}
+
+var WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondDecisionTaskCompletedRequest
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *shared.RespondDecisionTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
+  }
+return p.CompleteRequest
+}
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondDecisionTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRespondDecisionTaskCompletedResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRespondDecisionTaskCompletedResult() *WorkflowServiceRespondDecisionTaskCompletedResult {
+  return &WorkflowServiceRespondDecisionTaskCompletedResult{}
+}
+
+var WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskCompletedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - FailedRequest
+type WorkflowServiceRespondDecisionTaskFailedArgs struct {
+  FailedRequest *shared.RespondDecisionTaskFailedRequest `thrift:"failedRequest,1" db:"failedRequest" json:"failedRequest"`
+}
+
+func NewWorkflowServiceRespondDecisionTaskFailedArgs() *WorkflowServiceRespondDecisionTaskFailedArgs {
+  return &WorkflowServiceRespondDecisionTaskFailedArgs{}
+}
+
+var WorkflowServiceRespondDecisionTaskFailedArgs_FailedRequest_DEFAULT *shared.RespondDecisionTaskFailedRequest
+func (p *WorkflowServiceRespondDecisionTaskFailedArgs) GetFailedRequest() *shared.RespondDecisionTaskFailedRequest {
+  if !p.IsSetFailedRequest() {
+    return WorkflowServiceRespondDecisionTaskFailedArgs_FailedRequest_DEFAULT
+  }
+return p.FailedRequest
+}
+func (p *WorkflowServiceRespondDecisionTaskFailedArgs) IsSetFailedRequest() bool {
+  return p.FailedRequest != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailedRequest = &shared.RespondDecisionTaskFailedRequest{}
+  if err := p.FailedRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailedRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskFailed_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failedRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failedRequest: ", p), err) }
+  if err := p.FailedRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailedRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failedRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskFailedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRespondDecisionTaskFailedResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRespondDecisionTaskFailedResult() *WorkflowServiceRespondDecisionTaskFailedResult {
+  return &WorkflowServiceRespondDecisionTaskFailedResult{}
+}
+
+var WorkflowServiceRespondDecisionTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRespondDecisionTaskFailedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRespondDecisionTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRespondDecisionTaskFailedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRespondDecisionTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondDecisionTaskFailedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskFailed_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondDecisionTaskFailedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskFailedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - PollRequest
+type WorkflowServicePollForActivityTaskArgs struct {
+  PollRequest *shared.PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewWorkflowServicePollForActivityTaskArgs() *WorkflowServicePollForActivityTaskArgs {
+  return &WorkflowServicePollForActivityTaskArgs{}
+}
+
+var WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT *shared.PollForActivityTaskRequest
+func (p *WorkflowServicePollForActivityTaskArgs) GetPollRequest() *shared.PollForActivityTaskRequest {
+  if !p.IsSetPollRequest() {
+    return WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *WorkflowServicePollForActivityTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &shared.PollForActivityTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForActivityTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - ServiceBusyError
+type WorkflowServicePollForActivityTaskResult struct {
+  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+}
+
+func NewWorkflowServicePollForActivityTaskResult() *WorkflowServicePollForActivityTaskResult {
+  return &WorkflowServicePollForActivityTaskResult{}
+}
+
+var WorkflowServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
+func (p *WorkflowServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServicePollForActivityTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServicePollForActivityTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServicePollForActivityTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServicePollForActivityTaskResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServicePollForActivityTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForActivityTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServicePollForActivityTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServicePollForActivityTaskResult(%+v)", *p)
+}
+
+// Attributes:
+//  - HeartbeatRequest
+type WorkflowServiceRecordActivityTaskHeartbeatArgs struct {
+  HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+}
+
+func NewWorkflowServiceRecordActivityTaskHeartbeatArgs() *WorkflowServiceRecordActivityTaskHeartbeatArgs {
+  return &WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+}
+
+var WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatRequest
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatRequest {
+  if !p.IsSetHeartbeatRequest() {
+    return WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+  }
+return p.HeartbeatRequest
+}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
+  return p.HeartbeatRequest != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatRequest{}
+  if err := p.HeartbeatRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
+  if err := p.HeartbeatRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ServiceBusyError
+type WorkflowServiceRecordActivityTaskHeartbeatResult struct {
+  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+}
+
+func NewWorkflowServiceRecordActivityTaskHeartbeatResult() *WorkflowServiceRecordActivityTaskHeartbeatResult {
+  return &WorkflowServiceRecordActivityTaskHeartbeatResult{}
+}
+
+var WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+}
+
+// Attributes:
+//  - HeartbeatRequest
+type WorkflowServiceRecordActivityTaskHeartbeatByIDArgs struct {
+  HeartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+}
+
+func NewWorkflowServiceRecordActivityTaskHeartbeatByIDArgs() *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs {
+  return &WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{}
+}
+
+var WorkflowServiceRecordActivityTaskHeartbeatByIDArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatByIDRequest
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatByIDRequest {
+  if !p.IsSetHeartbeatRequest() {
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDArgs_HeartbeatRequest_DEFAULT
+  }
+return p.HeartbeatRequest
+}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) IsSetHeartbeatRequest() bool {
+  return p.HeartbeatRequest != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatByIDRequest{}
+  if err := p.HeartbeatRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatByID_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
+  if err := p.HeartbeatRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatByIDArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ServiceBusyError
+type WorkflowServiceRecordActivityTaskHeartbeatByIDResult struct {
+  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+}
+
+func NewWorkflowServiceRecordActivityTaskHeartbeatByIDResult() *WorkflowServiceRecordActivityTaskHeartbeatByIDResult {
+  return &WorkflowServiceRecordActivityTaskHeartbeatByIDResult{}
+}
+
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var WorkflowServiceRecordActivityTaskHeartbeatByIDResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceRecordActivityTaskHeartbeatByIDResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeatByID_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRecordActivityTaskHeartbeatByIDResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatByIDResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CompleteRequest
+type WorkflowServiceRespondActivityTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+}
+
+func NewWorkflowServiceRespondActivityTaskCompletedArgs() *WorkflowServiceRespondActivityTaskCompletedArgs {
+  return &WorkflowServiceRespondActivityTaskCompletedArgs{}
+}
+
+var WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedRequest
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
+  }
+return p.CompleteRequest
+}
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondActivityTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskCompletedResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+}
+
+func NewWorkflowServiceRespondActivityTaskCompletedResult() *WorkflowServiceRespondActivityTaskCompletedResult {
+  return &WorkflowServiceRespondActivityTaskCompletedResult{}
+}
+
+var WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
 }
-  if err2 = oprot.WriteMessageBegin("ListOpenWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+var WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
   }
-  if err != nil {
-    return
+return p.InternalServiceError
+}
+var WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
   }
-  return true, err
+return p.EntityNotExistError
+}
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
 }
 
-type workflowServiceProcessorListClosedWorkflowExecutions struct {
-  handler WorkflowService
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
 }
 
-func (p *workflowServiceProcessorListClosedWorkflowExecutions) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := WorkflowServiceListClosedWorkflowExecutionsArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := WorkflowServiceListClosedWorkflowExecutionsResult{}
-var retval *shared.ListClosedWorkflowExecutionsResponse
-  var err2 error
-  if retval, err2 = p.handler.ListClosedWorkflowExecutions(args.ListRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.EntityNotExistsError:
-  result.EntityNotExistError = v
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListClosedWorkflowExecutions: " + err2.Error())
-    oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  } else {
-    result.Success = retval
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
-  if err2 = oprot.WriteMessageBegin("ListClosedWorkflowExecutions", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
-  if err != nil {
-    return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
   }
-  return true, err
+  return err
 }
 
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRespondActivityTaskCompletedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedResult(%+v)", *p)
+}
 
 // Attributes:
-//  - RegisterRequest
-type WorkflowServiceRegisterDomainArgs struct {
-  RegisterRequest *shared.RegisterDomainRequest `thrift:"registerRequest,1" db:"registerRequest" json:"registerRequest"`
+//  - CompleteRequest
+type WorkflowServiceRespondActivityTaskCompletedByIDArgs struct {
+  CompleteRequest *shared.RespondActivityTaskCompletedByIDRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewWorkflowServiceRegisterDomainArgs() *WorkflowServiceRegisterDomainArgs {
-  return &WorkflowServiceRegisterDomainArgs{}
+func NewWorkflowServiceRespondActivityTaskCompletedByIDArgs() *WorkflowServiceRespondActivityTaskCompletedByIDArgs {
+  return &WorkflowServiceRespondActivityTaskCompletedByIDArgs{}
 }
 
-var WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT *shared.RegisterDomainRequest
-func (p *WorkflowServiceRegisterDomainArgs) GetRegisterRequest() *shared.RegisterDomainRequest {
-  if !p.IsSetRegisterRequest() {
-    return WorkflowServiceRegisterDomainArgs_RegisterRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskCompletedByIDArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedByIDRequest
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedByIDRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondActivityTaskCompletedByIDArgs_CompleteRequest_DEFAULT
   }
-return p.RegisterRequest
+return p.CompleteRequest
 }
-func (p *WorkflowServiceRegisterDomainArgs) IsSetRegisterRequest() bool {
-  return p.RegisterRequest != nil
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2974,16 +8920,16 @@ func (p *WorkflowServiceRegisterDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.RegisterRequest = &shared.RegisterDomainRequest{}
-  if err := p.RegisterRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.RegisterRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondActivityTaskCompletedByIDRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RegisterDomain_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedByID_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2995,72 +8941,72 @@ func (p *WorkflowServiceRegisterDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("registerRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:registerRequest: ", p), err) }
-  if err := p.RegisterRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.RegisterRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:registerRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRegisterDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedByIDArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
-//  - DomainExistsError
-type WorkflowServiceRegisterDomainResult struct {
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskCompletedByIDResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  DomainExistsError *shared.DomainAlreadyExistsError `thrift:"domainExistsError,3" db:"domainExistsError" json:"domainExistsError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRegisterDomainResult() *WorkflowServiceRegisterDomainResult {
-  return &WorkflowServiceRegisterDomainResult{}
+func NewWorkflowServiceRespondActivityTaskCompletedByIDResult() *WorkflowServiceRespondActivityTaskCompletedByIDResult {
+  return &WorkflowServiceRespondActivityTaskCompletedByIDResult{}
 }
 
-var WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRegisterDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskCompletedByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRegisterDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedByIDResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRegisterDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskCompletedByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRegisterDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCompletedByIDResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT *shared.DomainAlreadyExistsError
-func (p *WorkflowServiceRegisterDomainResult) GetDomainExistsError() *shared.DomainAlreadyExistsError {
-  if !p.IsSetDomainExistsError() {
-    return WorkflowServiceRegisterDomainResult_DomainExistsError_DEFAULT
+var WorkflowServiceRespondActivityTaskCompletedByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskCompletedByIDResult_EntityNotExistError_DEFAULT
   }
-return p.DomainExistsError
+return p.EntityNotExistError
 }
-func (p *WorkflowServiceRegisterDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
-
-func (p *WorkflowServiceRegisterDomainResult) IsSetDomainExistsError() bool {
-  return p.DomainExistsError != nil
+
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3100,7 +9046,7 @@ func (p *WorkflowServiceRegisterDomainResult) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3108,7 +9054,7 @@ func (p *WorkflowServiceRegisterDomainResult)  ReadField1(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3116,16 +9062,16 @@ func (p *WorkflowServiceRegisterDomainResult)  ReadField2(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.DomainExistsError = &shared.DomainAlreadyExistsError{}
-  if err := p.DomainExistsError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DomainExistsError), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RegisterDomain_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompletedByID_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3139,7 +9085,7 @@ func (p *WorkflowServiceRegisterDomainResult) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3152,7 +9098,7 @@ func (p *WorkflowServiceRegisterDomainResult) writeField1(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3165,48 +9111,48 @@ func (p *WorkflowServiceRegisterDomainResult) writeField2(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetDomainExistsError() {
-    if err := oprot.WriteFieldBegin("domainExistsError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:domainExistsError: ", p), err) }
-    if err := p.DomainExistsError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DomainExistsError), err)
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:domainExistsError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceRegisterDomainResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskCompletedByIDResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRegisterDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedByIDResult(%+v)", *p)
 }
 
 // Attributes:
-//  - DescribeRequest
-type WorkflowServiceDescribeDomainArgs struct {
-  DescribeRequest *shared.DescribeDomainRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
+//  - FailRequest
+type WorkflowServiceRespondActivityTaskFailedArgs struct {
+  FailRequest *shared.RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
 }
 
-func NewWorkflowServiceDescribeDomainArgs() *WorkflowServiceDescribeDomainArgs {
-  return &WorkflowServiceDescribeDomainArgs{}
+func NewWorkflowServiceRespondActivityTaskFailedArgs() *WorkflowServiceRespondActivityTaskFailedArgs {
+  return &WorkflowServiceRespondActivityTaskFailedArgs{}
 }
 
-var WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT *shared.DescribeDomainRequest
-func (p *WorkflowServiceDescribeDomainArgs) GetDescribeRequest() *shared.DescribeDomainRequest {
-  if !p.IsSetDescribeRequest() {
-    return WorkflowServiceDescribeDomainArgs_DescribeRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedRequest
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) GetFailRequest() *shared.RespondActivityTaskFailedRequest {
+  if !p.IsSetFailRequest() {
+    return WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
   }
-return p.DescribeRequest
+return p.FailRequest
 }
-func (p *WorkflowServiceDescribeDomainArgs) IsSetDescribeRequest() bool {
-  return p.DescribeRequest != nil
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
+  return p.FailRequest != nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3238,16 +9184,16 @@ func (p *WorkflowServiceDescribeDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.DescribeRequest = &shared.DescribeDomainRequest{}
-  if err := p.DescribeRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailRequest = &shared.RespondActivityTaskFailedRequest{}
+  if err := p.FailRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomain_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3259,85 +9205,72 @@ func (p *WorkflowServiceDescribeDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
-  if err := p.DescribeRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
+  if err := p.FailRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDescribeDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceDescribeDomainResult struct {
-  Success *shared.DescribeDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceRespondActivityTaskFailedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceDescribeDomainResult() *WorkflowServiceDescribeDomainResult {
-  return &WorkflowServiceDescribeDomainResult{}
+func NewWorkflowServiceRespondActivityTaskFailedResult() *WorkflowServiceRespondActivityTaskFailedResult {
+  return &WorkflowServiceRespondActivityTaskFailedResult{}
 }
 
-var WorkflowServiceDescribeDomainResult_Success_DEFAULT *shared.DescribeDomainResponse
-func (p *WorkflowServiceDescribeDomainResult) GetSuccess() *shared.DescribeDomainResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceDescribeDomainResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceDescribeDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceDescribeDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceDescribeDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceDescribeDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceDescribeDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceDescribeDomainResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceDescribeDomainResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *WorkflowServiceDescribeDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3350,10 +9283,6 @@ func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -3381,15 +9310,7 @@ func (p *WorkflowServiceDescribeDomainResult) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.DescribeDomainResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3397,7 +9318,7 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField1(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3405,7 +9326,7 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField2(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -3413,11 +9334,10 @@ func (p *WorkflowServiceDescribeDomainResult)  ReadField3(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DescribeDomain_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -3429,20 +9349,7 @@ func (p *WorkflowServiceDescribeDomainResult) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3455,7 +9362,7 @@ func (p *WorkflowServiceDescribeDomainResult) writeField1(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3468,7 +9375,7 @@ func (p *WorkflowServiceDescribeDomainResult) writeField2(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -3481,35 +9388,35 @@ func (p *WorkflowServiceDescribeDomainResult) writeField3(oprot thrift.TProtocol
   return err
 }
 
-func (p *WorkflowServiceDescribeDomainResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDescribeDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - UpdateRequest
-type WorkflowServiceUpdateDomainArgs struct {
-  UpdateRequest *shared.UpdateDomainRequest `thrift:"updateRequest,1" db:"updateRequest" json:"updateRequest"`
+//  - FailRequest
+type WorkflowServiceRespondActivityTaskFailedByIDArgs struct {
+  FailRequest *shared.RespondActivityTaskFailedByIDRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
 }
 
-func NewWorkflowServiceUpdateDomainArgs() *WorkflowServiceUpdateDomainArgs {
-  return &WorkflowServiceUpdateDomainArgs{}
+func NewWorkflowServiceRespondActivityTaskFailedByIDArgs() *WorkflowServiceRespondActivityTaskFailedByIDArgs {
+  return &WorkflowServiceRespondActivityTaskFailedByIDArgs{}
 }
 
-var WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT *shared.UpdateDomainRequest
-func (p *WorkflowServiceUpdateDomainArgs) GetUpdateRequest() *shared.UpdateDomainRequest {
-  if !p.IsSetUpdateRequest() {
-    return WorkflowServiceUpdateDomainArgs_UpdateRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskFailedByIDArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedByIDRequest
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) GetFailRequest() *shared.RespondActivityTaskFailedByIDRequest {
+  if !p.IsSetFailRequest() {
+    return WorkflowServiceRespondActivityTaskFailedByIDArgs_FailRequest_DEFAULT
   }
-return p.UpdateRequest
+return p.FailRequest
 }
-func (p *WorkflowServiceUpdateDomainArgs) IsSetUpdateRequest() bool {
-  return p.UpdateRequest != nil
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) IsSetFailRequest() bool {
+  return p.FailRequest != nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3541,16 +9448,16 @@ func (p *WorkflowServiceUpdateDomainArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.UpdateRequest = &shared.UpdateDomainRequest{}
-  if err := p.UpdateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.UpdateRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailRequest = &shared.RespondActivityTaskFailedByIDRequest{}
+  if err := p.FailRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomain_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailedByID_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3562,85 +9469,72 @@ func (p *WorkflowServiceUpdateDomainArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("updateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:updateRequest: ", p), err) }
-  if err := p.UpdateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.UpdateRequest), err)
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
+  if err := p.FailRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:updateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceUpdateDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedByIDArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceUpdateDomainResult struct {
-  Success *shared.UpdateDomainResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceRespondActivityTaskFailedByIDResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceUpdateDomainResult() *WorkflowServiceUpdateDomainResult {
-  return &WorkflowServiceUpdateDomainResult{}
+func NewWorkflowServiceRespondActivityTaskFailedByIDResult() *WorkflowServiceRespondActivityTaskFailedByIDResult {
+  return &WorkflowServiceRespondActivityTaskFailedByIDResult{}
 }
 
-var WorkflowServiceUpdateDomainResult_Success_DEFAULT *shared.UpdateDomainResponse
-func (p *WorkflowServiceUpdateDomainResult) GetSuccess() *shared.UpdateDomainResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceUpdateDomainResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceUpdateDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskFailedByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceUpdateDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedByIDResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceUpdateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskFailedByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceUpdateDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedByIDResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceUpdateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondActivityTaskFailedByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceUpdateDomainResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondActivityTaskFailedByIDResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceUpdateDomainResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *WorkflowServiceUpdateDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3653,10 +9547,6 @@ func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -3679,20 +9569,12 @@ func (p *WorkflowServiceUpdateDomainResult) Read(iprot thrift.TProtocol) error {
     }
   }
   if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceUpdateDomainResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.UpdateDomainResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3700,7 +9582,7 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField1(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3708,7 +9590,7 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField2(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -3716,11 +9598,10 @@ func (p *WorkflowServiceUpdateDomainResult)  ReadField3(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("UpdateDomain_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailedByID_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -3732,20 +9613,7 @@ func (p *WorkflowServiceUpdateDomainResult) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -3758,7 +9626,7 @@ func (p *WorkflowServiceUpdateDomainResult) writeField1(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -3771,7 +9639,7 @@ func (p *WorkflowServiceUpdateDomainResult) writeField2(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -3784,35 +9652,35 @@ func (p *WorkflowServiceUpdateDomainResult) writeField3(oprot thrift.TProtocol)
   return err
 }
 
-func (p *WorkflowServiceUpdateDomainResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskFailedByIDResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceUpdateDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedByIDResult(%+v)", *p)
 }
 
 // Attributes:
-//  - DeprecateRequest
-type WorkflowServiceDeprecateDomainArgs struct {
-  DeprecateRequest *shared.DeprecateDomainRequest `thrift:"deprecateRequest,1" db:"deprecateRequest" json:"deprecateRequest"`
+//  - CanceledRequest
+type WorkflowServiceRespondActivityTaskCanceledArgs struct {
+  CanceledRequest *shared.RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
 }
 
-func NewWorkflowServiceDeprecateDomainArgs() *WorkflowServiceDeprecateDomainArgs {
-  return &WorkflowServiceDeprecateDomainArgs{}
+func NewWorkflowServiceRespondActivityTaskCanceledArgs() *WorkflowServiceRespondActivityTaskCanceledArgs {
+  return &WorkflowServiceRespondActivityTaskCanceledArgs{}
 }
 
-var WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT *shared.DeprecateDomainRequest
-func (p *WorkflowServiceDeprecateDomainArgs) GetDeprecateRequest() *shared.DeprecateDomainRequest {
-  if !p.IsSetDeprecateRequest() {
-    return WorkflowServiceDeprecateDomainArgs_DeprecateRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledRequest
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledRequest {
+  if !p.IsSetCanceledRequest() {
+    return WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
   }
-return p.DeprecateRequest
+return p.CanceledRequest
 }
-func (p *WorkflowServiceDeprecateDomainArgs) IsSetDeprecateRequest() bool {
-  return p.DeprecateRequest != nil
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
+  return p.CanceledRequest != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3844,16 +9712,16 @@ func (p *WorkflowServiceDeprecateDomainArgs) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.DeprecateRequest = &shared.DeprecateDomainRequest{}
-  if err := p.DeprecateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DeprecateRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CanceledRequest = &shared.RespondActivityTaskCanceledRequest{}
+  if err := p.CanceledRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DeprecateDomain_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3865,72 +9733,72 @@ func (p *WorkflowServiceDeprecateDomainArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("deprecateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:deprecateRequest: ", p), err) }
-  if err := p.DeprecateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DeprecateRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
+  if err := p.CanceledRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:deprecateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDeprecateDomainArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceDeprecateDomainResult struct {
+type WorkflowServiceRespondActivityTaskCanceledResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceDeprecateDomainResult() *WorkflowServiceDeprecateDomainResult {
-  return &WorkflowServiceDeprecateDomainResult{}
+func NewWorkflowServiceRespondActivityTaskCanceledResult() *WorkflowServiceRespondActivityTaskCanceledResult {
+  return &WorkflowServiceRespondActivityTaskCanceledResult{}
 }
 
-var WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceDeprecateDomainResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceDeprecateDomainResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceDeprecateDomainResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceDeprecateDomainResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceDeprecateDomainResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceDeprecateDomainResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceDeprecateDomainResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3970,7 +9838,7 @@ func (p *WorkflowServiceDeprecateDomainResult) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -3978,7 +9846,7 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField1(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -3986,7 +9854,7 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField2(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -3994,8 +9862,8 @@ func (p *WorkflowServiceDeprecateDomainResult)  ReadField3(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("DeprecateDomain_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4009,7 +9877,7 @@ func (p *WorkflowServiceDeprecateDomainResult) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4022,7 +9890,7 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField1(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4035,7 +9903,7 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField2(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -4048,35 +9916,35 @@ func (p *WorkflowServiceDeprecateDomainResult) writeField3(oprot thrift.TProtoco
   return err
 }
 
-func (p *WorkflowServiceDeprecateDomainResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceDeprecateDomainResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledResult(%+v)", *p)
 }
 
 // Attributes:
-//  - StartRequest
-type WorkflowServiceStartWorkflowExecutionArgs struct {
-  StartRequest *shared.StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+//  - CanceledRequest
+type WorkflowServiceRespondActivityTaskCanceledByIDArgs struct {
+  CanceledRequest *shared.RespondActivityTaskCanceledByIDRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
 }
 
-func NewWorkflowServiceStartWorkflowExecutionArgs() *WorkflowServiceStartWorkflowExecutionArgs {
-  return &WorkflowServiceStartWorkflowExecutionArgs{}
+func NewWorkflowServiceRespondActivityTaskCanceledByIDArgs() *WorkflowServiceRespondActivityTaskCanceledByIDArgs {
+  return &WorkflowServiceRespondActivityTaskCanceledByIDArgs{}
 }
 
-var WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *shared.StartWorkflowExecutionRequest
-func (p *WorkflowServiceStartWorkflowExecutionArgs) GetStartRequest() *shared.StartWorkflowExecutionRequest {
-  if !p.IsSetStartRequest() {
-    return WorkflowServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
+var WorkflowServiceRespondActivityTaskCanceledByIDArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledByIDRequest
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledByIDRequest {
+  if !p.IsSetCanceledRequest() {
+    return WorkflowServiceRespondActivityTaskCanceledByIDArgs_CanceledRequest_DEFAULT
   }
-return p.StartRequest
+return p.CanceledRequest
 }
-func (p *WorkflowServiceStartWorkflowExecutionArgs) IsSetStartRequest() bool {
-  return p.StartRequest != nil
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) IsSetCanceledRequest() bool {
+  return p.CanceledRequest != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4108,16 +9976,16 @@ func (p *WorkflowServiceStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.StartRequest = &shared.StartWorkflowExecutionRequest{}
-  if err := p.StartRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.StartRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CanceledRequest = &shared.RespondActivityTaskCanceledByIDRequest{}
+  if err := p.CanceledRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledByID_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4129,85 +9997,72 @@ func (p *WorkflowServiceStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("startRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:startRequest: ", p), err) }
-  if err := p.StartRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.StartRequest), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
+  if err := p.CanceledRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:startRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledByIDArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - SessionAlreadyExistError
-type WorkflowServiceStartWorkflowExecutionResult struct {
-  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+type WorkflowServiceRespondActivityTaskCanceledByIDResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceStartWorkflowExecutionResult() *WorkflowServiceStartWorkflowExecutionResult {
-  return &WorkflowServiceStartWorkflowExecutionResult{}
+func NewWorkflowServiceRespondActivityTaskCanceledByIDResult() *WorkflowServiceRespondActivityTaskCanceledByIDResult {
+  return &WorkflowServiceRespondActivityTaskCanceledByIDResult{}
 }
 
-var WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceStartWorkflowExecutionResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondActivityTaskCanceledByIDResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceStartWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledByIDResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondActivityTaskCanceledByIDResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondActivityTaskCanceledByIDResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
-func (p *WorkflowServiceStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
-  if !p.IsSetSessionAlreadyExistError() {
-    return WorkflowServiceStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+var WorkflowServiceRespondActivityTaskCanceledByIDResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceRespondActivityTaskCanceledByIDResult_EntityNotExistError_DEFAULT
   }
-return p.SessionAlreadyExistError
-}
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSuccess() bool {
-  return p.Success != nil
+return p.EntityNotExistError
 }
-
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
-  return p.SessionAlreadyExistError != nil
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4220,10 +10075,6 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtoco
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -4251,15 +10102,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.StartWorkflowExecutionResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4267,7 +10110,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField1(iprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4275,19 +10118,18 @@ func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField2(iprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
-  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StartWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceledByID_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -4299,20 +10141,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4325,7 +10154,7 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) writeField1(oprot thrift.T
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4338,48 +10167,48 @@ func (p *WorkflowServiceStartWorkflowExecutionResult) writeField2(oprot thrift.T
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSessionAlreadyExistError() {
-    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
-    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceStartWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceRespondActivityTaskCanceledByIDResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceStartWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledByIDResult(%+v)", *p)
 }
 
 // Attributes:
-//  - GetRequest
-type WorkflowServiceGetWorkflowExecutionHistoryArgs struct {
-  GetRequest *shared.GetWorkflowExecutionHistoryRequest `thrift:"getRequest,1" db:"getRequest" json:"getRequest"`
+//  - CancelRequest
+type WorkflowServiceRequestCancelWorkflowExecutionArgs struct {
+  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
 }
 
-func NewWorkflowServiceGetWorkflowExecutionHistoryArgs() *WorkflowServiceGetWorkflowExecutionHistoryArgs {
-  return &WorkflowServiceGetWorkflowExecutionHistoryArgs{}
+func NewWorkflowServiceRequestCancelWorkflowExecutionArgs() *WorkflowServiceRequestCancelWorkflowExecutionArgs {
+  return &WorkflowServiceRequestCancelWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT *shared.GetWorkflowExecutionHistoryRequest
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) GetGetRequest() *shared.GetWorkflowExecutionHistoryRequest {
-  if !p.IsSetGetRequest() {
-    return WorkflowServiceGetWorkflowExecutionHistoryArgs_GetRequest_DEFAULT
+var WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
+  if !p.IsSetCancelRequest() {
+    return WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
   }
-return p.GetRequest
+return p.CancelRequest
 }
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) IsSetGetRequest() bool {
-  return p.GetRequest != nil
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
+  return p.CancelRequest != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4411,16 +10240,16 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.GetRequest = &shared.GetWorkflowExecutionHistoryRequest{}
-  if err := p.GetRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.GetRequest), err)
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
+  if err := p.CancelRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_args"); err != nil {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4432,85 +10261,85 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("getRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:getRequest: ", p), err) }
-  if err := p.GetRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.GetRequest), err)
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
+  if err := p.CancelRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:getRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryArgs) String() string {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceGetWorkflowExecutionHistoryResult struct {
-  Success *shared.GetWorkflowExecutionHistoryResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - ServiceBusyError
+type WorkflowServiceRequestCancelWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceGetWorkflowExecutionHistoryResult() *WorkflowServiceGetWorkflowExecutionHistoryResult {
-  return &WorkflowServiceGetWorkflowExecutionHistoryResult{}
+func NewWorkflowServiceRequestCancelWorkflowExecutionResult() *WorkflowServiceRequestCancelWorkflowExecutionResult {
+  return &WorkflowServiceRequestCancelWorkflowExecutionResult{}
 }
 
-var WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT *shared.GetWorkflowExecutionHistoryResponse
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetSuccess() *shared.GetWorkflowExecutionHistoryResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_BadRequestError_DEFAULT
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceGetWorkflowExecutionHistoryResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetSuccess() bool {
-  return p.Success != nil
+var WorkflowServiceRequestCancelWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceRequestCancelWorkflowExecutionResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
 }
-
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4523,10 +10352,6 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TPr
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -4539,6 +10364,10 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TPr
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4554,15 +10383,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.GetWorkflowExecutionHistoryResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4570,7 +10391,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4578,7 +10399,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -4586,14 +10407,22 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetWorkflowExecutionHistory_result"); err != nil {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4602,20 +10431,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4628,7 +10444,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4641,7 +10457,7 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -4654,35 +10470,48 @@ func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceGetWorkflowExecutionHistoryResult) String() string {
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceGetWorkflowExecutionHistoryResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - PollRequest
-type WorkflowServicePollForDecisionTaskArgs struct {
-  PollRequest *shared.PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - SignalRequest
+type WorkflowServiceSignalWorkflowExecutionArgs struct {
+  SignalRequest *shared.SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
 }
 
-func NewWorkflowServicePollForDecisionTaskArgs() *WorkflowServicePollForDecisionTaskArgs {
-  return &WorkflowServicePollForDecisionTaskArgs{}
+func NewWorkflowServiceSignalWorkflowExecutionArgs() *WorkflowServiceSignalWorkflowExecutionArgs {
+  return &WorkflowServiceSignalWorkflowExecutionArgs{}
 }
 
-var WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT *shared.PollForDecisionTaskRequest
-func (p *WorkflowServicePollForDecisionTaskArgs) GetPollRequest() *shared.PollForDecisionTaskRequest {
-  if !p.IsSetPollRequest() {
-    return WorkflowServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+var WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *shared.SignalWorkflowExecutionRequest
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) GetSignalRequest() *shared.SignalWorkflowExecutionRequest {
+  if !p.IsSetSignalRequest() {
+    return WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
   }
-return p.PollRequest
+return p.SignalRequest
 }
-func (p *WorkflowServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
+  return p.SignalRequest != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4714,16 +10543,16 @@ func (p *WorkflowServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &shared.PollForDecisionTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *WorkflowServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalRequest = &shared.SignalWorkflowExecutionRequest{}
+  if err := p.SignalRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4735,72 +10564,85 @@ func (p *WorkflowServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
+  if err := p.SignalRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskArgs) String() string {
+func (p *WorkflowServiceSignalWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type WorkflowServicePollForDecisionTaskResult struct {
-  Success *shared.PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+//  - ServiceBusyError
+type WorkflowServiceSignalWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServicePollForDecisionTaskResult() *WorkflowServicePollForDecisionTaskResult {
-  return &WorkflowServicePollForDecisionTaskResult{}
+func NewWorkflowServiceSignalWorkflowExecutionResult() *WorkflowServiceSignalWorkflowExecutionResult {
+  return &WorkflowServiceSignalWorkflowExecutionResult{}
 }
 
-var WorkflowServicePollForDecisionTaskResult_Success_DEFAULT *shared.PollForDecisionTaskResponse
-func (p *WorkflowServicePollForDecisionTaskResult) GetSuccess() *shared.PollForDecisionTaskResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServicePollForDecisionTaskResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+    return WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetSuccess() bool {
-  return p.Success != nil
+var WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
 }
-
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+var WorkflowServiceSignalWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceSignalWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceSignalWorkflowExecutionResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4813,10 +10655,6 @@ func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -4825,6 +10663,14 @@ func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
       if err := p.ReadField2(iprot); err != nil {
         return err
       }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4840,15 +10686,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForDecisionTaskResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -4856,7 +10694,7 @@ func (p *WorkflowServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -4864,13 +10702,30 @@ func (p *WorkflowServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4879,20 +10734,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -4905,7 +10747,7 @@ func (p *WorkflowServicePollForDecisionTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -4918,35 +10760,61 @@ func (p *WorkflowServicePollForDecisionTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForDecisionTaskResult) String() string {
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceSignalWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type WorkflowServiceRespondDecisionTaskCompletedArgs struct {
-  CompleteRequest *shared.RespondDecisionTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - SignalWithStartRequest
+type WorkflowServiceSignalWithStartWorkflowExecutionArgs struct {
+  SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `thrift:"signalWithStartRequest,1" db:"signalWithStartRequest" json:"signalWithStartRequest"`
 }
 
-func NewWorkflowServiceRespondDecisionTaskCompletedArgs() *WorkflowServiceRespondDecisionTaskCompletedArgs {
-  return &WorkflowServiceRespondDecisionTaskCompletedArgs{}
+func NewWorkflowServiceSignalWithStartWorkflowExecutionArgs() *WorkflowServiceSignalWithStartWorkflowExecutionArgs {
+  return &WorkflowServiceSignalWithStartWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondDecisionTaskCompletedRequest
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) GetCompleteRequest() *shared.RespondDecisionTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return WorkflowServiceRespondDecisionTaskCompletedArgs_CompleteRequest_DEFAULT
+var WorkflowServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT *shared.SignalWithStartWorkflowExecutionRequest
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) GetSignalWithStartRequest() *shared.SignalWithStartWorkflowExecutionRequest {
+  if !p.IsSetSignalWithStartRequest() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.SignalWithStartRequest
 }
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) IsSetSignalWithStartRequest() bool {
+  return p.SignalWithStartRequest != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4978,16 +10846,16 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &shared.RespondDecisionTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalWithStartRequest = &shared.SignalWithStartWorkflowExecutionRequest{}
+  if err := p.SignalWithStartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalWithStartRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_args"); err != nil {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4999,72 +10867,98 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalWithStartRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalWithStartRequest: ", p), err) }
+  if err := p.SignalWithStartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalWithStartRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalWithStartRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedArgs) String() string {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWithStartWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - EntityNotExistError
-type WorkflowServiceRespondDecisionTaskCompletedResult struct {
+//  - SessionAlreadyExistError
+//  - ServiceBusyError
+type WorkflowServiceSignalWithStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceRespondDecisionTaskCompletedResult() *WorkflowServiceRespondDecisionTaskCompletedResult {
-  return &WorkflowServiceRespondDecisionTaskCompletedResult{}
+func NewWorkflowServiceSignalWithStartWorkflowExecutionResult() *WorkflowServiceSignalWithStartWorkflowExecutionResult {
+  return &WorkflowServiceSignalWithStartWorkflowExecutionResult{}
 }
 
-var WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_BadRequestError_DEFAULT
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
-  if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondDecisionTaskCompletedResult_EntityNotExistError_DEFAULT
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
   }
-return p.EntityNotExistError
+return p.SessionAlreadyExistError
 }
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetBadRequestError() bool {
+var WorkflowServiceSignalWithStartWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceSignalWithStartWorkflowExecutionResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) IsSetEntityNotExistError() bool {
-  return p.EntityNotExistError != nil
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5077,6 +10971,10 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5089,6 +10987,10 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TP
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5104,7 +11006,15 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5112,7 +11022,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5120,21 +11030,31 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.EntityNotExistError = &shared.EntityNotExistsError{}
-  if err := p.EntityNotExistError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondDecisionTaskCompleted_result"); err != nil {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5143,7 +11063,20 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5156,7 +11089,7 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5169,48 +11102,61 @@ func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetEntityNotExistError() {
-    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
-    if err := p.EntityNotExistError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
   }
   return err
 }
 
-func (p *WorkflowServiceRespondDecisionTaskCompletedResult) String() string {
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceSignalWithStartWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondDecisionTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceSignalWithStartWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - PollRequest
-type WorkflowServicePollForActivityTaskArgs struct {
-  PollRequest *shared.PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - TerminateRequest
+type WorkflowServiceTerminateWorkflowExecutionArgs struct {
+  TerminateRequest *shared.TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
 }
 
-func NewWorkflowServicePollForActivityTaskArgs() *WorkflowServicePollForActivityTaskArgs {
-  return &WorkflowServicePollForActivityTaskArgs{}
+func NewWorkflowServiceTerminateWorkflowExecutionArgs() *WorkflowServiceTerminateWorkflowExecutionArgs {
+  return &WorkflowServiceTerminateWorkflowExecutionArgs{}
 }
 
-var WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT *shared.PollForActivityTaskRequest
-func (p *WorkflowServicePollForActivityTaskArgs) GetPollRequest() *shared.PollForActivityTaskRequest {
-  if !p.IsSetPollRequest() {
-    return WorkflowServicePollForActivityTaskArgs_PollRequest_DEFAULT
+var WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *shared.TerminateWorkflowExecutionRequest
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *shared.TerminateWorkflowExecutionRequest {
+  if !p.IsSetTerminateRequest() {
+    return WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
   }
-return p.PollRequest
+return p.TerminateRequest
 }
-func (p *WorkflowServicePollForActivityTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
+  return p.TerminateRequest != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5242,16 +11188,16 @@ func (p *WorkflowServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &shared.PollForActivityTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.TerminateRequest = &shared.TerminateWorkflowExecutionRequest{}
+  if err := p.TerminateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5263,72 +11209,85 @@ func (p *WorkflowServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
+  if err := p.TerminateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskArgs) String() string {
+func (p *WorkflowServiceTerminateWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForActivityTaskArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type WorkflowServicePollForActivityTaskResult struct {
-  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+//  - ServiceBusyError
+type WorkflowServiceTerminateWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,4" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServicePollForActivityTaskResult() *WorkflowServicePollForActivityTaskResult {
-  return &WorkflowServicePollForActivityTaskResult{}
+func NewWorkflowServiceTerminateWorkflowExecutionResult() *WorkflowServiceTerminateWorkflowExecutionResult {
+  return &WorkflowServiceTerminateWorkflowExecutionResult{}
 }
 
-var WorkflowServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
-func (p *WorkflowServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
-  if !p.IsSetSuccess() {
-    return WorkflowServicePollForActivityTaskResult_Success_DEFAULT
-  }
-return p.Success
-}
-var WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServicePollForActivityTaskResult_BadRequestError_DEFAULT
+    return WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+    return WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *WorkflowServicePollForActivityTaskResult) IsSetSuccess() bool {
-  return p.Success != nil
+var WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
 }
-
-func (p *WorkflowServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+var WorkflowServiceTerminateWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceTerminateWorkflowExecutionResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5341,10 +11300,6 @@ func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5353,6 +11308,14 @@ func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
       if err := p.ReadField2(iprot); err != nil {
         return err
       }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5368,15 +11331,7 @@ func (p *WorkflowServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForActivityTaskResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5384,7 +11339,7 @@ func (p *WorkflowServicePollForActivityTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5392,13 +11347,30 @@ func (p *WorkflowServicePollForActivityTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5407,20 +11379,7 @@ func (p *WorkflowServicePollForActivityTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5433,7 +11392,7 @@ func (p *WorkflowServicePollForActivityTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5446,35 +11405,61 @@ func (p *WorkflowServicePollForActivityTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *WorkflowServicePollForActivityTaskResult) String() string {
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceTerminateWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServicePollForActivityTaskResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - HeartbeatRequest
-type WorkflowServiceRecordActivityTaskHeartbeatArgs struct {
-  HeartbeatRequest *shared.RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+//  - ResetRequest
+type WorkflowServiceResetWorkflowExecutionArgs struct {
+  ResetRequest *shared.ResetWorkflowExecutionRequest `thrift:"resetRequest,1" db:"resetRequest" json:"resetRequest"`
 }
 
-func NewWorkflowServiceRecordActivityTaskHeartbeatArgs() *WorkflowServiceRecordActivityTaskHeartbeatArgs {
-  return &WorkflowServiceRecordActivityTaskHeartbeatArgs{}
+func NewWorkflowServiceResetWorkflowExecutionArgs() *WorkflowServiceResetWorkflowExecutionArgs {
+  return &WorkflowServiceResetWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *shared.RecordActivityTaskHeartbeatRequest
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *shared.RecordActivityTaskHeartbeatRequest {
-  if !p.IsSetHeartbeatRequest() {
-    return WorkflowServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+var WorkflowServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT *shared.ResetWorkflowExecutionRequest
+func (p *WorkflowServiceResetWorkflowExecutionArgs) GetResetRequest() *shared.ResetWorkflowExecutionRequest {
+  if !p.IsSetResetRequest() {
+    return WorkflowServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT
   }
-return p.HeartbeatRequest
+return p.ResetRequest
 }
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
-  return p.HeartbeatRequest != nil
+func (p *WorkflowServiceResetWorkflowExecutionArgs) IsSetResetRequest() bool {
+  return p.ResetRequest != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5506,16 +11491,16 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.HeartbeatRequest = &shared.RecordActivityTaskHeartbeatRequest{}
-  if err := p.HeartbeatRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+func (p *WorkflowServiceResetWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ResetRequest = &shared.ResetWorkflowExecutionRequest{}
+  if err := p.ResetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ResetRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+func (p *WorkflowServiceResetWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5527,22 +11512,22 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
-  if err := p.HeartbeatRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+func (p *WorkflowServiceResetWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("resetRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:resetRequest: ", p), err) }
+  if err := p.ResetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ResetRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:resetRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
+func (p *WorkflowServiceResetWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceResetWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -5550,62 +11535,88 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRecordActivityTaskHeartbeatResult struct {
-  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - SessionAlreadyExistError
+//  - ServiceBusyError
+type WorkflowServiceResetWorkflowExecutionResult struct {
+  Success *shared.ResetWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,4" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,5" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewWorkflowServiceRecordActivityTaskHeartbeatResult() *WorkflowServiceRecordActivityTaskHeartbeatResult {
-  return &WorkflowServiceRecordActivityTaskHeartbeatResult{}
+func NewWorkflowServiceResetWorkflowExecutionResult() *WorkflowServiceResetWorkflowExecutionResult {
+  return &WorkflowServiceResetWorkflowExecutionResult{}
 }
 
-var WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
+var WorkflowServiceResetWorkflowExecutionResult_Success_DEFAULT *shared.ResetWorkflowExecutionResponse
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetSuccess() *shared.ResetWorkflowExecutionResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
+    return WorkflowServiceResetWorkflowExecutionResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+    return WorkflowServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+    return WorkflowServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
+var WorkflowServiceResetWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return WorkflowServiceResetWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+  }
+return p.SessionAlreadyExistError
+}
+var WorkflowServiceResetWorkflowExecutionResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *WorkflowServiceResetWorkflowExecutionResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return WorkflowServiceResetWorkflowExecutionResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
+}
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5634,6 +11645,14 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPr
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    case 5:
+      if err := p.ReadField5(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5649,15 +11668,15 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ResetWorkflowExecutionResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5665,7 +11684,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5673,7 +11692,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -5681,14 +11700,32 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult)  ReadField5(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5697,7 +11734,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -5710,7 +11747,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5723,7 +11760,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -5736,7 +11773,7 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -5749,35 +11786,61 @@ func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRecordActivityTaskHeartbeatResult) String() string {
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:sessionAlreadyExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult) writeField5(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 5); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceResetWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceResetWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type WorkflowServiceRespondActivityTaskCompletedArgs struct {
-  CompleteRequest *shared.RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - ListRequest
+type WorkflowServiceListOpenWorkflowExecutionsArgs struct {
+  ListRequest *shared.ListOpenWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCompletedArgs() *WorkflowServiceRespondActivityTaskCompletedArgs {
-  return &WorkflowServiceRespondActivityTaskCompletedArgs{}
+func NewWorkflowServiceListOpenWorkflowExecutionsArgs() *WorkflowServiceListOpenWorkflowExecutionsArgs {
+  return &WorkflowServiceListOpenWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondActivityTaskCompletedRequest
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *shared.RespondActivityTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return WorkflowServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
+var WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListOpenWorkflowExecutionsRequest
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) GetListRequest() *shared.ListOpenWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5809,16 +11872,16 @@ func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &shared.RespondActivityTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.ListOpenWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -5830,72 +11893,85 @@ func (p *WorkflowServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedArgs) String() string {
+func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskCompletedResult struct {
+type WorkflowServiceListOpenWorkflowExecutionsResult struct {
+  Success *shared.ListOpenWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCompletedResult() *WorkflowServiceRespondActivityTaskCompletedResult {
-  return &WorkflowServiceRespondActivityTaskCompletedResult{}
+func NewWorkflowServiceListOpenWorkflowExecutionsResult() *WorkflowServiceListOpenWorkflowExecutionsResult {
+  return &WorkflowServiceListOpenWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT *shared.ListOpenWorkflowExecutionsResponse
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetSuccess() *shared.ListOpenWorkflowExecutionsResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
+    return WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5908,6 +11984,10 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -5935,7 +12015,15 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ListOpenWorkflowExecutionsResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -5943,7 +12031,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -5951,7 +12039,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -5959,10 +12047,11 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -5974,7 +12063,20 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -5987,7 +12089,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6000,7 +12102,7 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6013,35 +12115,35 @@ func (p *WorkflowServiceRespondActivityTaskCompletedResult) writeField3(oprot th
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCompletedResult) String() string {
+func (p *WorkflowServiceListOpenWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - FailRequest
-type WorkflowServiceRespondActivityTaskFailedArgs struct {
-  FailRequest *shared.RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
+//  - ListRequest
+type WorkflowServiceListClosedWorkflowExecutionsArgs struct {
+  ListRequest *shared.ListClosedWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskFailedArgs() *WorkflowServiceRespondActivityTaskFailedArgs {
-  return &WorkflowServiceRespondActivityTaskFailedArgs{}
+func NewWorkflowServiceListClosedWorkflowExecutionsArgs() *WorkflowServiceListClosedWorkflowExecutionsArgs {
+  return &WorkflowServiceListClosedWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *shared.RespondActivityTaskFailedRequest
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) GetFailRequest() *shared.RespondActivityTaskFailedRequest {
-  if !p.IsSetFailRequest() {
-    return WorkflowServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
+var WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListClosedWorkflowExecutionsRequest
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) GetListRequest() *shared.ListClosedWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.FailRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
-  return p.FailRequest != nil
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6073,16 +12175,16 @@ func (p *WorkflowServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.FailRequest = &shared.RespondActivityTaskFailedRequest{}
-  if err := p.FailRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.ListClosedWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6094,72 +12196,85 @@ func (p *WorkflowServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
-  if err := p.FailRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedArgs) String() string {
+func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskFailedResult struct {
+type WorkflowServiceListClosedWorkflowExecutionsResult struct {
+  Success *shared.ListClosedWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskFailedResult() *WorkflowServiceRespondActivityTaskFailedResult {
-  return &WorkflowServiceRespondActivityTaskFailedResult{}
+func NewWorkflowServiceListClosedWorkflowExecutionsResult() *WorkflowServiceListClosedWorkflowExecutionsResult {
+  return &WorkflowServiceListClosedWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT *shared.ListClosedWorkflowExecutionsResponse
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetSuccess() *shared.ListClosedWorkflowExecutionsResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
+    return WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
+    return WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6172,6 +12287,10 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProt
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6199,7 +12318,15 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ListClosedWorkflowExecutionsResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6207,7 +12334,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6215,7 +12342,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6223,10 +12350,11 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrif
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -6238,7 +12366,20 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6251,7 +12392,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField1(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6264,7 +12405,7 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField2(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6277,35 +12418,35 @@ func (p *WorkflowServiceRespondActivityTaskFailedResult) writeField3(oprot thrif
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskFailedResult) String() string {
+func (p *WorkflowServiceListClosedWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskFailedResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CanceledRequest
-type WorkflowServiceRespondActivityTaskCanceledArgs struct {
-  CanceledRequest *shared.RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
+//  - ListRequest
+type WorkflowServiceListWorkflowExecutionsArgs struct {
+  ListRequest *shared.ListWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCanceledArgs() *WorkflowServiceRespondActivityTaskCanceledArgs {
-  return &WorkflowServiceRespondActivityTaskCanceledArgs{}
+func NewWorkflowServiceListWorkflowExecutionsArgs() *WorkflowServiceListWorkflowExecutionsArgs {
+  return &WorkflowServiceListWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *shared.RespondActivityTaskCanceledRequest
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *shared.RespondActivityTaskCanceledRequest {
-  if !p.IsSetCanceledRequest() {
-    return WorkflowServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
+var WorkflowServiceListWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListWorkflowExecutionsRequest
+func (p *WorkflowServiceListWorkflowExecutionsArgs) GetListRequest() *shared.ListWorkflowExecutionsRequest {
+  if !p.IsSetListRequest() {
+    return WorkflowServiceListWorkflowExecutionsArgs_ListRequest_DEFAULT
   }
-return p.CanceledRequest
+return p.ListRequest
 }
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
-  return p.CanceledRequest != nil
+func (p *WorkflowServiceListWorkflowExecutionsArgs) IsSetListRequest() bool {
+  return p.ListRequest != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6337,16 +12478,16 @@ func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CanceledRequest = &shared.RespondActivityTaskCanceledRequest{}
-  if err := p.CanceledRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
+func (p *WorkflowServiceListWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ListRequest = &shared.ListWorkflowExecutionsRequest{}
+  if err := p.ListRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
+func (p *WorkflowServiceListWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6358,72 +12499,85 @@ func (p *WorkflowServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
-  if err := p.CanceledRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
+func (p *WorkflowServiceListWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
+  if err := p.ListRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledArgs) String() string {
+func (p *WorkflowServiceListWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRespondActivityTaskCanceledResult struct {
+type WorkflowServiceListWorkflowExecutionsResult struct {
+  Success *shared.ListWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRespondActivityTaskCanceledResult() *WorkflowServiceRespondActivityTaskCanceledResult {
-  return &WorkflowServiceRespondActivityTaskCanceledResult{}
+func NewWorkflowServiceListWorkflowExecutionsResult() *WorkflowServiceListWorkflowExecutionsResult {
+  return &WorkflowServiceListWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceListWorkflowExecutionsResult_Success_DEFAULT *shared.ListWorkflowExecutionsResponse
+func (p *WorkflowServiceListWorkflowExecutionsResult) GetSuccess() *shared.ListWorkflowExecutionsResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceListWorkflowExecutionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceListWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceListWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
+    return WorkflowServiceListWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceListWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceListWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
+    return WorkflowServiceListWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceListWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceListWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceListWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceListWorkflowExecutionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceListWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceListWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceListWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6436,6 +12590,10 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPr
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6463,7 +12621,15 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ListWorkflowExecutionsResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceListWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6471,7 +12637,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6479,7 +12645,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceListWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6487,10 +12653,11 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
+func (p *WorkflowServiceListWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -6502,7 +12669,20 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceListWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6515,7 +12695,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField1(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6528,7 +12708,7 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField2(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceListWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6541,35 +12721,35 @@ func (p *WorkflowServiceRespondActivityTaskCanceledResult) writeField3(oprot thr
   return err
 }
 
-func (p *WorkflowServiceRespondActivityTaskCanceledResult) String() string {
+func (p *WorkflowServiceListWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRespondActivityTaskCanceledResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceListWorkflowExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CancelRequest
-type WorkflowServiceRequestCancelWorkflowExecutionArgs struct {
-  CancelRequest *shared.RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
+//  - CountRequest
+type WorkflowServiceCountWorkflowExecutionsArgs struct {
+  CountRequest *shared.CountWorkflowExecutionsRequest `thrift:"countRequest,1" db:"countRequest" json:"countRequest"`
 }
 
-func NewWorkflowServiceRequestCancelWorkflowExecutionArgs() *WorkflowServiceRequestCancelWorkflowExecutionArgs {
-  return &WorkflowServiceRequestCancelWorkflowExecutionArgs{}
+func NewWorkflowServiceCountWorkflowExecutionsArgs() *WorkflowServiceCountWorkflowExecutionsArgs {
+  return &WorkflowServiceCountWorkflowExecutionsArgs{}
 }
 
-var WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *shared.RequestCancelWorkflowExecutionRequest
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *shared.RequestCancelWorkflowExecutionRequest {
-  if !p.IsSetCancelRequest() {
-    return WorkflowServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
+var WorkflowServiceCountWorkflowExecutionsArgs_CountRequest_DEFAULT *shared.CountWorkflowExecutionsRequest
+func (p *WorkflowServiceCountWorkflowExecutionsArgs) GetCountRequest() *shared.CountWorkflowExecutionsRequest {
+  if !p.IsSetCountRequest() {
+    return WorkflowServiceCountWorkflowExecutionsArgs_CountRequest_DEFAULT
   }
-return p.CancelRequest
+return p.CountRequest
 }
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
-  return p.CancelRequest != nil
+func (p *WorkflowServiceCountWorkflowExecutionsArgs) IsSetCountRequest() bool {
+  return p.CountRequest != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6601,16 +12781,16 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CancelRequest = &shared.RequestCancelWorkflowExecutionRequest{}
-  if err := p.CancelRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
+func (p *WorkflowServiceCountWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CountRequest = &shared.CountWorkflowExecutionsRequest{}
+  if err := p.CountRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CountRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceCountWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountWorkflowExecutions_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6622,72 +12802,85 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
-  if err := p.CancelRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
+func (p *WorkflowServiceCountWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("countRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:countRequest: ", p), err) }
+  if err := p.CountRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CountRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:countRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceCountWorkflowExecutionsArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceCountWorkflowExecutionsArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceRequestCancelWorkflowExecutionResult struct {
+type WorkflowServiceCountWorkflowExecutionsResult struct {
+  Success *shared.CountWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceRequestCancelWorkflowExecutionResult() *WorkflowServiceRequestCancelWorkflowExecutionResult {
-  return &WorkflowServiceRequestCancelWorkflowExecutionResult{}
+func NewWorkflowServiceCountWorkflowExecutionsResult() *WorkflowServiceCountWorkflowExecutionsResult {
+  return &WorkflowServiceCountWorkflowExecutionsResult{}
 }
 
-var WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceCountWorkflowExecutionsResult_Success_DEFAULT *shared.CountWorkflowExecutionsResponse
+func (p *WorkflowServiceCountWorkflowExecutionsResult) GetSuccess() *shared.CountWorkflowExecutionsResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceCountWorkflowExecutionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceCountWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceCountWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceCountWorkflowExecutionsResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceCountWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceCountWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceCountWorkflowExecutionsResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceCountWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceCountWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceCountWorkflowExecutionsResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceCountWorkflowExecutionsResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6700,6 +12893,10 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -6727,7 +12924,15 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.CountWorkflowExecutionsResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceCountWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6735,7 +12940,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -6743,7 +12948,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceCountWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -6751,10 +12956,11 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CountWorkflowExecutions_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -6766,7 +12972,20 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift
   return nil
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceCountWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -6779,7 +12998,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField1(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -6792,7 +13011,7 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField2(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -6805,35 +13024,35 @@ func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) writeField3(oprot
   return err
 }
 
-func (p *WorkflowServiceRequestCancelWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceCountWorkflowExecutionsResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceCountWorkflowExecutionsResult(%+v)", *p)
 }
 
 // Attributes:
-//  - SignalRequest
-type WorkflowServiceSignalWorkflowExecutionArgs struct {
-  SignalRequest *shared.SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
+//  - CompleteRequest
+type WorkflowServiceRespondQueryTaskCompletedArgs struct {
+  CompleteRequest *shared.RespondQueryTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewWorkflowServiceSignalWorkflowExecutionArgs() *WorkflowServiceSignalWorkflowExecutionArgs {
-  return &WorkflowServiceSignalWorkflowExecutionArgs{}
+func NewWorkflowServiceRespondQueryTaskCompletedArgs() *WorkflowServiceRespondQueryTaskCompletedArgs {
+  return &WorkflowServiceRespondQueryTaskCompletedArgs{}
 }
 
-var WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *shared.SignalWorkflowExecutionRequest
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) GetSignalRequest() *shared.SignalWorkflowExecutionRequest {
-  if !p.IsSetSignalRequest() {
-    return WorkflowServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
+var WorkflowServiceRespondQueryTaskCompletedArgs_CompleteRequest_DEFAULT *shared.RespondQueryTaskCompletedRequest
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) GetCompleteRequest() *shared.RespondQueryTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return WorkflowServiceRespondQueryTaskCompletedArgs_CompleteRequest_DEFAULT
   }
-return p.SignalRequest
+return p.CompleteRequest
 }
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
-  return p.SignalRequest != nil
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6865,16 +13084,16 @@ func (p *WorkflowServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.SignalRequest = &shared.SignalWorkflowExecutionRequest{}
-  if err := p.SignalRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &shared.RespondQueryTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6886,72 +13105,72 @@ func (p *WorkflowServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtoco
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
-  if err := p.SignalRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceRespondQueryTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondQueryTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceSignalWorkflowExecutionResult struct {
+type WorkflowServiceRespondQueryTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceSignalWorkflowExecutionResult() *WorkflowServiceSignalWorkflowExecutionResult {
-  return &WorkflowServiceSignalWorkflowExecutionResult{}
+func NewWorkflowServiceRespondQueryTaskCompletedResult() *WorkflowServiceRespondQueryTaskCompletedResult {
+  return &WorkflowServiceRespondQueryTaskCompletedResult{}
 }
 
-var WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6991,7 +13210,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -6999,7 +13218,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7007,7 +13226,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7015,8 +13234,8 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7030,7 +13249,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7043,7 +13262,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7056,7 +13275,7 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7069,35 +13288,35 @@ func (p *WorkflowServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.
   return err
 }
 
-func (p *WorkflowServiceSignalWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceRespondQueryTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceSignalWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceRespondQueryTaskCompletedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - TerminateRequest
-type WorkflowServiceTerminateWorkflowExecutionArgs struct {
-  TerminateRequest *shared.TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
+//  - QueryRequest
+type WorkflowServiceQueryWorkflowArgs struct {
+  QueryRequest *shared.QueryWorkflowRequest `thrift:"queryRequest,1" db:"queryRequest" json:"queryRequest"`
 }
 
-func NewWorkflowServiceTerminateWorkflowExecutionArgs() *WorkflowServiceTerminateWorkflowExecutionArgs {
-  return &WorkflowServiceTerminateWorkflowExecutionArgs{}
+func NewWorkflowServiceQueryWorkflowArgs() *WorkflowServiceQueryWorkflowArgs {
+  return &WorkflowServiceQueryWorkflowArgs{}
 }
 
-var WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *shared.TerminateWorkflowExecutionRequest
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *shared.TerminateWorkflowExecutionRequest {
-  if !p.IsSetTerminateRequest() {
-    return WorkflowServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
+var WorkflowServiceQueryWorkflowArgs_QueryRequest_DEFAULT *shared.QueryWorkflowRequest
+func (p *WorkflowServiceQueryWorkflowArgs) GetQueryRequest() *shared.QueryWorkflowRequest {
+  if !p.IsSetQueryRequest() {
+    return WorkflowServiceQueryWorkflowArgs_QueryRequest_DEFAULT
   }
-return p.TerminateRequest
+return p.QueryRequest
 }
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
-  return p.TerminateRequest != nil
+func (p *WorkflowServiceQueryWorkflowArgs) IsSetQueryRequest() bool {
+  return p.QueryRequest != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7129,16 +13348,16 @@ func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.TerminateRequest = &shared.TerminateWorkflowExecutionRequest{}
-  if err := p.TerminateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
+func (p *WorkflowServiceQueryWorkflowArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.QueryRequest = &shared.QueryWorkflowRequest{}
+  if err := p.QueryRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
+func (p *WorkflowServiceQueryWorkflowArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7150,72 +13369,98 @@ func (p *WorkflowServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
-  if err := p.TerminateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
+func (p *WorkflowServiceQueryWorkflowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("queryRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queryRequest: ", p), err) }
+  if err := p.QueryRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queryRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionArgs) String() string {
+func (p *WorkflowServiceQueryWorkflowArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceQueryWorkflowArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceTerminateWorkflowExecutionResult struct {
+//  - QueryFailedError
+type WorkflowServiceQueryWorkflowResult struct {
+  Success *shared.QueryWorkflowResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  QueryFailedError *shared.QueryFailedError `thrift:"queryFailedError,4" db:"queryFailedError" json:"queryFailedError,omitempty"`
 }
 
-func NewWorkflowServiceTerminateWorkflowExecutionResult() *WorkflowServiceTerminateWorkflowExecutionResult {
-  return &WorkflowServiceTerminateWorkflowExecutionResult{}
+func NewWorkflowServiceQueryWorkflowResult() *WorkflowServiceQueryWorkflowResult {
+  return &WorkflowServiceQueryWorkflowResult{}
 }
 
-var WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceQueryWorkflowResult_Success_DEFAULT *shared.QueryWorkflowResponse
+func (p *WorkflowServiceQueryWorkflowResult) GetSuccess() *shared.QueryWorkflowResponse {
+  if !p.IsSetSuccess() {
+    return WorkflowServiceQueryWorkflowResult_Success_DEFAULT
+  }
+return p.Success
+}
+var WorkflowServiceQueryWorkflowResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceQueryWorkflowResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
+    return WorkflowServiceQueryWorkflowResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceQueryWorkflowResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceQueryWorkflowResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return WorkflowServiceQueryWorkflowResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceQueryWorkflowResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceQueryWorkflowResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceQueryWorkflowResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
+var WorkflowServiceQueryWorkflowResult_QueryFailedError_DEFAULT *shared.QueryFailedError
+func (p *WorkflowServiceQueryWorkflowResult) GetQueryFailedError() *shared.QueryFailedError {
+  if !p.IsSetQueryFailedError() {
+    return WorkflowServiceQueryWorkflowResult_QueryFailedError_DEFAULT
+  }
+return p.QueryFailedError
+}
+func (p *WorkflowServiceQueryWorkflowResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceQueryWorkflowResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceQueryWorkflowResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult) IsSetQueryFailedError() bool {
+  return p.QueryFailedError != nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7228,6 +13473,10 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TPro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -7240,6 +13489,10 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TPro
       if err := p.ReadField3(iprot); err != nil {
         return err
       }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -7255,7 +13508,15 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.QueryWorkflowResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7263,7 +13524,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7271,7 +13532,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7279,13 +13540,23 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
+func (p *WorkflowServiceQueryWorkflowResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.QueryFailedError = &shared.QueryFailedError{}
+  if err := p.QueryFailedError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryFailedError), err)
+  }
+  return nil
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7294,7 +13565,20 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceQueryWorkflowResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7307,7 +13591,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField1(oprot thri
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceQueryWorkflowResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7320,7 +13604,7 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField2(oprot thri
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceQueryWorkflowResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7333,35 +13617,48 @@ func (p *WorkflowServiceTerminateWorkflowExecutionResult) writeField3(oprot thri
   return err
 }
 
-func (p *WorkflowServiceTerminateWorkflowExecutionResult) String() string {
+func (p *WorkflowServiceQueryWorkflowResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryFailedError() {
+    if err := oprot.WriteFieldBegin("queryFailedError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:queryFailedError: ", p), err) }
+    if err := p.QueryFailedError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryFailedError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:queryFailedError: ", p), err) }
+  }
+  return err
+}
+
+func (p *WorkflowServiceQueryWorkflowResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceTerminateWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceQueryWorkflowResult(%+v)", *p)
 }
 
 // Attributes:
-//  - ListRequest
-type WorkflowServiceListOpenWorkflowExecutionsArgs struct {
-  ListRequest *shared.ListOpenWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
+//  - DescribeRequest
+type WorkflowServiceDescribeWorkflowExecutionArgs struct {
+  DescribeRequest *shared.DescribeWorkflowExecutionRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
 }
 
-func NewWorkflowServiceListOpenWorkflowExecutionsArgs() *WorkflowServiceListOpenWorkflowExecutionsArgs {
-  return &WorkflowServiceListOpenWorkflowExecutionsArgs{}
+func NewWorkflowServiceDescribeWorkflowExecutionArgs() *WorkflowServiceDescribeWorkflowExecutionArgs {
+  return &WorkflowServiceDescribeWorkflowExecutionArgs{}
 }
 
-var WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListOpenWorkflowExecutionsRequest
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) GetListRequest() *shared.ListOpenWorkflowExecutionsRequest {
-  if !p.IsSetListRequest() {
-    return WorkflowServiceListOpenWorkflowExecutionsArgs_ListRequest_DEFAULT
+var WorkflowServiceDescribeWorkflowExecutionArgs_DescribeRequest_DEFAULT *shared.DescribeWorkflowExecutionRequest
+func (p *WorkflowServiceDescribeWorkflowExecutionArgs) GetDescribeRequest() *shared.DescribeWorkflowExecutionRequest {
+  if !p.IsSetDescribeRequest() {
+    return WorkflowServiceDescribeWorkflowExecutionArgs_DescribeRequest_DEFAULT
   }
-return p.ListRequest
+return p.DescribeRequest
 }
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) IsSetListRequest() bool {
-  return p.ListRequest != nil
+func (p *WorkflowServiceDescribeWorkflowExecutionArgs) IsSetDescribeRequest() bool {
+  return p.DescribeRequest != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7393,16 +13690,16 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.ListRequest = &shared.ListOpenWorkflowExecutionsRequest{}
-  if err := p.ListRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
+func (p *WorkflowServiceDescribeWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DescribeRequest = &shared.DescribeWorkflowExecutionRequest{}
+  if err := p.DescribeRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_args"); err != nil {
+func (p *WorkflowServiceDescribeWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7414,22 +13711,22 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
-  if err := p.ListRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
+func (p *WorkflowServiceDescribeWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
+  if err := p.DescribeRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) String() string {
+func (p *WorkflowServiceDescribeWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceDescribeWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -7437,62 +13734,62 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceListOpenWorkflowExecutionsResult struct {
-  Success *shared.ListOpenWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceDescribeWorkflowExecutionResult struct {
+  Success *shared.DescribeWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceListOpenWorkflowExecutionsResult() *WorkflowServiceListOpenWorkflowExecutionsResult {
-  return &WorkflowServiceListOpenWorkflowExecutionsResult{}
+func NewWorkflowServiceDescribeWorkflowExecutionResult() *WorkflowServiceDescribeWorkflowExecutionResult {
+  return &WorkflowServiceDescribeWorkflowExecutionResult{}
 }
 
-var WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT *shared.ListOpenWorkflowExecutionsResponse
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetSuccess() *shared.ListOpenWorkflowExecutionsResponse {
+var WorkflowServiceDescribeWorkflowExecutionResult_Success_DEFAULT *shared.DescribeWorkflowExecutionResponse
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) GetSuccess() *shared.DescribeWorkflowExecutionResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_Success_DEFAULT
+    return WorkflowServiceDescribeWorkflowExecutionResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceDescribeWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_BadRequestError_DEFAULT
+    return WorkflowServiceDescribeWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceDescribeWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_InternalServiceError_DEFAULT
+    return WorkflowServiceDescribeWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceDescribeWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceListOpenWorkflowExecutionsResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceDescribeWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetSuccess() bool {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7536,15 +13833,15 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.ListOpenWorkflowExecutionsResponse{}
+func (p *WorkflowServiceDescribeWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeWorkflowExecutionResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7552,7 +13849,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7560,7 +13857,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7568,8 +13865,8 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListOpenWorkflowExecutions_result"); err != nil {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -7584,7 +13881,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -7597,7 +13894,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField0(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7610,7 +13907,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField1(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7623,7 +13920,7 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField2(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7636,35 +13933,35 @@ func (p *WorkflowServiceListOpenWorkflowExecutionsResult) writeField3(oprot thri
   return err
 }
 
-func (p *WorkflowServiceListOpenWorkflowExecutionsResult) String() string {
+func (p *WorkflowServiceDescribeWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListOpenWorkflowExecutionsResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceDescribeWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - ListRequest
-type WorkflowServiceListClosedWorkflowExecutionsArgs struct {
-  ListRequest *shared.ListClosedWorkflowExecutionsRequest `thrift:"listRequest,1" db:"listRequest" json:"listRequest"`
+//  - Request
+type WorkflowServiceDescribeTaskListArgs struct {
+  Request *shared.DescribeTaskListRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewWorkflowServiceListClosedWorkflowExecutionsArgs() *WorkflowServiceListClosedWorkflowExecutionsArgs {
-  return &WorkflowServiceListClosedWorkflowExecutionsArgs{}
+func NewWorkflowServiceDescribeTaskListArgs() *WorkflowServiceDescribeTaskListArgs {
+  return &WorkflowServiceDescribeTaskListArgs{}
 }
 
-var WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT *shared.ListClosedWorkflowExecutionsRequest
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) GetListRequest() *shared.ListClosedWorkflowExecutionsRequest {
-  if !p.IsSetListRequest() {
-    return WorkflowServiceListClosedWorkflowExecutionsArgs_ListRequest_DEFAULT
+var WorkflowServiceDescribeTaskListArgs_Request_DEFAULT *shared.DescribeTaskListRequest
+func (p *WorkflowServiceDescribeTaskListArgs) GetRequest() *shared.DescribeTaskListRequest {
+  if !p.IsSetRequest() {
+    return WorkflowServiceDescribeTaskListArgs_Request_DEFAULT
   }
-return p.ListRequest
+return p.Request
 }
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) IsSetListRequest() bool {
-  return p.ListRequest != nil
+func (p *WorkflowServiceDescribeTaskListArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeTaskListArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7696,16 +13993,16 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.ListRequest = &shared.ListClosedWorkflowExecutionsRequest{}
-  if err := p.ListRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ListRequest), err)
+func (p *WorkflowServiceDescribeTaskListArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &shared.DescribeTaskListRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_args"); err != nil {
+func (p *WorkflowServiceDescribeTaskListArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeTaskList_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7717,22 +14014,22 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("listRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:listRequest: ", p), err) }
-  if err := p.ListRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ListRequest), err)
+func (p *WorkflowServiceDescribeTaskListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:listRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) String() string {
+func (p *WorkflowServiceDescribeTaskListArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsArgs(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceDescribeTaskListArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -7740,62 +14037,62 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsArgs) String() string {
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
-type WorkflowServiceListClosedWorkflowExecutionsResult struct {
-  Success *shared.ListClosedWorkflowExecutionsResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type WorkflowServiceDescribeTaskListResult struct {
+  Success *shared.DescribeTaskListResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewWorkflowServiceListClosedWorkflowExecutionsResult() *WorkflowServiceListClosedWorkflowExecutionsResult {
-  return &WorkflowServiceListClosedWorkflowExecutionsResult{}
+func NewWorkflowServiceDescribeTaskListResult() *WorkflowServiceDescribeTaskListResult {
+  return &WorkflowServiceDescribeTaskListResult{}
 }
 
-var WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT *shared.ListClosedWorkflowExecutionsResponse
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetSuccess() *shared.ListClosedWorkflowExecutionsResponse {
+var WorkflowServiceDescribeTaskListResult_Success_DEFAULT *shared.DescribeTaskListResponse
+func (p *WorkflowServiceDescribeTaskListResult) GetSuccess() *shared.DescribeTaskListResponse {
   if !p.IsSetSuccess() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_Success_DEFAULT
+    return WorkflowServiceDescribeTaskListResult_Success_DEFAULT
   }
 return p.Success
 }
-var WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetBadRequestError() *shared.BadRequestError {
+var WorkflowServiceDescribeTaskListResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *WorkflowServiceDescribeTaskListResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_BadRequestError_DEFAULT
+    return WorkflowServiceDescribeTaskListResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetInternalServiceError() *shared.InternalServiceError {
+var WorkflowServiceDescribeTaskListResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *WorkflowServiceDescribeTaskListResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_InternalServiceError_DEFAULT
+    return WorkflowServiceDescribeTaskListResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var WorkflowServiceDescribeTaskListResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *WorkflowServiceDescribeTaskListResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return WorkflowServiceListClosedWorkflowExecutionsResult_EntityNotExistError_DEFAULT
+    return WorkflowServiceDescribeTaskListResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetSuccess() bool {
+func (p *WorkflowServiceDescribeTaskListResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetBadRequestError() bool {
+func (p *WorkflowServiceDescribeTaskListResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetInternalServiceError() bool {
+func (p *WorkflowServiceDescribeTaskListResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) IsSetEntityNotExistError() bool {
+func (p *WorkflowServiceDescribeTaskListResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Read(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeTaskListResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7839,15 +14136,15 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.ListClosedWorkflowExecutionsResponse{}
+func (p *WorkflowServiceDescribeTaskListResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeTaskListResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeTaskListResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7855,7 +14152,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeTaskListResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7863,7 +14160,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *WorkflowServiceDescribeTaskListResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7871,8 +14168,8 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListClosedWorkflowExecutions_result"); err != nil {
+func (p *WorkflowServiceDescribeTaskListResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeTaskList_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
@@ -7887,7 +14184,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeTaskListResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -7900,7 +14197,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField0(oprot th
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeTaskListResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7913,7 +14210,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField1(oprot th
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeTaskListResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7926,7 +14223,7 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField2(oprot th
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *WorkflowServiceDescribeTaskListResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7939,11 +14236,11 @@ func (p *WorkflowServiceListClosedWorkflowExecutionsResult) writeField3(oprot th
   return err
 }
 
-func (p *WorkflowServiceListClosedWorkflowExecutionsResult) String() string {
+func (p *WorkflowServiceDescribeTaskListResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("WorkflowServiceListClosedWorkflowExecutionsResult(%+v)", *p)
+  return fmt.Sprintf("WorkflowServiceDescribeTaskListResult(%+v)", *p)
 }
 
 
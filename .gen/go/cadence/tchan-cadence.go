@@ -38,20 +38,33 @@ var _ = shared.GoUnusedProtection__
 
 // TChanWorkflowService is the interface that defines the server handler and client interface.
 type TChanWorkflowService interface {
+	CountWorkflowExecutions(ctx thrift.Context, countRequest *shared.CountWorkflowExecutionsRequest) (*shared.CountWorkflowExecutionsResponse, error)
 	DeprecateDomain(ctx thrift.Context, deprecateRequest *shared.DeprecateDomainRequest) error
 	DescribeDomain(ctx thrift.Context, describeRequest *shared.DescribeDomainRequest) (*shared.DescribeDomainResponse, error)
+	DescribeTaskList(ctx thrift.Context, request *shared.DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error)
+	DescribeWorkflowExecution(ctx thrift.Context, describeRequest *shared.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error)
 	GetWorkflowExecutionHistory(ctx thrift.Context, getRequest *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error)
 	ListClosedWorkflowExecutions(ctx thrift.Context, listRequest *shared.ListClosedWorkflowExecutionsRequest) (*shared.ListClosedWorkflowExecutionsResponse, error)
 	ListOpenWorkflowExecutions(ctx thrift.Context, listRequest *shared.ListOpenWorkflowExecutionsRequest) (*shared.ListOpenWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(ctx thrift.Context, listRequest *shared.ListWorkflowExecutionsRequest) (*shared.ListWorkflowExecutionsResponse, error)
 	PollForActivityTask(ctx thrift.Context, pollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error)
 	PollForDecisionTask(ctx thrift.Context, pollRequest *shared.PollForDecisionTaskRequest) (*shared.PollForDecisionTaskResponse, error)
+	QueryWorkflow(ctx thrift.Context, queryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error)
 	RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
 	RegisterDomain(ctx thrift.Context, registerRequest *shared.RegisterDomainRequest) error
 	RequestCancelWorkflowExecution(ctx thrift.Context, cancelRequest *shared.RequestCancelWorkflowExecutionRequest) error
+	ResetWorkflowExecution(ctx thrift.Context, resetRequest *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledRequest) error
+	RespondActivityTaskCanceledByID(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) error
 	RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedRequest) error
+	RespondActivityTaskCompletedByID(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedByIDRequest) error
 	RespondActivityTaskFailed(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedRequest) error
+	RespondActivityTaskFailedByID(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedByIDRequest) error
 	RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) error
+	RespondDecisionTaskFailed(ctx thrift.Context, failedRequest *shared.RespondDecisionTaskFailedRequest) error
+	RespondQueryTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondQueryTaskCompletedRequest) error
+	SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(ctx thrift.Context, signalRequest *shared.SignalWorkflowExecutionRequest) error
 	StartWorkflowExecution(ctx thrift.Context, startRequest *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(ctx thrift.Context, terminateRequest *shared.TerminateWorkflowExecutionRequest) error
@@ -77,6 +90,28 @@ func NewTChanWorkflowServiceClient(client thrift.TChanClient) TChanWorkflowServi
 	return NewTChanWorkflowServiceInheritedClient("WorkflowService", client)
 }
 
+func (c *tchanWorkflowServiceClient) CountWorkflowExecutions(ctx thrift.Context, countRequest *shared.CountWorkflowExecutionsRequest) (*shared.CountWorkflowExecutionsResponse, error) {
+	var resp WorkflowServiceCountWorkflowExecutionsResult
+	args := WorkflowServiceCountWorkflowExecutionsArgs{
+		CountRequest: countRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "CountWorkflowExecutions", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for CountWorkflowExecutions")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) DeprecateDomain(ctx thrift.Context, deprecateRequest *shared.DeprecateDomainRequest) error {
 	var resp WorkflowServiceDeprecateDomainResult
 	args := WorkflowServiceDeprecateDomainArgs{
@@ -121,6 +156,50 @@ func (c *tchanWorkflowServiceClient) DescribeDomain(ctx thrift.Context, describe
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) DescribeTaskList(ctx thrift.Context, request *shared.DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error) {
+	var resp WorkflowServiceDescribeTaskListResult
+	args := WorkflowServiceDescribeTaskListArgs{
+		Request: request,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "DescribeTaskList", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for DescribeTaskList")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
+func (c *tchanWorkflowServiceClient) DescribeWorkflowExecution(ctx thrift.Context, describeRequest *shared.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+	var resp WorkflowServiceDescribeWorkflowExecutionResult
+	args := WorkflowServiceDescribeWorkflowExecutionArgs{
+		DescribeRequest: describeRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "DescribeWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for DescribeWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) GetWorkflowExecutionHistory(ctx thrift.Context, getRequest *shared.GetWorkflowExecutionHistoryRequest) (*shared.GetWorkflowExecutionHistoryResponse, error) {
 	var resp WorkflowServiceGetWorkflowExecutionHistoryResult
 	args := WorkflowServiceGetWorkflowExecutionHistoryArgs{
@@ -187,6 +266,28 @@ func (c *tchanWorkflowServiceClient) ListOpenWorkflowExecutions(ctx thrift.Conte
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) ListWorkflowExecutions(ctx thrift.Context, listRequest *shared.ListWorkflowExecutionsRequest) (*shared.ListWorkflowExecutionsResponse, error) {
+	var resp WorkflowServiceListWorkflowExecutionsResult
+	args := WorkflowServiceListWorkflowExecutionsArgs{
+		ListRequest: listRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ListWorkflowExecutions", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ListWorkflowExecutions")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) PollForActivityTask(ctx thrift.Context, pollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error) {
 	var resp WorkflowServicePollForActivityTaskResult
 	args := WorkflowServicePollForActivityTaskArgs{
@@ -199,6 +300,8 @@ func (c *tchanWorkflowServiceClient) PollForActivityTask(ctx thrift.Context, pol
 			err = resp.BadRequestError
 		case resp.InternalServiceError != nil:
 			err = resp.InternalServiceError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
 		default:
 			err = fmt.Errorf("received no result or unknown exception for PollForActivityTask")
 		}
@@ -219,6 +322,8 @@ func (c *tchanWorkflowServiceClient) PollForDecisionTask(ctx thrift.Context, pol
 			err = resp.BadRequestError
 		case resp.InternalServiceError != nil:
 			err = resp.InternalServiceError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
 		default:
 			err = fmt.Errorf("received no result or unknown exception for PollForDecisionTask")
 		}
@@ -227,6 +332,30 @@ func (c *tchanWorkflowServiceClient) PollForDecisionTask(ctx thrift.Context, pol
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) QueryWorkflow(ctx thrift.Context, queryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error) {
+	var resp WorkflowServiceQueryWorkflowResult
+	args := WorkflowServiceQueryWorkflowArgs{
+		QueryRequest: queryRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "QueryWorkflow", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.QueryFailedError != nil:
+			err = resp.QueryFailedError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for QueryWorkflow")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
 	var resp WorkflowServiceRecordActivityTaskHeartbeatResult
 	args := WorkflowServiceRecordActivityTaskHeartbeatArgs{
@@ -241,6 +370,8 @@ func (c *tchanWorkflowServiceClient) RecordActivityTaskHeartbeat(ctx thrift.Cont
 			err = resp.InternalServiceError
 		case resp.EntityNotExistError != nil:
 			err = resp.EntityNotExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
 		default:
 			err = fmt.Errorf("received no result or unknown exception for RecordActivityTaskHeartbeat")
 		}
@@ -249,6 +380,30 @@ func (c *tchanWorkflowServiceClient) RecordActivityTaskHeartbeat(ctx thrift.Cont
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanWorkflowServiceClient) RecordActivityTaskHeartbeatByID(ctx thrift.Context, heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (*shared.RecordActivityTaskHeartbeatResponse, error) {
+	var resp WorkflowServiceRecordActivityTaskHeartbeatByIDResult
+	args := WorkflowServiceRecordActivityTaskHeartbeatByIDArgs{
+		HeartbeatRequest: heartbeatRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RecordActivityTaskHeartbeatByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RecordActivityTaskHeartbeatByID")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) RegisterDomain(ctx thrift.Context, registerRequest *shared.RegisterDomainRequest) error {
 	var resp WorkflowServiceRegisterDomainResult
 	args := WorkflowServiceRegisterDomainArgs{
@@ -285,6 +440,8 @@ func (c *tchanWorkflowServiceClient) RequestCancelWorkflowExecution(ctx thrift.C
 			err = resp.InternalServiceError
 		case resp.EntityNotExistError != nil:
 			err = resp.EntityNotExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
 		default:
 			err = fmt.Errorf("received no result or unknown exception for RequestCancelWorkflowExecution")
 		}
@@ -293,6 +450,32 @@ func (c *tchanWorkflowServiceClient) RequestCancelWorkflowExecution(ctx thrift.C
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) ResetWorkflowExecution(ctx thrift.Context, resetRequest *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	var resp WorkflowServiceResetWorkflowExecutionResult
+	args := WorkflowServiceResetWorkflowExecutionArgs{
+		ResetRequest: resetRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ResetWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.SessionAlreadyExistError != nil:
+			err = resp.SessionAlreadyExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ResetWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledRequest) error {
 	var resp WorkflowServiceRespondActivityTaskCanceledResult
 	args := WorkflowServiceRespondActivityTaskCanceledArgs{
@@ -315,6 +498,28 @@ func (c *tchanWorkflowServiceClient) RespondActivityTaskCanceled(ctx thrift.Cont
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) RespondActivityTaskCanceledByID(ctx thrift.Context, canceledRequest *shared.RespondActivityTaskCanceledByIDRequest) error {
+	var resp WorkflowServiceRespondActivityTaskCanceledByIDResult
+	args := WorkflowServiceRespondActivityTaskCanceledByIDArgs{
+		CanceledRequest: canceledRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondActivityTaskCanceledByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondActivityTaskCanceledByID")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanWorkflowServiceClient) RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedRequest) error {
 	var resp WorkflowServiceRespondActivityTaskCompletedResult
 	args := WorkflowServiceRespondActivityTaskCompletedArgs{
@@ -337,6 +542,28 @@ func (c *tchanWorkflowServiceClient) RespondActivityTaskCompleted(ctx thrift.Con
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) RespondActivityTaskCompletedByID(ctx thrift.Context, completeRequest *shared.RespondActivityTaskCompletedByIDRequest) error {
+	var resp WorkflowServiceRespondActivityTaskCompletedByIDResult
+	args := WorkflowServiceRespondActivityTaskCompletedByIDArgs{
+		CompleteRequest: completeRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondActivityTaskCompletedByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondActivityTaskCompletedByID")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanWorkflowServiceClient) RespondActivityTaskFailed(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedRequest) error {
 	var resp WorkflowServiceRespondActivityTaskFailedResult
 	args := WorkflowServiceRespondActivityTaskFailedArgs{
@@ -359,6 +586,28 @@ func (c *tchanWorkflowServiceClient) RespondActivityTaskFailed(ctx thrift.Contex
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) RespondActivityTaskFailedByID(ctx thrift.Context, failRequest *shared.RespondActivityTaskFailedByIDRequest) error {
+	var resp WorkflowServiceRespondActivityTaskFailedByIDResult
+	args := WorkflowServiceRespondActivityTaskFailedByIDArgs{
+		FailRequest: failRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondActivityTaskFailedByID", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondActivityTaskFailedByID")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanWorkflowServiceClient) RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondDecisionTaskCompletedRequest) error {
 	var resp WorkflowServiceRespondDecisionTaskCompletedResult
 	args := WorkflowServiceRespondDecisionTaskCompletedArgs{
@@ -381,6 +630,74 @@ func (c *tchanWorkflowServiceClient) RespondDecisionTaskCompleted(ctx thrift.Con
 	return err
 }
 
+func (c *tchanWorkflowServiceClient) RespondDecisionTaskFailed(ctx thrift.Context, failedRequest *shared.RespondDecisionTaskFailedRequest) error {
+	var resp WorkflowServiceRespondDecisionTaskFailedResult
+	args := WorkflowServiceRespondDecisionTaskFailedArgs{
+		FailedRequest: failedRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondDecisionTaskFailed", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondDecisionTaskFailed")
+		}
+	}
+
+	return err
+}
+
+func (c *tchanWorkflowServiceClient) RespondQueryTaskCompleted(ctx thrift.Context, completeRequest *shared.RespondQueryTaskCompletedRequest) error {
+	var resp WorkflowServiceRespondQueryTaskCompletedResult
+	args := WorkflowServiceRespondQueryTaskCompletedArgs{
+		CompleteRequest: completeRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondQueryTaskCompleted", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondQueryTaskCompleted")
+		}
+	}
+
+	return err
+}
+
+func (c *tchanWorkflowServiceClient) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	var resp WorkflowServiceSignalWithStartWorkflowExecutionResult
+	args := WorkflowServiceSignalWithStartWorkflowExecutionArgs{
+		SignalWithStartRequest: signalWithStartRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "SignalWithStartWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.SessionAlreadyExistError != nil:
+			err = resp.SessionAlreadyExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for SignalWithStartWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanWorkflowServiceClient) SignalWorkflowExecution(ctx thrift.Context, signalRequest *shared.SignalWorkflowExecutionRequest) error {
 	var resp WorkflowServiceSignalWorkflowExecutionResult
 	args := WorkflowServiceSignalWorkflowExecutionArgs{
@@ -395,6 +712,8 @@ func (c *tchanWorkflowServiceClient) SignalWorkflowExecution(ctx thrift.Context,
 			err = resp.InternalServiceError
 		case resp.EntityNotExistError != nil:
 			err = resp.EntityNotExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
 		default:
 			err = fmt.Errorf("received no result or unknown exception for SignalWorkflowExecution")
 		}
@@ -417,6 +736,8 @@ func (c *tchanWorkflowServiceClient) StartWorkflowExecution(ctx thrift.Context,
 			err = resp.InternalServiceError
 		case resp.SessionAlreadyExistError != nil:
 			err = resp.SessionAlreadyExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
 		default:
 			err = fmt.Errorf("received no result or unknown exception for StartWorkflowExecution")
 		}
@@ -439,6 +760,8 @@ func (c *tchanWorkflowServiceClient) TerminateWorkflowExecution(ctx thrift.Conte
 			err = resp.InternalServiceError
 		case resp.EntityNotExistError != nil:
 			err = resp.EntityNotExistError
+		case resp.ServiceBusyError != nil:
+			err = resp.ServiceBusyError
 		default:
 			err = fmt.Errorf("received no result or unknown exception for TerminateWorkflowExecution")
 		}
@@ -487,20 +810,33 @@ func (s *tchanWorkflowServiceServer) Service() string {
 
 func (s *tchanWorkflowServiceServer) Methods() []string {
 	return []string{
+		"CountWorkflowExecutions",
 		"DeprecateDomain",
 		"DescribeDomain",
+		"DescribeTaskList",
+		"DescribeWorkflowExecution",
 		"GetWorkflowExecutionHistory",
 		"ListClosedWorkflowExecutions",
 		"ListOpenWorkflowExecutions",
+		"ListWorkflowExecutions",
 		"PollForActivityTask",
 		"PollForDecisionTask",
+		"QueryWorkflow",
 		"RecordActivityTaskHeartbeat",
+		"RecordActivityTaskHeartbeatByID",
 		"RegisterDomain",
 		"RequestCancelWorkflowExecution",
+		"ResetWorkflowExecution",
 		"RespondActivityTaskCanceled",
+		"RespondActivityTaskCanceledByID",
 		"RespondActivityTaskCompleted",
+		"RespondActivityTaskCompletedByID",
 		"RespondActivityTaskFailed",
+		"RespondActivityTaskFailedByID",
 		"RespondDecisionTaskCompleted",
+		"RespondDecisionTaskFailed",
+		"RespondQueryTaskCompleted",
+		"SignalWithStartWorkflowExecution",
 		"SignalWorkflowExecution",
 		"StartWorkflowExecution",
 		"TerminateWorkflowExecution",
@@ -510,34 +846,60 @@ func (s *tchanWorkflowServiceServer) Methods() []string {
 
 func (s *tchanWorkflowServiceServer) Handle(ctx thrift.Context, methodName string, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	switch methodName {
+	case "CountWorkflowExecutions":
+		return s.handleCountWorkflowExecutions(ctx, protocol)
 	case "DeprecateDomain":
 		return s.handleDeprecateDomain(ctx, protocol)
 	case "DescribeDomain":
 		return s.handleDescribeDomain(ctx, protocol)
+	case "DescribeTaskList":
+		return s.handleDescribeTaskList(ctx, protocol)
+	case "DescribeWorkflowExecution":
+		return s.handleDescribeWorkflowExecution(ctx, protocol)
 	case "GetWorkflowExecutionHistory":
 		return s.handleGetWorkflowExecutionHistory(ctx, protocol)
 	case "ListClosedWorkflowExecutions":
 		return s.handleListClosedWorkflowExecutions(ctx, protocol)
 	case "ListOpenWorkflowExecutions":
 		return s.handleListOpenWorkflowExecutions(ctx, protocol)
+	case "ListWorkflowExecutions":
+		return s.handleListWorkflowExecutions(ctx, protocol)
 	case "PollForActivityTask":
 		return s.handlePollForActivityTask(ctx, protocol)
 	case "PollForDecisionTask":
 		return s.handlePollForDecisionTask(ctx, protocol)
+	case "QueryWorkflow":
+		return s.handleQueryWorkflow(ctx, protocol)
 	case "RecordActivityTaskHeartbeat":
 		return s.handleRecordActivityTaskHeartbeat(ctx, protocol)
+	case "RecordActivityTaskHeartbeatByID":
+		return s.handleRecordActivityTaskHeartbeatByID(ctx, protocol)
 	case "RegisterDomain":
 		return s.handleRegisterDomain(ctx, protocol)
 	case "RequestCancelWorkflowExecution":
 		return s.handleRequestCancelWorkflowExecution(ctx, protocol)
+	case "ResetWorkflowExecution":
+		return s.handleResetWorkflowExecution(ctx, protocol)
 	case "RespondActivityTaskCanceled":
 		return s.handleRespondActivityTaskCanceled(ctx, protocol)
+	case "RespondActivityTaskCanceledByID":
+		return s.handleRespondActivityTaskCanceledByID(ctx, protocol)
 	case "RespondActivityTaskCompleted":
 		return s.handleRespondActivityTaskCompleted(ctx, protocol)
+	case "RespondActivityTaskCompletedByID":
+		return s.handleRespondActivityTaskCompletedByID(ctx, protocol)
 	case "RespondActivityTaskFailed":
 		return s.handleRespondActivityTaskFailed(ctx, protocol)
+	case "RespondActivityTaskFailedByID":
+		return s.handleRespondActivityTaskFailedByID(ctx, protocol)
 	case "RespondDecisionTaskCompleted":
 		return s.handleRespondDecisionTaskCompleted(ctx, protocol)
+	case "RespondDecisionTaskFailed":
+		return s.handleRespondDecisionTaskFailed(ctx, protocol)
+	case "RespondQueryTaskCompleted":
+		return s.handleRespondQueryTaskCompleted(ctx, protocol)
+	case "SignalWithStartWorkflowExecution":
+		return s.handleSignalWithStartWorkflowExecution(ctx, protocol)
 	case "SignalWorkflowExecution":
 		return s.handleSignalWorkflowExecution(ctx, protocol)
 	case "StartWorkflowExecution":
@@ -552,16 +914,16 @@ func (s *tchanWorkflowServiceServer) Handle(ctx thrift.Context, methodName strin
 	}
 }
 
-func (s *tchanWorkflowServiceServer) handleDeprecateDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceDeprecateDomainArgs
-	var res WorkflowServiceDeprecateDomainResult
+func (s *tchanWorkflowServiceServer) handleCountWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceCountWorkflowExecutionsArgs
+	var res WorkflowServiceCountWorkflowExecutionsResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
-	err :=
-		s.handler.DeprecateDomain(ctx, req.DeprecateRequest)
+	r, err :=
+		s.handler.CountWorkflowExecutions(ctx, req.CountRequest)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -584,21 +946,22 @@ func (s *tchanWorkflowServiceServer) handleDeprecateDomain(ctx thrift.Context, p
 			return false, nil, err
 		}
 	} else {
+		res.Success = r
 	}
 
 	return err == nil, &res, nil
 }
 
-func (s *tchanWorkflowServiceServer) handleDescribeDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceDescribeDomainArgs
-	var res WorkflowServiceDescribeDomainResult
+func (s *tchanWorkflowServiceServer) handleDeprecateDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceDeprecateDomainArgs
+	var res WorkflowServiceDeprecateDomainResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
-	r, err :=
-		s.handler.DescribeDomain(ctx, req.DescribeRequest)
+	err :=
+		s.handler.DeprecateDomain(ctx, req.DeprecateRequest)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -621,22 +984,21 @@ func (s *tchanWorkflowServiceServer) handleDescribeDomain(ctx thrift.Context, pr
 			return false, nil, err
 		}
 	} else {
-		res.Success = r
 	}
 
 	return err == nil, &res, nil
 }
 
-func (s *tchanWorkflowServiceServer) handleGetWorkflowExecutionHistory(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceGetWorkflowExecutionHistoryArgs
-	var res WorkflowServiceGetWorkflowExecutionHistoryResult
+func (s *tchanWorkflowServiceServer) handleDescribeDomain(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceDescribeDomainArgs
+	var res WorkflowServiceDescribeDomainResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
 	r, err :=
-		s.handler.GetWorkflowExecutionHistory(ctx, req.GetRequest)
+		s.handler.DescribeDomain(ctx, req.DescribeRequest)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -665,16 +1027,16 @@ func (s *tchanWorkflowServiceServer) handleGetWorkflowExecutionHistory(ctx thrif
 	return err == nil, &res, nil
 }
 
-func (s *tchanWorkflowServiceServer) handleListClosedWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceListClosedWorkflowExecutionsArgs
-	var res WorkflowServiceListClosedWorkflowExecutionsResult
+func (s *tchanWorkflowServiceServer) handleDescribeTaskList(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceDescribeTaskListArgs
+	var res WorkflowServiceDescribeTaskListResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
 	r, err :=
-		s.handler.ListClosedWorkflowExecutions(ctx, req.ListRequest)
+		s.handler.DescribeTaskList(ctx, req.Request)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -703,16 +1065,16 @@ func (s *tchanWorkflowServiceServer) handleListClosedWorkflowExecutions(ctx thri
 	return err == nil, &res, nil
 }
 
-func (s *tchanWorkflowServiceServer) handleListOpenWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServiceListOpenWorkflowExecutionsArgs
-	var res WorkflowServiceListOpenWorkflowExecutionsResult
+func (s *tchanWorkflowServiceServer) handleDescribeWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceDescribeWorkflowExecutionArgs
+	var res WorkflowServiceDescribeWorkflowExecutionResult
 
 	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
 	r, err :=
-		s.handler.ListOpenWorkflowExecutions(ctx, req.ListRequest)
+		s.handler.DescribeWorkflowExecution(ctx, req.DescribeRequest)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -741,11 +1103,163 @@ func (s *tchanWorkflowServiceServer) handleListOpenWorkflowExecutions(ctx thrift
 	return err == nil, &res, nil
 }
 
-func (s *tchanWorkflowServiceServer) handlePollForActivityTask(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
-	var req WorkflowServicePollForActivityTaskArgs
-	var res WorkflowServicePollForActivityTaskResult
-
-	if err := req.Read(protocol); err != nil {
+func (s *tchanWorkflowServiceServer) handleGetWorkflowExecutionHistory(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceGetWorkflowExecutionHistoryArgs
+	var res WorkflowServiceGetWorkflowExecutionHistoryResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.GetWorkflowExecutionHistory(ctx, req.GetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleListClosedWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceListClosedWorkflowExecutionsArgs
+	var res WorkflowServiceListClosedWorkflowExecutionsResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ListClosedWorkflowExecutions(ctx, req.ListRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleListOpenWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceListOpenWorkflowExecutionsArgs
+	var res WorkflowServiceListOpenWorkflowExecutionsResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ListOpenWorkflowExecutions(ctx, req.ListRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleListWorkflowExecutions(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceListWorkflowExecutionsArgs
+	var res WorkflowServiceListWorkflowExecutionsResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ListWorkflowExecutions(ctx, req.ListRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handlePollForActivityTask(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServicePollForActivityTaskArgs
+	var res WorkflowServicePollForActivityTaskResult
+
+	if err := req.Read(protocol); err != nil {
 		return false, nil, err
 	}
 
@@ -764,6 +1278,11 @@ func (s *tchanWorkflowServiceServer) handlePollForActivityTask(ctx thrift.Contex
 				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
 			}
 			res.InternalServiceError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
 		default:
 			return false, nil, err
 		}
@@ -797,6 +1316,54 @@ func (s *tchanWorkflowServiceServer) handlePollForDecisionTask(ctx thrift.Contex
 				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
 			}
 			res.InternalServiceError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleQueryWorkflow(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceQueryWorkflowArgs
+	var res WorkflowServiceQueryWorkflowResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.QueryWorkflow(ctx, req.QueryRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *shared.QueryFailedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for queryFailedError returned non-nil error type *shared.QueryFailedError but nil value")
+			}
+			res.QueryFailedError = v
 		default:
 			return false, nil, err
 		}
@@ -835,6 +1402,54 @@ func (s *tchanWorkflowServiceServer) handleRecordActivityTaskHeartbeat(ctx thrif
 				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
 			}
 			res.EntityNotExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleRecordActivityTaskHeartbeatByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRecordActivityTaskHeartbeatByIDArgs
+	var res WorkflowServiceRecordActivityTaskHeartbeatByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.RecordActivityTaskHeartbeatByID(ctx, req.HeartbeatRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
 		default:
 			return false, nil, err
 		}
@@ -910,6 +1525,11 @@ func (s *tchanWorkflowServiceServer) handleRequestCancelWorkflowExecution(ctx th
 				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
 			}
 			res.EntityNotExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
 		default:
 			return false, nil, err
 		}
@@ -919,6 +1539,54 @@ func (s *tchanWorkflowServiceServer) handleRequestCancelWorkflowExecution(ctx th
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleResetWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceResetWorkflowExecutionArgs
+	var res WorkflowServiceResetWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ResetWorkflowExecution(ctx, req.ResetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
+			}
+			res.SessionAlreadyExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCanceled(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondActivityTaskCanceledArgs
 	var res WorkflowServiceRespondActivityTaskCanceledResult
@@ -956,6 +1624,43 @@ func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCanceled(ctx thrif
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCanceledByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondActivityTaskCanceledByIDArgs
+	var res WorkflowServiceRespondActivityTaskCanceledByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondActivityTaskCanceledByID(ctx, req.CanceledRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCompleted(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondActivityTaskCompletedArgs
 	var res WorkflowServiceRespondActivityTaskCompletedResult
@@ -993,6 +1698,43 @@ func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCompleted(ctx thri
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRespondActivityTaskCompletedByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondActivityTaskCompletedByIDArgs
+	var res WorkflowServiceRespondActivityTaskCompletedByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondActivityTaskCompletedByID(ctx, req.CompleteRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondActivityTaskFailed(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondActivityTaskFailedArgs
 	var res WorkflowServiceRespondActivityTaskFailedResult
@@ -1030,6 +1772,43 @@ func (s *tchanWorkflowServiceServer) handleRespondActivityTaskFailed(ctx thrift.
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRespondActivityTaskFailedByID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondActivityTaskFailedByIDArgs
+	var res WorkflowServiceRespondActivityTaskFailedByIDResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondActivityTaskFailedByID(ctx, req.FailRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleRespondDecisionTaskCompleted(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceRespondDecisionTaskCompletedArgs
 	var res WorkflowServiceRespondDecisionTaskCompletedResult
@@ -1067,6 +1846,123 @@ func (s *tchanWorkflowServiceServer) handleRespondDecisionTaskCompleted(ctx thri
 	return err == nil, &res, nil
 }
 
+func (s *tchanWorkflowServiceServer) handleRespondDecisionTaskFailed(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondDecisionTaskFailedArgs
+	var res WorkflowServiceRespondDecisionTaskFailedResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondDecisionTaskFailed(ctx, req.FailedRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleRespondQueryTaskCompleted(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceRespondQueryTaskCompletedArgs
+	var res WorkflowServiceRespondQueryTaskCompletedResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondQueryTaskCompleted(ctx, req.CompleteRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanWorkflowServiceServer) handleSignalWithStartWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req WorkflowServiceSignalWithStartWorkflowExecutionArgs
+	var res WorkflowServiceSignalWithStartWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.SignalWithStartWorkflowExecution(ctx, req.SignalWithStartRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
+			}
+			res.SessionAlreadyExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanWorkflowServiceServer) handleSignalWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req WorkflowServiceSignalWorkflowExecutionArgs
 	var res WorkflowServiceSignalWorkflowExecutionResult
@@ -1095,6 +1991,11 @@ func (s *tchanWorkflowServiceServer) handleSignalWorkflowExecution(ctx thrift.Co
 				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
 			}
 			res.EntityNotExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
 		default:
 			return false, nil, err
 		}
@@ -1132,6 +2033,11 @@ func (s *tchanWorkflowServiceServer) handleStartWorkflowExecution(ctx thrift.Con
 				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
 			}
 			res.SessionAlreadyExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
 		default:
 			return false, nil, err
 		}
@@ -1170,6 +2076,11 @@ func (s *tchanWorkflowServiceServer) handleTerminateWorkflowExecution(ctx thrift
 				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
 			}
 			res.EntityNotExistError = v
+		case *shared.ServiceBusyError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for serviceBusyError returned non-nil error type *shared.ServiceBusyError but nil value")
+			}
+			res.ServiceBusyError = v
 		default:
 			return false, nil, err
 		}
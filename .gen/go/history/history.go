@@ -623,11 +623,14 @@ func (p *StartWorkflowExecutionRequest) String() string {
 // Attributes:
 //  - DomainUUID
 //  - Execution
+//  - ExpectedNextEventId
 type GetWorkflowExecutionNextEventIDRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
   // unused fields # 11 to 19
   Execution *shared.WorkflowExecution `thrift:"execution,20" db:"execution" json:"execution,omitempty"`
+  // unused fields # 21 to 29
+  ExpectedNextEventId *int64 `thrift:"expectedNextEventId,30" db:"expectedNextEventId" json:"expectedNextEventId,omitempty"`
 }
 
 func NewGetWorkflowExecutionNextEventIDRequest() *GetWorkflowExecutionNextEventIDRequest {
@@ -648,6 +651,13 @@ func (p *GetWorkflowExecutionNextEventIDRequest) GetExecution() *shared.Workflow
   }
 return p.Execution
 }
+var GetWorkflowExecutionNextEventIDRequest_ExpectedNextEventId_DEFAULT int64
+func (p *GetWorkflowExecutionNextEventIDRequest) GetExpectedNextEventId() int64 {
+  if !p.IsSetExpectedNextEventId() {
+    return GetWorkflowExecutionNextEventIDRequest_ExpectedNextEventId_DEFAULT
+  }
+return *p.ExpectedNextEventId
+}
 func (p *GetWorkflowExecutionNextEventIDRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
@@ -656,6 +666,10 @@ func (p *GetWorkflowExecutionNextEventIDRequest) IsSetExecution() bool {
   return p.Execution != nil
 }
 
+func (p *GetWorkflowExecutionNextEventIDRequest) IsSetExpectedNextEventId() bool {
+  return p.ExpectedNextEventId != nil
+}
+
 func (p *GetWorkflowExecutionNextEventIDRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -677,6 +691,10 @@ func (p *GetWorkflowExecutionNextEventIDRequest) Read(iprot thrift.TProtocol) er
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -709,12 +727,22 @@ func (p *GetWorkflowExecutionNextEventIDRequest)  ReadField20(iprot thrift.TProt
   return nil
 }
 
+func (p *GetWorkflowExecutionNextEventIDRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.ExpectedNextEventId = &v
+}
+  return nil
+}
+
 func (p *GetWorkflowExecutionNextEventIDRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("GetWorkflowExecutionNextEventIDRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -748,6 +776,18 @@ func (p *GetWorkflowExecutionNextEventIDRequest) writeField20(oprot thrift.TProt
   return err
 }
 
+func (p *GetWorkflowExecutionNextEventIDRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExpectedNextEventId() {
+    if err := oprot.WriteFieldBegin("expectedNextEventId", thrift.I64, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:expectedNextEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ExpectedNextEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.expectedNextEventId (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:expectedNextEventId: ", p), err) }
+  }
+  return err
+}
+
 func (p *GetWorkflowExecutionNextEventIDRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -758,11 +798,20 @@ func (p *GetWorkflowExecutionNextEventIDRequest) String() string {
 // Attributes:
 //  - EventId
 //  - RunId
+//  - WorkflowType
+//  - TaskList
+//  - IsWorkflowRunning
 type GetWorkflowExecutionNextEventIDResponse struct {
   // unused fields # 1 to 9
   EventId *int64 `thrift:"eventId,10" db:"eventId" json:"eventId,omitempty"`
   // unused fields # 11 to 19
   RunId *string `thrift:"runId,20" db:"runId" json:"runId,omitempty"`
+  // unused fields # 21 to 29
+  WorkflowType *shared.WorkflowType `thrift:"workflowType,30" db:"workflowType" json:"workflowType,omitempty"`
+  // unused fields # 31 to 39
+  TaskList *shared.TaskList `thrift:"taskList,40" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 41 to 49
+  IsWorkflowRunning *bool `thrift:"isWorkflowRunning,50" db:"isWorkflowRunning" json:"isWorkflowRunning,omitempty"`
 }
 
 func NewGetWorkflowExecutionNextEventIDResponse() *GetWorkflowExecutionNextEventIDResponse {
@@ -783,6 +832,27 @@ func (p *GetWorkflowExecutionNextEventIDResponse) GetRunId() string {
   }
 return *p.RunId
 }
+var GetWorkflowExecutionNextEventIDResponse_WorkflowType_DEFAULT *shared.WorkflowType
+func (p *GetWorkflowExecutionNextEventIDResponse) GetWorkflowType() *shared.WorkflowType {
+  if !p.IsSetWorkflowType() {
+    return GetWorkflowExecutionNextEventIDResponse_WorkflowType_DEFAULT
+  }
+return p.WorkflowType
+}
+var GetWorkflowExecutionNextEventIDResponse_TaskList_DEFAULT *shared.TaskList
+func (p *GetWorkflowExecutionNextEventIDResponse) GetTaskList() *shared.TaskList {
+  if !p.IsSetTaskList() {
+    return GetWorkflowExecutionNextEventIDResponse_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var GetWorkflowExecutionNextEventIDResponse_IsWorkflowRunning_DEFAULT bool
+func (p *GetWorkflowExecutionNextEventIDResponse) GetIsWorkflowRunning() bool {
+  if !p.IsSetIsWorkflowRunning() {
+    return GetWorkflowExecutionNextEventIDResponse_IsWorkflowRunning_DEFAULT
+  }
+return *p.IsWorkflowRunning
+}
 func (p *GetWorkflowExecutionNextEventIDResponse) IsSetEventId() bool {
   return p.EventId != nil
 }
@@ -791,6 +861,18 @@ func (p *GetWorkflowExecutionNextEventIDResponse) IsSetRunId() bool {
   return p.RunId != nil
 }
 
+func (p *GetWorkflowExecutionNextEventIDResponse) IsSetWorkflowType() bool {
+  return p.WorkflowType != nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) IsSetIsWorkflowRunning() bool {
+  return p.IsWorkflowRunning != nil
+}
+
 func (p *GetWorkflowExecutionNextEventIDResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -812,6 +894,18 @@ func (p *GetWorkflowExecutionNextEventIDResponse) Read(iprot thrift.TProtocol) e
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -845,12 +939,40 @@ func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField20(iprot thrift.TPro
   return nil
 }
 
+func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField30(iprot thrift.TProtocol) error {
+  p.WorkflowType = &shared.WorkflowType{}
+  if err := p.WorkflowType.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.WorkflowType), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField40(iprot thrift.TProtocol) error {
+  p.TaskList = &shared.TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
+  }
+  return nil
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.IsWorkflowRunning = &v
+}
+  return nil
+}
+
 func (p *GetWorkflowExecutionNextEventIDResponse) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("GetWorkflowExecutionNextEventIDResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -883,6 +1005,44 @@ func (p *GetWorkflowExecutionNextEventIDResponse) writeField20(oprot thrift.TPro
   return err
 }
 
+func (p *GetWorkflowExecutionNextEventIDResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetWorkflowType() {
+    if err := oprot.WriteFieldBegin("workflowType", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:workflowType: ", p), err) }
+    if err := p.WorkflowType.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.WorkflowType), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:workflowType: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:taskList: ", p), err) }
+  }
+  return err
+}
+
+func (p *GetWorkflowExecutionNextEventIDResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetIsWorkflowRunning() {
+    if err := oprot.WriteFieldBegin("isWorkflowRunning", thrift.BOOL, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:isWorkflowRunning: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.IsWorkflowRunning)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.isWorkflowRunning (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:isWorkflowRunning: ", p), err) }
+  }
+  return err
+}
+
 func (p *GetWorkflowExecutionNextEventIDResponse) String() string {
   if p == nil {
     return "<nil>"
@@ -1025,6 +1185,141 @@ func (p *RespondDecisionTaskCompletedRequest) String() string {
   return fmt.Sprintf("RespondDecisionTaskCompletedRequest(%+v)", *p)
 }
 
+// Attributes:
+//  - DomainUUID
+//  - FailedRequest
+type RespondDecisionTaskFailedRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  FailedRequest *shared.RespondDecisionTaskFailedRequest `thrift:"failedRequest,20" db:"failedRequest" json:"failedRequest,omitempty"`
+}
+
+func NewRespondDecisionTaskFailedRequest() *RespondDecisionTaskFailedRequest {
+  return &RespondDecisionTaskFailedRequest{}
+}
+
+var RespondDecisionTaskFailedRequest_DomainUUID_DEFAULT string
+func (p *RespondDecisionTaskFailedRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return RespondDecisionTaskFailedRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var RespondDecisionTaskFailedRequest_FailedRequest_DEFAULT *shared.RespondDecisionTaskFailedRequest
+func (p *RespondDecisionTaskFailedRequest) GetFailedRequest() *shared.RespondDecisionTaskFailedRequest {
+  if !p.IsSetFailedRequest() {
+    return RespondDecisionTaskFailedRequest_FailedRequest_DEFAULT
+  }
+return p.FailedRequest
+}
+func (p *RespondDecisionTaskFailedRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *RespondDecisionTaskFailedRequest) IsSetFailedRequest() bool {
+  return p.FailedRequest != nil
+}
+
+func (p *RespondDecisionTaskFailedRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskFailedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *RespondDecisionTaskFailedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.FailedRequest = &shared.RespondDecisionTaskFailedRequest{}
+  if err := p.FailedRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailedRequest), err)
+  }
+  return nil
+}
+
+func (p *RespondDecisionTaskFailedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskFailedRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondDecisionTaskFailedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskFailedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetFailedRequest() {
+    if err := oprot.WriteFieldBegin("failedRequest", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:failedRequest: ", p), err) }
+    if err := p.FailedRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailedRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:failedRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *RespondDecisionTaskFailedRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondDecisionTaskFailedRequest(%+v)", *p)
+}
+
 // Attributes:
 //  - DomainUUID
 //  - HeartbeatRequest
@@ -1572,6 +1867,7 @@ func (p *RespondActivityTaskCanceledRequest) String() string {
 //  - TaskId
 //  - RequestId
 //  - PollRequest
+//  - ScheduleAttempt
 type RecordActivityTaskStartedRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
@@ -1585,6 +1881,8 @@ type RecordActivityTaskStartedRequest struct {
   RequestId *string `thrift:"requestId,45" db:"requestId" json:"requestId,omitempty"`
   // unused fields # 46 to 49
   PollRequest *shared.PollForActivityTaskRequest `thrift:"pollRequest,50" db:"pollRequest" json:"pollRequest,omitempty"`
+  // unused fields # 51 to 59
+  ScheduleAttempt *int64 `thrift:"scheduleAttempt,60" db:"scheduleAttempt" json:"scheduleAttempt,omitempty"`
 }
 
 func NewRecordActivityTaskStartedRequest() *RecordActivityTaskStartedRequest {
@@ -1633,6 +1931,13 @@ func (p *RecordActivityTaskStartedRequest) GetPollRequest() *shared.PollForActiv
   }
 return p.PollRequest
 }
+var RecordActivityTaskStartedRequest_ScheduleAttempt_DEFAULT int64
+func (p *RecordActivityTaskStartedRequest) GetScheduleAttempt() int64 {
+  if !p.IsSetScheduleAttempt() {
+    return RecordActivityTaskStartedRequest_ScheduleAttempt_DEFAULT
+  }
+return *p.ScheduleAttempt
+}
 func (p *RecordActivityTaskStartedRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
@@ -1657,6 +1962,10 @@ func (p *RecordActivityTaskStartedRequest) IsSetPollRequest() bool {
   return p.PollRequest != nil
 }
 
+func (p *RecordActivityTaskStartedRequest) IsSetScheduleAttempt() bool {
+  return p.ScheduleAttempt != nil
+}
+
 func (p *RecordActivityTaskStartedRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -1694,6 +2003,10 @@ func (p *RecordActivityTaskStartedRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1761,6 +2074,15 @@ func (p *RecordActivityTaskStartedRequest)  ReadField50(iprot thrift.TProtocol)
   return nil
 }
 
+func (p *RecordActivityTaskStartedRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.ScheduleAttempt = &v
+}
+  return nil
+}
+
 func (p *RecordActivityTaskStartedRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("RecordActivityTaskStartedRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -1771,6 +2093,7 @@ func (p *RecordActivityTaskStartedRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField45(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1853,6 +2176,18 @@ func (p *RecordActivityTaskStartedRequest) writeField50(oprot thrift.TProtocol)
   return err
 }
 
+func (p *RecordActivityTaskStartedRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleAttempt() {
+    if err := oprot.WriteFieldBegin("scheduleAttempt", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:scheduleAttempt: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduleAttempt)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleAttempt (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:scheduleAttempt: ", p), err) }
+  }
+  return err
+}
+
 func (p *RecordActivityTaskStartedRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -1863,11 +2198,14 @@ func (p *RecordActivityTaskStartedRequest) String() string {
 // Attributes:
 //  - StartedEvent
 //  - ScheduledEvent
+//  - Attempt
 type RecordActivityTaskStartedResponse struct {
   // unused fields # 1 to 9
   StartedEvent *shared.HistoryEvent `thrift:"startedEvent,10" db:"startedEvent" json:"startedEvent,omitempty"`
   // unused fields # 11 to 19
   ScheduledEvent *shared.HistoryEvent `thrift:"scheduledEvent,20" db:"scheduledEvent" json:"scheduledEvent,omitempty"`
+  // unused fields # 21 to 29
+  Attempt *int32 `thrift:"attempt,30" db:"attempt" json:"attempt,omitempty"`
 }
 
 func NewRecordActivityTaskStartedResponse() *RecordActivityTaskStartedResponse {
@@ -1888,6 +2226,13 @@ func (p *RecordActivityTaskStartedResponse) GetScheduledEvent() *shared.HistoryE
   }
 return p.ScheduledEvent
 }
+var RecordActivityTaskStartedResponse_Attempt_DEFAULT int32
+func (p *RecordActivityTaskStartedResponse) GetAttempt() int32 {
+  if !p.IsSetAttempt() {
+    return RecordActivityTaskStartedResponse_Attempt_DEFAULT
+  }
+return *p.Attempt
+}
 func (p *RecordActivityTaskStartedResponse) IsSetStartedEvent() bool {
   return p.StartedEvent != nil
 }
@@ -1896,6 +2241,10 @@ func (p *RecordActivityTaskStartedResponse) IsSetScheduledEvent() bool {
   return p.ScheduledEvent != nil
 }
 
+func (p *RecordActivityTaskStartedResponse) IsSetAttempt() bool {
+  return p.Attempt != nil
+}
+
 func (p *RecordActivityTaskStartedResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -1917,6 +2266,10 @@ func (p *RecordActivityTaskStartedResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1948,12 +2301,22 @@ func (p *RecordActivityTaskStartedResponse)  ReadField20(iprot thrift.TProtocol)
   return nil
 }
 
+func (p *RecordActivityTaskStartedResponse)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.Attempt = &v
+}
+  return nil
+}
+
 func (p *RecordActivityTaskStartedResponse) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("RecordActivityTaskStartedResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1988,6 +2351,18 @@ func (p *RecordActivityTaskStartedResponse) writeField20(oprot thrift.TProtocol)
   return err
 }
 
+func (p *RecordActivityTaskStartedResponse) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetAttempt() {
+    if err := oprot.WriteFieldBegin("attempt", thrift.I32, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:attempt: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.Attempt)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.attempt (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:attempt: ", p), err) }
+  }
+  return err
+}
+
 func (p *RecordActivityTaskStartedResponse) String() string {
   if p == nil {
     return "<nil>"
@@ -2002,6 +2377,7 @@ func (p *RecordActivityTaskStartedResponse) String() string {
 //  - TaskId
 //  - RequestId
 //  - PollRequest
+//  - ScheduleAttempt
 type RecordDecisionTaskStartedRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
@@ -2015,6 +2391,8 @@ type RecordDecisionTaskStartedRequest struct {
   RequestId *string `thrift:"requestId,45" db:"requestId" json:"requestId,omitempty"`
   // unused fields # 46 to 49
   PollRequest *shared.PollForDecisionTaskRequest `thrift:"pollRequest,50" db:"pollRequest" json:"pollRequest,omitempty"`
+  // unused fields # 51 to 59
+  ScheduleAttempt *int64 `thrift:"scheduleAttempt,60" db:"scheduleAttempt" json:"scheduleAttempt,omitempty"`
 }
 
 func NewRecordDecisionTaskStartedRequest() *RecordDecisionTaskStartedRequest {
@@ -2063,6 +2441,13 @@ func (p *RecordDecisionTaskStartedRequest) GetPollRequest() *shared.PollForDecis
   }
 return p.PollRequest
 }
+var RecordDecisionTaskStartedRequest_ScheduleAttempt_DEFAULT int64
+func (p *RecordDecisionTaskStartedRequest) GetScheduleAttempt() int64 {
+  if !p.IsSetScheduleAttempt() {
+    return RecordDecisionTaskStartedRequest_ScheduleAttempt_DEFAULT
+  }
+return *p.ScheduleAttempt
+}
 func (p *RecordDecisionTaskStartedRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
@@ -2087,6 +2472,10 @@ func (p *RecordDecisionTaskStartedRequest) IsSetPollRequest() bool {
   return p.PollRequest != nil
 }
 
+func (p *RecordDecisionTaskStartedRequest) IsSetScheduleAttempt() bool {
+  return p.ScheduleAttempt != nil
+}
+
 func (p *RecordDecisionTaskStartedRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -2124,6 +2513,10 @@ func (p *RecordDecisionTaskStartedRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2191,6 +2584,15 @@ func (p *RecordDecisionTaskStartedRequest)  ReadField50(iprot thrift.TProtocol)
   return nil
 }
 
+func (p *RecordDecisionTaskStartedRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.ScheduleAttempt = &v
+}
+  return nil
+}
+
 func (p *RecordDecisionTaskStartedRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("RecordDecisionTaskStartedRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -2201,6 +2603,7 @@ func (p *RecordDecisionTaskStartedRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField45(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2283,7 +2686,19 @@ func (p *RecordDecisionTaskStartedRequest) writeField50(oprot thrift.TProtocol)
   return err
 }
 
-func (p *RecordDecisionTaskStartedRequest) String() string {
+func (p *RecordDecisionTaskStartedRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleAttempt() {
+    if err := oprot.WriteFieldBegin("scheduleAttempt", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:scheduleAttempt: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduleAttempt)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleAttempt (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:scheduleAttempt: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordDecisionTaskStartedRequest) String() string {
   if p == nil {
     return "<nil>"
   }
@@ -2294,6 +2709,10 @@ func (p *RecordDecisionTaskStartedRequest) String() string {
 //  - WorkflowType
 //  - PreviousStartedEventId
 //  - StartedEventId
+//  - StickyExecutionEnabled
+//  - ScheduledEventId
+//  - Attempt
+//  - DecisionInfo
 type RecordDecisionTaskStartedResponse struct {
   // unused fields # 1 to 9
   WorkflowType *shared.WorkflowType `thrift:"workflowType,10" db:"workflowType" json:"workflowType,omitempty"`
@@ -2301,6 +2720,14 @@ type RecordDecisionTaskStartedResponse struct {
   PreviousStartedEventId *int64 `thrift:"previousStartedEventId,20" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
   // unused fields # 21 to 29
   StartedEventId *int64 `thrift:"startedEventId,30" db:"startedEventId" json:"startedEventId,omitempty"`
+  // unused fields # 31 to 39
+  StickyExecutionEnabled *bool `thrift:"stickyExecutionEnabled,40" db:"stickyExecutionEnabled" json:"stickyExecutionEnabled,omitempty"`
+  // unused fields # 41 to 49
+  ScheduledEventId *int64 `thrift:"scheduledEventId,50" db:"scheduledEventId" json:"scheduledEventId,omitempty"`
+  // unused fields # 51 to 59
+  Attempt *int64 `thrift:"attempt,60" db:"attempt" json:"attempt,omitempty"`
+  // unused fields # 61 to 69
+  DecisionInfo *shared.TransientDecisionInfo `thrift:"decisionInfo,70" db:"decisionInfo" json:"decisionInfo,omitempty"`
 }
 
 func NewRecordDecisionTaskStartedResponse() *RecordDecisionTaskStartedResponse {
@@ -2328,6 +2755,34 @@ func (p *RecordDecisionTaskStartedResponse) GetStartedEventId() int64 {
   }
 return *p.StartedEventId
 }
+var RecordDecisionTaskStartedResponse_StickyExecutionEnabled_DEFAULT bool
+func (p *RecordDecisionTaskStartedResponse) GetStickyExecutionEnabled() bool {
+  if !p.IsSetStickyExecutionEnabled() {
+    return RecordDecisionTaskStartedResponse_StickyExecutionEnabled_DEFAULT
+  }
+return *p.StickyExecutionEnabled
+}
+var RecordDecisionTaskStartedResponse_ScheduledEventId_DEFAULT int64
+func (p *RecordDecisionTaskStartedResponse) GetScheduledEventId() int64 {
+  if !p.IsSetScheduledEventId() {
+    return RecordDecisionTaskStartedResponse_ScheduledEventId_DEFAULT
+  }
+return *p.ScheduledEventId
+}
+var RecordDecisionTaskStartedResponse_Attempt_DEFAULT int64
+func (p *RecordDecisionTaskStartedResponse) GetAttempt() int64 {
+  if !p.IsSetAttempt() {
+    return RecordDecisionTaskStartedResponse_Attempt_DEFAULT
+  }
+return *p.Attempt
+}
+var RecordDecisionTaskStartedResponse_DecisionInfo_DEFAULT *shared.TransientDecisionInfo
+func (p *RecordDecisionTaskStartedResponse) GetDecisionInfo() *shared.TransientDecisionInfo {
+  if !p.IsSetDecisionInfo() {
+    return RecordDecisionTaskStartedResponse_DecisionInfo_DEFAULT
+  }
+return p.DecisionInfo
+}
 func (p *RecordDecisionTaskStartedResponse) IsSetWorkflowType() bool {
   return p.WorkflowType != nil
 }
@@ -2340,6 +2795,22 @@ func (p *RecordDecisionTaskStartedResponse) IsSetStartedEventId() bool {
   return p.StartedEventId != nil
 }
 
+func (p *RecordDecisionTaskStartedResponse) IsSetStickyExecutionEnabled() bool {
+  return p.StickyExecutionEnabled != nil
+}
+
+func (p *RecordDecisionTaskStartedResponse) IsSetScheduledEventId() bool {
+  return p.ScheduledEventId != nil
+}
+
+func (p *RecordDecisionTaskStartedResponse) IsSetAttempt() bool {
+  return p.Attempt != nil
+}
+
+func (p *RecordDecisionTaskStartedResponse) IsSetDecisionInfo() bool {
+  return p.DecisionInfo != nil
+}
+
 func (p *RecordDecisionTaskStartedResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -2365,6 +2836,22 @@ func (p *RecordDecisionTaskStartedResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField30(iprot); err != nil {
         return err
       }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2406,6 +2893,41 @@ func (p *RecordDecisionTaskStartedResponse)  ReadField30(iprot thrift.TProtocol)
   return nil
 }
 
+func (p *RecordDecisionTaskStartedResponse)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.StickyExecutionEnabled = &v
+}
+  return nil
+}
+
+func (p *RecordDecisionTaskStartedResponse)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.ScheduledEventId = &v
+}
+  return nil
+}
+
+func (p *RecordDecisionTaskStartedResponse)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.Attempt = &v
+}
+  return nil
+}
+
+func (p *RecordDecisionTaskStartedResponse)  ReadField70(iprot thrift.TProtocol) error {
+  p.DecisionInfo = &shared.TransientDecisionInfo{}
+  if err := p.DecisionInfo.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionInfo), err)
+  }
+  return nil
+}
+
 func (p *RecordDecisionTaskStartedResponse) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("RecordDecisionTaskStartedResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -2413,6 +2935,10 @@ func (p *RecordDecisionTaskStartedResponse) Write(oprot thrift.TProtocol) error
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2458,6 +2984,55 @@ func (p *RecordDecisionTaskStartedResponse) writeField30(oprot thrift.TProtocol)
   return err
 }
 
+func (p *RecordDecisionTaskStartedResponse) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStickyExecutionEnabled() {
+    if err := oprot.WriteFieldBegin("stickyExecutionEnabled", thrift.BOOL, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:stickyExecutionEnabled: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.StickyExecutionEnabled)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.stickyExecutionEnabled (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:stickyExecutionEnabled: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordDecisionTaskStartedResponse) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduledEventId() {
+    if err := oprot.WriteFieldBegin("scheduledEventId", thrift.I64, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:scheduledEventId: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduledEventId)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduledEventId (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:scheduledEventId: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordDecisionTaskStartedResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetAttempt() {
+    if err := oprot.WriteFieldBegin("attempt", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:attempt: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.Attempt)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.attempt (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:attempt: ", p), err) }
+  }
+  return err
+}
+
+func (p *RecordDecisionTaskStartedResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionInfo() {
+    if err := oprot.WriteFieldBegin("decisionInfo", thrift.STRUCT, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:decisionInfo: ", p), err) }
+    if err := p.DecisionInfo.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionInfo), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:decisionInfo: ", p), err) }
+  }
+  return err
+}
+
 func (p *RecordDecisionTaskStartedResponse) String() string {
   if p == nil {
     return "<nil>"
@@ -2600,14 +3175,155 @@ func (p *SignalWorkflowExecutionRequest) String() string {
   return fmt.Sprintf("SignalWorkflowExecutionRequest(%+v)", *p)
 }
 
+// Attributes:
+//  - DomainUUID
+//  - SignalWithStartRequest
+type SignalWithStartWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  SignalWithStartRequest *shared.SignalWithStartWorkflowExecutionRequest `thrift:"signalWithStartRequest,20" db:"signalWithStartRequest" json:"signalWithStartRequest,omitempty"`
+}
+
+func NewSignalWithStartWorkflowExecutionRequest() *SignalWithStartWorkflowExecutionRequest {
+  return &SignalWithStartWorkflowExecutionRequest{}
+}
+
+var SignalWithStartWorkflowExecutionRequest_DomainUUID_DEFAULT string
+func (p *SignalWithStartWorkflowExecutionRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return SignalWithStartWorkflowExecutionRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var SignalWithStartWorkflowExecutionRequest_SignalWithStartRequest_DEFAULT *shared.SignalWithStartWorkflowExecutionRequest
+func (p *SignalWithStartWorkflowExecutionRequest) GetSignalWithStartRequest() *shared.SignalWithStartWorkflowExecutionRequest {
+  if !p.IsSetSignalWithStartRequest() {
+    return SignalWithStartWorkflowExecutionRequest_SignalWithStartRequest_DEFAULT
+  }
+return p.SignalWithStartRequest
+}
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) IsSetSignalWithStartRequest() bool {
+  return p.SignalWithStartRequest != nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.SignalWithStartRequest = &shared.SignalWithStartWorkflowExecutionRequest{}
+  if err := p.SignalWithStartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalWithStartRequest), err)
+  }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSignalWithStartRequest() {
+    if err := oprot.WriteFieldBegin("signalWithStartRequest", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:signalWithStartRequest: ", p), err) }
+    if err := p.SignalWithStartRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalWithStartRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:signalWithStartRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *SignalWithStartWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SignalWithStartWorkflowExecutionRequest(%+v)", *p)
+}
+
 // Attributes:
 //  - DomainUUID
 //  - TerminateRequest
+//  - ExternalWorkflowExecution
+//  - ChildWorkflowOnly
 type TerminateWorkflowExecutionRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
   // unused fields # 11 to 19
   TerminateRequest *shared.TerminateWorkflowExecutionRequest `thrift:"terminateRequest,20" db:"terminateRequest" json:"terminateRequest,omitempty"`
+  // unused fields # 21 to 29
+  ExternalWorkflowExecution *shared.WorkflowExecution `thrift:"externalWorkflowExecution,30" db:"externalWorkflowExecution" json:"externalWorkflowExecution,omitempty"`
+  // unused fields # 31 to 39
+  ChildWorkflowOnly *bool `thrift:"childWorkflowOnly,40" db:"childWorkflowOnly" json:"childWorkflowOnly,omitempty"`
 }
 
 func NewTerminateWorkflowExecutionRequest() *TerminateWorkflowExecutionRequest {
@@ -2628,6 +3344,20 @@ func (p *TerminateWorkflowExecutionRequest) GetTerminateRequest() *shared.Termin
   }
 return p.TerminateRequest
 }
+var TerminateWorkflowExecutionRequest_ExternalWorkflowExecution_DEFAULT *shared.WorkflowExecution
+func (p *TerminateWorkflowExecutionRequest) GetExternalWorkflowExecution() *shared.WorkflowExecution {
+  if !p.IsSetExternalWorkflowExecution() {
+    return TerminateWorkflowExecutionRequest_ExternalWorkflowExecution_DEFAULT
+  }
+return p.ExternalWorkflowExecution
+}
+var TerminateWorkflowExecutionRequest_ChildWorkflowOnly_DEFAULT bool
+func (p *TerminateWorkflowExecutionRequest) GetChildWorkflowOnly() bool {
+  if !p.IsSetChildWorkflowOnly() {
+    return TerminateWorkflowExecutionRequest_ChildWorkflowOnly_DEFAULT
+  }
+return *p.ChildWorkflowOnly
+}
 func (p *TerminateWorkflowExecutionRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
@@ -2636,6 +3366,14 @@ func (p *TerminateWorkflowExecutionRequest) IsSetTerminateRequest() bool {
   return p.TerminateRequest != nil
 }
 
+func (p *TerminateWorkflowExecutionRequest) IsSetExternalWorkflowExecution() bool {
+  return p.ExternalWorkflowExecution != nil
+}
+
+func (p *TerminateWorkflowExecutionRequest) IsSetChildWorkflowOnly() bool {
+  return p.ChildWorkflowOnly != nil
+}
+
 func (p *TerminateWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -2657,6 +3395,14 @@ func (p *TerminateWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField20(iprot); err != nil {
         return err
       }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2689,12 +3435,31 @@ func (p *TerminateWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol)
   return nil
 }
 
+func (p *TerminateWorkflowExecutionRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.ExternalWorkflowExecution = &shared.WorkflowExecution{}
+  if err := p.ExternalWorkflowExecution.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ExternalWorkflowExecution), err)
+  }
+  return nil
+}
+
+func (p *TerminateWorkflowExecutionRequest)  ReadField40(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 40: ", err)
+} else {
+  p.ChildWorkflowOnly = &v
+}
+  return nil
+}
+
 func (p *TerminateWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("TerminateWorkflowExecutionRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField10(oprot); err != nil { return err }
     if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2728,6 +3493,31 @@ func (p *TerminateWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol)
   return err
 }
 
+func (p *TerminateWorkflowExecutionRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetExternalWorkflowExecution() {
+    if err := oprot.WriteFieldBegin("externalWorkflowExecution", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:externalWorkflowExecution: ", p), err) }
+    if err := p.ExternalWorkflowExecution.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ExternalWorkflowExecution), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:externalWorkflowExecution: ", p), err) }
+  }
+  return err
+}
+
+func (p *TerminateWorkflowExecutionRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowOnly() {
+    if err := oprot.WriteFieldBegin("childWorkflowOnly", thrift.BOOL, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:childWorkflowOnly: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.ChildWorkflowOnly)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.childWorkflowOnly (40) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:childWorkflowOnly: ", p), err) }
+  }
+  return err
+}
+
 func (p *TerminateWorkflowExecutionRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -2740,6 +3530,7 @@ func (p *TerminateWorkflowExecutionRequest) String() string {
 //  - CancelRequest
 //  - ExternalInitiatedEventId
 //  - ExternalWorkflowExecution
+//  - ChildWorkflowOnly
 type RequestCancelWorkflowExecutionRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
@@ -2749,6 +3540,8 @@ type RequestCancelWorkflowExecutionRequest struct {
   ExternalInitiatedEventId *int64 `thrift:"externalInitiatedEventId,30" db:"externalInitiatedEventId" json:"externalInitiatedEventId,omitempty"`
   // unused fields # 31 to 39
   ExternalWorkflowExecution *shared.WorkflowExecution `thrift:"externalWorkflowExecution,40" db:"externalWorkflowExecution" json:"externalWorkflowExecution,omitempty"`
+  // unused fields # 41 to 49
+  ChildWorkflowOnly *bool `thrift:"childWorkflowOnly,50" db:"childWorkflowOnly" json:"childWorkflowOnly,omitempty"`
 }
 
 func NewRequestCancelWorkflowExecutionRequest() *RequestCancelWorkflowExecutionRequest {
@@ -2783,6 +3576,13 @@ func (p *RequestCancelWorkflowExecutionRequest) GetExternalWorkflowExecution() *
   }
 return p.ExternalWorkflowExecution
 }
+var RequestCancelWorkflowExecutionRequest_ChildWorkflowOnly_DEFAULT bool
+func (p *RequestCancelWorkflowExecutionRequest) GetChildWorkflowOnly() bool {
+  if !p.IsSetChildWorkflowOnly() {
+    return RequestCancelWorkflowExecutionRequest_ChildWorkflowOnly_DEFAULT
+  }
+return *p.ChildWorkflowOnly
+}
 func (p *RequestCancelWorkflowExecutionRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
@@ -2799,6 +3599,10 @@ func (p *RequestCancelWorkflowExecutionRequest) IsSetExternalWorkflowExecution()
   return p.ExternalWorkflowExecution != nil
 }
 
+func (p *RequestCancelWorkflowExecutionRequest) IsSetChildWorkflowOnly() bool {
+  return p.ChildWorkflowOnly != nil
+}
+
 func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -2828,6 +3632,10 @@ func (p *RequestCancelWorkflowExecutionRequest) Read(iprot thrift.TProtocol) err
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2877,6 +3685,15 @@ func (p *RequestCancelWorkflowExecutionRequest)  ReadField40(iprot thrift.TProto
   return nil
 }
 
+func (p *RequestCancelWorkflowExecutionRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.ChildWorkflowOnly = &v
+}
+  return nil
+}
+
 func (p *RequestCancelWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("RequestCancelWorkflowExecutionRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -2885,6 +3702,7 @@ func (p *RequestCancelWorkflowExecutionRequest) Write(oprot thrift.TProtocol) er
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2943,6 +3761,18 @@ func (p *RequestCancelWorkflowExecutionRequest) writeField40(oprot thrift.TProto
   return err
 }
 
+func (p *RequestCancelWorkflowExecutionRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetChildWorkflowOnly() {
+    if err := oprot.WriteFieldBegin("childWorkflowOnly", thrift.BOOL, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:childWorkflowOnly: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.ChildWorkflowOnly)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.childWorkflowOnly (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:childWorkflowOnly: ", p), err) }
+  }
+  return err
+}
+
 func (p *RequestCancelWorkflowExecutionRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -3347,9 +4177,279 @@ func (p *RecordChildExecutionCompletedRequest) String() string {
   return fmt.Sprintf("RecordChildExecutionCompletedRequest(%+v)", *p)
 }
 
-type HistoryService interface {  //HistoryService provides API to start a new long running workflow instance, as well as query and update the history
-  //of workflow instances already created.
-  //
+// Attributes:
+//  - DomainUUID
+//  - Request
+type DescribeWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  Request *shared.DescribeWorkflowExecutionRequest `thrift:"request,20" db:"request" json:"request,omitempty"`
+}
+
+func NewDescribeWorkflowExecutionRequest() *DescribeWorkflowExecutionRequest {
+  return &DescribeWorkflowExecutionRequest{}
+}
+
+var DescribeWorkflowExecutionRequest_DomainUUID_DEFAULT string
+func (p *DescribeWorkflowExecutionRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return DescribeWorkflowExecutionRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var DescribeWorkflowExecutionRequest_Request_DEFAULT *shared.DescribeWorkflowExecutionRequest
+func (p *DescribeWorkflowExecutionRequest) GetRequest() *shared.DescribeWorkflowExecutionRequest {
+  if !p.IsSetRequest() {
+    return DescribeWorkflowExecutionRequest_Request_DEFAULT
+  }
+return p.Request
+}
+func (p *DescribeWorkflowExecutionRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *DescribeWorkflowExecutionRequest) IsSetRequest() bool {
+  return p.Request != nil
+}
+
+func (p *DescribeWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *DescribeWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *DescribeWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.Request = &shared.DescribeWorkflowExecutionRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
+  }
+  return nil
+}
+
+func (p *DescribeWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *DescribeWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *DescribeWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetRequest() {
+    if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:request: ", p), err) }
+    if err := p.Request.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:request: ", p), err) }
+  }
+  return err
+}
+
+func (p *DescribeWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("DescribeWorkflowExecutionRequest(%+v)", *p)
+}
+
+// Attributes:
+//  - DomainUUID
+//  - ResetRequest
+type ResetWorkflowExecutionRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  ResetRequest *shared.ResetWorkflowExecutionRequest `thrift:"resetRequest,20" db:"resetRequest" json:"resetRequest,omitempty"`
+}
+
+func NewResetWorkflowExecutionRequest() *ResetWorkflowExecutionRequest {
+  return &ResetWorkflowExecutionRequest{}
+}
+
+var ResetWorkflowExecutionRequest_DomainUUID_DEFAULT string
+func (p *ResetWorkflowExecutionRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return ResetWorkflowExecutionRequest_DomainUUID_DEFAULT
+  }
+return *p.DomainUUID
+}
+var ResetWorkflowExecutionRequest_ResetRequest_DEFAULT *shared.ResetWorkflowExecutionRequest
+func (p *ResetWorkflowExecutionRequest) GetResetRequest() *shared.ResetWorkflowExecutionRequest {
+  if !p.IsSetResetRequest() {
+    return ResetWorkflowExecutionRequest_ResetRequest_DEFAULT
+  }
+return p.ResetRequest
+}
+func (p *ResetWorkflowExecutionRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) IsSetResetRequest() bool {
+  return p.ResetRequest != nil
+}
+
+func (p *ResetWorkflowExecutionRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ResetWorkflowExecutionRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *ResetWorkflowExecutionRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.ResetRequest = &shared.ResetWorkflowExecutionRequest{}
+  if err := p.ResetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ResetRequest), err)
+  }
+  return nil
+}
+
+func (p *ResetWorkflowExecutionRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecutionRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ResetWorkflowExecutionRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
+  }
+  return err
+}
+
+func (p *ResetWorkflowExecutionRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetResetRequest() {
+    if err := oprot.WriteFieldBegin("resetRequest", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:resetRequest: ", p), err) }
+    if err := p.ResetRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ResetRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:resetRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *ResetWorkflowExecutionRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ResetWorkflowExecutionRequest(%+v)", *p)
+}
+
+type HistoryService interface {  //HistoryService provides API to start a new long running workflow instance, as well as query and update the history
+  //of workflow instances already created.
+  //
 
   // StartWorkflowExecution starts a new long running workflow instance.  It will create the instance with
   // 'WorkflowExecutionStarted' event in history and also schedule the first DecisionTask for the worker to make the
@@ -3362,6 +4462,7 @@ type HistoryService interface {  //HistoryService provides API to start a new lo
   StartWorkflowExecution(startRequest *StartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
   // Returns the nextEventID of the history of workflow execution. Only events in the history with Ids below the returned Id are
   // guaranteed to be valid, so the first step of reading an execution's history is to retrieve this event Id.
+  // When expectedNextEventId is set, the call long polls until the nextEventID moves past it or the execution closes.
   // It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
   // 
   // 
@@ -3394,6 +4495,14 @@ type HistoryService interface {  //HistoryService provides API to start a new lo
   // Parameters:
   //  - CompleteRequest
   RespondDecisionTaskCompleted(completeRequest *RespondDecisionTaskCompletedRequest) (err error)
+  // RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in
+  // DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to
+  // report any failures like non-determinism or panics during DecisionTask processing.
+  // 
+  // 
+  // Parameters:
+  //  - FailedRequest
+  RespondDecisionTaskFailed(failedRequest *RespondDecisionTaskFailedRequest) (err error)
   // RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
   // to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
   // 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
@@ -3441,6 +4550,15 @@ type HistoryService interface {  //HistoryService provides API to start a new lo
   // Parameters:
   //  - SignalRequest
   SignalWorkflowExecution(signalRequest *SignalWorkflowExecutionRequest) (err error)
+  // SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+  // is running, this results in WorkflowExecutionSignaled event recorded in the history and a decision task being
+  // created for the execution.  If the workflow is not running, a new workflow execution is started with the
+  // WorkflowExecutionSignaled event recorded right after its WorkflowExecutionStarted event.
+  // 
+  // 
+  // Parameters:
+  //  - SignalWithStartRequest
+  SignalWithStartWorkflowExecution(signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error)
   // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
   // in the history and immediately terminating the execution instance.
   // 
@@ -3473,6 +4591,21 @@ type HistoryService interface {  //HistoryService provides API to start a new lo
   // Parameters:
   //  - CompletionRequest
   RecordChildExecutionCompleted(completionRequest *RecordChildExecutionCompletedRequest) (err error)
+  // DescribeWorkflowExecution returns information about the specified workflow execution, including its configuration
+  // and the pending activities, timers, child executions and decision taken from its mutable state.
+  // 
+  // 
+  // Parameters:
+  //  - DescribeRequest
+  DescribeWorkflowExecution(describeRequest *DescribeWorkflowExecutionRequest) (r *shared.DescribeWorkflowExecutionResponse, err error)
+  // ResetWorkflowExecution starts a new run of the workflow execution whose history is a copy of the current run up to
+  // the given DecisionTaskCompleted event, reapplies the signals received after that point and terminates the current
+  // run if it is still open.
+  // 
+  // 
+  // Parameters:
+  //  - ResetRequest
+  ResetWorkflowExecution(resetRequest *ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error)
 }
 
 //HistoryService provides API to start a new long running workflow instance, as well as query and update the history
@@ -3601,6 +4734,7 @@ func (p *HistoryServiceClient) recvStartWorkflowExecution() (value *shared.Start
 
 // Returns the nextEventID of the history of workflow execution. Only events in the history with Ids below the returned Id are
 // guaranteed to be valid, so the first step of reading an execution's history is to retrieve this event Id.
+// When expectedNextEventId is set, the call long polls until the nextEventID moves past it or the execution closes.
 // It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
 // 
 // 
@@ -3982,32 +5116,30 @@ func (p *HistoryServiceClient) recvRespondDecisionTaskCompleted() (err error) {
   return
 }
 
-// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
-// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
-// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
-// fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for heartbeating.
+// RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in
+// DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to
+// report any failures like non-determinism or panics during DecisionTask processing.
 // 
 // 
 // Parameters:
-//  - HeartbeatRequest
-func (p *HistoryServiceClient) RecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
-  if err = p.sendRecordActivityTaskHeartbeat(heartbeatRequest); err != nil { return }
-  return p.recvRecordActivityTaskHeartbeat()
+//  - FailedRequest
+func (p *HistoryServiceClient) RespondDecisionTaskFailed(failedRequest *RespondDecisionTaskFailedRequest) (err error) {
+  if err = p.sendRespondDecisionTaskFailed(failedRequest); err != nil { return }
+  return p.recvRespondDecisionTaskFailed()
 }
 
-func (p *HistoryServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest)(err error) {
+func (p *HistoryServiceClient) sendRespondDecisionTaskFailed(failedRequest *RespondDecisionTaskFailedRequest)(err error) {
   oprot := p.OutputProtocol
   if oprot == nil {
     oprot = p.ProtocolFactory.GetProtocol(p.Transport)
     p.OutputProtocol = oprot
   }
   p.SeqId++
-  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.CALL, p.SeqId); err != nil {
+  if err = oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.CALL, p.SeqId); err != nil {
       return
   }
-  args := HistoryServiceRecordActivityTaskHeartbeatArgs{
-  HeartbeatRequest : heartbeatRequest,
+  args := HistoryServiceRespondDecisionTaskFailedArgs{
+  FailedRequest : failedRequest,
   }
   if err = args.Write(oprot); err != nil {
       return
@@ -4019,7 +5151,7 @@ func (p *HistoryServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest
 }
 
 
-func (p *HistoryServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
+func (p *HistoryServiceClient) recvRespondDecisionTaskFailed() (err error) {
   iprot := p.InputProtocol
   if iprot == nil {
     iprot = p.ProtocolFactory.GetProtocol(p.Transport)
@@ -4029,12 +5161,12 @@ func (p *HistoryServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.
   if err != nil {
     return
   }
-  if method != "RecordActivityTaskHeartbeat" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeat failed: wrong method name")
+  if method != "RespondDecisionTaskFailed" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondDecisionTaskFailed failed: wrong method name")
     return
   }
   if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeat failed: out of sequence response")
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondDecisionTaskFailed failed: out of sequence response")
     return
   }
   if mTypeId == thrift.EXCEPTION {
@@ -4051,10 +5183,10 @@ func (p *HistoryServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.
     return
   }
   if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeat failed: invalid message type")
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondDecisionTaskFailed failed: invalid message type")
     return
   }
-  result := HistoryServiceRecordActivityTaskHeartbeatResult{}
+  result := HistoryServiceRespondDecisionTaskFailedResult{}
   if err = result.Read(iprot); err != nil {
     return
   }
@@ -4074,22 +5206,117 @@ func (p *HistoryServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.
     err = result.ShardOwnershipLostError
     return 
   }
-  value = result.GetSuccess()
   return
 }
 
-// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
-// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
-// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
-// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
-// anymore due to activity timeout.
+// RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
+// to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
+// 'ActivityTaskTimedOut' event will be written to the workflow history.  Calling 'RecordActivityTaskHeartbeat' will
+// fail with 'EntityNotExistsError' in such situations.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for heartbeating.
 // 
 // 
 // Parameters:
-//  - CompleteRequest
-func (p *HistoryServiceClient) RespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest) (err error) {
-  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
-  return p.recvRespondActivityTaskCompleted()
+//  - HeartbeatRequest
+func (p *HistoryServiceClient) RecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest) (r *shared.RecordActivityTaskHeartbeatResponse, err error) {
+  if err = p.sendRecordActivityTaskHeartbeat(heartbeatRequest); err != nil { return }
+  return p.recvRecordActivityTaskHeartbeat()
+}
+
+func (p *HistoryServiceClient) sendRecordActivityTaskHeartbeat(heartbeatRequest *RecordActivityTaskHeartbeatRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RecordActivityTaskHeartbeat", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceRecordActivityTaskHeartbeatArgs{
+  HeartbeatRequest : heartbeatRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvRecordActivityTaskHeartbeat() (value *shared.RecordActivityTaskHeartbeatResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "RecordActivityTaskHeartbeat" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RecordActivityTaskHeartbeat failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RecordActivityTaskHeartbeat failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error12 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error13 error
+    error13, err = error12.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error13
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RecordActivityTaskHeartbeat failed: invalid message type")
+    return
+  }
+  result := HistoryServiceRecordActivityTaskHeartbeatResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// RespondActivityTaskCompleted is called by application worker when it is done processing an ActivityTask.  It will
+// result in a new 'ActivityTaskCompleted' event being written to the workflow history and a new DecisionTask
+// created for the workflow so new decisions could be made.  Use the 'taskToken' provided as response of
+// PollForActivityTask API call for completion. It fails with 'EntityNotExistsError' if the taskToken is not valid
+// anymore due to activity timeout.
+// 
+// 
+// Parameters:
+//  - CompleteRequest
+func (p *HistoryServiceClient) RespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest) (err error) {
+  if err = p.sendRespondActivityTaskCompleted(completeRequest); err != nil { return }
+  return p.recvRespondActivityTaskCompleted()
 }
 
 func (p *HistoryServiceClient) sendRespondActivityTaskCompleted(completeRequest *RespondActivityTaskCompletedRequest)(err error) {
@@ -4134,16 +5361,16 @@ func (p *HistoryServiceClient) recvRespondActivityTaskCompleted() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error12 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error13 error
-    error13, err = error12.Read(iprot)
+    error14 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error15 error
+    error15, err = error14.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error13
+    err = error15
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4229,16 +5456,16 @@ func (p *HistoryServiceClient) recvRespondActivityTaskFailed() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error14 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error15 error
-    error15, err = error14.Read(iprot)
+    error16 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error17 error
+    error17, err = error16.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error15
+    err = error17
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4324,16 +5551,16 @@ func (p *HistoryServiceClient) recvRespondActivityTaskCanceled() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error16 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error17 error
-    error17, err = error16.Read(iprot)
+    error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error19 error
+    error19, err = error18.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error17
+    err = error19
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4416,16 +5643,16 @@ func (p *HistoryServiceClient) recvSignalWorkflowExecution() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error18 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error19 error
-    error19, err = error18.Read(iprot)
+    error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error21 error
+    error21, err = error20.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error19
+    err = error21
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4455,6 +5682,101 @@ func (p *HistoryServiceClient) recvSignalWorkflowExecution() (err error) {
   return
 }
 
+// SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+// is running, this results in WorkflowExecutionSignaled event recorded in the history and a decision task being
+// created for the execution.  If the workflow is not running, a new workflow execution is started with the
+// WorkflowExecutionSignaled event recorded right after its WorkflowExecutionStarted event.
+// 
+// 
+// Parameters:
+//  - SignalWithStartRequest
+func (p *HistoryServiceClient) SignalWithStartWorkflowExecution(signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (r *shared.StartWorkflowExecutionResponse, err error) {
+  if err = p.sendSignalWithStartWorkflowExecution(signalWithStartRequest); err != nil { return }
+  return p.recvSignalWithStartWorkflowExecution()
+}
+
+func (p *HistoryServiceClient) sendSignalWithStartWorkflowExecution(signalWithStartRequest *SignalWithStartWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceSignalWithStartWorkflowExecutionArgs{
+  SignalWithStartRequest : signalWithStartRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvSignalWithStartWorkflowExecution() (value *shared.StartWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "SignalWithStartWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "SignalWithStartWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "SignalWithStartWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error23 error
+    error23, err = error22.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error23
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "SignalWithStartWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceSignalWithStartWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 // 
@@ -4508,16 +5830,16 @@ func (p *HistoryServiceClient) recvTerminateWorkflowExecution() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error20 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error21 error
-    error21, err = error20.Read(iprot)
+    error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error25 error
+    error25, err = error24.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error21
+    err = error25
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4602,16 +5924,16 @@ func (p *HistoryServiceClient) recvRequestCancelWorkflowExecution() (err error)
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error22 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error23 error
-    error23, err = error22.Read(iprot)
+    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error27 error
+    error27, err = error26.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error23
+    err = error27
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4696,16 +6018,16 @@ func (p *HistoryServiceClient) recvScheduleDecisionTask() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error24 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error25 error
-    error25, err = error24.Read(iprot)
+    error28 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error29 error
+    error29, err = error28.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error25
+    err = error29
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4788,16 +6110,16 @@ func (p *HistoryServiceClient) recvRecordChildExecutionCompleted() (err error) {
     return
   }
   if mTypeId == thrift.EXCEPTION {
-    error26 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error27 error
-    error27, err = error26.Read(iprot)
+    error30 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error31 error
+    error31, err = error30.Read(iprot)
     if err != nil {
       return
     }
     if err = iprot.ReadMessageEnd(); err != nil {
       return
     }
-    err = error27
+    err = error31
     return
   }
   if mTypeId != thrift.REPLY {
@@ -4827,18 +6149,208 @@ func (p *HistoryServiceClient) recvRecordChildExecutionCompleted() (err error) {
   return
 }
 
-
-type HistoryServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler HistoryService
+// DescribeWorkflowExecution returns information about the specified workflow execution, including its configuration
+// and the pending activities, timers, child executions and decision taken from its mutable state.
+// 
+// 
+// Parameters:
+//  - DescribeRequest
+func (p *HistoryServiceClient) DescribeWorkflowExecution(describeRequest *DescribeWorkflowExecutionRequest) (r *shared.DescribeWorkflowExecutionResponse, err error) {
+  if err = p.sendDescribeWorkflowExecution(describeRequest); err != nil { return }
+  return p.recvDescribeWorkflowExecution()
 }
 
-func (p *HistoryServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
+func (p *HistoryServiceClient) sendDescribeWorkflowExecution(describeRequest *DescribeWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceDescribeWorkflowExecutionArgs{
+  DescribeRequest : describeRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
 }
 
-func (p *HistoryServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
+
+func (p *HistoryServiceClient) recvDescribeWorkflowExecution() (value *shared.DescribeWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "DescribeWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "DescribeWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "DescribeWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error32 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error33 error
+    error33, err = error32.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error33
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "DescribeWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceDescribeWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// ResetWorkflowExecution starts a new run of the workflow execution whose history is a copy of the current run up to
+// the given DecisionTaskCompleted event, reapplies the signals received after that point and terminates the current
+// run if it is still open.
+// 
+// 
+// Parameters:
+//  - ResetRequest
+func (p *HistoryServiceClient) ResetWorkflowExecution(resetRequest *ResetWorkflowExecutionRequest) (r *shared.ResetWorkflowExecutionResponse, err error) {
+  if err = p.sendResetWorkflowExecution(resetRequest); err != nil { return }
+  return p.recvResetWorkflowExecution()
+}
+
+func (p *HistoryServiceClient) sendResetWorkflowExecution(resetRequest *ResetWorkflowExecutionRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := HistoryServiceResetWorkflowExecutionArgs{
+  ResetRequest : resetRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *HistoryServiceClient) recvResetWorkflowExecution() (value *shared.ResetWorkflowExecutionResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "ResetWorkflowExecution" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "ResetWorkflowExecution failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "ResetWorkflowExecution failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error34 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error35 error
+    error35, err = error34.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error35
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "ResetWorkflowExecution failed: invalid message type")
+    return
+  }
+  result := HistoryServiceResetWorkflowExecutionResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.ShardOwnershipLostError != nil {
+    err = result.ShardOwnershipLostError
+    return 
+  } else   if result.SessionAlreadyExistError != nil {
+    err = result.SessionAlreadyExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+
+type HistoryServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler HistoryService
+}
+
+func (p *HistoryServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *HistoryServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
   return processor, ok
 }
 
@@ -4848,22 +6360,26 @@ func (p *HistoryServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFun
 
 func NewHistoryServiceProcessor(handler HistoryService) *HistoryServiceProcessor {
 
-  self28 := &HistoryServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self28.processorMap["StartWorkflowExecution"] = &historyServiceProcessorStartWorkflowExecution{handler:handler}
-  self28.processorMap["GetWorkflowExecutionNextEventID"] = &historyServiceProcessorGetWorkflowExecutionNextEventID{handler:handler}
-  self28.processorMap["RecordDecisionTaskStarted"] = &historyServiceProcessorRecordDecisionTaskStarted{handler:handler}
-  self28.processorMap["RecordActivityTaskStarted"] = &historyServiceProcessorRecordActivityTaskStarted{handler:handler}
-  self28.processorMap["RespondDecisionTaskCompleted"] = &historyServiceProcessorRespondDecisionTaskCompleted{handler:handler}
-  self28.processorMap["RecordActivityTaskHeartbeat"] = &historyServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
-  self28.processorMap["RespondActivityTaskCompleted"] = &historyServiceProcessorRespondActivityTaskCompleted{handler:handler}
-  self28.processorMap["RespondActivityTaskFailed"] = &historyServiceProcessorRespondActivityTaskFailed{handler:handler}
-  self28.processorMap["RespondActivityTaskCanceled"] = &historyServiceProcessorRespondActivityTaskCanceled{handler:handler}
-  self28.processorMap["SignalWorkflowExecution"] = &historyServiceProcessorSignalWorkflowExecution{handler:handler}
-  self28.processorMap["TerminateWorkflowExecution"] = &historyServiceProcessorTerminateWorkflowExecution{handler:handler}
-  self28.processorMap["RequestCancelWorkflowExecution"] = &historyServiceProcessorRequestCancelWorkflowExecution{handler:handler}
-  self28.processorMap["ScheduleDecisionTask"] = &historyServiceProcessorScheduleDecisionTask{handler:handler}
-  self28.processorMap["RecordChildExecutionCompleted"] = &historyServiceProcessorRecordChildExecutionCompleted{handler:handler}
-return self28
+  self36 := &HistoryServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self36.processorMap["StartWorkflowExecution"] = &historyServiceProcessorStartWorkflowExecution{handler:handler}
+  self36.processorMap["GetWorkflowExecutionNextEventID"] = &historyServiceProcessorGetWorkflowExecutionNextEventID{handler:handler}
+  self36.processorMap["RecordDecisionTaskStarted"] = &historyServiceProcessorRecordDecisionTaskStarted{handler:handler}
+  self36.processorMap["RecordActivityTaskStarted"] = &historyServiceProcessorRecordActivityTaskStarted{handler:handler}
+  self36.processorMap["RespondDecisionTaskCompleted"] = &historyServiceProcessorRespondDecisionTaskCompleted{handler:handler}
+  self36.processorMap["RespondDecisionTaskFailed"] = &historyServiceProcessorRespondDecisionTaskFailed{handler:handler}
+  self36.processorMap["RecordActivityTaskHeartbeat"] = &historyServiceProcessorRecordActivityTaskHeartbeat{handler:handler}
+  self36.processorMap["RespondActivityTaskCompleted"] = &historyServiceProcessorRespondActivityTaskCompleted{handler:handler}
+  self36.processorMap["RespondActivityTaskFailed"] = &historyServiceProcessorRespondActivityTaskFailed{handler:handler}
+  self36.processorMap["RespondActivityTaskCanceled"] = &historyServiceProcessorRespondActivityTaskCanceled{handler:handler}
+  self36.processorMap["SignalWorkflowExecution"] = &historyServiceProcessorSignalWorkflowExecution{handler:handler}
+  self36.processorMap["SignalWithStartWorkflowExecution"] = &historyServiceProcessorSignalWithStartWorkflowExecution{handler:handler}
+  self36.processorMap["TerminateWorkflowExecution"] = &historyServiceProcessorTerminateWorkflowExecution{handler:handler}
+  self36.processorMap["RequestCancelWorkflowExecution"] = &historyServiceProcessorRequestCancelWorkflowExecution{handler:handler}
+  self36.processorMap["ScheduleDecisionTask"] = &historyServiceProcessorScheduleDecisionTask{handler:handler}
+  self36.processorMap["RecordChildExecutionCompleted"] = &historyServiceProcessorRecordChildExecutionCompleted{handler:handler}
+  self36.processorMap["DescribeWorkflowExecution"] = &historyServiceProcessorDescribeWorkflowExecution{handler:handler}
+  self36.processorMap["ResetWorkflowExecution"] = &historyServiceProcessorResetWorkflowExecution{handler:handler}
+return self36
 }
 
 func (p *HistoryServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
@@ -4874,12 +6390,12 @@ func (p *HistoryServiceProcessor) Process(iprot, oprot thrift.TProtocol) (succes
   }
   iprot.Skip(thrift.STRUCT)
   iprot.ReadMessageEnd()
-  x29 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  x37 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
   oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x29.Write(oprot)
+  x37.Write(oprot)
   oprot.WriteMessageEnd()
   oprot.Flush()
-  return false, x29
+  return false, x37
 
 }
 
@@ -5179,6 +6695,62 @@ func (p *historyServiceProcessorRespondDecisionTaskCompleted) Process(seqId int3
   return true, err
 }
 
+type historyServiceProcessorRespondDecisionTaskFailed struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorRespondDecisionTaskFailed) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceRespondDecisionTaskFailedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceRespondDecisionTaskFailedResult{}
+  var err2 error
+  if err2 = p.handler.RespondDecisionTaskFailed(args.FailedRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondDecisionTaskFailed: " + err2.Error())
+    oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondDecisionTaskFailed", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
 type historyServiceProcessorRecordActivityTaskHeartbeat struct {
   handler HistoryService
 }
@@ -5462,6 +7034,65 @@ func (p *historyServiceProcessorSignalWorkflowExecution) Process(seqId int32, ip
   return true, err
 }
 
+type historyServiceProcessorSignalWithStartWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorSignalWithStartWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceSignalWithStartWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceSignalWithStartWorkflowExecutionResult{}
+var retval *shared.StartWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.SignalWithStartWorkflowExecution(args.SignalWithStartRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing SignalWithStartWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("SignalWithStartWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
 type historyServiceProcessorTerminateWorkflowExecution struct {
   handler HistoryService
 }
@@ -5686,21 +7317,141 @@ func (p *historyServiceProcessorRecordChildExecutionCompleted) Process(seqId int
   return true, err
 }
 
-
-// HELPER FUNCTIONS AND STRUCTURES
-
-// Attributes:
-//  - StartRequest
-type HistoryServiceStartWorkflowExecutionArgs struct {
-  StartRequest *StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+type historyServiceProcessorDescribeWorkflowExecution struct {
+  handler HistoryService
 }
 
-func NewHistoryServiceStartWorkflowExecutionArgs() *HistoryServiceStartWorkflowExecutionArgs {
-  return &HistoryServiceStartWorkflowExecutionArgs{}
-}
+func (p *historyServiceProcessorDescribeWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceDescribeWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
 
-var HistoryServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *StartWorkflowExecutionRequest
-func (p *HistoryServiceStartWorkflowExecutionArgs) GetStartRequest() *StartWorkflowExecutionRequest {
+  iprot.ReadMessageEnd()
+  result := HistoryServiceDescribeWorkflowExecutionResult{}
+var retval *shared.DescribeWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeWorkflowExecution(args.DescribeRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type historyServiceProcessorResetWorkflowExecution struct {
+  handler HistoryService
+}
+
+func (p *historyServiceProcessorResetWorkflowExecution) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := HistoryServiceResetWorkflowExecutionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := HistoryServiceResetWorkflowExecutionResult{}
+var retval *shared.ResetWorkflowExecutionResponse
+  var err2 error
+  if retval, err2 = p.handler.ResetWorkflowExecution(args.ResetRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *ShardOwnershipLostError:
+  result.ShardOwnershipLostError = v
+    case *shared.WorkflowExecutionAlreadyStartedError:
+  result.SessionAlreadyExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ResetWorkflowExecution: " + err2.Error())
+    oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ResetWorkflowExecution", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - StartRequest
+type HistoryServiceStartWorkflowExecutionArgs struct {
+  StartRequest *StartWorkflowExecutionRequest `thrift:"startRequest,1" db:"startRequest" json:"startRequest"`
+}
+
+func NewHistoryServiceStartWorkflowExecutionArgs() *HistoryServiceStartWorkflowExecutionArgs {
+  return &HistoryServiceStartWorkflowExecutionArgs{}
+}
+
+var HistoryServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT *StartWorkflowExecutionRequest
+func (p *HistoryServiceStartWorkflowExecutionArgs) GetStartRequest() *StartWorkflowExecutionRequest {
   if !p.IsSetStartRequest() {
     return HistoryServiceStartWorkflowExecutionArgs_StartRequest_DEFAULT
   }
@@ -7439,27 +9190,27 @@ func (p *HistoryServiceRespondDecisionTaskCompletedResult) String() string {
 }
 
 // Attributes:
-//  - HeartbeatRequest
-type HistoryServiceRecordActivityTaskHeartbeatArgs struct {
-  HeartbeatRequest *RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
+//  - FailedRequest
+type HistoryServiceRespondDecisionTaskFailedArgs struct {
+  FailedRequest *RespondDecisionTaskFailedRequest `thrift:"failedRequest,1" db:"failedRequest" json:"failedRequest"`
 }
 
-func NewHistoryServiceRecordActivityTaskHeartbeatArgs() *HistoryServiceRecordActivityTaskHeartbeatArgs {
-  return &HistoryServiceRecordActivityTaskHeartbeatArgs{}
+func NewHistoryServiceRespondDecisionTaskFailedArgs() *HistoryServiceRespondDecisionTaskFailedArgs {
+  return &HistoryServiceRespondDecisionTaskFailedArgs{}
 }
 
-var HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *RecordActivityTaskHeartbeatRequest
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
-  if !p.IsSetHeartbeatRequest() {
-    return HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
+var HistoryServiceRespondDecisionTaskFailedArgs_FailedRequest_DEFAULT *RespondDecisionTaskFailedRequest
+func (p *HistoryServiceRespondDecisionTaskFailedArgs) GetFailedRequest() *RespondDecisionTaskFailedRequest {
+  if !p.IsSetFailedRequest() {
+    return HistoryServiceRespondDecisionTaskFailedArgs_FailedRequest_DEFAULT
   }
-return p.HeartbeatRequest
+return p.FailedRequest
 }
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
-  return p.HeartbeatRequest != nil
+func (p *HistoryServiceRespondDecisionTaskFailedArgs) IsSetFailedRequest() bool {
+  return p.FailedRequest != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondDecisionTaskFailedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7491,16 +9242,16 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.HeartbeatRequest = &RecordActivityTaskHeartbeatRequest{}
-  if err := p.HeartbeatRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
+func (p *HistoryServiceRespondDecisionTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailedRequest = &RespondDecisionTaskFailedRequest{}
+  if err := p.FailedRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailedRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
+func (p *HistoryServiceRespondDecisionTaskFailedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskFailed_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7512,98 +9263,85 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
-  if err := p.HeartbeatRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
+func (p *HistoryServiceRespondDecisionTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failedRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failedRequest: ", p), err) }
+  if err := p.FailedRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailedRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failedRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) String() string {
+func (p *HistoryServiceRespondDecisionTaskFailedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondDecisionTaskFailedArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRecordActivityTaskHeartbeatResult struct {
-  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+type HistoryServiceRespondDecisionTaskFailedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRecordActivityTaskHeartbeatResult() *HistoryServiceRecordActivityTaskHeartbeatResult {
-  return &HistoryServiceRecordActivityTaskHeartbeatResult{}
+func NewHistoryServiceRespondDecisionTaskFailedResult() *HistoryServiceRespondDecisionTaskFailedResult {
+  return &HistoryServiceRespondDecisionTaskFailedResult{}
 }
 
-var HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
-  if !p.IsSetSuccess() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
-  }
-return p.Success
-}
-var HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRespondDecisionTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondDecisionTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
+    return HistoryServiceRespondDecisionTaskFailedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRespondDecisionTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondDecisionTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
+    return HistoryServiceRespondDecisionTaskFailedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRespondDecisionTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondDecisionTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRespondDecisionTaskFailedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRespondDecisionTaskFailedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondDecisionTaskFailedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRespondDecisionTaskFailedResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
-  return p.Success != nil
-}
-
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7616,10 +9354,6 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -7651,15 +9385,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondDecisionTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7667,7 +9393,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondDecisionTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7675,7 +9401,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondDecisionTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -7683,7 +9409,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondDecisionTaskFailedResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -7691,11 +9417,10 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField4(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondDecisionTaskFailed_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -7708,20 +9433,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -7734,7 +9446,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -7747,7 +9459,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -7760,7 +9472,7 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -7773,35 +9485,35 @@ func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField4(oprot thri
   return err
 }
 
-func (p *HistoryServiceRecordActivityTaskHeartbeatResult) String() string {
+func (p *HistoryServiceRespondDecisionTaskFailedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondDecisionTaskFailedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompleteRequest
-type HistoryServiceRespondActivityTaskCompletedArgs struct {
-  CompleteRequest *RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
+//  - HeartbeatRequest
+type HistoryServiceRecordActivityTaskHeartbeatArgs struct {
+  HeartbeatRequest *RecordActivityTaskHeartbeatRequest `thrift:"heartbeatRequest,1" db:"heartbeatRequest" json:"heartbeatRequest"`
 }
 
-func NewHistoryServiceRespondActivityTaskCompletedArgs() *HistoryServiceRespondActivityTaskCompletedArgs {
-  return &HistoryServiceRespondActivityTaskCompletedArgs{}
+func NewHistoryServiceRecordActivityTaskHeartbeatArgs() *HistoryServiceRecordActivityTaskHeartbeatArgs {
+  return &HistoryServiceRecordActivityTaskHeartbeatArgs{}
 }
 
-var HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *RespondActivityTaskCompletedRequest
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *RespondActivityTaskCompletedRequest {
-  if !p.IsSetCompleteRequest() {
-    return HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
+var HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT *RecordActivityTaskHeartbeatRequest
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) GetHeartbeatRequest() *RecordActivityTaskHeartbeatRequest {
+  if !p.IsSetHeartbeatRequest() {
+    return HistoryServiceRecordActivityTaskHeartbeatArgs_HeartbeatRequest_DEFAULT
   }
-return p.CompleteRequest
+return p.HeartbeatRequest
 }
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
-  return p.CompleteRequest != nil
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) IsSetHeartbeatRequest() bool {
+  return p.HeartbeatRequest != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7833,16 +9545,16 @@ func (p *HistoryServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompleteRequest = &RespondActivityTaskCompletedRequest{}
-  if err := p.CompleteRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.HeartbeatRequest = &RecordActivityTaskHeartbeatRequest{}
+  if err := p.HeartbeatRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HeartbeatRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7854,85 +9566,98 @@ func (p *HistoryServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
-  if err := p.CompleteRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("heartbeatRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:heartbeatRequest: ", p), err) }
+  if err := p.HeartbeatRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HeartbeatRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:heartbeatRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedArgs) String() string {
+func (p *HistoryServiceRecordActivityTaskHeartbeatArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRespondActivityTaskCompletedResult struct {
+type HistoryServiceRecordActivityTaskHeartbeatResult struct {
+  Success *shared.RecordActivityTaskHeartbeatResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRespondActivityTaskCompletedResult() *HistoryServiceRespondActivityTaskCompletedResult {
-  return &HistoryServiceRespondActivityTaskCompletedResult{}
+func NewHistoryServiceRecordActivityTaskHeartbeatResult() *HistoryServiceRecordActivityTaskHeartbeatResult {
+  return &HistoryServiceRecordActivityTaskHeartbeatResult{}
 }
 
-var HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT *shared.RecordActivityTaskHeartbeatResponse
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetSuccess() *shared.RecordActivityTaskHeartbeatResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceRecordActivityTaskHeartbeatResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
+    return HistoryServiceRecordActivityTaskHeartbeatResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
+    return HistoryServiceRecordActivityTaskHeartbeatResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRecordActivityTaskHeartbeatResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRespondActivityTaskCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRecordActivityTaskHeartbeatResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7945,6 +9670,10 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TPr
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -7976,7 +9705,15 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.RecordActivityTaskHeartbeatResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -7984,7 +9721,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -7992,7 +9729,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8000,7 +9737,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8008,10 +9745,11 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField4(iprot thr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordActivityTaskHeartbeat_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -8024,7 +9762,20 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8037,7 +9788,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField1(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8050,7 +9801,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField2(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8063,7 +9814,7 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField3(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8076,35 +9827,35 @@ func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField4(oprot thr
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCompletedResult) String() string {
+func (p *HistoryServiceRecordActivityTaskHeartbeatResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRecordActivityTaskHeartbeatResult(%+v)", *p)
 }
 
 // Attributes:
-//  - FailRequest
-type HistoryServiceRespondActivityTaskFailedArgs struct {
-  FailRequest *RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
+//  - CompleteRequest
+type HistoryServiceRespondActivityTaskCompletedArgs struct {
+  CompleteRequest *RespondActivityTaskCompletedRequest `thrift:"completeRequest,1" db:"completeRequest" json:"completeRequest"`
 }
 
-func NewHistoryServiceRespondActivityTaskFailedArgs() *HistoryServiceRespondActivityTaskFailedArgs {
-  return &HistoryServiceRespondActivityTaskFailedArgs{}
+func NewHistoryServiceRespondActivityTaskCompletedArgs() *HistoryServiceRespondActivityTaskCompletedArgs {
+  return &HistoryServiceRespondActivityTaskCompletedArgs{}
 }
 
-var HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *RespondActivityTaskFailedRequest
-func (p *HistoryServiceRespondActivityTaskFailedArgs) GetFailRequest() *RespondActivityTaskFailedRequest {
-  if !p.IsSetFailRequest() {
-    return HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
+var HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT *RespondActivityTaskCompletedRequest
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) GetCompleteRequest() *RespondActivityTaskCompletedRequest {
+  if !p.IsSetCompleteRequest() {
+    return HistoryServiceRespondActivityTaskCompletedArgs_CompleteRequest_DEFAULT
   }
-return p.FailRequest
+return p.CompleteRequest
 }
-func (p *HistoryServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
-  return p.FailRequest != nil
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) IsSetCompleteRequest() bool {
+  return p.CompleteRequest != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8136,16 +9887,16 @@ func (p *HistoryServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.FailRequest = &RespondActivityTaskFailedRequest{}
-  if err := p.FailRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
+func (p *HistoryServiceRespondActivityTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompleteRequest = &RespondActivityTaskCompletedRequest{}
+  if err := p.CompleteRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompleteRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8157,22 +9908,22 @@ func (p *HistoryServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
-  if err := p.FailRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completeRequest: ", p), err) }
+  if err := p.CompleteRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompleteRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completeRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedArgs) String() string {
+func (p *HistoryServiceRespondActivityTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -8180,62 +9931,62 @@ func (p *HistoryServiceRespondActivityTaskFailedArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRespondActivityTaskFailedResult struct {
+type HistoryServiceRespondActivityTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRespondActivityTaskFailedResult() *HistoryServiceRespondActivityTaskFailedResult {
-  return &HistoryServiceRespondActivityTaskFailedResult{}
+func NewHistoryServiceRespondActivityTaskCompletedResult() *HistoryServiceRespondActivityTaskCompletedResult {
+  return &HistoryServiceRespondActivityTaskCompletedResult{}
 }
 
-var HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
+    return HistoryServiceRespondActivityTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
+    return HistoryServiceRespondActivityTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRespondActivityTaskCompletedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRespondActivityTaskFailedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondActivityTaskCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRespondActivityTaskCompletedResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8279,7 +10030,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -8287,7 +10038,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -8295,7 +10046,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8303,7 +10054,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8311,8 +10062,8 @@ func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField4(iprot thrift
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8327,7 +10078,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8340,7 +10091,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField1(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8353,7 +10104,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField2(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8366,7 +10117,7 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField3(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8379,35 +10130,35 @@ func (p *HistoryServiceRespondActivityTaskFailedResult) writeField4(oprot thrift
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskFailedResult) String() string {
+func (p *HistoryServiceRespondActivityTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCompletedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CanceledRequest
-type HistoryServiceRespondActivityTaskCanceledArgs struct {
-  CanceledRequest *RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
+//  - FailRequest
+type HistoryServiceRespondActivityTaskFailedArgs struct {
+  FailRequest *RespondActivityTaskFailedRequest `thrift:"failRequest,1" db:"failRequest" json:"failRequest"`
 }
 
-func NewHistoryServiceRespondActivityTaskCanceledArgs() *HistoryServiceRespondActivityTaskCanceledArgs {
-  return &HistoryServiceRespondActivityTaskCanceledArgs{}
+func NewHistoryServiceRespondActivityTaskFailedArgs() *HistoryServiceRespondActivityTaskFailedArgs {
+  return &HistoryServiceRespondActivityTaskFailedArgs{}
 }
 
-var HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *RespondActivityTaskCanceledRequest
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *RespondActivityTaskCanceledRequest {
-  if !p.IsSetCanceledRequest() {
-    return HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
+var HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT *RespondActivityTaskFailedRequest
+func (p *HistoryServiceRespondActivityTaskFailedArgs) GetFailRequest() *RespondActivityTaskFailedRequest {
+  if !p.IsSetFailRequest() {
+    return HistoryServiceRespondActivityTaskFailedArgs_FailRequest_DEFAULT
   }
-return p.CanceledRequest
+return p.FailRequest
 }
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
-  return p.CanceledRequest != nil
+func (p *HistoryServiceRespondActivityTaskFailedArgs) IsSetFailRequest() bool {
+  return p.FailRequest != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRespondActivityTaskFailedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8439,16 +10190,1267 @@ func (p *HistoryServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CanceledRequest = &RespondActivityTaskCanceledRequest{}
-  if err := p.CanceledRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
+func (p *HistoryServiceRespondActivityTaskFailedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.FailRequest = &RespondActivityTaskFailedRequest{}
+  if err := p.FailRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.FailRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("failRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:failRequest: ", p), err) }
+  if err := p.FailRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.FailRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:failRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceRespondActivityTaskFailedResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceRespondActivityTaskFailedResult() *HistoryServiceRespondActivityTaskFailedResult {
+  return &HistoryServiceRespondActivityTaskFailedResult{}
+}
+
+var HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceRespondActivityTaskFailedResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceRespondActivityTaskFailedResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceRespondActivityTaskFailedResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondActivityTaskFailedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceRespondActivityTaskFailedResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskFailed_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskFailedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskFailedResult(%+v)", *p)
+}
+
+// Attributes:
+//  - CanceledRequest
+type HistoryServiceRespondActivityTaskCanceledArgs struct {
+  CanceledRequest *RespondActivityTaskCanceledRequest `thrift:"canceledRequest,1" db:"canceledRequest" json:"canceledRequest"`
+}
+
+func NewHistoryServiceRespondActivityTaskCanceledArgs() *HistoryServiceRespondActivityTaskCanceledArgs {
+  return &HistoryServiceRespondActivityTaskCanceledArgs{}
+}
+
+var HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT *RespondActivityTaskCanceledRequest
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) GetCanceledRequest() *RespondActivityTaskCanceledRequest {
+  if !p.IsSetCanceledRequest() {
+    return HistoryServiceRespondActivityTaskCanceledArgs_CanceledRequest_DEFAULT
+  }
+return p.CanceledRequest
+}
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) IsSetCanceledRequest() bool {
+  return p.CanceledRequest != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CanceledRequest = &RespondActivityTaskCanceledRequest{}
+  if err := p.CanceledRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CanceledRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
+  if err := p.CanceledRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceRespondActivityTaskCanceledResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceRespondActivityTaskCanceledResult() *HistoryServiceRespondActivityTaskCanceledResult {
+  return &HistoryServiceRespondActivityTaskCanceledResult{}
+}
+
+var HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRespondActivityTaskCanceledResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceRespondActivityTaskCanceledResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledResult(%+v)", *p)
+}
+
+// Attributes:
+//  - SignalRequest
+type HistoryServiceSignalWorkflowExecutionArgs struct {
+  SignalRequest *SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
+}
+
+func NewHistoryServiceSignalWorkflowExecutionArgs() *HistoryServiceSignalWorkflowExecutionArgs {
+  return &HistoryServiceSignalWorkflowExecutionArgs{}
+}
+
+var HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *SignalWorkflowExecutionRequest
+func (p *HistoryServiceSignalWorkflowExecutionArgs) GetSignalRequest() *SignalWorkflowExecutionRequest {
+  if !p.IsSetSignalRequest() {
+    return HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
+  }
+return p.SignalRequest
+}
+func (p *HistoryServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
+  return p.SignalRequest != nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalRequest = &SignalWorkflowExecutionRequest{}
+  if err := p.SignalRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
+  if err := p.SignalRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - EntityNotExistError
+//  - ShardOwnershipLostError
+type HistoryServiceSignalWorkflowExecutionResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceSignalWorkflowExecutionResult() *HistoryServiceSignalWorkflowExecutionResult {
+  return &HistoryServiceSignalWorkflowExecutionResult{}
+}
+
+var HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceSignalWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWorkflowExecutionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionResult(%+v)", *p)
+}
+
+// Attributes:
+//  - SignalWithStartRequest
+type HistoryServiceSignalWithStartWorkflowExecutionArgs struct {
+  SignalWithStartRequest *SignalWithStartWorkflowExecutionRequest `thrift:"signalWithStartRequest,1" db:"signalWithStartRequest" json:"signalWithStartRequest"`
+}
+
+func NewHistoryServiceSignalWithStartWorkflowExecutionArgs() *HistoryServiceSignalWithStartWorkflowExecutionArgs {
+  return &HistoryServiceSignalWithStartWorkflowExecutionArgs{}
+}
+
+var HistoryServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT *SignalWithStartWorkflowExecutionRequest
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) GetSignalWithStartRequest() *SignalWithStartWorkflowExecutionRequest {
+  if !p.IsSetSignalWithStartRequest() {
+    return HistoryServiceSignalWithStartWorkflowExecutionArgs_SignalWithStartRequest_DEFAULT
+  }
+return p.SignalWithStartRequest
+}
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) IsSetSignalWithStartRequest() bool {
+  return p.SignalWithStartRequest != nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.SignalWithStartRequest = &SignalWithStartWorkflowExecutionRequest{}
+  if err := p.SignalWithStartRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalWithStartRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signalWithStartRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalWithStartRequest: ", p), err) }
+  if err := p.SignalWithStartRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalWithStartRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalWithStartRequest: ", p), err) }
+  return err
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceSignalWithStartWorkflowExecutionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+//  - SessionAlreadyExistError
+//  - ShardOwnershipLostError
+type HistoryServiceSignalWithStartWorkflowExecutionResult struct {
+  Success *shared.StartWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,3" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
+  ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+}
+
+func NewHistoryServiceSignalWithStartWorkflowExecutionResult() *HistoryServiceSignalWithStartWorkflowExecutionResult {
+  return &HistoryServiceSignalWithStartWorkflowExecutionResult{}
+}
+
+var HistoryServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT *shared.StartWorkflowExecutionResponse
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetSuccess() *shared.StartWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var HistoryServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+var HistoryServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+  }
+return p.SessionAlreadyExistError
+}
+var HistoryServiceSignalWithStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+  if !p.IsSetShardOwnershipLostError() {
+    return HistoryServiceSignalWithStartWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+  }
+return p.ShardOwnershipLostError
+}
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+  return p.ShardOwnershipLostError != nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.StartWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.ShardOwnershipLostError = &ShardOwnershipLostError{}
+  if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SignalWithStartWorkflowExecution_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:sessionAlreadyExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetShardOwnershipLostError() {
+    if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
+    if err := p.ShardOwnershipLostError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ShardOwnershipLostError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:shardOwnershipLostError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceSignalWithStartWorkflowExecutionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HistoryServiceSignalWithStartWorkflowExecutionResult(%+v)", *p)
+}
+
+// Attributes:
+//  - TerminateRequest
+type HistoryServiceTerminateWorkflowExecutionArgs struct {
+  TerminateRequest *TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
+}
+
+func NewHistoryServiceTerminateWorkflowExecutionArgs() *HistoryServiceTerminateWorkflowExecutionArgs {
+  return &HistoryServiceTerminateWorkflowExecutionArgs{}
+}
+
+var HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *TerminateWorkflowExecutionRequest
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *TerminateWorkflowExecutionRequest {
+  if !p.IsSetTerminateRequest() {
+    return HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
+  }
+return p.TerminateRequest
+}
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
+  return p.TerminateRequest != nil
+}
+
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_args"); err != nil {
+func (p *HistoryServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.TerminateRequest = &TerminateWorkflowExecutionRequest{}
+  if err := p.TerminateRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8460,22 +11462,22 @@ func (p *HistoryServiceRespondActivityTaskCanceledArgs) Write(oprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("canceledRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:canceledRequest: ", p), err) }
-  if err := p.CanceledRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CanceledRequest), err)
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
+  if err := p.TerminateRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:canceledRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledArgs) String() string {
+func (p *HistoryServiceTerminateWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -8483,62 +11485,62 @@ func (p *HistoryServiceRespondActivityTaskCanceledArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRespondActivityTaskCanceledResult struct {
+type HistoryServiceTerminateWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRespondActivityTaskCanceledResult() *HistoryServiceRespondActivityTaskCanceledResult {
-  return &HistoryServiceRespondActivityTaskCanceledResult{}
+func NewHistoryServiceTerminateWorkflowExecutionResult() *HistoryServiceTerminateWorkflowExecutionResult {
+  return &HistoryServiceTerminateWorkflowExecutionResult{}
 }
 
-var HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_BadRequestError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_InternalServiceError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_EntityNotExistError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRespondActivityTaskCanceledResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceTerminateWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRespondActivityTaskCanceledResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8582,7 +11584,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -8590,7 +11592,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField1(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -8598,7 +11600,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField2(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8606,7 +11608,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField3(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8614,8 +11616,8 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult)  ReadField4(iprot thri
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RespondActivityTaskCanceled_result"); err != nil {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8630,7 +11632,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8643,7 +11645,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField1(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8656,7 +11658,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField2(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8669,7 +11671,7 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField3(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8682,35 +11684,35 @@ func (p *HistoryServiceRespondActivityTaskCanceledResult) writeField4(oprot thri
   return err
 }
 
-func (p *HistoryServiceRespondActivityTaskCanceledResult) String() string {
+func (p *HistoryServiceTerminateWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRespondActivityTaskCanceledResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - SignalRequest
-type HistoryServiceSignalWorkflowExecutionArgs struct {
-  SignalRequest *SignalWorkflowExecutionRequest `thrift:"signalRequest,1" db:"signalRequest" json:"signalRequest"`
+//  - CancelRequest
+type HistoryServiceRequestCancelWorkflowExecutionArgs struct {
+  CancelRequest *RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
 }
 
-func NewHistoryServiceSignalWorkflowExecutionArgs() *HistoryServiceSignalWorkflowExecutionArgs {
-  return &HistoryServiceSignalWorkflowExecutionArgs{}
+func NewHistoryServiceRequestCancelWorkflowExecutionArgs() *HistoryServiceRequestCancelWorkflowExecutionArgs {
+  return &HistoryServiceRequestCancelWorkflowExecutionArgs{}
 }
 
-var HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT *SignalWorkflowExecutionRequest
-func (p *HistoryServiceSignalWorkflowExecutionArgs) GetSignalRequest() *SignalWorkflowExecutionRequest {
-  if !p.IsSetSignalRequest() {
-    return HistoryServiceSignalWorkflowExecutionArgs_SignalRequest_DEFAULT
+var HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *RequestCancelWorkflowExecutionRequest
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *RequestCancelWorkflowExecutionRequest {
+  if !p.IsSetCancelRequest() {
+    return HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
   }
-return p.SignalRequest
+return p.CancelRequest
 }
-func (p *HistoryServiceSignalWorkflowExecutionArgs) IsSetSignalRequest() bool {
-  return p.SignalRequest != nil
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
+  return p.CancelRequest != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8742,16 +11744,16 @@ func (p *HistoryServiceSignalWorkflowExecutionArgs) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.SignalRequest = &SignalWorkflowExecutionRequest{}
-  if err := p.SignalRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SignalRequest), err)
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CancelRequest = &RequestCancelWorkflowExecutionRequest{}
+  if err := p.CancelRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_args"); err != nil {
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8763,22 +11765,22 @@ func (p *HistoryServiceSignalWorkflowExecutionArgs) Write(oprot thrift.TProtocol
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("signalRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:signalRequest: ", p), err) }
-  if err := p.SignalRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SignalRequest), err)
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
+  if err := p.CancelRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:signalRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionArgs) String() string {
+func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -8786,62 +11788,62 @@ func (p *HistoryServiceSignalWorkflowExecutionArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceSignalWorkflowExecutionResult struct {
+type HistoryServiceRequestCancelWorkflowExecutionResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceSignalWorkflowExecutionResult() *HistoryServiceSignalWorkflowExecutionResult {
-  return &HistoryServiceSignalWorkflowExecutionResult{}
+func NewHistoryServiceRequestCancelWorkflowExecutionResult() *HistoryServiceRequestCancelWorkflowExecutionResult {
+  return &HistoryServiceRequestCancelWorkflowExecutionResult{}
 }
 
-var HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceSignalWorkflowExecutionResult_BadRequestError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceSignalWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceSignalWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceSignalWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceSignalWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8885,7 +11887,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) Read(iprot thrift.TProtoco
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -8893,7 +11895,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField1(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -8901,7 +11903,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField2(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -8909,7 +11911,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField3(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -8917,8 +11919,8 @@ func (p *HistoryServiceSignalWorkflowExecutionResult)  ReadField4(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SignalWorkflowExecution_result"); err != nil {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8933,7 +11935,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) Write(oprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -8946,7 +11948,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField1(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -8959,7 +11961,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField2(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -8972,7 +11974,7 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField3(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -8985,35 +11987,35 @@ func (p *HistoryServiceSignalWorkflowExecutionResult) writeField4(oprot thrift.T
   return err
 }
 
-func (p *HistoryServiceSignalWorkflowExecutionResult) String() string {
+func (p *HistoryServiceRequestCancelWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceSignalWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - TerminateRequest
-type HistoryServiceTerminateWorkflowExecutionArgs struct {
-  TerminateRequest *TerminateWorkflowExecutionRequest `thrift:"terminateRequest,1" db:"terminateRequest" json:"terminateRequest"`
+//  - ScheduleRequest
+type HistoryServiceScheduleDecisionTaskArgs struct {
+  ScheduleRequest *ScheduleDecisionTaskRequest `thrift:"scheduleRequest,1" db:"scheduleRequest" json:"scheduleRequest"`
 }
 
-func NewHistoryServiceTerminateWorkflowExecutionArgs() *HistoryServiceTerminateWorkflowExecutionArgs {
-  return &HistoryServiceTerminateWorkflowExecutionArgs{}
+func NewHistoryServiceScheduleDecisionTaskArgs() *HistoryServiceScheduleDecisionTaskArgs {
+  return &HistoryServiceScheduleDecisionTaskArgs{}
 }
 
-var HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT *TerminateWorkflowExecutionRequest
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) GetTerminateRequest() *TerminateWorkflowExecutionRequest {
-  if !p.IsSetTerminateRequest() {
-    return HistoryServiceTerminateWorkflowExecutionArgs_TerminateRequest_DEFAULT
+var HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT *ScheduleDecisionTaskRequest
+func (p *HistoryServiceScheduleDecisionTaskArgs) GetScheduleRequest() *ScheduleDecisionTaskRequest {
+  if !p.IsSetScheduleRequest() {
+    return HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT
   }
-return p.TerminateRequest
+return p.ScheduleRequest
 }
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) IsSetTerminateRequest() bool {
-  return p.TerminateRequest != nil
+func (p *HistoryServiceScheduleDecisionTaskArgs) IsSetScheduleRequest() bool {
+  return p.ScheduleRequest != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9045,16 +12047,16 @@ func (p *HistoryServiceTerminateWorkflowExecutionArgs) Read(iprot thrift.TProtoc
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.TerminateRequest = &TerminateWorkflowExecutionRequest{}
-  if err := p.TerminateRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TerminateRequest), err)
+func (p *HistoryServiceScheduleDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ScheduleRequest = &ScheduleDecisionTaskRequest{}
+  if err := p.ScheduleRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ScheduleRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_args"); err != nil {
+func (p *HistoryServiceScheduleDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScheduleDecisionTask_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9066,22 +12068,22 @@ func (p *HistoryServiceTerminateWorkflowExecutionArgs) Write(oprot thrift.TProto
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("terminateRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:terminateRequest: ", p), err) }
-  if err := p.TerminateRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TerminateRequest), err)
+func (p *HistoryServiceScheduleDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("scheduleRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:scheduleRequest: ", p), err) }
+  if err := p.ScheduleRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ScheduleRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:terminateRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:scheduleRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionArgs) String() string {
+func (p *HistoryServiceScheduleDecisionTaskArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -9089,62 +12091,62 @@ func (p *HistoryServiceTerminateWorkflowExecutionArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceTerminateWorkflowExecutionResult struct {
+type HistoryServiceScheduleDecisionTaskResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceTerminateWorkflowExecutionResult() *HistoryServiceTerminateWorkflowExecutionResult {
-  return &HistoryServiceTerminateWorkflowExecutionResult{}
+func NewHistoryServiceScheduleDecisionTaskResult() *HistoryServiceScheduleDecisionTaskResult {
+  return &HistoryServiceScheduleDecisionTaskResult{}
 }
 
-var HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_BadRequestError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceTerminateWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceScheduleDecisionTaskResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceTerminateWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceScheduleDecisionTaskResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9188,7 +12190,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) Read(iprot thrift.TProt
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -9196,7 +12198,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField1(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -9204,7 +12206,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField2(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -9212,7 +12214,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField3(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -9220,8 +12222,8 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult)  ReadField4(iprot thrif
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TerminateWorkflowExecution_result"); err != nil {
+func (p *HistoryServiceScheduleDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ScheduleDecisionTask_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9236,7 +12238,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) Write(oprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -9249,7 +12251,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField1(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -9262,7 +12264,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField2(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -9275,7 +12277,7 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField3(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceScheduleDecisionTaskResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -9288,35 +12290,35 @@ func (p *HistoryServiceTerminateWorkflowExecutionResult) writeField4(oprot thrif
   return err
 }
 
-func (p *HistoryServiceTerminateWorkflowExecutionResult) String() string {
+func (p *HistoryServiceScheduleDecisionTaskResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceTerminateWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CancelRequest
-type HistoryServiceRequestCancelWorkflowExecutionArgs struct {
-  CancelRequest *RequestCancelWorkflowExecutionRequest `thrift:"cancelRequest,1" db:"cancelRequest" json:"cancelRequest"`
+//  - CompletionRequest
+type HistoryServiceRecordChildExecutionCompletedArgs struct {
+  CompletionRequest *RecordChildExecutionCompletedRequest `thrift:"completionRequest,1" db:"completionRequest" json:"completionRequest"`
 }
 
-func NewHistoryServiceRequestCancelWorkflowExecutionArgs() *HistoryServiceRequestCancelWorkflowExecutionArgs {
-  return &HistoryServiceRequestCancelWorkflowExecutionArgs{}
+func NewHistoryServiceRecordChildExecutionCompletedArgs() *HistoryServiceRecordChildExecutionCompletedArgs {
+  return &HistoryServiceRecordChildExecutionCompletedArgs{}
 }
 
-var HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT *RequestCancelWorkflowExecutionRequest
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) GetCancelRequest() *RequestCancelWorkflowExecutionRequest {
-  if !p.IsSetCancelRequest() {
-    return HistoryServiceRequestCancelWorkflowExecutionArgs_CancelRequest_DEFAULT
+var HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT *RecordChildExecutionCompletedRequest
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) GetCompletionRequest() *RecordChildExecutionCompletedRequest {
+  if !p.IsSetCompletionRequest() {
+    return HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT
   }
-return p.CancelRequest
+return p.CompletionRequest
 }
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) IsSetCancelRequest() bool {
-  return p.CancelRequest != nil
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) IsSetCompletionRequest() bool {
+  return p.CompletionRequest != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9348,16 +12350,16 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Read(iprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CancelRequest = &RequestCancelWorkflowExecutionRequest{}
-  if err := p.CancelRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CancelRequest), err)
+func (p *HistoryServiceRecordChildExecutionCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.CompletionRequest = &RecordChildExecutionCompletedRequest{}
+  if err := p.CompletionRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompletionRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_args"); err != nil {
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9369,22 +12371,22 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) Write(oprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("cancelRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:cancelRequest: ", p), err) }
-  if err := p.CancelRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CancelRequest), err)
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("completionRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completionRequest: ", p), err) }
+  if err := p.CompletionRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompletionRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:cancelRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completionRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) String() string {
+func (p *HistoryServiceRecordChildExecutionCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
@@ -9392,62 +12394,62 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionArgs) String() string {
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRequestCancelWorkflowExecutionResult struct {
+type HistoryServiceRecordChildExecutionCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceRequestCancelWorkflowExecutionResult() *HistoryServiceRequestCancelWorkflowExecutionResult {
-  return &HistoryServiceRequestCancelWorkflowExecutionResult{}
+func NewHistoryServiceRecordChildExecutionCompletedResult() *HistoryServiceRecordChildExecutionCompletedResult {
+  return &HistoryServiceRecordChildExecutionCompletedResult{}
 }
 
-var HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_BadRequestError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_InternalServiceError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_EntityNotExistError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceRecordChildExecutionCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRequestCancelWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9491,7 +12493,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Read(iprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -9499,7 +12501,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField1(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -9507,7 +12509,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField2(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -9515,7 +12517,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField3(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -9523,8 +12525,8 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult)  ReadField4(iprot t
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RequestCancelWorkflowExecution_result"); err != nil {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9539,7 +12541,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) Write(oprot thrift.
   return nil
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -9552,7 +12554,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField1(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -9565,7 +12567,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField2(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -9578,7 +12580,7 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField3(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -9591,35 +12593,35 @@ func (p *HistoryServiceRequestCancelWorkflowExecutionResult) writeField4(oprot t
   return err
 }
 
-func (p *HistoryServiceRequestCancelWorkflowExecutionResult) String() string {
+func (p *HistoryServiceRecordChildExecutionCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRequestCancelWorkflowExecutionResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - ScheduleRequest
-type HistoryServiceScheduleDecisionTaskArgs struct {
-  ScheduleRequest *ScheduleDecisionTaskRequest `thrift:"scheduleRequest,1" db:"scheduleRequest" json:"scheduleRequest"`
+//  - DescribeRequest
+type HistoryServiceDescribeWorkflowExecutionArgs struct {
+  DescribeRequest *DescribeWorkflowExecutionRequest `thrift:"describeRequest,1" db:"describeRequest" json:"describeRequest"`
 }
 
-func NewHistoryServiceScheduleDecisionTaskArgs() *HistoryServiceScheduleDecisionTaskArgs {
-  return &HistoryServiceScheduleDecisionTaskArgs{}
+func NewHistoryServiceDescribeWorkflowExecutionArgs() *HistoryServiceDescribeWorkflowExecutionArgs {
+  return &HistoryServiceDescribeWorkflowExecutionArgs{}
 }
 
-var HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT *ScheduleDecisionTaskRequest
-func (p *HistoryServiceScheduleDecisionTaskArgs) GetScheduleRequest() *ScheduleDecisionTaskRequest {
-  if !p.IsSetScheduleRequest() {
-    return HistoryServiceScheduleDecisionTaskArgs_ScheduleRequest_DEFAULT
+var HistoryServiceDescribeWorkflowExecutionArgs_DescribeRequest_DEFAULT *DescribeWorkflowExecutionRequest
+func (p *HistoryServiceDescribeWorkflowExecutionArgs) GetDescribeRequest() *DescribeWorkflowExecutionRequest {
+  if !p.IsSetDescribeRequest() {
+    return HistoryServiceDescribeWorkflowExecutionArgs_DescribeRequest_DEFAULT
   }
-return p.ScheduleRequest
+return p.DescribeRequest
 }
-func (p *HistoryServiceScheduleDecisionTaskArgs) IsSetScheduleRequest() bool {
-  return p.ScheduleRequest != nil
+func (p *HistoryServiceDescribeWorkflowExecutionArgs) IsSetDescribeRequest() bool {
+  return p.DescribeRequest != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9651,16 +12653,16 @@ func (p *HistoryServiceScheduleDecisionTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.ScheduleRequest = &ScheduleDecisionTaskRequest{}
-  if err := p.ScheduleRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ScheduleRequest), err)
+func (p *HistoryServiceDescribeWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.DescribeRequest = &DescribeWorkflowExecutionRequest{}
+  if err := p.DescribeRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescribeRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ScheduleDecisionTask_args"); err != nil {
+func (p *HistoryServiceDescribeWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9672,85 +12674,98 @@ func (p *HistoryServiceScheduleDecisionTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("scheduleRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:scheduleRequest: ", p), err) }
-  if err := p.ScheduleRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ScheduleRequest), err)
+func (p *HistoryServiceDescribeWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("describeRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:describeRequest: ", p), err) }
+  if err := p.DescribeRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescribeRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:scheduleRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:describeRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskArgs) String() string {
+func (p *HistoryServiceDescribeWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceDescribeWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceScheduleDecisionTaskResult struct {
+type HistoryServiceDescribeWorkflowExecutionResult struct {
+  Success *shared.DescribeWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
 }
 
-func NewHistoryServiceScheduleDecisionTaskResult() *HistoryServiceScheduleDecisionTaskResult {
-  return &HistoryServiceScheduleDecisionTaskResult{}
+func NewHistoryServiceDescribeWorkflowExecutionResult() *HistoryServiceDescribeWorkflowExecutionResult {
+  return &HistoryServiceDescribeWorkflowExecutionResult{}
 }
 
-var HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceDescribeWorkflowExecutionResult_Success_DEFAULT *shared.DescribeWorkflowExecutionResponse
+func (p *HistoryServiceDescribeWorkflowExecutionResult) GetSuccess() *shared.DescribeWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceDescribeWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceDescribeWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceDescribeWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceScheduleDecisionTaskResult_BadRequestError_DEFAULT
+    return HistoryServiceDescribeWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceDescribeWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceDescribeWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceScheduleDecisionTaskResult_InternalServiceError_DEFAULT
+    return HistoryServiceDescribeWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceDescribeWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceDescribeWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceScheduleDecisionTaskResult_EntityNotExistError_DEFAULT
+    return HistoryServiceDescribeWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceScheduleDecisionTaskResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceDescribeWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceDescribeWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceScheduleDecisionTaskResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceDescribeWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetBadRequestError() bool {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceDescribeWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9763,6 +12778,10 @@ func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol)
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -9794,7 +12813,15 @@ func (p *HistoryServiceScheduleDecisionTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceDescribeWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -9802,7 +12829,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -9810,7 +12837,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -9818,7 +12845,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField3(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceDescribeWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -9826,10 +12853,11 @@ func (p *HistoryServiceScheduleDecisionTaskResult)  ReadField4(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ScheduleDecisionTask_result"); err != nil {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -9842,7 +12870,20 @@ func (p *HistoryServiceScheduleDecisionTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceDescribeWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -9855,7 +12896,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -9868,7 +12909,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -9881,7 +12922,7 @@ func (p *HistoryServiceScheduleDecisionTaskResult) writeField3(oprot thrift.TPro
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -9894,35 +12935,35 @@ func (p *HistoryServiceScheduleDecisionTaskResult) writeField4(oprot thrift.TPro
   return err
 }
 
-func (p *HistoryServiceScheduleDecisionTaskResult) String() string {
+func (p *HistoryServiceDescribeWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceScheduleDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceDescribeWorkflowExecutionResult(%+v)", *p)
 }
 
 // Attributes:
-//  - CompletionRequest
-type HistoryServiceRecordChildExecutionCompletedArgs struct {
-  CompletionRequest *RecordChildExecutionCompletedRequest `thrift:"completionRequest,1" db:"completionRequest" json:"completionRequest"`
+//  - ResetRequest
+type HistoryServiceResetWorkflowExecutionArgs struct {
+  ResetRequest *ResetWorkflowExecutionRequest `thrift:"resetRequest,1" db:"resetRequest" json:"resetRequest"`
 }
 
-func NewHistoryServiceRecordChildExecutionCompletedArgs() *HistoryServiceRecordChildExecutionCompletedArgs {
-  return &HistoryServiceRecordChildExecutionCompletedArgs{}
+func NewHistoryServiceResetWorkflowExecutionArgs() *HistoryServiceResetWorkflowExecutionArgs {
+  return &HistoryServiceResetWorkflowExecutionArgs{}
 }
 
-var HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT *RecordChildExecutionCompletedRequest
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) GetCompletionRequest() *RecordChildExecutionCompletedRequest {
-  if !p.IsSetCompletionRequest() {
-    return HistoryServiceRecordChildExecutionCompletedArgs_CompletionRequest_DEFAULT
+var HistoryServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT *ResetWorkflowExecutionRequest
+func (p *HistoryServiceResetWorkflowExecutionArgs) GetResetRequest() *ResetWorkflowExecutionRequest {
+  if !p.IsSetResetRequest() {
+    return HistoryServiceResetWorkflowExecutionArgs_ResetRequest_DEFAULT
   }
-return p.CompletionRequest
+return p.ResetRequest
 }
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) IsSetCompletionRequest() bool {
-  return p.CompletionRequest != nil
+func (p *HistoryServiceResetWorkflowExecutionArgs) IsSetResetRequest() bool {
+  return p.ResetRequest != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -9954,16 +12995,16 @@ func (p *HistoryServiceRecordChildExecutionCompletedArgs) Read(iprot thrift.TPro
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.CompletionRequest = &RecordChildExecutionCompletedRequest{}
-  if err := p.CompletionRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompletionRequest), err)
+func (p *HistoryServiceResetWorkflowExecutionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.ResetRequest = &ResetWorkflowExecutionRequest{}
+  if err := p.ResetRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ResetRequest), err)
   }
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_args"); err != nil {
+func (p *HistoryServiceResetWorkflowExecutionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -9975,85 +13016,111 @@ func (p *HistoryServiceRecordChildExecutionCompletedArgs) Write(oprot thrift.TPr
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("completionRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:completionRequest: ", p), err) }
-  if err := p.CompletionRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompletionRequest), err)
+func (p *HistoryServiceResetWorkflowExecutionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("resetRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:resetRequest: ", p), err) }
+  if err := p.ResetRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ResetRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:completionRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:resetRequest: ", p), err) }
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedArgs) String() string {
+func (p *HistoryServiceResetWorkflowExecutionArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedArgs(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceResetWorkflowExecutionArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
 //  - EntityNotExistError
 //  - ShardOwnershipLostError
-type HistoryServiceRecordChildExecutionCompletedResult struct {
+//  - SessionAlreadyExistError
+type HistoryServiceResetWorkflowExecutionResult struct {
+  Success *shared.ResetWorkflowExecutionResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
   EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
   ShardOwnershipLostError *ShardOwnershipLostError `thrift:"shardOwnershipLostError,4" db:"shardOwnershipLostError" json:"shardOwnershipLostError,omitempty"`
+  SessionAlreadyExistError *shared.WorkflowExecutionAlreadyStartedError `thrift:"sessionAlreadyExistError,5" db:"sessionAlreadyExistError" json:"sessionAlreadyExistError,omitempty"`
 }
 
-func NewHistoryServiceRecordChildExecutionCompletedResult() *HistoryServiceRecordChildExecutionCompletedResult {
-  return &HistoryServiceRecordChildExecutionCompletedResult{}
+func NewHistoryServiceResetWorkflowExecutionResult() *HistoryServiceResetWorkflowExecutionResult {
+  return &HistoryServiceResetWorkflowExecutionResult{}
 }
 
-var HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetBadRequestError() *shared.BadRequestError {
+var HistoryServiceResetWorkflowExecutionResult_Success_DEFAULT *shared.ResetWorkflowExecutionResponse
+func (p *HistoryServiceResetWorkflowExecutionResult) GetSuccess() *shared.ResetWorkflowExecutionResponse {
+  if !p.IsSetSuccess() {
+    return HistoryServiceResetWorkflowExecutionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var HistoryServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_BadRequestError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
+var HistoryServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_InternalServiceError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+var HistoryServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetEntityNotExistError() *shared.EntityNotExistsError {
   if !p.IsSetEntityNotExistError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_EntityNotExistError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_EntityNotExistError_DEFAULT
   }
 return p.EntityNotExistError
 }
-var HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
-func (p *HistoryServiceRecordChildExecutionCompletedResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
+var HistoryServiceResetWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT *ShardOwnershipLostError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetShardOwnershipLostError() *ShardOwnershipLostError {
   if !p.IsSetShardOwnershipLostError() {
-    return HistoryServiceRecordChildExecutionCompletedResult_ShardOwnershipLostError_DEFAULT
+    return HistoryServiceResetWorkflowExecutionResult_ShardOwnershipLostError_DEFAULT
   }
 return p.ShardOwnershipLostError
 }
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetBadRequestError() bool {
+var HistoryServiceResetWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT *shared.WorkflowExecutionAlreadyStartedError
+func (p *HistoryServiceResetWorkflowExecutionResult) GetSessionAlreadyExistError() *shared.WorkflowExecutionAlreadyStartedError {
+  if !p.IsSetSessionAlreadyExistError() {
+    return HistoryServiceResetWorkflowExecutionResult_SessionAlreadyExistError_DEFAULT
+  }
+return p.SessionAlreadyExistError
+}
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetInternalServiceError() bool {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetEntityNotExistError() bool {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetEntityNotExistError() bool {
   return p.EntityNotExistError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) IsSetShardOwnershipLostError() bool {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetShardOwnershipLostError() bool {
   return p.ShardOwnershipLostError != nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult) IsSetSessionAlreadyExistError() bool {
+  return p.SessionAlreadyExistError != nil
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -10066,6 +13133,10 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TP
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -10082,6 +13153,10 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TP
       if err := p.ReadField4(iprot); err != nil {
         return err
       }
+    case 5:
+      if err := p.ReadField5(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -10097,7 +13172,15 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) Read(iprot thrift.TP
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.ResetWorkflowExecutionResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -10105,7 +13188,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField1(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -10113,7 +13196,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField2(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField3(iprot thrift.TProtocol) error {
   p.EntityNotExistError = &shared.EntityNotExistsError{}
   if err := p.EntityNotExistError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
@@ -10121,7 +13204,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField3(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField4(iprot thrift.TProtocol) error {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField4(iprot thrift.TProtocol) error {
   p.ShardOwnershipLostError = &ShardOwnershipLostError{}
   if err := p.ShardOwnershipLostError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ShardOwnershipLostError), err)
@@ -10129,14 +13212,24 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult)  ReadField4(iprot th
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("RecordChildExecutionCompleted_result"); err != nil {
+func (p *HistoryServiceResetWorkflowExecutionResult)  ReadField5(iprot thrift.TProtocol) error {
+  p.SessionAlreadyExistError = &shared.WorkflowExecutionAlreadyStartedError{}
+  if err := p.SessionAlreadyExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.SessionAlreadyExistError), err)
+  }
+  return nil
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ResetWorkflowExecution_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
     if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -10145,7 +13238,20 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) Write(oprot thrift.T
   return nil
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -10158,7 +13264,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField1(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -10171,7 +13277,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField2(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField3(oprot thrift.TProtocol) (err error) {
   if p.IsSetEntityNotExistError() {
     if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
@@ -10184,7 +13290,7 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField3(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField4(oprot thrift.TProtocol) (err error) {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField4(oprot thrift.TProtocol) (err error) {
   if p.IsSetShardOwnershipLostError() {
     if err := oprot.WriteFieldBegin("shardOwnershipLostError", thrift.STRUCT, 4); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:shardOwnershipLostError: ", p), err) }
@@ -10197,11 +13303,24 @@ func (p *HistoryServiceRecordChildExecutionCompletedResult) writeField4(oprot th
   return err
 }
 
-func (p *HistoryServiceRecordChildExecutionCompletedResult) String() string {
+func (p *HistoryServiceResetWorkflowExecutionResult) writeField5(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSessionAlreadyExistError() {
+    if err := oprot.WriteFieldBegin("sessionAlreadyExistError", thrift.STRUCT, 5); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:sessionAlreadyExistError: ", p), err) }
+    if err := p.SessionAlreadyExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.SessionAlreadyExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 5:sessionAlreadyExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *HistoryServiceResetWorkflowExecutionResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HistoryServiceRecordChildExecutionCompletedResult(%+v)", *p)
+  return fmt.Sprintf("HistoryServiceResetWorkflowExecutionResult(%+v)", *p)
 }
 
 
@@ -38,17 +38,21 @@ var _ = shared.GoUnusedProtection__
 
 // TChanHistoryService is the interface that defines the server handler and client interface.
 type TChanHistoryService interface {
+	DescribeWorkflowExecution(ctx thrift.Context, describeRequest *DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error)
 	GetWorkflowExecutionNextEventID(ctx thrift.Context, getRequest *GetWorkflowExecutionNextEventIDRequest) (*GetWorkflowExecutionNextEventIDResponse, error)
 	RecordActivityTaskHeartbeat(ctx thrift.Context, heartbeatRequest *RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
 	RecordActivityTaskStarted(ctx thrift.Context, addRequest *RecordActivityTaskStartedRequest) (*RecordActivityTaskStartedResponse, error)
 	RecordChildExecutionCompleted(ctx thrift.Context, completionRequest *RecordChildExecutionCompletedRequest) error
 	RecordDecisionTaskStarted(ctx thrift.Context, addRequest *RecordDecisionTaskStartedRequest) (*RecordDecisionTaskStartedResponse, error)
 	RequestCancelWorkflowExecution(ctx thrift.Context, cancelRequest *RequestCancelWorkflowExecutionRequest) error
+	ResetWorkflowExecution(ctx thrift.Context, resetRequest *ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error)
 	RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *RespondActivityTaskCanceledRequest) error
 	RespondActivityTaskCompleted(ctx thrift.Context, completeRequest *RespondActivityTaskCompletedRequest) error
 	RespondActivityTaskFailed(ctx thrift.Context, failRequest *RespondActivityTaskFailedRequest) error
 	RespondDecisionTaskCompleted(ctx thrift.Context, completeRequest *RespondDecisionTaskCompletedRequest) error
+	RespondDecisionTaskFailed(ctx thrift.Context, failedRequest *RespondDecisionTaskFailedRequest) error
 	ScheduleDecisionTask(ctx thrift.Context, scheduleRequest *ScheduleDecisionTaskRequest) error
+	SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	SignalWorkflowExecution(ctx thrift.Context, signalRequest *SignalWorkflowExecutionRequest) error
 	StartWorkflowExecution(ctx thrift.Context, startRequest *StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(ctx thrift.Context, terminateRequest *TerminateWorkflowExecutionRequest) error
@@ -73,6 +77,30 @@ func NewTChanHistoryServiceClient(client thrift.TChanClient) TChanHistoryService
 	return NewTChanHistoryServiceInheritedClient("HistoryService", client)
 }
 
+func (c *tchanHistoryServiceClient) DescribeWorkflowExecution(ctx thrift.Context, describeRequest *DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+	var resp HistoryServiceDescribeWorkflowExecutionResult
+	args := HistoryServiceDescribeWorkflowExecutionArgs{
+		DescribeRequest: describeRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "DescribeWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for DescribeWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanHistoryServiceClient) GetWorkflowExecutionNextEventID(ctx thrift.Context, getRequest *GetWorkflowExecutionNextEventIDRequest) (*GetWorkflowExecutionNextEventIDResponse, error) {
 	var resp HistoryServiceGetWorkflowExecutionNextEventIDResult
 	args := HistoryServiceGetWorkflowExecutionNextEventIDArgs{
@@ -221,6 +249,32 @@ func (c *tchanHistoryServiceClient) RequestCancelWorkflowExecution(ctx thrift.Co
 	return err
 }
 
+func (c *tchanHistoryServiceClient) ResetWorkflowExecution(ctx thrift.Context, resetRequest *ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	var resp HistoryServiceResetWorkflowExecutionResult
+	args := HistoryServiceResetWorkflowExecutionArgs{
+		ResetRequest: resetRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "ResetWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		case resp.SessionAlreadyExistError != nil:
+			err = resp.SessionAlreadyExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for ResetWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanHistoryServiceClient) RespondActivityTaskCanceled(ctx thrift.Context, canceledRequest *RespondActivityTaskCanceledRequest) error {
 	var resp HistoryServiceRespondActivityTaskCanceledResult
 	args := HistoryServiceRespondActivityTaskCanceledArgs{
@@ -317,6 +371,30 @@ func (c *tchanHistoryServiceClient) RespondDecisionTaskCompleted(ctx thrift.Cont
 	return err
 }
 
+func (c *tchanHistoryServiceClient) RespondDecisionTaskFailed(ctx thrift.Context, failedRequest *RespondDecisionTaskFailedRequest) error {
+	var resp HistoryServiceRespondDecisionTaskFailedResult
+	args := HistoryServiceRespondDecisionTaskFailedArgs{
+		FailedRequest: failedRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondDecisionTaskFailed", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondDecisionTaskFailed")
+		}
+	}
+
+	return err
+}
+
 func (c *tchanHistoryServiceClient) ScheduleDecisionTask(ctx thrift.Context, scheduleRequest *ScheduleDecisionTaskRequest) error {
 	var resp HistoryServiceScheduleDecisionTaskResult
 	args := HistoryServiceScheduleDecisionTaskArgs{
@@ -341,6 +419,30 @@ func (c *tchanHistoryServiceClient) ScheduleDecisionTask(ctx thrift.Context, sch
 	return err
 }
 
+func (c *tchanHistoryServiceClient) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	var resp HistoryServiceSignalWithStartWorkflowExecutionResult
+	args := HistoryServiceSignalWithStartWorkflowExecutionArgs{
+		SignalWithStartRequest: signalWithStartRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "SignalWithStartWorkflowExecution", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.SessionAlreadyExistError != nil:
+			err = resp.SessionAlreadyExistError
+		case resp.ShardOwnershipLostError != nil:
+			err = resp.ShardOwnershipLostError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for SignalWithStartWorkflowExecution")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanHistoryServiceClient) SignalWorkflowExecution(ctx thrift.Context, signalRequest *SignalWorkflowExecutionRequest) error {
 	var resp HistoryServiceSignalWorkflowExecutionResult
 	args := HistoryServiceSignalWorkflowExecutionArgs{
@@ -431,17 +533,21 @@ func (s *tchanHistoryServiceServer) Service() string {
 
 func (s *tchanHistoryServiceServer) Methods() []string {
 	return []string{
+		"DescribeWorkflowExecution",
 		"GetWorkflowExecutionNextEventID",
 		"RecordActivityTaskHeartbeat",
 		"RecordActivityTaskStarted",
 		"RecordChildExecutionCompleted",
 		"RecordDecisionTaskStarted",
 		"RequestCancelWorkflowExecution",
+		"ResetWorkflowExecution",
 		"RespondActivityTaskCanceled",
 		"RespondActivityTaskCompleted",
 		"RespondActivityTaskFailed",
 		"RespondDecisionTaskCompleted",
+		"RespondDecisionTaskFailed",
 		"ScheduleDecisionTask",
+		"SignalWithStartWorkflowExecution",
 		"SignalWorkflowExecution",
 		"StartWorkflowExecution",
 		"TerminateWorkflowExecution",
@@ -450,6 +556,8 @@ func (s *tchanHistoryServiceServer) Methods() []string {
 
 func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	switch methodName {
+	case "DescribeWorkflowExecution":
+		return s.handleDescribeWorkflowExecution(ctx, protocol)
 	case "GetWorkflowExecutionNextEventID":
 		return s.handleGetWorkflowExecutionNextEventID(ctx, protocol)
 	case "RecordActivityTaskHeartbeat":
@@ -462,6 +570,8 @@ func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string
 		return s.handleRecordDecisionTaskStarted(ctx, protocol)
 	case "RequestCancelWorkflowExecution":
 		return s.handleRequestCancelWorkflowExecution(ctx, protocol)
+	case "ResetWorkflowExecution":
+		return s.handleResetWorkflowExecution(ctx, protocol)
 	case "RespondActivityTaskCanceled":
 		return s.handleRespondActivityTaskCanceled(ctx, protocol)
 	case "RespondActivityTaskCompleted":
@@ -470,8 +580,12 @@ func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string
 		return s.handleRespondActivityTaskFailed(ctx, protocol)
 	case "RespondDecisionTaskCompleted":
 		return s.handleRespondDecisionTaskCompleted(ctx, protocol)
+	case "RespondDecisionTaskFailed":
+		return s.handleRespondDecisionTaskFailed(ctx, protocol)
 	case "ScheduleDecisionTask":
 		return s.handleScheduleDecisionTask(ctx, protocol)
+	case "SignalWithStartWorkflowExecution":
+		return s.handleSignalWithStartWorkflowExecution(ctx, protocol)
 	case "SignalWorkflowExecution":
 		return s.handleSignalWorkflowExecution(ctx, protocol)
 	case "StartWorkflowExecution":
@@ -484,6 +598,49 @@ func (s *tchanHistoryServiceServer) Handle(ctx thrift.Context, methodName string
 	}
 }
 
+func (s *tchanHistoryServiceServer) handleDescribeWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceDescribeWorkflowExecutionArgs
+	var res HistoryServiceDescribeWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.DescribeWorkflowExecution(ctx, req.DescribeRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleGetWorkflowExecutionNextEventID(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceGetWorkflowExecutionNextEventIDArgs
 	var res HistoryServiceGetWorkflowExecutionNextEventIDResult
@@ -750,6 +907,54 @@ func (s *tchanHistoryServiceServer) handleRequestCancelWorkflowExecution(ctx thr
 	return err == nil, &res, nil
 }
 
+func (s *tchanHistoryServiceServer) handleResetWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceResetWorkflowExecutionArgs
+	var res HistoryServiceResetWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.ResetWorkflowExecution(ctx, req.ResetRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
+			}
+			res.SessionAlreadyExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleRespondActivityTaskCanceled(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceRespondActivityTaskCanceledArgs
 	var res HistoryServiceRespondActivityTaskCanceledResult
@@ -918,6 +1123,48 @@ func (s *tchanHistoryServiceServer) handleRespondDecisionTaskCompleted(ctx thrif
 	return err == nil, &res, nil
 }
 
+func (s *tchanHistoryServiceServer) handleRespondDecisionTaskFailed(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceRespondDecisionTaskFailedArgs
+	var res HistoryServiceRespondDecisionTaskFailedResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondDecisionTaskFailed(ctx, req.FailedRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleScheduleDecisionTask(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceScheduleDecisionTaskArgs
 	var res HistoryServiceScheduleDecisionTaskResult
@@ -960,6 +1207,49 @@ func (s *tchanHistoryServiceServer) handleScheduleDecisionTask(ctx thrift.Contex
 	return err == nil, &res, nil
 }
 
+func (s *tchanHistoryServiceServer) handleSignalWithStartWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req HistoryServiceSignalWithStartWorkflowExecutionArgs
+	var res HistoryServiceSignalWithStartWorkflowExecutionResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.SignalWithStartWorkflowExecution(ctx, req.SignalWithStartRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.WorkflowExecutionAlreadyStartedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for sessionAlreadyExistError returned non-nil error type *shared.WorkflowExecutionAlreadyStartedError but nil value")
+			}
+			res.SessionAlreadyExistError = v
+		case *ShardOwnershipLostError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for shardOwnershipLostError returned non-nil error type *ShardOwnershipLostError but nil value")
+			}
+			res.ShardOwnershipLostError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanHistoryServiceServer) handleSignalWorkflowExecution(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req HistoryServiceSignalWorkflowExecutionArgs
 	var res HistoryServiceSignalWorkflowExecutionResult
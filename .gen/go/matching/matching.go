@@ -178,6 +178,10 @@ func (p *PollForDecisionTaskRequest) String() string {
 //  - WorkflowType
 //  - PreviousStartedEventId
 //  - StartedEventId
+//  - Query
+//  - StickyExecutionEnabled
+//  - Attempt
+//  - DecisionInfo
 type PollForDecisionTaskResponse struct {
   // unused fields # 1 to 9
   TaskToken []byte `thrift:"taskToken,10" db:"taskToken" json:"taskToken,omitempty"`
@@ -189,6 +193,14 @@ type PollForDecisionTaskResponse struct {
   PreviousStartedEventId *int64 `thrift:"previousStartedEventId,40" db:"previousStartedEventId" json:"previousStartedEventId,omitempty"`
   // unused fields # 41 to 49
   StartedEventId *int64 `thrift:"startedEventId,50" db:"startedEventId" json:"startedEventId,omitempty"`
+  // unused fields # 51 to 59
+  Query *shared.WorkflowQuery `thrift:"query,60" db:"query" json:"query,omitempty"`
+  // unused fields # 61 to 69
+  StickyExecutionEnabled *bool `thrift:"stickyExecutionEnabled,70" db:"stickyExecutionEnabled" json:"stickyExecutionEnabled,omitempty"`
+  // unused fields # 71 to 79
+  Attempt *int64 `thrift:"attempt,80" db:"attempt" json:"attempt,omitempty"`
+  // unused fields # 81 to 89
+  DecisionInfo *shared.TransientDecisionInfo `thrift:"decisionInfo,90" db:"decisionInfo" json:"decisionInfo,omitempty"`
 }
 
 func NewPollForDecisionTaskResponse() *PollForDecisionTaskResponse {
@@ -228,6 +240,34 @@ func (p *PollForDecisionTaskResponse) GetStartedEventId() int64 {
   }
 return *p.StartedEventId
 }
+var PollForDecisionTaskResponse_Query_DEFAULT *shared.WorkflowQuery
+func (p *PollForDecisionTaskResponse) GetQuery() *shared.WorkflowQuery {
+  if !p.IsSetQuery() {
+    return PollForDecisionTaskResponse_Query_DEFAULT
+  }
+return p.Query
+}
+var PollForDecisionTaskResponse_StickyExecutionEnabled_DEFAULT bool
+func (p *PollForDecisionTaskResponse) GetStickyExecutionEnabled() bool {
+  if !p.IsSetStickyExecutionEnabled() {
+    return PollForDecisionTaskResponse_StickyExecutionEnabled_DEFAULT
+  }
+return *p.StickyExecutionEnabled
+}
+var PollForDecisionTaskResponse_Attempt_DEFAULT int64
+func (p *PollForDecisionTaskResponse) GetAttempt() int64 {
+  if !p.IsSetAttempt() {
+    return PollForDecisionTaskResponse_Attempt_DEFAULT
+  }
+return *p.Attempt
+}
+var PollForDecisionTaskResponse_DecisionInfo_DEFAULT *shared.TransientDecisionInfo
+func (p *PollForDecisionTaskResponse) GetDecisionInfo() *shared.TransientDecisionInfo {
+  if !p.IsSetDecisionInfo() {
+    return PollForDecisionTaskResponse_DecisionInfo_DEFAULT
+  }
+return p.DecisionInfo
+}
 func (p *PollForDecisionTaskResponse) IsSetTaskToken() bool {
   return p.TaskToken != nil
 }
@@ -248,6 +288,22 @@ func (p *PollForDecisionTaskResponse) IsSetStartedEventId() bool {
   return p.StartedEventId != nil
 }
 
+func (p *PollForDecisionTaskResponse) IsSetQuery() bool {
+  return p.Query != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetStickyExecutionEnabled() bool {
+  return p.StickyExecutionEnabled != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetAttempt() bool {
+  return p.Attempt != nil
+}
+
+func (p *PollForDecisionTaskResponse) IsSetDecisionInfo() bool {
+  return p.DecisionInfo != nil
+}
+
 func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -281,6 +337,22 @@ func (p *PollForDecisionTaskResponse) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField50(iprot); err != nil {
         return err
       }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
+    case 80:
+      if err := p.ReadField80(iprot); err != nil {
+        return err
+      }
+    case 90:
+      if err := p.ReadField90(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -339,6 +411,40 @@ func (p *PollForDecisionTaskResponse)  ReadField50(iprot thrift.TProtocol) error
   return nil
 }
 
+func (p *PollForDecisionTaskResponse)  ReadField60(iprot thrift.TProtocol) error {
+  p.Query = &shared.WorkflowQuery{}
+  if err := p.Query.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Query), err)
+  }
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.StickyExecutionEnabled = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField80(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 80: ", err)
+} else {
+  p.Attempt = &v
+}
+  return nil
+}
+
+func (p *PollForDecisionTaskResponse)  ReadField90(iprot thrift.TProtocol) error {
+  p.DecisionInfo = &shared.TransientDecisionInfo{}
+  if err := p.DecisionInfo.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DecisionInfo), err)
+  }
+  return nil
+}
+
 func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("PollForDecisionTaskResponse"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -348,6 +454,10 @@ func (p *PollForDecisionTaskResponse) Write(oprot thrift.TProtocol) error {
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
+    if err := p.writeField80(oprot); err != nil { return err }
+    if err := p.writeField90(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -418,6 +528,56 @@ func (p *PollForDecisionTaskResponse) writeField50(oprot thrift.TProtocol) (err
   return err
 }
 
+func (p *PollForDecisionTaskResponse) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQuery() {
+    if err := oprot.WriteFieldBegin("query", thrift.STRUCT, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:query: ", p), err) }
+    if err := p.Query.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Query), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:query: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetStickyExecutionEnabled() {
+    if err := oprot.WriteFieldBegin("stickyExecutionEnabled", thrift.BOOL, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:stickyExecutionEnabled: ", p), err) }
+    if err := oprot.WriteBool(bool(*p.StickyExecutionEnabled)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.stickyExecutionEnabled (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:stickyExecutionEnabled: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField80(oprot thrift.TProtocol) (err error) {
+  if p.IsSetAttempt() {
+    if err := oprot.WriteFieldBegin("attempt", thrift.I64, 80); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 80:attempt: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.Attempt)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.attempt (80) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 80:attempt: ", p), err) }
+  }
+  return err
+}
+
+func (p *PollForDecisionTaskResponse) writeField90(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDecisionInfo() {
+    if err := oprot.WriteFieldBegin("decisionInfo", thrift.STRUCT, 90); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 90:decisionInfo: ", p), err) }
+    if err := p.DecisionInfo.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DecisionInfo), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 90:decisionInfo: ", p), err) }
+  }
+  return err
+}
+
 func (p *PollForDecisionTaskResponse) String() string {
   if p == nil {
     return "<nil>"
@@ -565,6 +725,8 @@ func (p *PollForActivityTaskRequest) String() string {
 //  - Execution
 //  - TaskList
 //  - ScheduleId
+//  - ScheduleToStartTimeoutSeconds
+//  - ScheduleAttempt
 type AddDecisionTaskRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
@@ -574,6 +736,10 @@ type AddDecisionTaskRequest struct {
   TaskList *shared.TaskList `thrift:"taskList,30" db:"taskList" json:"taskList,omitempty"`
   // unused fields # 31 to 39
   ScheduleId *int64 `thrift:"scheduleId,40" db:"scheduleId" json:"scheduleId,omitempty"`
+  // unused fields # 41 to 49
+  ScheduleToStartTimeoutSeconds *int32 `thrift:"scheduleToStartTimeoutSeconds,50" db:"scheduleToStartTimeoutSeconds" json:"scheduleToStartTimeoutSeconds,omitempty"`
+  // unused fields # 51 to 59
+  ScheduleAttempt *int64 `thrift:"scheduleAttempt,60" db:"scheduleAttempt" json:"scheduleAttempt,omitempty"`
 }
 
 func NewAddDecisionTaskRequest() *AddDecisionTaskRequest {
@@ -608,6 +774,20 @@ func (p *AddDecisionTaskRequest) GetScheduleId() int64 {
   }
 return *p.ScheduleId
 }
+var AddDecisionTaskRequest_ScheduleToStartTimeoutSeconds_DEFAULT int32
+func (p *AddDecisionTaskRequest) GetScheduleToStartTimeoutSeconds() int32 {
+  if !p.IsSetScheduleToStartTimeoutSeconds() {
+    return AddDecisionTaskRequest_ScheduleToStartTimeoutSeconds_DEFAULT
+  }
+return *p.ScheduleToStartTimeoutSeconds
+}
+var AddDecisionTaskRequest_ScheduleAttempt_DEFAULT int64
+func (p *AddDecisionTaskRequest) GetScheduleAttempt() int64 {
+  if !p.IsSetScheduleAttempt() {
+    return AddDecisionTaskRequest_ScheduleAttempt_DEFAULT
+  }
+return *p.ScheduleAttempt
+}
 func (p *AddDecisionTaskRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
@@ -624,6 +804,14 @@ func (p *AddDecisionTaskRequest) IsSetScheduleId() bool {
   return p.ScheduleId != nil
 }
 
+func (p *AddDecisionTaskRequest) IsSetScheduleToStartTimeoutSeconds() bool {
+  return p.ScheduleToStartTimeoutSeconds != nil
+}
+
+func (p *AddDecisionTaskRequest) IsSetScheduleAttempt() bool {
+  return p.ScheduleAttempt != nil
+}
+
 func (p *AddDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -653,6 +841,14 @@ func (p *AddDecisionTaskRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField40(iprot); err != nil {
         return err
       }
+    case 50:
+      if err := p.ReadField50(iprot); err != nil {
+        return err
+      }
+    case 60:
+      if err := p.ReadField60(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -702,6 +898,24 @@ func (p *AddDecisionTaskRequest)  ReadField40(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *AddDecisionTaskRequest)  ReadField50(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 50: ", err)
+} else {
+  p.ScheduleToStartTimeoutSeconds = &v
+}
+  return nil
+}
+
+func (p *AddDecisionTaskRequest)  ReadField60(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 60: ", err)
+} else {
+  p.ScheduleAttempt = &v
+}
+  return nil
+}
+
 func (p *AddDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("AddDecisionTaskRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -710,6 +924,8 @@ func (p *AddDecisionTaskRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField20(oprot); err != nil { return err }
     if err := p.writeField30(oprot); err != nil { return err }
     if err := p.writeField40(oprot); err != nil { return err }
+    if err := p.writeField50(oprot); err != nil { return err }
+    if err := p.writeField60(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -768,6 +984,30 @@ func (p *AddDecisionTaskRequest) writeField40(oprot thrift.TProtocol) (err error
   return err
 }
 
+func (p *AddDecisionTaskRequest) writeField50(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleToStartTimeoutSeconds() {
+    if err := oprot.WriteFieldBegin("scheduleToStartTimeoutSeconds", thrift.I32, 50); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 50:scheduleToStartTimeoutSeconds: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.ScheduleToStartTimeoutSeconds)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleToStartTimeoutSeconds (50) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 50:scheduleToStartTimeoutSeconds: ", p), err) }
+  }
+  return err
+}
+
+func (p *AddDecisionTaskRequest) writeField60(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleAttempt() {
+    if err := oprot.WriteFieldBegin("scheduleAttempt", thrift.I64, 60); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 60:scheduleAttempt: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduleAttempt)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleAttempt (60) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 60:scheduleAttempt: ", p), err) }
+  }
+  return err
+}
+
 func (p *AddDecisionTaskRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -782,6 +1022,7 @@ func (p *AddDecisionTaskRequest) String() string {
 //  - TaskList
 //  - ScheduleId
 //  - ScheduleToStartTimeoutSeconds
+//  - ScheduleAttempt
 type AddActivityTaskRequest struct {
   // unused fields # 1 to 9
   DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
@@ -795,6 +1036,8 @@ type AddActivityTaskRequest struct {
   ScheduleId *int64 `thrift:"scheduleId,50" db:"scheduleId" json:"scheduleId,omitempty"`
   // unused fields # 51 to 59
   ScheduleToStartTimeoutSeconds *int32 `thrift:"scheduleToStartTimeoutSeconds,60" db:"scheduleToStartTimeoutSeconds" json:"scheduleToStartTimeoutSeconds,omitempty"`
+  // unused fields # 61 to 69
+  ScheduleAttempt *int64 `thrift:"scheduleAttempt,70" db:"scheduleAttempt" json:"scheduleAttempt,omitempty"`
 }
 
 func NewAddActivityTaskRequest() *AddActivityTaskRequest {
@@ -843,6 +1086,13 @@ func (p *AddActivityTaskRequest) GetScheduleToStartTimeoutSeconds() int32 {
   }
 return *p.ScheduleToStartTimeoutSeconds
 }
+var AddActivityTaskRequest_ScheduleAttempt_DEFAULT int64
+func (p *AddActivityTaskRequest) GetScheduleAttempt() int64 {
+  if !p.IsSetScheduleAttempt() {
+    return AddActivityTaskRequest_ScheduleAttempt_DEFAULT
+  }
+return *p.ScheduleAttempt
+}
 func (p *AddActivityTaskRequest) IsSetDomainUUID() bool {
   return p.DomainUUID != nil
 }
@@ -867,6 +1117,10 @@ func (p *AddActivityTaskRequest) IsSetScheduleToStartTimeoutSeconds() bool {
   return p.ScheduleToStartTimeoutSeconds != nil
 }
 
+func (p *AddActivityTaskRequest) IsSetScheduleAttempt() bool {
+  return p.ScheduleAttempt != nil
+}
+
 func (p *AddActivityTaskRequest) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
@@ -904,6 +1158,10 @@ func (p *AddActivityTaskRequest) Read(iprot thrift.TProtocol) error {
       if err := p.ReadField60(iprot); err != nil {
         return err
       }
+    case 70:
+      if err := p.ReadField70(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -971,6 +1229,15 @@ func (p *AddActivityTaskRequest)  ReadField60(iprot thrift.TProtocol) error {
   return nil
 }
 
+func (p *AddActivityTaskRequest)  ReadField70(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 70: ", err)
+} else {
+  p.ScheduleAttempt = &v
+}
+  return nil
+}
+
 func (p *AddActivityTaskRequest) Write(oprot thrift.TProtocol) error {
   if err := oprot.WriteStructBegin("AddActivityTaskRequest"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
@@ -981,6 +1248,7 @@ func (p *AddActivityTaskRequest) Write(oprot thrift.TProtocol) error {
     if err := p.writeField40(oprot); err != nil { return err }
     if err := p.writeField50(oprot); err != nil { return err }
     if err := p.writeField60(oprot); err != nil { return err }
+    if err := p.writeField70(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1063,6 +1331,18 @@ func (p *AddActivityTaskRequest) writeField60(oprot thrift.TProtocol) (err error
   return err
 }
 
+func (p *AddActivityTaskRequest) writeField70(oprot thrift.TProtocol) (err error) {
+  if p.IsSetScheduleAttempt() {
+    if err := oprot.WriteFieldBegin("scheduleAttempt", thrift.I64, 70); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 70:scheduleAttempt: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.ScheduleAttempt)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.scheduleAttempt (70) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 70:scheduleAttempt: ", p), err) }
+  }
+  return err
+}
+
 func (p *AddActivityTaskRequest) String() string {
   if p == nil {
     return "<nil>"
@@ -1070,716 +1350,2497 @@ func (p *AddActivityTaskRequest) String() string {
   return fmt.Sprintf("AddActivityTaskRequest(%+v)", *p)
 }
 
-type MatchingService interface {  //MatchingService API is exposed to provide support for polling from long running applications.
-  //Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
-  //DecisionTask, application is expected to process the history of events for that session and respond back with next
-  //decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
-  //with completion or failure.
-  //
-
-  // PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
-  // DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
-  // 
-  // 
-  // Parameters:
-  //  - PollRequest
-  PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error)
-  // PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
-  // is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
-  // 
-  // 
-  // Parameters:
-  //  - PollRequest
-  PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error)
-  // AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-  // by the MatchingEngine.
-  // 
-  // 
-  // Parameters:
-  //  - AddRequest
-  AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error)
-  // AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-  // by the MatchingEngine.
-  // 
-  // 
-  // Parameters:
-  //  - AddRequest
-  AddActivityTask(addRequest *AddActivityTaskRequest) (err error)
+// Attributes:
+//  - DomainUUID
+//  - TaskList
+//  - QueryRequest
+type QueryWorkflowRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  TaskList *shared.TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 21 to 29
+  QueryRequest *shared.QueryWorkflowRequest `thrift:"queryRequest,30" db:"queryRequest" json:"queryRequest,omitempty"`
 }
 
-//MatchingService API is exposed to provide support for polling from long running applications.
-//Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
-//DecisionTask, application is expected to process the history of events for that session and respond back with next
-//decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
-//with completion or failure.
-//
-type MatchingServiceClient struct {
-  Transport thrift.TTransport
-  ProtocolFactory thrift.TProtocolFactory
-  InputProtocol thrift.TProtocol
-  OutputProtocol thrift.TProtocol
-  SeqId int32
+func NewQueryWorkflowRequest() *QueryWorkflowRequest {
+  return &QueryWorkflowRequest{}
 }
 
-func NewMatchingServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *MatchingServiceClient {
-  return &MatchingServiceClient{Transport: t,
-    ProtocolFactory: f,
-    InputProtocol: f.GetProtocol(t),
-    OutputProtocol: f.GetProtocol(t),
-    SeqId: 0,
+var QueryWorkflowRequest_DomainUUID_DEFAULT string
+func (p *QueryWorkflowRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return QueryWorkflowRequest_DomainUUID_DEFAULT
   }
+return *p.DomainUUID
 }
-
-func NewMatchingServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *MatchingServiceClient {
-  return &MatchingServiceClient{Transport: t,
-    ProtocolFactory: nil,
-    InputProtocol: iprot,
-    OutputProtocol: oprot,
-    SeqId: 0,
+var QueryWorkflowRequest_TaskList_DEFAULT *shared.TaskList
+func (p *QueryWorkflowRequest) GetTaskList() *shared.TaskList {
+  if !p.IsSetTaskList() {
+    return QueryWorkflowRequest_TaskList_DEFAULT
+  }
+return p.TaskList
+}
+var QueryWorkflowRequest_QueryRequest_DEFAULT *shared.QueryWorkflowRequest
+func (p *QueryWorkflowRequest) GetQueryRequest() *shared.QueryWorkflowRequest {
+  if !p.IsSetQueryRequest() {
+    return QueryWorkflowRequest_QueryRequest_DEFAULT
   }
+return p.QueryRequest
 }
-
-// PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
-// DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
-// 
-// 
-// Parameters:
-//  - PollRequest
-func (p *MatchingServiceClient) PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error) {
-  if err = p.sendPollForDecisionTask(pollRequest); err != nil { return }
-  return p.recvPollForDecisionTask()
+func (p *QueryWorkflowRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
 }
 
-func (p *MatchingServiceClient) sendPollForDecisionTask(pollRequest *PollForDecisionTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("PollForDecisionTask", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := MatchingServicePollForDecisionTaskArgs{
-  PollRequest : pollRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
-  }
-  return oprot.Flush()
+func (p *QueryWorkflowRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
 }
 
+func (p *QueryWorkflowRequest) IsSetQueryRequest() bool {
+  return p.QueryRequest != nil
+}
 
-func (p *MatchingServiceClient) recvPollForDecisionTask() (value *PollForDecisionTaskResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+func (p *QueryWorkflowRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if method != "PollForDecisionTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForDecisionTask failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForDecisionTask failed: out of sequence response")
-    return
-  }
-  if mTypeId == thrift.EXCEPTION {
-    error0 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error1 error
-    error1, err = error0.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error1
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForDecisionTask failed: invalid message type")
-    return
-  }
-  result := MatchingServicePollForDecisionTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  value = result.GetSuccess()
-  return
+  return nil
 }
 
-// PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
-// is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
-// 
-// 
-// Parameters:
-//  - PollRequest
-func (p *MatchingServiceClient) PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error) {
-  if err = p.sendPollForActivityTask(pollRequest); err != nil { return }
-  return p.recvPollForActivityTask()
+func (p *QueryWorkflowRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
 }
 
-func (p *MatchingServiceClient) sendPollForActivityTask(pollRequest *PollForActivityTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("PollForActivityTask", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := MatchingServicePollForActivityTaskArgs{
-  PollRequest : pollRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+func (p *QueryWorkflowRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &shared.TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
   }
-  return oprot.Flush()
+  return nil
 }
 
-
-func (p *MatchingServiceClient) recvPollForActivityTask() (value *shared.PollForActivityTaskResponse, err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+func (p *QueryWorkflowRequest)  ReadField30(iprot thrift.TProtocol) error {
+  p.QueryRequest = &shared.QueryWorkflowRequest{}
+  if err := p.QueryRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryRequest), err)
   }
-  if method != "PollForActivityTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForActivityTask failed: wrong method name")
-    return
+  return nil
+}
+
+func (p *QueryWorkflowRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflowRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForActivityTask failed: out of sequence response")
-    return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *QueryWorkflowRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
   }
-  if mTypeId == thrift.EXCEPTION {
-    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error3 error
-    error3, err = error2.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *QueryWorkflowRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
     }
-    err = error3
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForActivityTask failed: invalid message type")
-    return
-  }
-  result := MatchingServicePollForActivityTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
   }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
+  return err
+}
+
+func (p *QueryWorkflowRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryRequest() {
+    if err := oprot.WriteFieldBegin("queryRequest", thrift.STRUCT, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:queryRequest: ", p), err) }
+    if err := p.QueryRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:queryRequest: ", p), err) }
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
+  return err
+}
+
+func (p *QueryWorkflowRequest) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  value = result.GetSuccess()
-  return
+  return fmt.Sprintf("QueryWorkflowRequest(%+v)", *p)
 }
 
-// AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-// by the MatchingEngine.
-// 
-// 
-// Parameters:
-//  - AddRequest
-func (p *MatchingServiceClient) AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error) {
-  if err = p.sendAddDecisionTask(addRequest); err != nil { return }
-  return p.recvAddDecisionTask()
+// Attributes:
+//  - DomainUUID
+//  - TaskList
+//  - TaskID
+//  - CompletedRequest
+type RespondQueryTaskCompletedRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  TaskList *shared.TaskList `thrift:"taskList,20" db:"taskList" json:"taskList,omitempty"`
+  // unused fields # 21 to 29
+  TaskID *string `thrift:"taskID,30" db:"taskID" json:"taskID,omitempty"`
+  // unused fields # 31 to 39
+  CompletedRequest *shared.RespondQueryTaskCompletedRequest `thrift:"completedRequest,40" db:"completedRequest" json:"completedRequest,omitempty"`
 }
 
-func (p *MatchingServiceClient) sendAddDecisionTask(addRequest *AddDecisionTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("AddDecisionTask", thrift.CALL, p.SeqId); err != nil {
-      return
+func NewRespondQueryTaskCompletedRequest() *RespondQueryTaskCompletedRequest {
+  return &RespondQueryTaskCompletedRequest{}
+}
+
+var RespondQueryTaskCompletedRequest_DomainUUID_DEFAULT string
+func (p *RespondQueryTaskCompletedRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return RespondQueryTaskCompletedRequest_DomainUUID_DEFAULT
   }
-  args := MatchingServiceAddDecisionTaskArgs{
-  AddRequest : addRequest,
+return *p.DomainUUID
+}
+var RespondQueryTaskCompletedRequest_TaskList_DEFAULT *shared.TaskList
+func (p *RespondQueryTaskCompletedRequest) GetTaskList() *shared.TaskList {
+  if !p.IsSetTaskList() {
+    return RespondQueryTaskCompletedRequest_TaskList_DEFAULT
   }
-  if err = args.Write(oprot); err != nil {
-      return
+return p.TaskList
+}
+var RespondQueryTaskCompletedRequest_TaskID_DEFAULT string
+func (p *RespondQueryTaskCompletedRequest) GetTaskID() string {
+  if !p.IsSetTaskID() {
+    return RespondQueryTaskCompletedRequest_TaskID_DEFAULT
   }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+return *p.TaskID
+}
+var RespondQueryTaskCompletedRequest_CompletedRequest_DEFAULT *shared.RespondQueryTaskCompletedRequest
+func (p *RespondQueryTaskCompletedRequest) GetCompletedRequest() *shared.RespondQueryTaskCompletedRequest {
+  if !p.IsSetCompletedRequest() {
+    return RespondQueryTaskCompletedRequest_CompletedRequest_DEFAULT
   }
-  return oprot.Flush()
+return p.CompletedRequest
+}
+func (p *RespondQueryTaskCompletedRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
 }
 
+func (p *RespondQueryTaskCompletedRequest) IsSetTaskList() bool {
+  return p.TaskList != nil
+}
 
-func (p *MatchingServiceClient) recvAddDecisionTask() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
-  }
-  if method != "AddDecisionTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddDecisionTask failed: wrong method name")
-    return
-  }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddDecisionTask failed: out of sequence response")
-    return
+func (p *RespondQueryTaskCompletedRequest) IsSetTaskID() bool {
+  return p.TaskID != nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) IsSetCompletedRequest() bool {
+  return p.CompletedRequest != nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if mTypeId == thrift.EXCEPTION {
-    error4 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error5 error
-    error5, err = error4.Read(iprot)
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
     if err != nil {
-      return
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    case 30:
+      if err := p.ReadField30(iprot); err != nil {
+        return err
+      }
+    case 40:
+      if err := p.ReadField40(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
     }
-    err = error5
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddDecisionTask failed: invalid message type")
-    return
-  }
-  result := MatchingServiceAddDecisionTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
   }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return
+  return nil
 }
 
-// AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
-// by the MatchingEngine.
-// 
-// 
-// Parameters:
-//  - AddRequest
-func (p *MatchingServiceClient) AddActivityTask(addRequest *AddActivityTaskRequest) (err error) {
-  if err = p.sendAddActivityTask(addRequest); err != nil { return }
-  return p.recvAddActivityTask()
+func (p *RespondQueryTaskCompletedRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
 }
 
-func (p *MatchingServiceClient) sendAddActivityTask(addRequest *AddActivityTaskRequest)(err error) {
-  oprot := p.OutputProtocol
-  if oprot == nil {
-    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.OutputProtocol = oprot
-  }
-  p.SeqId++
-  if err = oprot.WriteMessageBegin("AddActivityTask", thrift.CALL, p.SeqId); err != nil {
-      return
-  }
-  args := MatchingServiceAddActivityTaskArgs{
-  AddRequest : addRequest,
-  }
-  if err = args.Write(oprot); err != nil {
-      return
-  }
-  if err = oprot.WriteMessageEnd(); err != nil {
-      return
+func (p *RespondQueryTaskCompletedRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.TaskList = &shared.TaskList{}
+  if err := p.TaskList.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.TaskList), err)
   }
-  return oprot.Flush()
+  return nil
 }
 
+func (p *RespondQueryTaskCompletedRequest)  ReadField30(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 30: ", err)
+} else {
+  p.TaskID = &v
+}
+  return nil
+}
 
-func (p *MatchingServiceClient) recvAddActivityTask() (err error) {
-  iprot := p.InputProtocol
-  if iprot == nil {
-    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
-    p.InputProtocol = iprot
-  }
-  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
-  if err != nil {
-    return
+func (p *RespondQueryTaskCompletedRequest)  ReadField40(iprot thrift.TProtocol) error {
+  p.CompletedRequest = &shared.RespondQueryTaskCompletedRequest{}
+  if err := p.CompletedRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.CompletedRequest), err)
   }
-  if method != "AddActivityTask" {
-    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddActivityTask failed: wrong method name")
-    return
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompletedRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
+    if err := p.writeField30(oprot); err != nil { return err }
+    if err := p.writeField40(oprot); err != nil { return err }
   }
-  if p.SeqId != seqId {
-    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddActivityTask failed: out of sequence response")
-    return
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *RespondQueryTaskCompletedRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
   }
-  if mTypeId == thrift.EXCEPTION {
-    error6 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
-    var error7 error
-    error7, err = error6.Read(iprot)
-    if err != nil {
-      return
-    }
-    if err = iprot.ReadMessageEnd(); err != nil {
-      return
+  return err
+}
+
+func (p *RespondQueryTaskCompletedRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskList() {
+    if err := oprot.WriteFieldBegin("taskList", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:taskList: ", p), err) }
+    if err := p.TaskList.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.TaskList), err)
     }
-    err = error7
-    return
-  }
-  if mTypeId != thrift.REPLY {
-    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddActivityTask failed: invalid message type")
-    return
-  }
-  result := MatchingServiceAddActivityTaskResult{}
-  if err = result.Read(iprot); err != nil {
-    return
-  }
-  if err = iprot.ReadMessageEnd(); err != nil {
-    return
-  }
-  if result.BadRequestError != nil {
-    err = result.BadRequestError
-    return 
-  } else   if result.InternalServiceError != nil {
-    err = result.InternalServiceError
-    return 
-  } else   if result.ServiceBusyError != nil {
-    err = result.ServiceBusyError
-    return 
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:taskList: ", p), err) }
   }
-  return
+  return err
 }
 
-
-type MatchingServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler MatchingService
+func (p *RespondQueryTaskCompletedRequest) writeField30(oprot thrift.TProtocol) (err error) {
+  if p.IsSetTaskID() {
+    if err := oprot.WriteFieldBegin("taskID", thrift.STRING, 30); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 30:taskID: ", p), err) }
+    if err := oprot.WriteString(string(*p.TaskID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.taskID (30) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 30:taskID: ", p), err) }
+  }
+  return err
 }
 
-func (p *MatchingServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
+func (p *RespondQueryTaskCompletedRequest) writeField40(oprot thrift.TProtocol) (err error) {
+  if p.IsSetCompletedRequest() {
+    if err := oprot.WriteFieldBegin("completedRequest", thrift.STRUCT, 40); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 40:completedRequest: ", p), err) }
+    if err := p.CompletedRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.CompletedRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 40:completedRequest: ", p), err) }
+  }
+  return err
 }
 
-func (p *MatchingServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
+func (p *RespondQueryTaskCompletedRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("RespondQueryTaskCompletedRequest(%+v)", *p)
 }
 
-func (p *MatchingServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
+// Attributes:
+//  - DomainUUID
+//  - DescRequest
+type DescribeTaskListRequest struct {
+  // unused fields # 1 to 9
+  DomainUUID *string `thrift:"domainUUID,10" db:"domainUUID" json:"domainUUID,omitempty"`
+  // unused fields # 11 to 19
+  DescRequest *shared.DescribeTaskListRequest `thrift:"descRequest,20" db:"descRequest" json:"descRequest,omitempty"`
 }
 
-func NewMatchingServiceProcessor(handler MatchingService) *MatchingServiceProcessor {
-
-  self8 := &MatchingServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self8.processorMap["PollForDecisionTask"] = &matchingServiceProcessorPollForDecisionTask{handler:handler}
-  self8.processorMap["PollForActivityTask"] = &matchingServiceProcessorPollForActivityTask{handler:handler}
-  self8.processorMap["AddDecisionTask"] = &matchingServiceProcessorAddDecisionTask{handler:handler}
-  self8.processorMap["AddActivityTask"] = &matchingServiceProcessorAddActivityTask{handler:handler}
-return self8
+func NewDescribeTaskListRequest() *DescribeTaskListRequest {
+  return &DescribeTaskListRequest{}
 }
 
-func (p *MatchingServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err := iprot.ReadMessageBegin()
-  if err != nil { return false, err }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(seqId, iprot, oprot)
+var DescribeTaskListRequest_DomainUUID_DEFAULT string
+func (p *DescribeTaskListRequest) GetDomainUUID() string {
+  if !p.IsSetDomainUUID() {
+    return DescribeTaskListRequest_DomainUUID_DEFAULT
   }
-  iprot.Skip(thrift.STRUCT)
-  iprot.ReadMessageEnd()
-  x9 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x9.Write(oprot)
-  oprot.WriteMessageEnd()
-  oprot.Flush()
-  return false, x9
-
+return *p.DomainUUID
+}
+var DescribeTaskListRequest_DescRequest_DEFAULT *shared.DescribeTaskListRequest
+func (p *DescribeTaskListRequest) GetDescRequest() *shared.DescribeTaskListRequest {
+  if !p.IsSetDescRequest() {
+    return DescribeTaskListRequest_DescRequest_DEFAULT
+  }
+return p.DescRequest
+}
+func (p *DescribeTaskListRequest) IsSetDomainUUID() bool {
+  return p.DomainUUID != nil
 }
 
-type matchingServiceProcessorPollForDecisionTask struct {
-  handler MatchingService
+func (p *DescribeTaskListRequest) IsSetDescRequest() bool {
+  return p.DescRequest != nil
 }
 
-func (p *matchingServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServicePollForDecisionTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *DescribeTaskListRequest) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := MatchingServicePollForDecisionTaskResult{}
-var retval *PollForDecisionTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 10:
+      if err := p.ReadField10(iprot); err != nil {
+        return err
+      }
+    case 20:
+      if err := p.ReadField20(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  } else {
-    result.Success = retval
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
-  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+
+func (p *DescribeTaskListRequest)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.DomainUUID = &v
+}
+  return nil
+}
+
+func (p *DescribeTaskListRequest)  ReadField20(iprot thrift.TProtocol) error {
+  p.DescRequest = &shared.DescribeTaskListRequest{}
+  if err := p.DescRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.DescRequest), err)
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *DescribeTaskListRequest) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeTaskListRequest"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField20(oprot); err != nil { return err }
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *DescribeTaskListRequest) writeField10(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDomainUUID() {
+    if err := oprot.WriteFieldBegin("domainUUID", thrift.STRING, 10); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:domainUUID: ", p), err) }
+    if err := oprot.WriteString(string(*p.DomainUUID)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.domainUUID (10) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 10:domainUUID: ", p), err) }
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *DescribeTaskListRequest) writeField20(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDescRequest() {
+    if err := oprot.WriteFieldBegin("descRequest", thrift.STRUCT, 20); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 20:descRequest: ", p), err) }
+    if err := p.DescRequest.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.DescRequest), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 20:descRequest: ", p), err) }
+  }
+  return err
+}
+
+func (p *DescribeTaskListRequest) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("DescribeTaskListRequest(%+v)", *p)
+}
+
+type MatchingService interface {  //MatchingService API is exposed to provide support for polling from long running applications.
+  //Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
+  //DecisionTask, application is expected to process the history of events for that session and respond back with next
+  //decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
+  //with completion or failure.
+  //
+
+  // PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
+  // DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
+  // 
+  // 
+  // Parameters:
+  //  - PollRequest
+  PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error)
+  // PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
+  // is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
+  // 
+  // 
+  // Parameters:
+  //  - PollRequest
+  PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error)
+  // AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+  // by the MatchingEngine.
+  // 
+  // 
+  // Parameters:
+  //  - AddRequest
+  AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error)
+  // AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+  // by the MatchingEngine.
+  // 
+  // 
+  // Parameters:
+  //  - AddRequest
+  AddActivityTask(addRequest *AddActivityTaskRequest) (err error)
+  // QueryWorkflow is called by frontend to query a workflow.  The query is dispatched as a QueryTask to a poller on the
+  // decision task list of the workflow, and the call blocks until the worker responds with RespondQueryTaskCompleted.
+  // 
+  // 
+  // Parameters:
+  //  - QueryRequest
+  QueryWorkflow(queryRequest *QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error)
+  // RespondQueryTaskCompleted is called by frontend to respond query completed.
+  // 
+  // 
+  // Parameters:
+  //  - Request
+  RespondQueryTaskCompleted(request *RespondQueryTaskCompletedRequest) (err error)
+  // DescribeTaskList returns the pollers which polled the target task list in the last few minutes, along with its ack
+  // level, read levels and approximate backlog.
+  // 
+  // 
+  // Parameters:
+  //  - Request
+  DescribeTaskList(request *DescribeTaskListRequest) (r *shared.DescribeTaskListResponse, err error)
+}
+
+//MatchingService API is exposed to provide support for polling from long running applications.
+//Such applications are expected to have a worker which regularly polls for DecisionTask and ActivityTask.  For each
+//DecisionTask, application is expected to process the history of events for that session and respond back with next
+//decisions.  For each ActivityTask, application is expected to execute the actual logic for that task and respond back
+//with completion or failure.
+//
+type MatchingServiceClient struct {
+  Transport thrift.TTransport
+  ProtocolFactory thrift.TProtocolFactory
+  InputProtocol thrift.TProtocol
+  OutputProtocol thrift.TProtocol
+  SeqId int32
+}
+
+func NewMatchingServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *MatchingServiceClient {
+  return &MatchingServiceClient{Transport: t,
+    ProtocolFactory: f,
+    InputProtocol: f.GetProtocol(t),
+    OutputProtocol: f.GetProtocol(t),
+    SeqId: 0,
+  }
+}
+
+func NewMatchingServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *MatchingServiceClient {
+  return &MatchingServiceClient{Transport: t,
+    ProtocolFactory: nil,
+    InputProtocol: iprot,
+    OutputProtocol: oprot,
+    SeqId: 0,
+  }
+}
+
+// PollForDecisionTask is called by frontend to process DecisionTask from a specific taskList.  A
+// DecisionTask is dispatched to callers for active workflow executions, with pending decisions.
+// 
+// 
+// Parameters:
+//  - PollRequest
+func (p *MatchingServiceClient) PollForDecisionTask(pollRequest *PollForDecisionTaskRequest) (r *PollForDecisionTaskResponse, err error) {
+  if err = p.sendPollForDecisionTask(pollRequest); err != nil { return }
+  return p.recvPollForDecisionTask()
+}
+
+func (p *MatchingServiceClient) sendPollForDecisionTask(pollRequest *PollForDecisionTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("PollForDecisionTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServicePollForDecisionTaskArgs{
+  PollRequest : pollRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvPollForDecisionTask() (value *PollForDecisionTaskResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
   }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
   if err != nil {
     return
   }
-  return true, err
+  if method != "PollForDecisionTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForDecisionTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForDecisionTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error0 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error1 error
+    error1, err = error0.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error1
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForDecisionTask failed: invalid message type")
+    return
+  }
+  result := MatchingServicePollForDecisionTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// PollForActivityTask is called by frontend to process ActivityTask from a specific taskList.  ActivityTask
+// is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
+// 
+// 
+// Parameters:
+//  - PollRequest
+func (p *MatchingServiceClient) PollForActivityTask(pollRequest *PollForActivityTaskRequest) (r *shared.PollForActivityTaskResponse, err error) {
+  if err = p.sendPollForActivityTask(pollRequest); err != nil { return }
+  return p.recvPollForActivityTask()
+}
+
+func (p *MatchingServiceClient) sendPollForActivityTask(pollRequest *PollForActivityTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("PollForActivityTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServicePollForActivityTaskArgs{
+  PollRequest : pollRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvPollForActivityTask() (value *shared.PollForActivityTaskResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "PollForActivityTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "PollForActivityTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "PollForActivityTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error2 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error3 error
+    error3, err = error2.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error3
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "PollForActivityTask failed: invalid message type")
+    return
+  }
+  result := MatchingServicePollForActivityTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// AddDecisionTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+// by the MatchingEngine.
+// 
+// 
+// Parameters:
+//  - AddRequest
+func (p *MatchingServiceClient) AddDecisionTask(addRequest *AddDecisionTaskRequest) (err error) {
+  if err = p.sendAddDecisionTask(addRequest); err != nil { return }
+  return p.recvAddDecisionTask()
+}
+
+func (p *MatchingServiceClient) sendAddDecisionTask(addRequest *AddDecisionTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("AddDecisionTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceAddDecisionTaskArgs{
+  AddRequest : addRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvAddDecisionTask() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "AddDecisionTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddDecisionTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddDecisionTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error4 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error5 error
+    error5, err = error4.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error5
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddDecisionTask failed: invalid message type")
+    return
+  }
+  result := MatchingServiceAddDecisionTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
+}
+
+// AddActivityTask is called by the history service when a decision task is scheduled, so that it can be dispatched
+// by the MatchingEngine.
+// 
+// 
+// Parameters:
+//  - AddRequest
+func (p *MatchingServiceClient) AddActivityTask(addRequest *AddActivityTaskRequest) (err error) {
+  if err = p.sendAddActivityTask(addRequest); err != nil { return }
+  return p.recvAddActivityTask()
+}
+
+func (p *MatchingServiceClient) sendAddActivityTask(addRequest *AddActivityTaskRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("AddActivityTask", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceAddActivityTaskArgs{
+  AddRequest : addRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvAddActivityTask() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "AddActivityTask" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "AddActivityTask failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "AddActivityTask failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error6 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error7 error
+    error7, err = error6.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error7
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "AddActivityTask failed: invalid message type")
+    return
+  }
+  result := MatchingServiceAddActivityTaskResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.ServiceBusyError != nil {
+    err = result.ServiceBusyError
+    return 
+  }
+  return
+}
+
+// QueryWorkflow is called by frontend to query a workflow.  The query is dispatched as a QueryTask to a poller on the
+// decision task list of the workflow, and the call blocks until the worker responds with RespondQueryTaskCompleted.
+// 
+// 
+// Parameters:
+//  - QueryRequest
+func (p *MatchingServiceClient) QueryWorkflow(queryRequest *QueryWorkflowRequest) (r *shared.QueryWorkflowResponse, err error) {
+  if err = p.sendQueryWorkflow(queryRequest); err != nil { return }
+  return p.recvQueryWorkflow()
+}
+
+func (p *MatchingServiceClient) sendQueryWorkflow(queryRequest *QueryWorkflowRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("QueryWorkflow", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceQueryWorkflowArgs{
+  QueryRequest : queryRequest,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvQueryWorkflow() (value *shared.QueryWorkflowResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "QueryWorkflow" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "QueryWorkflow failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "QueryWorkflow failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error8 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error9 error
+    error9, err = error8.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error9
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "QueryWorkflow failed: invalid message type")
+    return
+  }
+  result := MatchingServiceQueryWorkflowResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  } else   if result.QueryFailedError != nil {
+    err = result.QueryFailedError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+// RespondQueryTaskCompleted is called by frontend to respond query completed.
+// 
+// 
+// Parameters:
+//  - Request
+func (p *MatchingServiceClient) RespondQueryTaskCompleted(request *RespondQueryTaskCompletedRequest) (err error) {
+  if err = p.sendRespondQueryTaskCompleted(request); err != nil { return }
+  return p.recvRespondQueryTaskCompleted()
+}
+
+func (p *MatchingServiceClient) sendRespondQueryTaskCompleted(request *RespondQueryTaskCompletedRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceRespondQueryTaskCompletedArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvRespondQueryTaskCompleted() (err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "RespondQueryTaskCompleted" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "RespondQueryTaskCompleted failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "RespondQueryTaskCompleted failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error10 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error11 error
+    error11, err = error10.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error11
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "RespondQueryTaskCompleted failed: invalid message type")
+    return
+  }
+  result := MatchingServiceRespondQueryTaskCompletedResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  return
+}
+
+// DescribeTaskList returns the pollers which polled the target task list in the last few minutes, along with its ack
+// level, read levels and approximate backlog.
+// 
+// 
+// Parameters:
+//  - Request
+func (p *MatchingServiceClient) DescribeTaskList(request *DescribeTaskListRequest) (r *shared.DescribeTaskListResponse, err error) {
+  if err = p.sendDescribeTaskList(request); err != nil { return }
+  return p.recvDescribeTaskList()
+}
+
+func (p *MatchingServiceClient) sendDescribeTaskList(request *DescribeTaskListRequest)(err error) {
+  oprot := p.OutputProtocol
+  if oprot == nil {
+    oprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.OutputProtocol = oprot
+  }
+  p.SeqId++
+  if err = oprot.WriteMessageBegin("DescribeTaskList", thrift.CALL, p.SeqId); err != nil {
+      return
+  }
+  args := MatchingServiceDescribeTaskListArgs{
+  Request : request,
+  }
+  if err = args.Write(oprot); err != nil {
+      return
+  }
+  if err = oprot.WriteMessageEnd(); err != nil {
+      return
+  }
+  return oprot.Flush()
+}
+
+
+func (p *MatchingServiceClient) recvDescribeTaskList() (value *shared.DescribeTaskListResponse, err error) {
+  iprot := p.InputProtocol
+  if iprot == nil {
+    iprot = p.ProtocolFactory.GetProtocol(p.Transport)
+    p.InputProtocol = iprot
+  }
+  method, mTypeId, seqId, err := iprot.ReadMessageBegin()
+  if err != nil {
+    return
+  }
+  if method != "DescribeTaskList" {
+    err = thrift.NewTApplicationException(thrift.WRONG_METHOD_NAME, "DescribeTaskList failed: wrong method name")
+    return
+  }
+  if p.SeqId != seqId {
+    err = thrift.NewTApplicationException(thrift.BAD_SEQUENCE_ID, "DescribeTaskList failed: out of sequence response")
+    return
+  }
+  if mTypeId == thrift.EXCEPTION {
+    error12 := thrift.NewTApplicationException(thrift.UNKNOWN_APPLICATION_EXCEPTION, "Unknown Exception")
+    var error13 error
+    error13, err = error12.Read(iprot)
+    if err != nil {
+      return
+    }
+    if err = iprot.ReadMessageEnd(); err != nil {
+      return
+    }
+    err = error13
+    return
+  }
+  if mTypeId != thrift.REPLY {
+    err = thrift.NewTApplicationException(thrift.INVALID_MESSAGE_TYPE_EXCEPTION, "DescribeTaskList failed: invalid message type")
+    return
+  }
+  result := MatchingServiceDescribeTaskListResult{}
+  if err = result.Read(iprot); err != nil {
+    return
+  }
+  if err = iprot.ReadMessageEnd(); err != nil {
+    return
+  }
+  if result.BadRequestError != nil {
+    err = result.BadRequestError
+    return 
+  } else   if result.InternalServiceError != nil {
+    err = result.InternalServiceError
+    return 
+  } else   if result.EntityNotExistError != nil {
+    err = result.EntityNotExistError
+    return 
+  }
+  value = result.GetSuccess()
+  return
+}
+
+
+type MatchingServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler MatchingService
+}
+
+func (p *MatchingServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *MatchingServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *MatchingServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewMatchingServiceProcessor(handler MatchingService) *MatchingServiceProcessor {
+
+  self14 := &MatchingServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self14.processorMap["PollForDecisionTask"] = &matchingServiceProcessorPollForDecisionTask{handler:handler}
+  self14.processorMap["PollForActivityTask"] = &matchingServiceProcessorPollForActivityTask{handler:handler}
+  self14.processorMap["AddDecisionTask"] = &matchingServiceProcessorAddDecisionTask{handler:handler}
+  self14.processorMap["AddActivityTask"] = &matchingServiceProcessorAddActivityTask{handler:handler}
+  self14.processorMap["QueryWorkflow"] = &matchingServiceProcessorQueryWorkflow{handler:handler}
+  self14.processorMap["RespondQueryTaskCompleted"] = &matchingServiceProcessorRespondQueryTaskCompleted{handler:handler}
+  self14.processorMap["DescribeTaskList"] = &matchingServiceProcessorDescribeTaskList{handler:handler}
+return self14
+}
+
+func (p *MatchingServiceProcessor) Process(iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err := iprot.ReadMessageBegin()
+  if err != nil { return false, err }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(seqId, iprot, oprot)
+  }
+  iprot.Skip(thrift.STRUCT)
+  iprot.ReadMessageEnd()
+  x15 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+  x15.Write(oprot)
+  oprot.WriteMessageEnd()
+  oprot.Flush()
+  return false, x15
+
+}
+
+type matchingServiceProcessorPollForDecisionTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorPollForDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServicePollForDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServicePollForDecisionTaskResult{}
+var retval *PollForDecisionTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForDecisionTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForDecisionTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorPollForActivityTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServicePollForActivityTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServicePollForActivityTaskResult{}
+var retval *shared.PollForActivityTaskResponse
+  var err2 error
+  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
+    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorAddDecisionTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorAddDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceAddDecisionTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceAddDecisionTaskResult{}
+  var err2 error
+  if err2 = p.handler.AddDecisionTask(args.AddRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddDecisionTask: " + err2.Error())
+    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("AddDecisionTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorAddActivityTask struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorAddActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceAddActivityTaskArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceAddActivityTaskResult{}
+  var err2 error
+  if err2 = p.handler.AddActivityTask(args.AddRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.ServiceBusyError:
+  result.ServiceBusyError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddActivityTask: " + err2.Error())
+    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("AddActivityTask", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorQueryWorkflow struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorQueryWorkflow) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceQueryWorkflowArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceQueryWorkflowResult{}
+var retval *shared.QueryWorkflowResponse
+  var err2 error
+  if retval, err2 = p.handler.QueryWorkflow(args.QueryRequest); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    case *shared.QueryFailedError:
+  result.QueryFailedError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing QueryWorkflow: " + err2.Error())
+    oprot.WriteMessageBegin("QueryWorkflow", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("QueryWorkflow", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorRespondQueryTaskCompleted struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorRespondQueryTaskCompleted) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceRespondQueryTaskCompletedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceRespondQueryTaskCompletedResult{}
+  var err2 error
+  if err2 = p.handler.RespondQueryTaskCompleted(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing RespondQueryTaskCompleted: " + err2.Error())
+    oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  }
+  if err2 = oprot.WriteMessageBegin("RespondQueryTaskCompleted", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type matchingServiceProcessorDescribeTaskList struct {
+  handler MatchingService
+}
+
+func (p *matchingServiceProcessorDescribeTaskList) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := MatchingServiceDescribeTaskListArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DescribeTaskList", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := MatchingServiceDescribeTaskListResult{}
+var retval *shared.DescribeTaskListResponse
+  var err2 error
+  if retval, err2 = p.handler.DescribeTaskList(args.Request); err2 != nil {
+  switch v := err2.(type) {
+    case *shared.BadRequestError:
+  result.BadRequestError = v
+    case *shared.InternalServiceError:
+  result.InternalServiceError = v
+    case *shared.EntityNotExistsError:
+  result.EntityNotExistError = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DescribeTaskList: " + err2.Error())
+    oprot.WriteMessageBegin("DescribeTaskList", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush()
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DescribeTaskList", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - PollRequest
+type MatchingServicePollForDecisionTaskArgs struct {
+  PollRequest *PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewMatchingServicePollForDecisionTaskArgs() *MatchingServicePollForDecisionTaskArgs {
+  return &MatchingServicePollForDecisionTaskArgs{}
+}
+
+var MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT *PollForDecisionTaskRequest
+func (p *MatchingServicePollForDecisionTaskArgs) GetPollRequest() *PollForDecisionTaskRequest {
+  if !p.IsSetPollRequest() {
+    return MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *MatchingServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &PollForDecisionTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForDecisionTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+type MatchingServicePollForDecisionTaskResult struct {
+  Success *PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+}
+
+func NewMatchingServicePollForDecisionTaskResult() *MatchingServicePollForDecisionTaskResult {
+  return &MatchingServicePollForDecisionTaskResult{}
+}
+
+var MatchingServicePollForDecisionTaskResult_Success_DEFAULT *PollForDecisionTaskResponse
+func (p *MatchingServicePollForDecisionTaskResult) GetSuccess() *PollForDecisionTaskResponse {
+  if !p.IsSetSuccess() {
+    return MatchingServicePollForDecisionTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+func (p *MatchingServicePollForDecisionTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &PollForDecisionTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForDecisionTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForDecisionTaskResult(%+v)", *p)
+}
+
+// Attributes:
+//  - PollRequest
+type MatchingServicePollForActivityTaskArgs struct {
+  PollRequest *PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+}
+
+func NewMatchingServicePollForActivityTaskArgs() *MatchingServicePollForActivityTaskArgs {
+  return &MatchingServicePollForActivityTaskArgs{}
+}
+
+var MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT *PollForActivityTaskRequest
+func (p *MatchingServicePollForActivityTaskArgs) GetPollRequest() *PollForActivityTaskRequest {
+  if !p.IsSetPollRequest() {
+    return MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT
+  }
+return p.PollRequest
+}
+func (p *MatchingServicePollForActivityTaskArgs) IsSetPollRequest() bool {
+  return p.PollRequest != nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.PollRequest = &PollForActivityTaskRequest{}
+  if err := p.PollRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
+  if err := p.PollRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+  return err
+}
+
+func (p *MatchingServicePollForActivityTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForActivityTaskArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - BadRequestError
+//  - InternalServiceError
+type MatchingServicePollForActivityTaskResult struct {
+  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+}
+
+func NewMatchingServicePollForActivityTaskResult() *MatchingServicePollForActivityTaskResult {
+  return &MatchingServicePollForActivityTaskResult{}
+}
+
+var MatchingServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
+func (p *MatchingServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+  if !p.IsSetSuccess() {
+    return MatchingServicePollForActivityTaskResult_Success_DEFAULT
+  }
+return p.Success
+}
+var MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT
+  }
+return p.BadRequestError
+}
+var MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+  }
+return p.InternalServiceError
+}
+func (p *MatchingServicePollForActivityTaskResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.PollForActivityTaskResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServicePollForActivityTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServicePollForActivityTaskResult(%+v)", *p)
+}
+
+// Attributes:
+//  - AddRequest
+type MatchingServiceAddDecisionTaskArgs struct {
+  AddRequest *AddDecisionTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+}
+
+func NewMatchingServiceAddDecisionTaskArgs() *MatchingServiceAddDecisionTaskArgs {
+  return &MatchingServiceAddDecisionTaskArgs{}
+}
+
+var MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT *AddDecisionTaskRequest
+func (p *MatchingServiceAddDecisionTaskArgs) GetAddRequest() *AddDecisionTaskRequest {
+  if !p.IsSetAddRequest() {
+    return MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT
+  }
+return p.AddRequest
 }
-
-type matchingServiceProcessorPollForActivityTask struct {
-  handler MatchingService
+func (p *MatchingServiceAddDecisionTaskArgs) IsSetAddRequest() bool {
+  return p.AddRequest != nil
 }
 
-func (p *matchingServiceProcessorPollForActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServicePollForActivityTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *MatchingServiceAddDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := MatchingServicePollForActivityTaskResult{}
-var retval *shared.PollForActivityTaskResponse
-  var err2 error
-  if retval, err2 = p.handler.PollForActivityTask(args.PollRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing PollForActivityTask: " + err2.Error())
-    oprot.WriteMessageBegin("PollForActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("PollForActivityTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.AddRequest = &AddDecisionTaskRequest{}
+  if err := p.AddRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddDecisionTask_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
+  if err := p.AddRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
   }
-  if err != nil {
-    return
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+  return err
+}
+
+func (p *MatchingServiceAddDecisionTaskArgs) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return true, err
+  return fmt.Sprintf("MatchingServiceAddDecisionTaskArgs(%+v)", *p)
 }
 
-type matchingServiceProcessorAddDecisionTask struct {
-  handler MatchingService
+// Attributes:
+//  - BadRequestError
+//  - InternalServiceError
+//  - ServiceBusyError
+type MatchingServiceAddDecisionTaskResult struct {
+  BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
+  InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func (p *matchingServiceProcessorAddDecisionTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServiceAddDecisionTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
-  }
+func NewMatchingServiceAddDecisionTaskResult() *MatchingServiceAddDecisionTaskResult {
+  return &MatchingServiceAddDecisionTaskResult{}
+}
 
-  iprot.ReadMessageEnd()
-  result := MatchingServiceAddDecisionTaskResult{}
-  var err2 error
-  if err2 = p.handler.AddDecisionTask(args.AddRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
-    default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddDecisionTask: " + err2.Error())
-    oprot.WriteMessageBegin("AddDecisionTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
-  }
-  }
-  if err2 = oprot.WriteMessageBegin("AddDecisionTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+var MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceAddDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+  if !p.IsSetBadRequestError() {
+    return MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+return p.BadRequestError
+}
+var MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceAddDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+  if !p.IsSetInternalServiceError() {
+    return MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT
   }
-  if err != nil {
-    return
+return p.InternalServiceError
+}
+var MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *MatchingServiceAddDecisionTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT
   }
-  return true, err
+return p.ServiceBusyError
+}
+func (p *MatchingServiceAddDecisionTaskResult) IsSetBadRequestError() bool {
+  return p.BadRequestError != nil
 }
 
-type matchingServiceProcessorAddActivityTask struct {
-  handler MatchingService
+func (p *MatchingServiceAddDecisionTaskResult) IsSetInternalServiceError() bool {
+  return p.InternalServiceError != nil
 }
 
-func (p *matchingServiceProcessorAddActivityTask) Process(seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := MatchingServiceAddActivityTaskArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return false, err
+func (p *MatchingServiceAddDecisionTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := MatchingServiceAddActivityTaskResult{}
-  var err2 error
-  if err2 = p.handler.AddActivityTask(args.AddRequest); err2 != nil {
-  switch v := err2.(type) {
-    case *shared.BadRequestError:
-  result.BadRequestError = v
-    case *shared.InternalServiceError:
-  result.InternalServiceError = v
-    case *shared.ServiceBusyError:
-  result.ServiceBusyError = v
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if err := p.ReadField1(iprot); err != nil {
+        return err
+      }
+    case 2:
+      if err := p.ReadField2(iprot); err != nil {
+        return err
+      }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
     default:
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing AddActivityTask: " + err2.Error())
-    oprot.WriteMessageBegin("AddActivityTask", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush()
-    return true, err2
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.BadRequestError = &shared.BadRequestError{}
+  if err := p.BadRequestError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
   }
-  if err2 = oprot.WriteMessageBegin("AddActivityTask", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+  p.InternalServiceError = &shared.InternalServiceError{}
+  if err := p.InternalServiceError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddDecisionTask_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
-  if err2 = oprot.Flush(); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *MatchingServiceAddDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetBadRequestError() {
+    if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
+    if err := p.BadRequestError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.BadRequestError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:badRequestError: ", p), err) }
   }
-  if err != nil {
-    return
+  return err
+}
+
+func (p *MatchingServiceAddDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+  if p.IsSetInternalServiceError() {
+    if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
+    if err := p.InternalServiceError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.InternalServiceError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 2:internalServiceError: ", p), err) }
   }
-  return true, err
+  return err
 }
 
+func (p *MatchingServiceAddDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+  }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *MatchingServiceAddDecisionTaskResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("MatchingServiceAddDecisionTaskResult(%+v)", *p)
+}
 
 // Attributes:
-//  - PollRequest
-type MatchingServicePollForDecisionTaskArgs struct {
-  PollRequest *PollForDecisionTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - AddRequest
+type MatchingServiceAddActivityTaskArgs struct {
+  AddRequest *AddActivityTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
 }
 
-func NewMatchingServicePollForDecisionTaskArgs() *MatchingServicePollForDecisionTaskArgs {
-  return &MatchingServicePollForDecisionTaskArgs{}
+func NewMatchingServiceAddActivityTaskArgs() *MatchingServiceAddActivityTaskArgs {
+  return &MatchingServiceAddActivityTaskArgs{}
 }
 
-var MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT *PollForDecisionTaskRequest
-func (p *MatchingServicePollForDecisionTaskArgs) GetPollRequest() *PollForDecisionTaskRequest {
-  if !p.IsSetPollRequest() {
-    return MatchingServicePollForDecisionTaskArgs_PollRequest_DEFAULT
+var MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT *AddActivityTaskRequest
+func (p *MatchingServiceAddActivityTaskArgs) GetAddRequest() *AddActivityTaskRequest {
+  if !p.IsSetAddRequest() {
+    return MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT
   }
-return p.PollRequest
+return p.AddRequest
 }
-func (p *MatchingServicePollForDecisionTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *MatchingServiceAddActivityTaskArgs) IsSetAddRequest() bool {
+  return p.AddRequest != nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1811,16 +3872,16 @@ func (p *MatchingServicePollForDecisionTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &PollForDecisionTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *MatchingServiceAddActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.AddRequest = &AddActivityTaskRequest{}
+  if err := p.AddRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
   }
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_args"); err != nil {
+func (p *MatchingServiceAddActivityTaskArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddActivityTask_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -1832,72 +3893,72 @@ func (p *MatchingServicePollForDecisionTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *MatchingServiceAddActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
+  if err := p.AddRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
   return err
 }
 
-func (p *MatchingServicePollForDecisionTaskArgs) String() string {
+func (p *MatchingServiceAddActivityTaskArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceAddActivityTaskArgs(%+v)", *p)
 }
 
 // Attributes:
-//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type MatchingServicePollForDecisionTaskResult struct {
-  Success *PollForDecisionTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - ServiceBusyError
+type MatchingServiceAddActivityTaskResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
 }
 
-func NewMatchingServicePollForDecisionTaskResult() *MatchingServicePollForDecisionTaskResult {
-  return &MatchingServicePollForDecisionTaskResult{}
+func NewMatchingServiceAddActivityTaskResult() *MatchingServiceAddActivityTaskResult {
+  return &MatchingServiceAddActivityTaskResult{}
 }
 
-var MatchingServicePollForDecisionTaskResult_Success_DEFAULT *PollForDecisionTaskResponse
-func (p *MatchingServicePollForDecisionTaskResult) GetSuccess() *PollForDecisionTaskResponse {
-  if !p.IsSetSuccess() {
-    return MatchingServicePollForDecisionTaskResult_Success_DEFAULT
-  }
-return p.Success
-}
-var MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServicePollForDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceAddActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServicePollForDecisionTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServicePollForDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceAddActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServicePollForDecisionTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *MatchingServicePollForDecisionTaskResult) IsSetSuccess() bool {
-  return p.Success != nil
+var MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
+func (p *MatchingServiceAddActivityTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
+  if !p.IsSetServiceBusyError() {
+    return MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT
+  }
+return p.ServiceBusyError
 }
-
-func (p *MatchingServicePollForDecisionTaskResult) IsSetBadRequestError() bool {
+func (p *MatchingServiceAddActivityTaskResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceAddActivityTaskResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskResult) IsSetServiceBusyError() bool {
+  return p.ServiceBusyError != nil
+}
+
+func (p *MatchingServiceAddActivityTaskResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1910,10 +3971,6 @@ func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
-    case 0:
-      if err := p.ReadField0(iprot); err != nil {
-        return err
-      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -1922,6 +3979,10 @@ func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
       if err := p.ReadField2(iprot); err != nil {
         return err
       }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1937,15 +3998,7 @@ func (p *MatchingServicePollForDecisionTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &PollForDecisionTaskResponse{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
-  }
-  return nil
-}
-
-func (p *MatchingServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -1953,7 +4006,7 @@ func (p *MatchingServicePollForDecisionTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceAddActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -1961,13 +4014,21 @@ func (p *MatchingServicePollForDecisionTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForDecisionTask_result"); err != nil {
+func (p *MatchingServiceAddActivityTaskResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.ServiceBusyError = &shared.ServiceBusyError{}
+  if err := p.ServiceBusyError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServiceAddActivityTaskResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AddActivityTask_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1976,20 +4037,7 @@ func (p *MatchingServicePollForDecisionTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
-    if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
-  }
-  return err
-}
-
-func (p *MatchingServicePollForDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceAddActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2002,7 +4050,7 @@ func (p *MatchingServicePollForDecisionTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceAddActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2015,35 +4063,48 @@ func (p *MatchingServicePollForDecisionTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForDecisionTaskResult) String() string {
+func (p *MatchingServiceAddActivityTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetServiceBusyError() {
+    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
+    if err := p.ServiceBusyError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServiceAddActivityTaskResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceAddActivityTaskResult(%+v)", *p)
 }
 
 // Attributes:
-//  - PollRequest
-type MatchingServicePollForActivityTaskArgs struct {
-  PollRequest *PollForActivityTaskRequest `thrift:"pollRequest,1" db:"pollRequest" json:"pollRequest"`
+//  - QueryRequest
+type MatchingServiceQueryWorkflowArgs struct {
+  QueryRequest *QueryWorkflowRequest `thrift:"queryRequest,1" db:"queryRequest" json:"queryRequest"`
 }
 
-func NewMatchingServicePollForActivityTaskArgs() *MatchingServicePollForActivityTaskArgs {
-  return &MatchingServicePollForActivityTaskArgs{}
+func NewMatchingServiceQueryWorkflowArgs() *MatchingServiceQueryWorkflowArgs {
+  return &MatchingServiceQueryWorkflowArgs{}
 }
 
-var MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT *PollForActivityTaskRequest
-func (p *MatchingServicePollForActivityTaskArgs) GetPollRequest() *PollForActivityTaskRequest {
-  if !p.IsSetPollRequest() {
-    return MatchingServicePollForActivityTaskArgs_PollRequest_DEFAULT
+var MatchingServiceQueryWorkflowArgs_QueryRequest_DEFAULT *QueryWorkflowRequest
+func (p *MatchingServiceQueryWorkflowArgs) GetQueryRequest() *QueryWorkflowRequest {
+  if !p.IsSetQueryRequest() {
+    return MatchingServiceQueryWorkflowArgs_QueryRequest_DEFAULT
   }
-return p.PollRequest
+return p.QueryRequest
 }
-func (p *MatchingServicePollForActivityTaskArgs) IsSetPollRequest() bool {
-  return p.PollRequest != nil
+func (p *MatchingServiceQueryWorkflowArgs) IsSetQueryRequest() bool {
+  return p.QueryRequest != nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2075,16 +4136,16 @@ func (p *MatchingServicePollForActivityTaskArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.PollRequest = &PollForActivityTaskRequest{}
-  if err := p.PollRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.PollRequest), err)
+func (p *MatchingServiceQueryWorkflowArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.QueryRequest = &QueryWorkflowRequest{}
+  if err := p.QueryRequest.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryRequest), err)
   }
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_args"); err != nil {
+func (p *MatchingServiceQueryWorkflowArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2096,72 +4157,98 @@ func (p *MatchingServicePollForActivityTaskArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("pollRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:pollRequest: ", p), err) }
-  if err := p.PollRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.PollRequest), err)
+func (p *MatchingServiceQueryWorkflowArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("queryRequest", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:queryRequest: ", p), err) }
+  if err := p.QueryRequest.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryRequest), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:pollRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:queryRequest: ", p), err) }
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskArgs) String() string {
+func (p *MatchingServiceQueryWorkflowArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForActivityTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceQueryWorkflowArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
 //  - BadRequestError
 //  - InternalServiceError
-type MatchingServicePollForActivityTaskResult struct {
-  Success *shared.PollForActivityTaskResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - EntityNotExistError
+//  - QueryFailedError
+type MatchingServiceQueryWorkflowResult struct {
+  Success *shared.QueryWorkflowResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
+  QueryFailedError *shared.QueryFailedError `thrift:"queryFailedError,4" db:"queryFailedError" json:"queryFailedError,omitempty"`
 }
 
-func NewMatchingServicePollForActivityTaskResult() *MatchingServicePollForActivityTaskResult {
-  return &MatchingServicePollForActivityTaskResult{}
+func NewMatchingServiceQueryWorkflowResult() *MatchingServiceQueryWorkflowResult {
+  return &MatchingServiceQueryWorkflowResult{}
 }
 
-var MatchingServicePollForActivityTaskResult_Success_DEFAULT *shared.PollForActivityTaskResponse
-func (p *MatchingServicePollForActivityTaskResult) GetSuccess() *shared.PollForActivityTaskResponse {
+var MatchingServiceQueryWorkflowResult_Success_DEFAULT *shared.QueryWorkflowResponse
+func (p *MatchingServiceQueryWorkflowResult) GetSuccess() *shared.QueryWorkflowResponse {
   if !p.IsSetSuccess() {
-    return MatchingServicePollForActivityTaskResult_Success_DEFAULT
+    return MatchingServiceQueryWorkflowResult_Success_DEFAULT
   }
 return p.Success
 }
-var MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServicePollForActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceQueryWorkflowResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceQueryWorkflowResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServicePollForActivityTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceQueryWorkflowResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServicePollForActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceQueryWorkflowResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceQueryWorkflowResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServicePollForActivityTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceQueryWorkflowResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-func (p *MatchingServicePollForActivityTaskResult) IsSetSuccess() bool {
+var MatchingServiceQueryWorkflowResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *MatchingServiceQueryWorkflowResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return MatchingServiceQueryWorkflowResult_EntityNotExistError_DEFAULT
+  }
+return p.EntityNotExistError
+}
+var MatchingServiceQueryWorkflowResult_QueryFailedError_DEFAULT *shared.QueryFailedError
+func (p *MatchingServiceQueryWorkflowResult) GetQueryFailedError() *shared.QueryFailedError {
+  if !p.IsSetQueryFailedError() {
+    return MatchingServiceQueryWorkflowResult_QueryFailedError_DEFAULT
+  }
+return p.QueryFailedError
+}
+func (p *MatchingServiceQueryWorkflowResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) IsSetBadRequestError() bool {
+func (p *MatchingServiceQueryWorkflowResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceQueryWorkflowResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult) IsSetQueryFailedError() bool {
+  return p.QueryFailedError != nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2186,6 +4273,14 @@ func (p *MatchingServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
       if err := p.ReadField2(iprot); err != nil {
         return err
       }
+    case 3:
+      if err := p.ReadField3(iprot); err != nil {
+        return err
+      }
+    case 4:
+      if err := p.ReadField4(iprot); err != nil {
+        return err
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2201,15 +4296,15 @@ func (p *MatchingServicePollForActivityTaskResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &shared.PollForActivityTaskResponse{}
+func (p *MatchingServiceQueryWorkflowResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.QueryWorkflowResponse{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -2217,7 +4312,7 @@ func (p *MatchingServicePollForActivityTaskResult)  ReadField1(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceQueryWorkflowResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -2225,13 +4320,31 @@ func (p *MatchingServicePollForActivityTaskResult)  ReadField2(iprot thrift.TPro
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PollForActivityTask_result"); err != nil {
+func (p *MatchingServiceQueryWorkflowResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult)  ReadField4(iprot thrift.TProtocol) error {
+  p.QueryFailedError = &shared.QueryFailedError{}
+  if err := p.QueryFailedError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.QueryFailedError), err)
+  }
+  return nil
+}
+
+func (p *MatchingServiceQueryWorkflowResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("QueryWorkflow_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2240,7 +4353,7 @@ func (p *MatchingServicePollForActivityTaskResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *MatchingServicePollForActivityTaskResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceQueryWorkflowResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -2253,7 +4366,7 @@ func (p *MatchingServicePollForActivityTaskResult) writeField0(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceQueryWorkflowResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2266,7 +4379,7 @@ func (p *MatchingServicePollForActivityTaskResult) writeField1(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceQueryWorkflowResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2279,35 +4392,61 @@ func (p *MatchingServicePollForActivityTaskResult) writeField2(oprot thrift.TPro
   return err
 }
 
-func (p *MatchingServicePollForActivityTaskResult) String() string {
+func (p *MatchingServiceQueryWorkflowResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServiceQueryWorkflowResult) writeField4(oprot thrift.TProtocol) (err error) {
+  if p.IsSetQueryFailedError() {
+    if err := oprot.WriteFieldBegin("queryFailedError", thrift.STRUCT, 4); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:queryFailedError: ", p), err) }
+    if err := p.QueryFailedError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.QueryFailedError), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 4:queryFailedError: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServiceQueryWorkflowResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServicePollForActivityTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceQueryWorkflowResult(%+v)", *p)
 }
 
 // Attributes:
-//  - AddRequest
-type MatchingServiceAddDecisionTaskArgs struct {
-  AddRequest *AddDecisionTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+//  - Request
+type MatchingServiceRespondQueryTaskCompletedArgs struct {
+  Request *RespondQueryTaskCompletedRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewMatchingServiceAddDecisionTaskArgs() *MatchingServiceAddDecisionTaskArgs {
-  return &MatchingServiceAddDecisionTaskArgs{}
+func NewMatchingServiceRespondQueryTaskCompletedArgs() *MatchingServiceRespondQueryTaskCompletedArgs {
+  return &MatchingServiceRespondQueryTaskCompletedArgs{}
 }
 
-var MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT *AddDecisionTaskRequest
-func (p *MatchingServiceAddDecisionTaskArgs) GetAddRequest() *AddDecisionTaskRequest {
-  if !p.IsSetAddRequest() {
-    return MatchingServiceAddDecisionTaskArgs_AddRequest_DEFAULT
+var MatchingServiceRespondQueryTaskCompletedArgs_Request_DEFAULT *RespondQueryTaskCompletedRequest
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) GetRequest() *RespondQueryTaskCompletedRequest {
+  if !p.IsSetRequest() {
+    return MatchingServiceRespondQueryTaskCompletedArgs_Request_DEFAULT
   }
-return p.AddRequest
+return p.Request
 }
-func (p *MatchingServiceAddDecisionTaskArgs) IsSetAddRequest() bool {
-  return p.AddRequest != nil
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2339,16 +4478,16 @@ func (p *MatchingServiceAddDecisionTaskArgs) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.AddRequest = &AddDecisionTaskRequest{}
-  if err := p.AddRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+func (p *MatchingServiceRespondQueryTaskCompletedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &RespondQueryTaskCompletedRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddDecisionTask_args"); err != nil {
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2360,72 +4499,72 @@ func (p *MatchingServiceAddDecisionTaskArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
-  if err := p.AddRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskArgs) String() string {
+func (p *MatchingServiceRespondQueryTaskCompletedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddDecisionTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceRespondQueryTaskCompletedArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - BadRequestError
 //  - InternalServiceError
-//  - ServiceBusyError
-type MatchingServiceAddDecisionTaskResult struct {
+//  - EntityNotExistError
+type MatchingServiceRespondQueryTaskCompletedResult struct {
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewMatchingServiceAddDecisionTaskResult() *MatchingServiceAddDecisionTaskResult {
-  return &MatchingServiceAddDecisionTaskResult{}
+func NewMatchingServiceRespondQueryTaskCompletedResult() *MatchingServiceRespondQueryTaskCompletedResult {
+  return &MatchingServiceRespondQueryTaskCompletedResult{}
 }
 
-var MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServiceAddDecisionTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceRespondQueryTaskCompletedResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServiceAddDecisionTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceRespondQueryTaskCompletedResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServiceAddDecisionTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceRespondQueryTaskCompletedResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServiceAddDecisionTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceRespondQueryTaskCompletedResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *MatchingServiceAddDecisionTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return MatchingServiceAddDecisionTaskResult_ServiceBusyError_DEFAULT
+var MatchingServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *MatchingServiceRespondQueryTaskCompletedResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return MatchingServiceRespondQueryTaskCompletedResult_EntityNotExistError_DEFAULT
   }
-return p.ServiceBusyError
+return p.EntityNotExistError
 }
-func (p *MatchingServiceAddDecisionTaskResult) IsSetBadRequestError() bool {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
+func (p *MatchingServiceRespondQueryTaskCompletedResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2465,7 +4604,7 @@ func (p *MatchingServiceAddDecisionTaskResult) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -2473,7 +4612,7 @@ func (p *MatchingServiceAddDecisionTaskResult)  ReadField1(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceRespondQueryTaskCompletedResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -2481,16 +4620,16 @@ func (p *MatchingServiceAddDecisionTaskResult)  ReadField2(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceRespondQueryTaskCompletedResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddDecisionTask_result"); err != nil {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("RespondQueryTaskCompleted_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2504,7 +4643,7 @@ func (p *MatchingServiceAddDecisionTaskResult) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2517,7 +4656,7 @@ func (p *MatchingServiceAddDecisionTaskResult) writeField1(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2530,48 +4669,48 @@ func (p *MatchingServiceAddDecisionTaskResult) writeField2(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceRespondQueryTaskCompletedResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *MatchingServiceAddDecisionTaskResult) String() string {
+func (p *MatchingServiceRespondQueryTaskCompletedResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddDecisionTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceRespondQueryTaskCompletedResult(%+v)", *p)
 }
 
 // Attributes:
-//  - AddRequest
-type MatchingServiceAddActivityTaskArgs struct {
-  AddRequest *AddActivityTaskRequest `thrift:"addRequest,1" db:"addRequest" json:"addRequest"`
+//  - Request
+type MatchingServiceDescribeTaskListArgs struct {
+  Request *DescribeTaskListRequest `thrift:"request,1" db:"request" json:"request"`
 }
 
-func NewMatchingServiceAddActivityTaskArgs() *MatchingServiceAddActivityTaskArgs {
-  return &MatchingServiceAddActivityTaskArgs{}
+func NewMatchingServiceDescribeTaskListArgs() *MatchingServiceDescribeTaskListArgs {
+  return &MatchingServiceDescribeTaskListArgs{}
 }
 
-var MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT *AddActivityTaskRequest
-func (p *MatchingServiceAddActivityTaskArgs) GetAddRequest() *AddActivityTaskRequest {
-  if !p.IsSetAddRequest() {
-    return MatchingServiceAddActivityTaskArgs_AddRequest_DEFAULT
+var MatchingServiceDescribeTaskListArgs_Request_DEFAULT *DescribeTaskListRequest
+func (p *MatchingServiceDescribeTaskListArgs) GetRequest() *DescribeTaskListRequest {
+  if !p.IsSetRequest() {
+    return MatchingServiceDescribeTaskListArgs_Request_DEFAULT
   }
-return p.AddRequest
+return p.Request
 }
-func (p *MatchingServiceAddActivityTaskArgs) IsSetAddRequest() bool {
-  return p.AddRequest != nil
+func (p *MatchingServiceDescribeTaskListArgs) IsSetRequest() bool {
+  return p.Request != nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDescribeTaskListArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2603,16 +4742,16 @@ func (p *MatchingServiceAddActivityTaskArgs) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.AddRequest = &AddActivityTaskRequest{}
-  if err := p.AddRequest.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.AddRequest), err)
+func (p *MatchingServiceDescribeTaskListArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Request = &DescribeTaskListRequest{}
+  if err := p.Request.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Request), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddActivityTask_args"); err != nil {
+func (p *MatchingServiceDescribeTaskListArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeTaskList_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -2624,72 +4763,85 @@ func (p *MatchingServiceAddActivityTaskArgs) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("addRequest", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addRequest: ", p), err) }
-  if err := p.AddRequest.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.AddRequest), err)
+func (p *MatchingServiceDescribeTaskListArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("request", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:request: ", p), err) }
+  if err := p.Request.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Request), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addRequest: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:request: ", p), err) }
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskArgs) String() string {
+func (p *MatchingServiceDescribeTaskListArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddActivityTaskArgs(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceDescribeTaskListArgs(%+v)", *p)
 }
 
 // Attributes:
+//  - Success
 //  - BadRequestError
 //  - InternalServiceError
-//  - ServiceBusyError
-type MatchingServiceAddActivityTaskResult struct {
+//  - EntityNotExistError
+type MatchingServiceDescribeTaskListResult struct {
+  Success *shared.DescribeTaskListResponse `thrift:"success,0" db:"success" json:"success,omitempty"`
   BadRequestError *shared.BadRequestError `thrift:"badRequestError,1" db:"badRequestError" json:"badRequestError,omitempty"`
   InternalServiceError *shared.InternalServiceError `thrift:"internalServiceError,2" db:"internalServiceError" json:"internalServiceError,omitempty"`
-  ServiceBusyError *shared.ServiceBusyError `thrift:"serviceBusyError,3" db:"serviceBusyError" json:"serviceBusyError,omitempty"`
+  EntityNotExistError *shared.EntityNotExistsError `thrift:"entityNotExistError,3" db:"entityNotExistError" json:"entityNotExistError,omitempty"`
 }
 
-func NewMatchingServiceAddActivityTaskResult() *MatchingServiceAddActivityTaskResult {
-  return &MatchingServiceAddActivityTaskResult{}
+func NewMatchingServiceDescribeTaskListResult() *MatchingServiceDescribeTaskListResult {
+  return &MatchingServiceDescribeTaskListResult{}
 }
 
-var MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT *shared.BadRequestError
-func (p *MatchingServiceAddActivityTaskResult) GetBadRequestError() *shared.BadRequestError {
+var MatchingServiceDescribeTaskListResult_Success_DEFAULT *shared.DescribeTaskListResponse
+func (p *MatchingServiceDescribeTaskListResult) GetSuccess() *shared.DescribeTaskListResponse {
+  if !p.IsSetSuccess() {
+    return MatchingServiceDescribeTaskListResult_Success_DEFAULT
+  }
+return p.Success
+}
+var MatchingServiceDescribeTaskListResult_BadRequestError_DEFAULT *shared.BadRequestError
+func (p *MatchingServiceDescribeTaskListResult) GetBadRequestError() *shared.BadRequestError {
   if !p.IsSetBadRequestError() {
-    return MatchingServiceAddActivityTaskResult_BadRequestError_DEFAULT
+    return MatchingServiceDescribeTaskListResult_BadRequestError_DEFAULT
   }
 return p.BadRequestError
 }
-var MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT *shared.InternalServiceError
-func (p *MatchingServiceAddActivityTaskResult) GetInternalServiceError() *shared.InternalServiceError {
+var MatchingServiceDescribeTaskListResult_InternalServiceError_DEFAULT *shared.InternalServiceError
+func (p *MatchingServiceDescribeTaskListResult) GetInternalServiceError() *shared.InternalServiceError {
   if !p.IsSetInternalServiceError() {
-    return MatchingServiceAddActivityTaskResult_InternalServiceError_DEFAULT
+    return MatchingServiceDescribeTaskListResult_InternalServiceError_DEFAULT
   }
 return p.InternalServiceError
 }
-var MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT *shared.ServiceBusyError
-func (p *MatchingServiceAddActivityTaskResult) GetServiceBusyError() *shared.ServiceBusyError {
-  if !p.IsSetServiceBusyError() {
-    return MatchingServiceAddActivityTaskResult_ServiceBusyError_DEFAULT
+var MatchingServiceDescribeTaskListResult_EntityNotExistError_DEFAULT *shared.EntityNotExistsError
+func (p *MatchingServiceDescribeTaskListResult) GetEntityNotExistError() *shared.EntityNotExistsError {
+  if !p.IsSetEntityNotExistError() {
+    return MatchingServiceDescribeTaskListResult_EntityNotExistError_DEFAULT
   }
-return p.ServiceBusyError
+return p.EntityNotExistError
 }
-func (p *MatchingServiceAddActivityTaskResult) IsSetBadRequestError() bool {
+func (p *MatchingServiceDescribeTaskListResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *MatchingServiceDescribeTaskListResult) IsSetBadRequestError() bool {
   return p.BadRequestError != nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) IsSetInternalServiceError() bool {
+func (p *MatchingServiceDescribeTaskListResult) IsSetInternalServiceError() bool {
   return p.InternalServiceError != nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) IsSetServiceBusyError() bool {
-  return p.ServiceBusyError != nil
+func (p *MatchingServiceDescribeTaskListResult) IsSetEntityNotExistError() bool {
+  return p.EntityNotExistError != nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) Read(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDescribeTaskListResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2702,6 +4854,10 @@ func (p *MatchingServiceAddActivityTaskResult) Read(iprot thrift.TProtocol) erro
     }
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
+    case 0:
+      if err := p.ReadField0(iprot); err != nil {
+        return err
+      }
     case 1:
       if err := p.ReadField1(iprot); err != nil {
         return err
@@ -2729,7 +4885,15 @@ func (p *MatchingServiceAddActivityTaskResult) Read(iprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult)  ReadField1(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDescribeTaskListResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &shared.DescribeTaskListResponse{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *MatchingServiceDescribeTaskListResult)  ReadField1(iprot thrift.TProtocol) error {
   p.BadRequestError = &shared.BadRequestError{}
   if err := p.BadRequestError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.BadRequestError), err)
@@ -2737,7 +4901,7 @@ func (p *MatchingServiceAddActivityTaskResult)  ReadField1(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult)  ReadField2(iprot thrift.TProtocol) error {
+func (p *MatchingServiceDescribeTaskListResult)  ReadField2(iprot thrift.TProtocol) error {
   p.InternalServiceError = &shared.InternalServiceError{}
   if err := p.InternalServiceError.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.InternalServiceError), err)
@@ -2745,18 +4909,19 @@ func (p *MatchingServiceAddActivityTaskResult)  ReadField2(iprot thrift.TProtoco
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult)  ReadField3(iprot thrift.TProtocol) error {
-  p.ServiceBusyError = &shared.ServiceBusyError{}
-  if err := p.ServiceBusyError.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceDescribeTaskListResult)  ReadField3(iprot thrift.TProtocol) error {
+  p.EntityNotExistError = &shared.EntityNotExistsError{}
+  if err := p.EntityNotExistError.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.EntityNotExistError), err)
   }
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AddActivityTask_result"); err != nil {
+func (p *MatchingServiceDescribeTaskListResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DescribeTaskList_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
@@ -2768,7 +4933,20 @@ func (p *MatchingServiceAddActivityTaskResult) Write(oprot thrift.TProtocol) err
   return nil
 }
 
-func (p *MatchingServiceAddActivityTaskResult) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceDescribeTaskListResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *MatchingServiceDescribeTaskListResult) writeField1(oprot thrift.TProtocol) (err error) {
   if p.IsSetBadRequestError() {
     if err := oprot.WriteFieldBegin("badRequestError", thrift.STRUCT, 1); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:badRequestError: ", p), err) }
@@ -2781,7 +4959,7 @@ func (p *MatchingServiceAddActivityTaskResult) writeField1(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskResult) writeField2(oprot thrift.TProtocol) (err error) {
+func (p *MatchingServiceDescribeTaskListResult) writeField2(oprot thrift.TProtocol) (err error) {
   if p.IsSetInternalServiceError() {
     if err := oprot.WriteFieldBegin("internalServiceError", thrift.STRUCT, 2); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:internalServiceError: ", p), err) }
@@ -2794,24 +4972,24 @@ func (p *MatchingServiceAddActivityTaskResult) writeField2(oprot thrift.TProtoco
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskResult) writeField3(oprot thrift.TProtocol) (err error) {
-  if p.IsSetServiceBusyError() {
-    if err := oprot.WriteFieldBegin("serviceBusyError", thrift.STRUCT, 3); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:serviceBusyError: ", p), err) }
-    if err := p.ServiceBusyError.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.ServiceBusyError), err)
+func (p *MatchingServiceDescribeTaskListResult) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetEntityNotExistError() {
+    if err := oprot.WriteFieldBegin("entityNotExistError", thrift.STRUCT, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:entityNotExistError: ", p), err) }
+    if err := p.EntityNotExistError.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.EntityNotExistError), err)
     }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:serviceBusyError: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:entityNotExistError: ", p), err) }
   }
   return err
 }
 
-func (p *MatchingServiceAddActivityTaskResult) String() string {
+func (p *MatchingServiceDescribeTaskListResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("MatchingServiceAddActivityTaskResult(%+v)", *p)
+  return fmt.Sprintf("MatchingServiceDescribeTaskListResult(%+v)", *p)
 }
 
 
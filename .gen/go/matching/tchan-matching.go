@@ -40,8 +40,11 @@ var _ = shared.GoUnusedProtection__
 type TChanMatchingService interface {
 	AddActivityTask(ctx thrift.Context, addRequest *AddActivityTaskRequest) error
 	AddDecisionTask(ctx thrift.Context, addRequest *AddDecisionTaskRequest) error
+	DescribeTaskList(ctx thrift.Context, request *DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error)
 	PollForActivityTask(ctx thrift.Context, pollRequest *PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error)
 	PollForDecisionTask(ctx thrift.Context, pollRequest *PollForDecisionTaskRequest) (*PollForDecisionTaskResponse, error)
+	QueryWorkflow(ctx thrift.Context, queryRequest *QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error)
+	RespondQueryTaskCompleted(ctx thrift.Context, request *RespondQueryTaskCompletedRequest) error
 }
 
 // Implementation of a client and service handler.
@@ -107,6 +110,28 @@ func (c *tchanMatchingServiceClient) AddDecisionTask(ctx thrift.Context, addRequ
 	return err
 }
 
+func (c *tchanMatchingServiceClient) DescribeTaskList(ctx thrift.Context, request *DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error) {
+	var resp MatchingServiceDescribeTaskListResult
+	args := MatchingServiceDescribeTaskListArgs{
+		Request: request,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "DescribeTaskList", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for DescribeTaskList")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
 func (c *tchanMatchingServiceClient) PollForActivityTask(ctx thrift.Context, pollRequest *PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error) {
 	var resp MatchingServicePollForActivityTaskResult
 	args := MatchingServicePollForActivityTaskArgs{
@@ -147,6 +172,52 @@ func (c *tchanMatchingServiceClient) PollForDecisionTask(ctx thrift.Context, pol
 	return resp.GetSuccess(), err
 }
 
+func (c *tchanMatchingServiceClient) QueryWorkflow(ctx thrift.Context, queryRequest *QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error) {
+	var resp MatchingServiceQueryWorkflowResult
+	args := MatchingServiceQueryWorkflowArgs{
+		QueryRequest: queryRequest,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "QueryWorkflow", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		case resp.QueryFailedError != nil:
+			err = resp.QueryFailedError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for QueryWorkflow")
+		}
+	}
+
+	return resp.GetSuccess(), err
+}
+
+func (c *tchanMatchingServiceClient) RespondQueryTaskCompleted(ctx thrift.Context, request *RespondQueryTaskCompletedRequest) error {
+	var resp MatchingServiceRespondQueryTaskCompletedResult
+	args := MatchingServiceRespondQueryTaskCompletedArgs{
+		Request: request,
+	}
+	success, err := c.client.Call(ctx, c.thriftService, "RespondQueryTaskCompleted", &args, &resp)
+	if err == nil && !success {
+		switch {
+		case resp.BadRequestError != nil:
+			err = resp.BadRequestError
+		case resp.InternalServiceError != nil:
+			err = resp.InternalServiceError
+		case resp.EntityNotExistError != nil:
+			err = resp.EntityNotExistError
+		default:
+			err = fmt.Errorf("received no result or unknown exception for RespondQueryTaskCompleted")
+		}
+	}
+
+	return err
+}
+
 type tchanMatchingServiceServer struct {
 	handler TChanMatchingService
 }
@@ -167,8 +238,11 @@ func (s *tchanMatchingServiceServer) Methods() []string {
 	return []string{
 		"AddActivityTask",
 		"AddDecisionTask",
+		"DescribeTaskList",
 		"PollForActivityTask",
 		"PollForDecisionTask",
+		"QueryWorkflow",
+		"RespondQueryTaskCompleted",
 	}
 }
 
@@ -178,10 +252,16 @@ func (s *tchanMatchingServiceServer) Handle(ctx thrift.Context, methodName strin
 		return s.handleAddActivityTask(ctx, protocol)
 	case "AddDecisionTask":
 		return s.handleAddDecisionTask(ctx, protocol)
+	case "DescribeTaskList":
+		return s.handleDescribeTaskList(ctx, protocol)
 	case "PollForActivityTask":
 		return s.handlePollForActivityTask(ctx, protocol)
 	case "PollForDecisionTask":
 		return s.handlePollForDecisionTask(ctx, protocol)
+	case "QueryWorkflow":
+		return s.handleQueryWorkflow(ctx, protocol)
+	case "RespondQueryTaskCompleted":
+		return s.handleRespondQueryTaskCompleted(ctx, protocol)
 
 	default:
 		return false, nil, fmt.Errorf("method %v not found in service %v", methodName, s.Service())
@@ -262,6 +342,44 @@ func (s *tchanMatchingServiceServer) handleAddDecisionTask(ctx thrift.Context, p
 	return err == nil, &res, nil
 }
 
+func (s *tchanMatchingServiceServer) handleDescribeTaskList(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req MatchingServiceDescribeTaskListArgs
+	var res MatchingServiceDescribeTaskListResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.DescribeTaskList(ctx, req.Request)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
 func (s *tchanMatchingServiceServer) handlePollForActivityTask(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
 	var req MatchingServicePollForActivityTaskArgs
 	var res MatchingServicePollForActivityTaskResult
@@ -327,3 +445,83 @@ func (s *tchanMatchingServiceServer) handlePollForDecisionTask(ctx thrift.Contex
 
 	return err == nil, &res, nil
 }
+
+func (s *tchanMatchingServiceServer) handleQueryWorkflow(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req MatchingServiceQueryWorkflowArgs
+	var res MatchingServiceQueryWorkflowResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	r, err :=
+		s.handler.QueryWorkflow(ctx, req.QueryRequest)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		case *shared.QueryFailedError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for queryFailedError returned non-nil error type *shared.QueryFailedError but nil value")
+			}
+			res.QueryFailedError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+		res.Success = r
+	}
+
+	return err == nil, &res, nil
+}
+
+func (s *tchanMatchingServiceServer) handleRespondQueryTaskCompleted(ctx thrift.Context, protocol athrift.TProtocol) (bool, athrift.TStruct, error) {
+	var req MatchingServiceRespondQueryTaskCompletedArgs
+	var res MatchingServiceRespondQueryTaskCompletedResult
+
+	if err := req.Read(protocol); err != nil {
+		return false, nil, err
+	}
+
+	err :=
+		s.handler.RespondQueryTaskCompleted(ctx, req.Request)
+
+	if err != nil {
+		switch v := err.(type) {
+		case *shared.BadRequestError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for badRequestError returned non-nil error type *shared.BadRequestError but nil value")
+			}
+			res.BadRequestError = v
+		case *shared.InternalServiceError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for internalServiceError returned non-nil error type *shared.InternalServiceError but nil value")
+			}
+			res.InternalServiceError = v
+		case *shared.EntityNotExistsError:
+			if v == nil {
+				return false, nil, fmt.Errorf("Handler for entityNotExistError returned non-nil error type *shared.EntityNotExistsError but nil value")
+			}
+			res.EntityNotExistError = v
+		default:
+			return false, nil, err
+		}
+	} else {
+	}
+
+	return err == nil, &res, nil
+}
@@ -118,30 +118,60 @@ func (c *clientImpl) RecordActivityTaskHeartbeat(heartbeatRequest *workflow.Reco
 	return c.client.RecordActivityTaskHeartbeat(ctx, heartbeatRequest)
 }
 
+func (c *clientImpl) RecordActivityTaskHeartbeatByID(request *workflow.RecordActivityTaskHeartbeatByIDRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.RecordActivityTaskHeartbeatByID(ctx, request)
+}
+
 func (c *clientImpl) RespondDecisionTaskCompleted(request *workflow.RespondDecisionTaskCompletedRequest) error {
 	ctx, cancel := c.createContext()
 	defer cancel()
 	return c.client.RespondDecisionTaskCompleted(ctx, request)
 }
 
+func (c *clientImpl) RespondDecisionTaskFailed(request *workflow.RespondDecisionTaskFailedRequest) error {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.RespondDecisionTaskFailed(ctx, request)
+}
+
 func (c *clientImpl) RespondActivityTaskCompleted(request *workflow.RespondActivityTaskCompletedRequest) error {
 	ctx, cancel := c.createContext()
 	defer cancel()
 	return c.client.RespondActivityTaskCompleted(ctx, request)
 }
 
+func (c *clientImpl) RespondActivityTaskCompletedByID(request *workflow.RespondActivityTaskCompletedByIDRequest) error {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.RespondActivityTaskCompletedByID(ctx, request)
+}
+
 func (c *clientImpl) RespondActivityTaskFailed(request *workflow.RespondActivityTaskFailedRequest) error {
 	ctx, cancel := c.createContext()
 	defer cancel()
 	return c.client.RespondActivityTaskFailed(ctx, request)
 }
 
+func (c *clientImpl) RespondActivityTaskFailedByID(request *workflow.RespondActivityTaskFailedByIDRequest) error {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.RespondActivityTaskFailedByID(ctx, request)
+}
+
 func (c *clientImpl) RespondActivityTaskCanceled(request *workflow.RespondActivityTaskCanceledRequest) error {
 	ctx, cancel := c.createContext()
 	defer cancel()
 	return c.client.RespondActivityTaskCanceled(ctx, request)
 }
 
+func (c *clientImpl) RespondActivityTaskCanceledByID(request *workflow.RespondActivityTaskCanceledByIDRequest) error {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.RespondActivityTaskCanceledByID(ctx, request)
+}
+
 func (c *clientImpl) RequestCancelWorkflowExecution(cancelRequest *workflow.RequestCancelWorkflowExecutionRequest) error {
 	ctx, cancel := c.createContext()
 	defer cancel()
@@ -154,6 +184,12 @@ func (c *clientImpl) SignalWorkflowExecution(request *workflow.SignalWorkflowExe
 	return c.client.SignalWorkflowExecution(ctx, request)
 }
 
+func (c *clientImpl) SignalWithStartWorkflowExecution(request *workflow.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.SignalWithStartWorkflowExecution(ctx, request)
+}
+
 func (c *clientImpl) TerminateWorkflowExecution(request *workflow.TerminateWorkflowExecutionRequest) error {
 	ctx, cancel := c.createContext()
 	defer cancel()
@@ -173,3 +209,50 @@ func (c *clientImpl) ListClosedWorkflowExecutions(
 	defer cancel()
 	return c.client.ListClosedWorkflowExecutions(ctx, listRequest)
 }
+
+func (c *clientImpl) ListWorkflowExecutions(
+	listRequest *workflow.ListWorkflowExecutionsRequest) (*workflow.ListWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.ListWorkflowExecutions(ctx, listRequest)
+}
+
+func (c *clientImpl) CountWorkflowExecutions(
+	countRequest *workflow.CountWorkflowExecutionsRequest) (*workflow.CountWorkflowExecutionsResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.CountWorkflowExecutions(ctx, countRequest)
+}
+
+func (c *clientImpl) QueryWorkflow(queryRequest *workflow.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.QueryWorkflow(ctx, queryRequest)
+}
+
+func (c *clientImpl) RespondQueryTaskCompleted(request *workflow.RespondQueryTaskCompletedRequest) error {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.RespondQueryTaskCompleted(ctx, request)
+}
+
+func (c *clientImpl) DescribeWorkflowExecution(
+	request *workflow.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.DescribeWorkflowExecution(ctx, request)
+}
+
+func (c *clientImpl) DescribeTaskList(
+	request *workflow.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.DescribeTaskList(ctx, request)
+}
+
+func (c *clientImpl) ResetWorkflowExecution(
+	request *workflow.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	ctx, cancel := c.createContext()
+	defer cancel()
+	return c.client.ResetWorkflowExecution(ctx, request)
+}
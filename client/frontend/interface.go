@@ -34,14 +34,27 @@ type Client interface {
 	PollForActivityTask(pollRequest *shared.PollForActivityTaskRequest) (*shared.PollForActivityTaskResponse, error)
 	PollForDecisionTask(pollRequest *shared.PollForDecisionTaskRequest) (*shared.PollForDecisionTaskResponse, error)
 	RecordActivityTaskHeartbeat(heartbeatRequest *shared.RecordActivityTaskHeartbeatRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
+	RecordActivityTaskHeartbeatByID(heartbeatRequest *shared.RecordActivityTaskHeartbeatByIDRequest) (*shared.RecordActivityTaskHeartbeatResponse, error)
 	RespondActivityTaskCompleted(completeRequest *shared.RespondActivityTaskCompletedRequest) error
+	RespondActivityTaskCompletedByID(completeRequest *shared.RespondActivityTaskCompletedByIDRequest) error
 	RespondActivityTaskFailed(failRequest *shared.RespondActivityTaskFailedRequest) error
+	RespondActivityTaskFailedByID(failRequest *shared.RespondActivityTaskFailedByIDRequest) error
 	RespondActivityTaskCanceled(cancelRequest *shared.RespondActivityTaskCanceledRequest) error
+	RespondActivityTaskCanceledByID(cancelRequest *shared.RespondActivityTaskCanceledByIDRequest) error
 	RespondDecisionTaskCompleted(completeRequest *shared.RespondDecisionTaskCompletedRequest) error
+	RespondDecisionTaskFailed(failedRequest *shared.RespondDecisionTaskFailedRequest) error
 	StartWorkflowExecution(startRequest *shared.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	RequestCancelWorkflowExecution(cancelRequest *shared.RequestCancelWorkflowExecutionRequest) error
 	SignalWorkflowExecution(request *shared.SignalWorkflowExecutionRequest) error
+	SignalWithStartWorkflowExecution(request *shared.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error)
 	TerminateWorkflowExecution(terminateRequest *shared.TerminateWorkflowExecutionRequest) error
 	ListOpenWorkflowExecutions(listRequest *shared.ListOpenWorkflowExecutionsRequest) (*shared.ListOpenWorkflowExecutionsResponse, error)
 	ListClosedWorkflowExecutions(listRequest *shared.ListClosedWorkflowExecutionsRequest) (*shared.ListClosedWorkflowExecutionsResponse, error)
+	ListWorkflowExecutions(listRequest *shared.ListWorkflowExecutionsRequest) (*shared.ListWorkflowExecutionsResponse, error)
+	CountWorkflowExecutions(countRequest *shared.CountWorkflowExecutionsRequest) (*shared.CountWorkflowExecutionsResponse, error)
+	QueryWorkflow(queryRequest *shared.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error)
+	RespondQueryTaskCompleted(completeRequest *shared.RespondQueryTaskCompletedRequest) error
+	DescribeWorkflowExecution(request *shared.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error)
+	ResetWorkflowExecution(request *shared.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error)
+	DescribeTaskList(request *shared.DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error)
 }
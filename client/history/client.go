@@ -166,6 +166,25 @@ func (c *clientImpl) RespondDecisionTaskCompleted(context thrift.Context,
 	return err
 }
 
+func (c *clientImpl) RespondDecisionTaskFailed(context thrift.Context,
+	request *h.RespondDecisionTaskFailedRequest) error {
+	taskToken, err := c.tokenSerializer.Deserialize(request.GetFailedRequest().GetTaskToken())
+	if err != nil {
+		return err
+	}
+	client, err := c.getHostForRequest(taskToken.WorkflowID)
+	if err != nil {
+		return err
+	}
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		return client.RespondDecisionTaskFailed(ctx, request)
+	}
+	err = c.executeWithRedirect(context, client, op)
+	return err
+}
+
 func (c *clientImpl) RespondActivityTaskCompleted(context thrift.Context,
 	request *h.RespondActivityTaskCompletedRequest) error {
 	taskToken, err := c.tokenSerializer.Deserialize(request.GetCompleteRequest().GetTaskToken())
@@ -278,6 +297,27 @@ func (c *clientImpl) SignalWorkflowExecution(context thrift.Context,
 	return err
 }
 
+func (c *clientImpl) SignalWithStartWorkflowExecution(context thrift.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(request.GetSignalWithStartRequest().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.StartWorkflowExecutionResponse
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		response, err = client.SignalWithStartWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(context, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) TerminateWorkflowExecution(context thrift.Context,
 	request *h.TerminateWorkflowExecutionRequest) error {
 	client, err := c.getHostForRequest(request.GetTerminateRequest().GetWorkflowExecution().GetWorkflowId())
@@ -321,6 +361,48 @@ func (c *clientImpl) RecordChildExecutionCompleted(context thrift.Context, reque
 	return err
 }
 
+func (c *clientImpl) DescribeWorkflowExecution(context thrift.Context,
+	request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(request.GetRequest().GetExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.DescribeWorkflowExecutionResponse
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		response, err = client.DescribeWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(context, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ResetWorkflowExecution(context thrift.Context,
+	request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	client, err := c.getHostForRequest(request.GetResetRequest().GetWorkflowExecution().GetWorkflowId())
+	if err != nil {
+		return nil, err
+	}
+	var response *workflow.ResetWorkflowExecutionResponse
+	op := func(context thrift.Context, client h.TChanHistoryService) error {
+		var err error
+		ctx, cancel := c.createContext(context)
+		defer cancel()
+		response, err = client.ResetWorkflowExecution(ctx, request)
+		return err
+	}
+	err = c.executeWithRedirect(context, client, op)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) getHostForRequest(workflowID string) (h.TChanHistoryService, error) {
 	key := common.WorkflowIDToHistoryShard(workflowID, c.numberOfShards)
 	host, err := c.resolver.Lookup(string(key))
@@ -117,6 +117,21 @@ func (c *metricClient) RespondDecisionTaskCompleted(context thrift.Context,
 	return err
 }
 
+func (c *metricClient) RespondDecisionTaskFailed(context thrift.Context,
+	request *h.RespondDecisionTaskFailedRequest) error {
+	c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskFailedScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientRespondDecisionTaskFailedScope, metrics.CadenceLatency)
+	err := c.client.RespondDecisionTaskFailed(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientRespondDecisionTaskFailedScope, metrics.CadenceFailures)
+	}
+
+	return err
+}
+
 func (c *metricClient) RespondActivityTaskCompleted(context thrift.Context,
 	request *h.RespondActivityTaskCompletedRequest) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientRespondActivityTaskCompletedScope, metrics.CadenceRequests)
@@ -207,6 +222,21 @@ func (c *metricClient) SignalWorkflowExecution(context thrift.Context,
 	return err
 }
 
+func (c *metricClient) SignalWithStartWorkflowExecution(context thrift.Context,
+	request *h.SignalWithStartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.SignalWithStartWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientSignalWithStartWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
 func (c *metricClient) TerminateWorkflowExecution(context thrift.Context,
 	request *h.TerminateWorkflowExecutionRequest) error {
 	c.metricsClient.IncCounter(metrics.HistoryClientTerminateWorkflowExecutionScope, metrics.CadenceRequests)
@@ -251,3 +281,33 @@ func (c *metricClient) RecordChildExecutionCompleted(context thrift.Context,
 
 	return err
 }
+
+func (c *metricClient) DescribeWorkflowExecution(context thrift.Context,
+	request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientDescribeWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) ResetWorkflowExecution(context thrift.Context,
+	request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceLatency)
+	resp, err := c.client.ResetWorkflowExecution(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.HistoryClientResetWorkflowExecutionScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
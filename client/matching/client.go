@@ -102,6 +102,39 @@ func (c *clientImpl) PollForDecisionTask(context thrift.Context,
 	return client.PollForDecisionTask(ctx, pollRequest)
 }
 
+func (c *clientImpl) QueryWorkflow(context thrift.Context,
+	queryRequest *m.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error) {
+	client, err := c.getHostForRequest(queryRequest.GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(context)
+	defer cancel()
+	return client.QueryWorkflow(ctx, queryRequest)
+}
+
+func (c *clientImpl) DescribeTaskList(context thrift.Context,
+	request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error) {
+	client, err := c.getHostForRequest(request.GetDescRequest().GetTaskList().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.createContext(context)
+	defer cancel()
+	return client.DescribeTaskList(ctx, request)
+}
+
+func (c *clientImpl) RespondQueryTaskCompleted(context thrift.Context,
+	request *m.RespondQueryTaskCompletedRequest) error {
+	client, err := c.getHostForRequest(request.GetTaskList().GetName())
+	if err != nil {
+		return err
+	}
+	ctx, cancel := c.createContext(context)
+	defer cancel()
+	return client.RespondQueryTaskCompleted(ctx, request)
+}
+
 func (c *clientImpl) getHostForRequest(key string) (m.TChanMatchingService, error) {
 	host, err := c.resolver.Lookup(key)
 	if err != nil {
@@ -99,3 +99,48 @@ func (c *metricClient) PollForDecisionTask(context thrift.Context,
 
 	return resp, err
 }
+
+func (c *metricClient) QueryWorkflow(context thrift.Context,
+	queryRequest *m.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceLatency)
+	resp, err := c.client.QueryWorkflow(context, queryRequest)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientQueryWorkflowScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) DescribeTaskList(context thrift.Context,
+	request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error) {
+	c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceLatency)
+	resp, err := c.client.DescribeTaskList(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientDescribeTaskListScope, metrics.CadenceFailures)
+	}
+
+	return resp, err
+}
+
+func (c *metricClient) RespondQueryTaskCompleted(context thrift.Context,
+	request *m.RespondQueryTaskCompletedRequest) error {
+	c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceRequests)
+
+	sw := c.metricsClient.StartTimer(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceLatency)
+	err := c.client.RespondQueryTaskCompleted(context, request)
+	sw.Stop()
+
+	if err != nil {
+		c.metricsClient.IncCounter(metrics.MatchingClientRespondQueryTaskCompletedScope, metrics.CadenceFailures)
+	}
+
+	return err
+}
@@ -27,6 +27,15 @@ const (
 	EmptyEventID int64 = -23
 )
 
+const (
+	// DefaultBlobSizeLimitWarn is the payload size in bytes above which a warning is logged, for domains which do
+	// not override it
+	DefaultBlobSizeLimitWarn = 256 * 1024
+	// DefaultBlobSizeLimitError is the payload size in bytes above which a payload is rejected, for domains which do
+	// not override it
+	DefaultBlobSizeLimitError = 2 * 1024 * 1024
+)
+
 const (
 	// FrontendServiceName is the name of the frontend service
 	FrontendServiceName = "cadence-frontend"
@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type (
+	ScheduleSuite struct {
+		*require.Assertions // override suite.Suite.Assertions with require.Assertions; this means that s.NotNil(nil) will stop the test, not merely log an error
+		suite.Suite
+	}
+)
+
+func TestScheduleSuite(t *testing.T) {
+	suite.Run(t, new(ScheduleSuite))
+}
+
+func (s *ScheduleSuite) SetupTest() {
+	s.Assertions = require.New(s.T()) // Have to define our overridden assertions in the test setup. If we did it earlier, s.T() will return nil
+}
+
+func (s *ScheduleSuite) TestNext() {
+	// Wednesday
+	now := time.Date(2017, time.June, 14, 10, 30, 45, 0, time.UTC)
+	testCases := []struct {
+		spec     string
+		expected time.Time
+	}{
+		{"* * * * *", time.Date(2017, time.June, 14, 10, 31, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2017, time.June, 14, 10, 45, 0, 0, time.UTC)},
+		{"0 * * * *", time.Date(2017, time.June, 14, 11, 0, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2017, time.June, 15, 10, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2017, time.June, 14, 13, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2017, time.July, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * mon,fri", time.Date(2017, time.June, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2017, time.June, 18, 0, 0, 0, 0, time.UTC)},
+		{"0 0 13 * fri", time.Date(2017, time.June, 16, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2017, time.June, 14, 11, 0, 0, 0, time.UTC)},
+		{"@yearly", time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tc := range testCases {
+		schedule, err := Parse(tc.spec)
+		s.NoError(err, tc.spec)
+		s.Equal(tc.expected, schedule.Next(now), tc.spec)
+	}
+}
+
+func (s *ScheduleSuite) TestNeverFires() {
+	schedule, err := Parse("0 0 30 2 *")
+	s.NoError(err)
+	s.True(schedule.Next(time.Now()).IsZero())
+}
+
+func (s *ScheduleSuite) TestInvalidSchedule() {
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *",
+		"* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *", "* * * * * *"} {
+		_, err := Parse(spec)
+		s.Error(err, spec)
+	}
+}
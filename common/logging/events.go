@@ -35,6 +35,10 @@ const (
 	DuplicateTaskEventID               = 2030
 	MultipleCompletionDecisionsEventID = 2040
 	DuplicateTransferTaskEventID       = 2050
+	WorkflowLimitWarnEventID           = 2060
+	WorkflowLimitErrorEventID          = 2061
+	BlobSizeLimitWarnEventID           = 2070
+	BlobSizeLimitErrorEventID          = 2071
 
 	// Transfer Queue Processor events
 	TransferQueueProcessorStarting         = 2100
@@ -254,3 +254,32 @@ func LogMultipleCompletionDecisionsEvent(lg bark.Logger, decisionType shared.Dec
 		TagDecisionType:    decisionType,
 	}).Warnf("Multiple completion decisions.  DecisionType: %v", decisionType)
 }
+
+// LogWorkflowLimitWarnEvent is used to log an execution crossing the warn threshold of one of its limits
+func LogWorkflowLimitWarnEvent(lg bark.Logger, limitName string, value, limit int64) {
+	lg.WithFields(bark.Fields{
+		TagWorkflowEventID: WorkflowLimitWarnEventID,
+	}).Warnf("Workflow execution is approaching its %v limit.  Value: %v, WarnLimit: %v", limitName, value, limit)
+}
+
+// LogWorkflowLimitErrorEvent is used to log an execution terminated for crossing the error threshold of one of its limits
+func LogWorkflowLimitErrorEvent(lg bark.Logger, limitName string, value, limit int64) {
+	lg.WithFields(bark.Fields{
+		TagWorkflowEventID: WorkflowLimitErrorEventID,
+	}).Errorf("Workflow execution exceeded its %v limit and is terminated.  Value: %v, ErrorLimit: %v",
+		limitName, value, limit)
+}
+
+// LogBlobSizeLimitWarnEvent is used to log a payload crossing the warn blob size limit of its domain
+func LogBlobSizeLimitWarnEvent(lg bark.Logger, domainID string, size, limit int) {
+	lg.WithFields(bark.Fields{
+		TagWorkflowEventID: BlobSizeLimitWarnEventID,
+	}).Warnf("Blob size is approaching the limit.  DomainID: %v, Size: %v, WarnLimit: %v", domainID, size, limit)
+}
+
+// LogBlobSizeLimitErrorEvent is used to log a payload rejected for crossing the error blob size limit of its domain
+func LogBlobSizeLimitErrorEvent(lg bark.Logger, domainID string, size, limit int) {
+	lg.WithFields(bark.Fields{
+		TagWorkflowEventID: BlobSizeLimitErrorEventID,
+	}).Errorf("Blob size exceeds the limit.  DomainID: %v, Size: %v, ErrorLimit: %v", domainID, size, limit)
+}
@@ -67,6 +67,7 @@ const (
 	TagValueActionCompleteWorkflow                = "add-complete-workflow-event"
 	TagValueActionFailWorkflow                    = "add-fail-workflow-event"
 	TagValueActionCancelWorkflow                  = "add-cancel-workflow-event"
+	TagValueActionWorkflowTimeout                 = "add-workflowexecution-timedout-event"
 	TagValueActionUnknownEvent                    = "add-unknown-event"
 	TagValueActionTimerStarted                    = "add-timer-started-event"
 	TagValueActionTimerFired                      = "add-timer-fired-event"
@@ -81,6 +82,9 @@ const (
 	TagValueActionChildExecutionFailed            = "add-childexecution-failed-event"
 	TagValueActionChildExecutionCanceled          = "add-childexecution-canceled-event"
 	TagValueActionChildExecutionTerminated        = "add-childexecution-terminated-event"
+	TagValueActionChildExecutionTimedOut          = "add-childexecution-timedout-event"
+	TagValueActionExternalWorkflowSignaled        = "add-externalworkflow-signaled-event"
+	TagValueActionSignalExternalWorkflowFailed    = "add-signal-externalworkflow-failed-event"
 
 	// TagStoreOperation values
 	TagValueStoreOperationGetTasks                = "get-tasks"
@@ -164,6 +164,8 @@ const (
 	HistoryClientRecordActivityTaskHeartbeatScope
 	// HistoryClientRespondDecisionTaskCompletedScope tracks RPC calls to history service
 	HistoryClientRespondDecisionTaskCompletedScope
+	// HistoryClientRespondDecisionTaskFailedScope tracks RPC calls to history service
+	HistoryClientRespondDecisionTaskFailedScope
 	// HistoryClientRespondActivityTaskCompletedScope tracks RPC calls to history service
 	HistoryClientRespondActivityTaskCompletedScope
 	// HistoryClientRespondActivityTaskFailedScope tracks RPC calls to history service
@@ -180,12 +182,18 @@ const (
 	HistoryClientRequestCancelWorkflowExecutionScope
 	// HistoryClientSignalWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientSignalWorkflowExecutionScope
+	// HistoryClientSignalWithStartWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientSignalWithStartWorkflowExecutionScope
 	// HistoryClientTerminateWorkflowExecutionScope tracks RPC calls to history service
 	HistoryClientTerminateWorkflowExecutionScope
 	// HistoryClientScheduleDecisionTaskScope tracks RPC calls to history service
 	HistoryClientScheduleDecisionTaskScope
 	// HistoryClientRecordChildExecutionCompletedScope tracks RPC calls to history service
 	HistoryClientRecordChildExecutionCompletedScope
+	// HistoryClientDescribeWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientDescribeWorkflowExecutionScope
+	// HistoryClientResetWorkflowExecutionScope tracks RPC calls to history service
+	HistoryClientResetWorkflowExecutionScope
 	// MatchingClientPollForDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientPollForDecisionTaskScope
 	// MatchingClientPollForActivityTaskScope tracks RPC calls to matching service
@@ -194,6 +202,12 @@ const (
 	MatchingClientAddActivityTaskScope
 	// MatchingClientAddDecisionTaskScope tracks RPC calls to matching service
 	MatchingClientAddDecisionTaskScope
+	// MatchingClientQueryWorkflowScope tracks RPC calls to matching service
+	MatchingClientQueryWorkflowScope
+	// MatchingClientRespondQueryTaskCompletedScope tracks RPC calls to matching service
+	MatchingClientRespondQueryTaskCompletedScope
+	// MatchingClientDescribeTaskListScope tracks RPC calls to matching service
+	MatchingClientDescribeTaskListScope
 
 	NumCommonScopes
 )
@@ -216,6 +230,10 @@ const (
 	RespondActivityTaskFailedScope
 	// GetWorkflowExecutionHistoryScope tracks GetWorkflowExecutionHistory API calls received by service
 	GetWorkflowExecutionHistoryScope
+	// BlobSizeLimitScope tracks payloads crossing the blob size limits of their domain
+	BlobSizeLimitScope
+	// RateLimitScope tracks requests rejected for exceeding the rate limits of their domain or of the service
+	RateLimitScope
 
 	NumFrontendScopes
 )
@@ -228,6 +246,8 @@ const (
 	HistoryRecordActivityTaskHeartbeatScope
 	// HistoryRespondDecisionTaskCompletedScope tracks RespondDecisionTaskCompleted API calls received by service
 	HistoryRespondDecisionTaskCompletedScope
+	// HistoryRespondDecisionTaskFailedScope tracks RespondDecisionTaskFailed API calls received by service
+	HistoryRespondDecisionTaskFailedScope
 	// HistoryRespondActivityTaskCompletedScope tracks RespondActivityTaskCompleted API calls received by service
 	HistoryRespondActivityTaskCompletedScope
 	// HistoryRespondActivityTaskFailedScope tracks RespondActivityTaskFailed API calls received by service
@@ -242,18 +262,28 @@ const (
 	HistoryRecordActivityTaskStartedScope
 	// HistorySignalWorkflowExecutionScope tracks SignalWorkflowExecution API calls received by service
 	HistorySignalWorkflowExecutionScope
+	// HistorySignalWithStartWorkflowExecutionScope tracks SignalWithStartWorkflowExecution API calls received by service
+	HistorySignalWithStartWorkflowExecutionScope
 	// HistoryTerminateWorkflowExecutionScope tracks TerminateWorkflowExecution API calls received by service
 	HistoryTerminateWorkflowExecutionScope
 	// HistoryScheduleDecisionTaskScope tracks ScheduleDecisionTask API calls received by service
 	HistoryScheduleDecisionTaskScope
 	// HistoryRecordChildExecutionCompletedScope tracks CompleteChildExecution API calls received by service
 	HistoryRecordChildExecutionCompletedScope
+	// HistoryDescribeWorkflowExecutionScope tracks DescribeWorkflowExecution API calls received by service
+	HistoryDescribeWorkflowExecutionScope
+	// HistoryResetWorkflowExecutionScope tracks ResetWorkflowExecution API calls received by service
+	HistoryResetWorkflowExecutionScope
 	// HistoryProcessTransferTasksScope tracks number of transfer tasks processed
 	HistoryProcessTransferTasksScope
 	// HistoryRequestCancelWorkflowExecutionScope tracks RequestCancelWorkflowExecution API calls received by service
 	HistoryRequestCancelWorkflowExecutionScope
 	// HistoryMultipleCompletionDecisionsScope tracks number of duplicate completion decisions for an execution
 	HistoryMultipleCompletionDecisionsScope
+	// HistoryWorkflowLimitsScope tracks executions crossing the history size, count and pending limits of their domain
+	HistoryWorkflowLimitsScope
+	// HistoryBlobSizeLimitScope tracks decision payloads crossing the blob size limits of their domain
+	HistoryBlobSizeLimitScope
 
 	NumHistoryScopes
 )
@@ -268,6 +298,12 @@ const (
 	MatchingAddActivityTaskScope
 	// MatchingAddDecisionTaskScope tracks AddDecisionTask API calls received by service
 	MatchingAddDecisionTaskScope
+	// MatchingQueryWorkflowScope tracks QueryWorkflow API calls received by service
+	MatchingQueryWorkflowScope
+	// MatchingRespondQueryTaskCompletedScope tracks RespondQueryTaskCompleted API calls received by service
+	MatchingRespondQueryTaskCompletedScope
+	// MatchingDescribeTaskListScope tracks DescribeTaskList API calls received by service
+	MatchingDescribeTaskListScope
 
 	NumMatchingScopes
 )
@@ -302,24 +338,31 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		PersistenceDeleteDomainScope:                   {operation: "DeleteDomain"},
 		PersistenceDeleteDomainByNameScope:             {operation: "DeleteDomainByName"},
 
-		HistoryClientStartWorkflowExecutionScope:          {operation: "HistoryClientStartWorkflowExecution"},
-		HistoryClientRecordActivityTaskHeartbeatScope:     {operation: "HistoryClientRecordActivityTaskHeartbeat"},
-		HistoryClientRespondDecisionTaskCompletedScope:    {operation: "HistoryClientRespondDecisionTaskCompleted"},
-		HistoryClientRespondActivityTaskCompletedScope:    {operation: "HistoryClientRespondActivityTaskCompleted"},
-		HistoryClientRespondActivityTaskFailedScope:       {operation: "HistoryClientRespondActivityTaskFailed"},
-		HistoryClientRespondActivityTaskCanceledScope:     {operation: "HistoryClientRespondActivityTaskCanceled"},
-		HistoryClientGetWorkflowExecutionNextEventIDScope: {operation: "HistoryClientGetWorkflowExecutionNextEventId"},
-		HistoryClientRecordDecisionTaskStartedScope:       {operation: "HistoryClientRecordDecisionTaskStarted"},
-		HistoryClientRecordActivityTaskStartedScope:       {operation: "HistoryClientRecordActivityTaskStarted"},
-		HistoryClientRequestCancelWorkflowExecutionScope:  {operation: "HistoryClientRequestCancelWorkflowExecution"},
-		HistoryClientSignalWorkflowExecutionScope:         {operation: "HistoryClientSignalWorkflowExecution"},
-		HistoryClientTerminateWorkflowExecutionScope:      {operation: "HistoryClientTerminateWorkflowExecution"},
-		HistoryClientScheduleDecisionTaskScope:            {operation: "HistoryClientScheduleDecisionTask"},
-		HistoryClientRecordChildExecutionCompletedScope:   {operation: "HistoryClientRecordChildExecutionCompleted"},
-		MatchingClientPollForDecisionTaskScope:            {operation: "MatchingClientPollForDecisionTask"},
-		MatchingClientPollForActivityTaskScope:            {operation: "MatchingClientPollForActivityTask"},
-		MatchingClientAddActivityTaskScope:                {operation: "MatchingClientAddActivityTask"},
-		MatchingClientAddDecisionTaskScope:                {operation: "MatchingClientAddDecisionTask"},
+		HistoryClientStartWorkflowExecutionScope:           {operation: "HistoryClientStartWorkflowExecution"},
+		HistoryClientRecordActivityTaskHeartbeatScope:      {operation: "HistoryClientRecordActivityTaskHeartbeat"},
+		HistoryClientRespondDecisionTaskCompletedScope:     {operation: "HistoryClientRespondDecisionTaskCompleted"},
+		HistoryClientRespondDecisionTaskFailedScope:        {operation: "HistoryClientRespondDecisionTaskFailed"},
+		HistoryClientRespondActivityTaskCompletedScope:     {operation: "HistoryClientRespondActivityTaskCompleted"},
+		HistoryClientRespondActivityTaskFailedScope:        {operation: "HistoryClientRespondActivityTaskFailed"},
+		HistoryClientRespondActivityTaskCanceledScope:      {operation: "HistoryClientRespondActivityTaskCanceled"},
+		HistoryClientGetWorkflowExecutionNextEventIDScope:  {operation: "HistoryClientGetWorkflowExecutionNextEventId"},
+		HistoryClientRecordDecisionTaskStartedScope:        {operation: "HistoryClientRecordDecisionTaskStarted"},
+		HistoryClientRecordActivityTaskStartedScope:        {operation: "HistoryClientRecordActivityTaskStarted"},
+		HistoryClientRequestCancelWorkflowExecutionScope:   {operation: "HistoryClientRequestCancelWorkflowExecution"},
+		HistoryClientSignalWorkflowExecutionScope:          {operation: "HistoryClientSignalWorkflowExecution"},
+		HistoryClientSignalWithStartWorkflowExecutionScope: {operation: "HistoryClientSignalWithStartWorkflowExecution"},
+		HistoryClientTerminateWorkflowExecutionScope:       {operation: "HistoryClientTerminateWorkflowExecution"},
+		HistoryClientScheduleDecisionTaskScope:             {operation: "HistoryClientScheduleDecisionTask"},
+		HistoryClientRecordChildExecutionCompletedScope:    {operation: "HistoryClientRecordChildExecutionCompleted"},
+		HistoryClientDescribeWorkflowExecutionScope:        {operation: "HistoryClientDescribeWorkflowExecution"},
+		HistoryClientResetWorkflowExecutionScope:           {operation: "HistoryClientResetWorkflowExecution"},
+		MatchingClientPollForDecisionTaskScope:             {operation: "MatchingClientPollForDecisionTask"},
+		MatchingClientPollForActivityTaskScope:             {operation: "MatchingClientPollForActivityTask"},
+		MatchingClientAddActivityTaskScope:                 {operation: "MatchingClientAddActivityTask"},
+		MatchingClientAddDecisionTaskScope:                 {operation: "MatchingClientAddDecisionTask"},
+		MatchingClientQueryWorkflowScope:                   {operation: "MatchingClientQueryWorkflow"},
+		MatchingClientRespondQueryTaskCompletedScope:       {operation: "MatchingClientRespondQueryTaskCompleted"},
+		MatchingClientDescribeTaskListScope:                {operation: "MatchingClientDescribeTaskList"},
 	},
 	// Frontend Scope Names
 	Frontend: {
@@ -331,32 +374,43 @@ var ScopeDefs = map[ServiceIdx]map[int]scopeDefinition{
 		RespondActivityTaskCompletedScope: {operation: "RespondActivityTaskCompleted"},
 		RespondActivityTaskFailedScope:    {operation: "RespondActivityTaskFailed"},
 		GetWorkflowExecutionHistoryScope:  {operation: "GetWorkflowExecutionHistory"},
+		BlobSizeLimitScope:                {operation: "BlobSizeLimit"},
+		RateLimitScope:                    {operation: "RateLimit"},
 	},
 	// History Scope Names
 	History: {
-		HistoryStartWorkflowExecutionScope:          {operation: "StartWorkflowExecution"},
-		HistoryRecordActivityTaskHeartbeatScope:     {operation: "RecordActivityTaskHeartbeat"},
-		HistoryRespondDecisionTaskCompletedScope:    {operation: "RespondDecisionTaskCompleted"},
-		HistoryRespondActivityTaskCompletedScope:    {operation: "RespondActivityTaskCompleted"},
-		HistoryRespondActivityTaskFailedScope:       {operation: "RespondActivityTaskFailed"},
-		HistoryRespondActivityTaskCanceledScope:     {operation: "RespondActivityTaskCanceled"},
-		HistoryGetWorkflowExecutionNextEventIDScope: {operation: "GetWorkflowExecutionNextEventIDScope"},
-		HistoryRecordDecisionTaskStartedScope:       {operation: "RecordDecisionTaskStarted"},
-		HistoryRecordActivityTaskStartedScope:       {operation: "RecordActivityTaskStarted"},
-		HistorySignalWorkflowExecutionScope:         {operation: "SignalWorkflowExecution"},
-		HistoryTerminateWorkflowExecutionScope:      {operation: "TerminateWorkflowExecution"},
-		HistoryScheduleDecisionTaskScope:            {operation: "ScheduleDecisionTask"},
-		HistoryRecordChildExecutionCompletedScope:   {operation: "RecordChildExecutionCompleted"},
-		HistoryProcessTransferTasksScope:            {operation: "ProcessTransferTask"},
-		HistoryRequestCancelWorkflowExecutionScope:  {operation: "RequestCancelWorkflowExecution"},
-		HistoryMultipleCompletionDecisionsScope:     {operation: "MultipleCompletionDecisions"},
+		HistoryStartWorkflowExecutionScope:           {operation: "StartWorkflowExecution"},
+		HistoryRecordActivityTaskHeartbeatScope:      {operation: "RecordActivityTaskHeartbeat"},
+		HistoryRespondDecisionTaskCompletedScope:     {operation: "RespondDecisionTaskCompleted"},
+		HistoryRespondDecisionTaskFailedScope:        {operation: "RespondDecisionTaskFailed"},
+		HistoryRespondActivityTaskCompletedScope:     {operation: "RespondActivityTaskCompleted"},
+		HistoryRespondActivityTaskFailedScope:        {operation: "RespondActivityTaskFailed"},
+		HistoryRespondActivityTaskCanceledScope:      {operation: "RespondActivityTaskCanceled"},
+		HistoryGetWorkflowExecutionNextEventIDScope:  {operation: "GetWorkflowExecutionNextEventIDScope"},
+		HistoryRecordDecisionTaskStartedScope:        {operation: "RecordDecisionTaskStarted"},
+		HistoryRecordActivityTaskStartedScope:        {operation: "RecordActivityTaskStarted"},
+		HistorySignalWorkflowExecutionScope:          {operation: "SignalWorkflowExecution"},
+		HistorySignalWithStartWorkflowExecutionScope: {operation: "SignalWithStartWorkflowExecution"},
+		HistoryTerminateWorkflowExecutionScope:       {operation: "TerminateWorkflowExecution"},
+		HistoryScheduleDecisionTaskScope:             {operation: "ScheduleDecisionTask"},
+		HistoryRecordChildExecutionCompletedScope:    {operation: "RecordChildExecutionCompleted"},
+		HistoryDescribeWorkflowExecutionScope:        {operation: "DescribeWorkflowExecution"},
+		HistoryResetWorkflowExecutionScope:           {operation: "ResetWorkflowExecution"},
+		HistoryProcessTransferTasksScope:             {operation: "ProcessTransferTask"},
+		HistoryRequestCancelWorkflowExecutionScope:   {operation: "RequestCancelWorkflowExecution"},
+		HistoryMultipleCompletionDecisionsScope:      {operation: "MultipleCompletionDecisions"},
+		HistoryWorkflowLimitsScope:                   {operation: "WorkflowLimits"},
+		HistoryBlobSizeLimitScope:                    {operation: "BlobSizeLimit"},
 	},
 	// Matching Scope Names
 	Matching: {
-		MatchingPollForDecisionTaskScope: {operation: "PollForDecisionTask"},
-		MatchingPollForActivityTaskScope: {operation: "PollForActivityTask"},
-		MatchingAddActivityTaskScope:     {operation: "AddActivityTask"},
-		MatchingAddDecisionTaskScope:     {operation: "AddDecisionTask"},
+		MatchingPollForDecisionTaskScope:       {operation: "PollForDecisionTask"},
+		MatchingPollForActivityTaskScope:       {operation: "PollForActivityTask"},
+		MatchingAddActivityTaskScope:           {operation: "AddActivityTask"},
+		MatchingAddDecisionTaskScope:           {operation: "AddDecisionTask"},
+		MatchingQueryWorkflowScope:             {operation: "QueryWorkflow"},
+		MatchingRespondQueryTaskCompletedScope: {operation: "RespondQueryTaskCompleted"},
+		MatchingDescribeTaskListScope:          {operation: "DescribeTaskList"},
 	},
 }
 
@@ -369,6 +423,7 @@ const (
 	CadenceErrEntityNotExistsCounter
 	CadenceErrExecutionAlreadyStartedCounter
 	CadenceErrDomainAlreadyExistsCounter
+	CadenceErrServiceBusyCounter
 	PersistenceRequests
 	PersistenceFailures
 	PersistenceLatency
@@ -376,6 +431,8 @@ const (
 	PersistenceErrShardOwnershipLostCounter
 	PersistenceErrConditionFailedCounter
 	PersistenceErrTimeoutCounter
+	BlobSizeLimitWarnCounter
+	BlobSizeLimitErrorCounter
 
 	NumCommonMetrics
 )
@@ -387,6 +444,12 @@ const (
 	FailedDecisionsCounter
 	CadenceErrEventAlreadyStartedCounter
 	CadenceErrShardOwnershipLostCounter
+	HistorySizeLimitWarnCounter
+	HistorySizeLimitErrorCounter
+	HistoryCountLimitWarnCounter
+	HistoryCountLimitErrorCounter
+	PendingLimitWarnCounter
+	PendingLimitErrorCounter
 )
 
 // MetricDefs record the metrics for all services
@@ -399,6 +462,7 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		CadenceErrEntityNotExistsCounter:         {metricName: "cadence.errors.entity-not-exists", metricType: Counter},
 		CadenceErrExecutionAlreadyStartedCounter: {metricName: "cadence.errors.execution-already-started", metricType: Counter},
 		CadenceErrDomainAlreadyExistsCounter:     {metricName: "cadence.errors.domain-already-exists", metricType: Counter},
+		CadenceErrServiceBusyCounter:             {metricName: "cadence.errors.service-busy", metricType: Counter},
 		PersistenceRequests:                      {metricName: "persistence.requests", metricType: Counter},
 		PersistenceFailures:                      {metricName: "persistence.errors", metricType: Counter},
 		PersistenceLatency:                       {metricName: "persistence.latency", metricType: Timer},
@@ -406,6 +470,8 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		PersistenceErrShardOwnershipLostCounter:  {metricName: "persistence.errors.shard-ownership-lost", metricType: Counter},
 		PersistenceErrConditionFailedCounter:     {metricName: "persistence.errors.condition-failed", metricType: Counter},
 		PersistenceErrTimeoutCounter:             {metricName: "persistence.errors.timeout", metricType: Counter},
+		BlobSizeLimitWarnCounter:                 {metricName: "blob-size-limit-warn", metricType: Counter},
+		BlobSizeLimitErrorCounter:                {metricName: "blob-size-limit-error", metricType: Counter},
 	},
 	Frontend: {},
 	History: {
@@ -414,6 +480,12 @@ var MetricDefs = map[ServiceIdx]map[int]metricDefinition{
 		FailedDecisionsCounter:               {metricName: "failed-decisions", metricType: Counter},
 		CadenceErrShardOwnershipLostCounter:  {metricName: "cadence.errors.shard-ownership-lost", metricType: Counter},
 		CadenceErrEventAlreadyStartedCounter: {metricName: "cadence.errors.event-already-started", metricType: Counter},
+		HistorySizeLimitWarnCounter:          {metricName: "history-size-limit-warn", metricType: Counter},
+		HistorySizeLimitErrorCounter:         {metricName: "history-size-limit-error", metricType: Counter},
+		HistoryCountLimitWarnCounter:         {metricName: "history-count-limit-warn", metricType: Counter},
+		HistoryCountLimitErrorCounter:        {metricName: "history-count-limit-error", metricType: Counter},
+		PendingLimitWarnCounter:              {metricName: "pending-limit-warn", metricType: Counter},
+		PendingLimitErrorCounter:             {metricName: "pending-limit-error", metricType: Counter},
 	},
 	Matching: {},
 }
@@ -178,6 +178,20 @@ func (_m *HistoryClient) RespondDecisionTaskCompleted(ctx thrift.Context, comple
 	return r0
 }
 
+// RespondDecisionTaskFailed provides a mock function with given fields: ctx, failedRequest
+func (_m *HistoryClient) RespondDecisionTaskFailed(ctx thrift.Context, failedRequest *history.RespondDecisionTaskFailedRequest) error {
+	ret := _m.Called(ctx, failedRequest)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.RespondDecisionTaskFailedRequest) error); ok {
+		r0 = rf(ctx, failedRequest)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SignalWorkflowExecution provides a mock function with given fields: ctx, signalRequest
 func (_m *HistoryClient) SignalWorkflowExecution(ctx thrift.Context, signalRequest *history.SignalWorkflowExecutionRequest) error {
 	ret := _m.Called(ctx, signalRequest)
@@ -192,6 +206,29 @@ func (_m *HistoryClient) SignalWorkflowExecution(ctx thrift.Context, signalReque
 	return r0
 }
 
+// SignalWithStartWorkflowExecution provides a mock function with given fields: ctx, signalWithStartRequest
+func (_m *HistoryClient) SignalWithStartWorkflowExecution(ctx thrift.Context, signalWithStartRequest *history.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, signalWithStartRequest)
+
+	var r0 *shared.StartWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.SignalWithStartWorkflowExecutionRequest) *shared.StartWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, signalWithStartRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.StartWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.SignalWithStartWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, signalWithStartRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // StartWorkflowExecution provides a mock function with given fields: ctx, startRequest
 func (_m *HistoryClient) StartWorkflowExecution(ctx thrift.Context, startRequest *history.StartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
 	ret := _m.Called(ctx, startRequest)
@@ -270,3 +307,49 @@ func (_m *HistoryClient) RecordChildExecutionCompleted(ctx thrift.Context, reque
 
 	return r0
 }
+
+// DescribeWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) DescribeWorkflowExecution(ctx thrift.Context, request *history.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.DescribeWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetWorkflowExecution provides a mock function with given fields: ctx, request
+func (_m *HistoryClient) ResetWorkflowExecution(ctx thrift.Context, request *history.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *history.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *history.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
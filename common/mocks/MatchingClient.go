@@ -107,3 +107,66 @@ func (_m *MatchingClient) PollForDecisionTask(ctx thrift.Context,
 
 	return r0, r1
 }
+
+// QueryWorkflow provides a mock function with given fields: ctx, queryRequest
+func (_m *MatchingClient) QueryWorkflow(ctx thrift.Context,
+	queryRequest *matching.QueryWorkflowRequest) (*shared.QueryWorkflowResponse, error) {
+	ret := _m.Called(ctx, queryRequest)
+
+	var r0 *shared.QueryWorkflowResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *matching.QueryWorkflowRequest) *shared.QueryWorkflowResponse); ok {
+		r0 = rf(ctx, queryRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.QueryWorkflowResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *matching.QueryWorkflowRequest) error); ok {
+		r1 = rf(ctx, queryRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RespondQueryTaskCompleted provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) RespondQueryTaskCompleted(ctx thrift.Context,
+	request *matching.RespondQueryTaskCompletedRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(thrift.Context, *matching.RespondQueryTaskCompletedRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeTaskList provides a mock function with given fields: ctx, request
+func (_m *MatchingClient) DescribeTaskList(ctx thrift.Context,
+	request *matching.DescribeTaskListRequest) (*shared.DescribeTaskListResponse, error) {
+	ret := _m.Called(ctx, request)
+
+	var r0 *shared.DescribeTaskListResponse
+	if rf, ok := ret.Get(0).(func(thrift.Context, *matching.DescribeTaskListRequest) *shared.DescribeTaskListResponse); ok {
+		r0 = rf(ctx, request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeTaskListResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(thrift.Context, *matching.DescribeTaskListRequest) error); ok {
+		r1 = rf(ctx, request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -28,6 +28,29 @@ type VisibilityManager struct {
 	mock.Mock
 }
 
+// CountWorkflowExecutionsByQuery provides a mock function with given fields: request
+func (_m *VisibilityManager) CountWorkflowExecutionsByQuery(request *persistence.CountWorkflowExecutionsByQueryRequest) (*persistence.CountWorkflowExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.CountWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.CountWorkflowExecutionsByQueryRequest) *persistence.CountWorkflowExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.CountWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.CountWorkflowExecutionsByQueryRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListClosedWorkflowExecutions provides a mock function with given fields: request
 func (_m *VisibilityManager) ListClosedWorkflowExecutions(request *persistence.ListWorkflowExecutionsRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
 	ret := _m.Called(request)
@@ -189,6 +212,29 @@ func (_m *VisibilityManager) ListOpenWorkflowExecutionsByWorkflowID(request *per
 	return r0, r1
 }
 
+// ListWorkflowExecutionsByQuery provides a mock function with given fields: request
+func (_m *VisibilityManager) ListWorkflowExecutionsByQuery(request *persistence.ListWorkflowExecutionsByQueryRequest) (*persistence.ListWorkflowExecutionsResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *persistence.ListWorkflowExecutionsResponse
+	if rf, ok := ret.Get(0).(func(*persistence.ListWorkflowExecutionsByQueryRequest) *persistence.ListWorkflowExecutionsResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*persistence.ListWorkflowExecutionsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*persistence.ListWorkflowExecutionsByQueryRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RecordWorkflowExecutionClosed provides a mock function with given fields: request
 func (_m *VisibilityManager) RecordWorkflowExecutionClosed(request *persistence.RecordWorkflowExecutionClosedRequest) error {
 	ret := _m.Called(request)
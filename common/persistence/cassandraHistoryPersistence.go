@@ -44,6 +44,7 @@ const (
 		`WHERE domain_id = ? ` +
 		`AND workflow_id = ? ` +
 		`AND run_id = ? ` +
+		`AND first_event_id >= ? ` +
 		`AND first_event_id < ?`
 
 	templateDeleteWorkflowExecutionHistory = `DELETE FROM events ` +
@@ -134,6 +135,7 @@ func (h *cassandraHistoryPersistence) GetWorkflowExecutionHistory(request *GetWo
 		request.DomainID,
 		execution.GetWorkflowId(),
 		execution.GetRunId(),
+		request.FirstEventID,
 		request.NextEventID)
 
 	iter := query.PageSize(request.PageSize).PageState(request.NextPageToken).Iter()
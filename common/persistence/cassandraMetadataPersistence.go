@@ -42,7 +42,17 @@ const (
 
 	templateDomainConfigType = `{` +
 		`retention: ?, ` +
-		`emit_metric: ?` +
+		`emit_metric: ?, ` +
+		`history_size_limit_warn: ?, ` +
+		`history_size_limit_error: ?, ` +
+		`history_count_limit_warn: ?, ` +
+		`history_count_limit_error: ?, ` +
+		`pending_limit_warn: ?, ` +
+		`pending_limit_error: ?, ` +
+		`blob_size_limit_warn: ?, ` +
+		`blob_size_limit_error: ?, ` +
+		`poll_rate_limit: ?, ` +
+		`mutation_rate_limit: ?` +
 		`}`
 
 	templateCreateDomainQuery = `INSERT INTO domains (` +
@@ -54,12 +64,18 @@ const (
 		`VALUES(?, ` + templateDomainType + `, ` + templateDomainConfigType + `) IF NOT EXISTS`
 
 	templateGetDomainQuery = `SELECT domain.id, domain.name, domain.status, domain.description, domain.owner_email, ` +
-		`config.retention, config.emit_metric ` +
+		`config.retention, config.emit_metric, config.history_size_limit_warn, config.history_size_limit_error, ` +
+		`config.history_count_limit_warn, config.history_count_limit_error, config.pending_limit_warn, ` +
+		`config.pending_limit_error, config.blob_size_limit_warn, config.blob_size_limit_error, ` +
+		`config.poll_rate_limit, config.mutation_rate_limit ` +
 		`FROM domains ` +
 		`WHERE id = ?`
 
 	templateGetDomainByNameQuery = `SELECT domain.id, domain.name, domain.status, domain.description, ` +
-		`domain.owner_email, config.retention, config.emit_metric ` +
+		`domain.owner_email, config.retention, config.emit_metric, config.history_size_limit_warn, ` +
+		`config.history_size_limit_error, config.history_count_limit_warn, config.history_count_limit_error, ` +
+		`config.pending_limit_warn, config.pending_limit_error, config.blob_size_limit_warn, ` +
+		`config.blob_size_limit_error, config.poll_rate_limit, config.mutation_rate_limit ` +
 		`FROM domains_by_name ` +
 		`WHERE name = ?`
 
@@ -119,7 +135,17 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		request.Description,
 		request.OwnerEmail,
 		request.Retention,
-		request.EmitMetric).Exec(); err != nil {
+		request.EmitMetric,
+		0, // History size, count, pending, blob size and rate limits use the service defaults
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0).Exec(); err != nil {
 		return nil, &workflow.InternalServiceError{
 			Message: fmt.Sprintf("CreateDomain operation failed. Inserting into domains table. Error: %v", err),
 		}
@@ -133,7 +159,17 @@ func (m *cassandraMetadataPersistence) CreateDomain(request *CreateDomainRequest
 		request.Description,
 		request.OwnerEmail,
 		request.Retention,
-		request.EmitMetric)
+		request.EmitMetric,
+		0, // History size, count, pending, blob size and rate limits use the service defaults
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0,
+		0)
 
 	previous := make(map[string]interface{})
 	applied, err := query.MapScanCAS(previous)
@@ -185,7 +221,17 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 			&info.Description,
 			&info.OwnerEmail,
 			&config.Retention,
-			&config.EmitMetric)
+			&config.EmitMetric,
+			&config.HistorySizeLimitWarn,
+			&config.HistorySizeLimitError,
+			&config.HistoryCountLimitWarn,
+			&config.HistoryCountLimitError,
+			&config.PendingLimitWarn,
+			&config.PendingLimitError,
+			&config.BlobSizeLimitWarn,
+			&config.BlobSizeLimitError,
+			&config.PollRateLimit,
+			&config.MutationRateLimit)
 	} else if len(request.Name) > 0 {
 		query = m.session.Query(templateGetDomainByNameQuery,
 			request.Name)
@@ -196,7 +242,17 @@ func (m *cassandraMetadataPersistence) GetDomain(request *GetDomainRequest) (*Ge
 			&info.Description,
 			&info.OwnerEmail,
 			&config.Retention,
-			&config.EmitMetric)
+			&config.EmitMetric,
+			&config.HistorySizeLimitWarn,
+			&config.HistorySizeLimitError,
+			&config.HistoryCountLimitWarn,
+			&config.HistoryCountLimitError,
+			&config.PendingLimitWarn,
+			&config.PendingLimitError,
+			&config.BlobSizeLimitWarn,
+			&config.BlobSizeLimitError,
+			&config.PollRateLimit,
+			&config.MutationRateLimit)
 	} else {
 		return nil, &workflow.BadRequestError{
 			Message: "GetDomain operation failed.  Both ID and Name are empty.",
@@ -239,6 +295,16 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.HistorySizeLimitWarn,
+		request.Config.HistorySizeLimitError,
+		request.Config.HistoryCountLimitWarn,
+		request.Config.HistoryCountLimitError,
+		request.Config.PendingLimitWarn,
+		request.Config.PendingLimitError,
+		request.Config.BlobSizeLimitWarn,
+		request.Config.BlobSizeLimitError,
+		request.Config.PollRateLimit,
+		request.Config.MutationRateLimit,
 		request.Info.ID)
 
 	batch.Query(templateUpdateDomainByNameQuery,
@@ -249,6 +315,16 @@ func (m *cassandraMetadataPersistence) UpdateDomain(request *UpdateDomainRequest
 		request.Info.OwnerEmail,
 		request.Config.Retention,
 		request.Config.EmitMetric,
+		request.Config.HistorySizeLimitWarn,
+		request.Config.HistorySizeLimitError,
+		request.Config.HistoryCountLimitWarn,
+		request.Config.HistoryCountLimitError,
+		request.Config.PendingLimitWarn,
+		request.Config.PendingLimitError,
+		request.Config.BlobSizeLimitWarn,
+		request.Config.BlobSizeLimitError,
+		request.Config.PollRateLimit,
+		request.Config.MutationRateLimit,
 		request.Info.Name)
 
 	if err := m.session.ExecuteBatch(batch); err != nil {
@@ -194,6 +194,10 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	updatedOwner := "owner-updated"
 	updatedRetention := int32(20)
 	updatedEmitMetric := false
+	updatedHistoryCountLimitWarn := int32(1000)
+	updatedHistoryCountLimitError := int32(5000)
+	updatedBlobSizeLimitError := int32(1024 * 1024)
+	updatedMutationRateLimit := int32(50)
 
 	err3 := m.UpdateDomain(
 		&DomainInfo{
@@ -204,8 +208,12 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 			OwnerEmail:  updatedOwner,
 		},
 		&DomainConfig{
-			Retention:  updatedRetention,
-			EmitMetric: updatedEmitMetric,
+			Retention:              updatedRetention,
+			EmitMetric:             updatedEmitMetric,
+			HistoryCountLimitWarn:  updatedHistoryCountLimitWarn,
+			HistoryCountLimitError: updatedHistoryCountLimitError,
+			BlobSizeLimitError:     updatedBlobSizeLimitError,
+			MutationRateLimit:      updatedMutationRateLimit,
 		})
 
 	m.Nil(err3)
@@ -220,6 +228,13 @@ func (m *metadataPersistenceSuite) TestUpdateDomain() {
 	m.Equal(updatedOwner, resp4.Info.OwnerEmail)
 	m.Equal(updatedRetention, resp4.Config.Retention)
 	m.Equal(updatedEmitMetric, resp4.Config.EmitMetric)
+	m.Equal(updatedHistoryCountLimitWarn, resp4.Config.HistoryCountLimitWarn)
+	m.Equal(updatedHistoryCountLimitError, resp4.Config.HistoryCountLimitError)
+	m.Equal(int32(0), resp4.Config.HistorySizeLimitWarn)
+	m.Equal(updatedBlobSizeLimitError, resp4.Config.BlobSizeLimitError)
+	m.Equal(int32(0), resp4.Config.BlobSizeLimitWarn)
+	m.Equal(updatedMutationRateLimit, resp4.Config.MutationRateLimit)
+	m.Equal(int32(0), resp4.Config.PollRateLimit)
 
 	resp5, err5 := m.GetDomain("", name)
 	m.Nil(err5)
@@ -108,7 +108,30 @@ const (
 		`decision_schedule_id: ?, ` +
 		`decision_started_id: ?, ` +
 		`decision_request_id: ?, ` +
-		`decision_timeout: ?` +
+		`decision_timeout: ?, ` +
+		`attempt: ?, ` +
+		`has_retry_policy: ?, ` +
+		`init_interval: ?, ` +
+		`backoff_coefficient: ?, ` +
+		`max_interval: ?, ` +
+		`max_attempts: ?, ` +
+		`expiration_seconds: ?, ` +
+		`expiration_time: ?, ` +
+		`non_retriable_errors: ?, ` +
+		`cron_schedule: ?, ` +
+		`workflow_timeout: ?, ` +
+		`sticky_task_list: ?, ` +
+		`sticky_schedule_to_start_timeout: ?, ` +
+		`first_decision_backoff_time: ?, ` +
+		`memo: ?, ` +
+		`search_attributes: ?, ` +
+		`decision_attempt: ?, ` +
+		`decision_timestamp: ?, ` +
+		`sticky_timer_schedule_id: ?, ` +
+		`sticky_timer_attempt: ?, ` +
+		`history_size: ?, ` +
+		`cancel_requested: ?, ` +
+		`cancel_request_id: ?` +
 		`}`
 
 	templateTransferTaskType = `{` +
@@ -131,7 +154,8 @@ const (
 		`task_id: ?, ` +
 		`type: ?, ` +
 		`timeout_type: ?, ` +
-		`event_id: ?` +
+		`event_id: ?, ` +
+		`attempt: ?` +
 		`}`
 
 	templateActivityInfoType = `{` +
@@ -148,7 +172,15 @@ const (
 		`heart_beat_timeout: ?, ` +
 		`cancel_requested: ?, ` +
 		`cancel_request_id: ?, ` +
-		`last_hb_updated_time: ?` +
+		`last_hb_updated_time: ?, ` +
+		`attempt: ?, ` +
+		`has_retry_policy: ?, ` +
+		`init_interval: ?, ` +
+		`backoff_coefficient: ?, ` +
+		`max_interval: ?, ` +
+		`max_attempts: ?, ` +
+		`expiration_time: ?, ` +
+		`non_retriable_errors: ?` +
 		`}`
 
 	templateTimerInfoType = `{` +
@@ -166,6 +198,19 @@ const (
 		`create_request_id: ?` +
 		`}`
 
+	templateSignalInfoType = `{` +
+		`initiated_id: ?, ` +
+		`signal_name: ?, ` +
+		`input: ?, ` +
+		`control: ?` +
+		`}`
+
+	templateSerializedEventBatch = `{` +
+		`encoding_type: ?, ` +
+		`version: ?, ` +
+		`data: ?` +
+		`}`
+
 	templateTaskListType = `{` +
 		`domain_id: ?, ` +
 		`name: ?, ` +
@@ -177,7 +222,8 @@ const (
 		`domain_id: ?, ` +
 		`workflow_id: ?, ` +
 		`run_id: ?, ` +
-		`schedule_id: ?` +
+		`schedule_id: ?, ` +
+		`schedule_attempt: ?` +
 		`}`
 
 	templateCreateShardQuery = `INSERT INTO executions (` +
@@ -204,7 +250,7 @@ const (
 		`IF range_id = ?`
 
 	templateUpdateCurrentWorkflowExecutionQuery = `UPDATE executions ` +
-		`SET current_run_id = ?, execution = {run_id: ?, create_request_id: ?}` +
+		`SET current_run_id = ?, execution = {run_id: ?, create_request_id: ?, state: ?, close_status: ?} ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
@@ -212,9 +258,12 @@ const (
 		`and run_id = ? ` +
 		`and task_id = ? `
 
+	templateUpdateCurrentWorkflowExecutionForNewQuery = templateUpdateCurrentWorkflowExecutionQuery +
+		`IF current_run_id = ?`
+
 	templateCreateWorkflowExecutionQuery = `INSERT INTO executions (` +
 		`shard_id, type, domain_id, workflow_id, run_id, task_id, current_run_id, execution) ` +
-		`VALUES(?, ?, ?, ?, ?, ?, ?, {run_id: ?, create_request_id: ?}) IF NOT EXISTS`
+		`VALUES(?, ?, ?, ?, ?, ?, ?, {run_id: ?, create_request_id: ?, state: ?, close_status: ?}) IF NOT EXISTS`
 
 	templateCreateWorkflowExecutionQuery2 = `INSERT INTO executions (` +
 		`shard_id, domain_id, workflow_id, run_id, type, execution, next_event_id, task_id) ` +
@@ -233,7 +282,8 @@ const (
 		`WHERE shard_id = ? ` +
 		`IF range_id = ?`
 
-	templateGetWorkflowExecutionQuery = `SELECT execution, activity_map, timer_map, child_executions_map ` +
+	templateGetWorkflowExecutionQuery = `SELECT execution, activity_map, timer_map, child_executions_map, signal_map, ` +
+		`signal_requested, buffered_events_list ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -242,7 +292,7 @@ const (
 		`and run_id = ? ` +
 		`and task_id = ?`
 
-	templateGetCurrentExecutionQuery = `SELECT current_run_id ` +
+	templateGetCurrentExecutionQuery = `SELECT current_run_id, execution ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
@@ -261,6 +311,24 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ? and range_id = ?`
 
+	templateCreateActivityInfoQuery = `UPDATE executions ` +
+		`SET activity_map[ ? ] =` + templateActivityInfoType + ` ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and task_id = ?`
+
+	templateCreateTimerInfoQuery = `UPDATE executions ` +
+		`SET timer_map[ ? ] =` + templateTimerInfoType + ` ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and task_id = ?`
+
 	templateUpdateActivityInfoQuery = `UPDATE executions ` +
 		`SET activity_map[ ? ] =` + templateActivityInfoType + ` ` +
 		`WHERE shard_id = ? ` +
@@ -291,6 +359,26 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ? and range_id = ?`
 
+	templateUpdateSignalInfoQuery = `UPDATE executions ` +
+		`SET signal_map[ ? ] =` + templateSignalInfoType + ` ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ? and range_id = ?`
+
+	templateAppendBufferedEventsQuery = `UPDATE executions ` +
+		`SET buffered_events_list = buffered_events_list + [ ` + templateSerializedEventBatch + ` ] ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ? and range_id = ?`
+
 	templateDeleteActivityInfoQuery = `DELETE activity_map[ ? ] ` +
 		`FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -321,13 +409,35 @@ const (
 		`and task_id = ? ` +
 		`IF next_event_id = ? and range_id = ?`
 
-	templateDeleteWorkflowExecutionQuery = `DELETE FROM executions ` +
+	templateDeleteSignalInfoQuery = `DELETE signal_map[ ? ] ` +
+		`FROM executions ` +
 		`WHERE shard_id = ? ` +
 		`and type = ? ` +
 		`and domain_id = ? ` +
 		`and workflow_id = ? ` +
 		`and run_id = ? ` +
-		`and task_id = ? `
+		`and task_id = ? ` +
+		`IF next_event_id = ? and range_id = ?`
+
+	templateUpdateSignalRequestedQuery = `UPDATE executions ` +
+		`SET signal_requested = signal_requested + ? ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ? and range_id = ?`
+
+	templateDeleteBufferedEventsQuery = `UPDATE executions ` +
+		`SET buffered_events_list = [] ` +
+		`WHERE shard_id = ? ` +
+		`and type = ? ` +
+		`and domain_id = ? ` +
+		`and workflow_id = ? ` +
+		`and run_id = ? ` +
+		`and task_id = ? ` +
+		`IF next_event_id = ? and range_id = ?`
 
 	templateDeleteWorkflowExecutionMutableStateQuery = `DELETE FROM executions ` +
 		`WHERE shard_id = ? ` +
@@ -661,6 +771,17 @@ func (d *cassandraPersistence) CreateWorkflowExecution(request *CreateWorkflowEx
 			}
 		}
 
+		if runID, ok := previous["current_run_id"].(gocql.UUID); ok && runID.String() != request.PreviousRunID {
+			// CreateWorkflowExecution failed because another run replaced the previous run
+			msg := fmt.Sprintf("Workflow execution already started. WorkflowId: %v, RunId: %v, PreviousRunId: %v, "+
+				"rangeID: %v, columns: (%v)", request.Execution.GetWorkflowId(), runID.String(), request.PreviousRunID,
+				request.RangeID, strings.Join(columns, ","))
+			return nil, &workflow.WorkflowExecutionAlreadyStartedError{
+				Message: common.StringPtr(msg),
+				RunId:   common.StringPtr(runID.String()),
+			}
+		}
+
 		return nil, &ConditionFailedError{
 			Msg: fmt.Sprintf("Failed to create workflow execution.  Request RangeID: %v, columns: (%v)",
 				request.RangeID, strings.Join(columns, ",")),
@@ -677,12 +798,29 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			request.Execution.GetRunId(),
 			request.Execution.GetRunId(),
 			request.RequestID,
+			WorkflowStateRunning,
+			WorkflowCloseStatusNone,
 			d.shardID,
 			rowTypeExecution,
 			request.DomainID,
 			request.Execution.GetWorkflowId(),
 			permanentRunID,
 			rowTypeExecutionTaskID)
+	} else if request.PreviousRunID != "" {
+		// Replace the closed run which is still recorded as the current execution
+		batch.Query(templateUpdateCurrentWorkflowExecutionForNewQuery,
+			request.Execution.GetRunId(),
+			request.Execution.GetRunId(),
+			request.RequestID,
+			WorkflowStateRunning,
+			WorkflowCloseStatusNone,
+			d.shardID,
+			rowTypeExecution,
+			request.DomainID,
+			request.Execution.GetWorkflowId(),
+			permanentRunID,
+			rowTypeExecutionTaskID,
+			request.PreviousRunID)
 	} else {
 		batch.Query(templateCreateWorkflowExecutionQuery,
 			d.shardID,
@@ -693,7 +831,9 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 			rowTypeExecutionTaskID,
 			request.Execution.GetRunId(),
 			request.Execution.GetRunId(),
-			request.RequestID)
+			request.RequestID,
+			WorkflowStateRunning,
+			WorkflowCloseStatusNone)
 	}
 
 	parentDomainID := emptyDomainID
@@ -736,8 +876,36 @@ func (d *cassandraPersistence) CreateWorkflowExecutionWithinBatch(request *Creat
 		request.DecisionStartedID,
 		"", // Decision Start Request ID
 		request.DecisionStartToCloseTimeout,
+		request.Attempt,
+		request.HasRetryPolicy,
+		request.InitialInterval,
+		request.BackoffCoefficient,
+		request.MaximumInterval,
+		request.MaximumAttempts,
+		request.ExpirationSeconds,
+		request.ExpirationTime,
+		request.NonRetriableErrors,
+		request.CronSchedule,
+		request.WorkflowTimeout,
+		"", // Sticky Task List
+		0,  // Sticky Schedule To Start Timeout
+		request.FirstDecisionBackoffTime,
+		request.Memo,
+		request.SearchAttributes,
+		0, // Decision Attempt
+		0, // Decision Timestamp
+		0, // Sticky Timer Schedule ID
+		0, // Sticky Timer Attempt
+		request.HistorySize,
+		request.CancelRequested,
+		request.CancelRequestID,
 		request.NextEventID,
 		rowTypeExecutionTaskID)
+
+	d.createActivityInfos(batch, request.ActivityInfos, request.DomainID, request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId())
+	d.createTimerInfos(batch, request.TimerInfos, request.DomainID, request.Execution.GetWorkflowId(),
+		request.Execution.GetRunId())
 }
 
 func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutionRequest) (
@@ -793,6 +961,29 @@ func (d *cassandraPersistence) GetWorkflowExecution(request *GetWorkflowExecutio
 	}
 	state.ChildExecutionInfos = childExecutionInfos
 
+	signalInfos := make(map[int64]*SignalInfo)
+	sMap := result["signal_map"].(map[int64]map[string]interface{})
+	for key, value := range sMap {
+		info := createSignalInfo(value)
+		signalInfos[key] = info
+	}
+	state.SignalInfos = signalInfos
+
+	signalRequestedIDs := make(map[string]struct{})
+	sList := result["signal_requested"].([]string)
+	for _, v := range sList {
+		signalRequestedIDs[v] = struct{}{}
+	}
+	state.SignalRequestedIDs = signalRequestedIDs
+
+	eList := result["buffered_events_list"].([]map[string]interface{})
+	bufferedEvents := make([]*SerializedHistoryEventBatch, 0, len(eList))
+	for _, v := range eList {
+		eventBatch := createSerializedHistoryEventBatch(v)
+		bufferedEvents = append(bufferedEvents, eventBatch)
+	}
+	state.BufferedEvents = bufferedEvents
+
 	return &GetWorkflowExecutionResponse{State: state}, nil
 }
 
@@ -825,6 +1016,29 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 		executionInfo.DecisionStartedID,
 		executionInfo.DecisionRequestID,
 		executionInfo.DecisionTimeout,
+		executionInfo.Attempt,
+		executionInfo.HasRetryPolicy,
+		executionInfo.InitialInterval,
+		executionInfo.BackoffCoefficient,
+		executionInfo.MaximumInterval,
+		executionInfo.MaximumAttempts,
+		executionInfo.ExpirationSeconds,
+		executionInfo.ExpirationTime,
+		executionInfo.NonRetriableErrors,
+		executionInfo.CronSchedule,
+		executionInfo.WorkflowTimeout,
+		executionInfo.StickyTaskList,
+		executionInfo.StickyScheduleToStartTimeout,
+		executionInfo.FirstDecisionBackoffTime,
+		executionInfo.Memo,
+		executionInfo.SearchAttributes,
+		executionInfo.DecisionAttempt,
+		executionInfo.DecisionTimestamp,
+		executionInfo.StickyTimerScheduleID,
+		executionInfo.StickyTimerAttempt,
+		executionInfo.HistorySize,
+		executionInfo.CancelRequested,
+		executionInfo.CancelRequestID,
 		executionInfo.NextEventID,
 		d.shardID,
 		rowTypeExecution,
@@ -850,14 +1064,31 @@ func (d *cassandraPersistence) UpdateWorkflowExecution(request *UpdateWorkflowEx
 	d.updateChildExecutionInfos(batch, request.UpsertChildExecutionInfos, request.DeleteChildExecutionInfo,
 		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
 
+	d.updateSignalInfos(batch, request.UpsertSignalInfos, request.DeleteSignalInfo,
+		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
+
+	d.updateSignalsRequested(batch, request.UpsertSignalRequestedIDs,
+		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
+
+	d.updateBufferedEvents(batch, request.NewBufferedEvents, request.ClearBufferedEvents,
+		executionInfo.DomainID, executionInfo.WorkflowID, executionInfo.RunID, request.Condition, request.RangeID)
+
 	if request.ContinueAsNew != nil {
 		startReq := request.ContinueAsNew
 		d.CreateWorkflowExecutionWithinBatch(startReq, batch, cqlNowTimestamp)
 		d.createTransferTasks(batch, startReq.TransferTasks, startReq.DomainID, startReq.Execution.GetWorkflowId(),
 			startReq.Execution.GetRunId(), cqlNowTimestamp)
+		d.createTimerTasks(batch, startReq.TimerTasks, nil, startReq.DomainID, startReq.Execution.GetWorkflowId(),
+			startReq.Execution.GetRunId(), cqlNowTimestamp)
 	} else if request.CloseExecution {
-		// Delete WorkflowExecution row representing current execution
-		batch.Query(templateDeleteWorkflowExecutionQuery,
+		// Keep the row representing current execution, but record how it was closed so the workflowId can be
+		// reused according to the policy of the next start request
+		batch.Query(templateUpdateCurrentWorkflowExecutionQuery,
+			executionInfo.RunID,
+			executionInfo.RunID,
+			executionInfo.CreateRequestID,
+			executionInfo.State,
+			executionInfo.CloseStatus,
 			d.shardID,
 			rowTypeExecution,
 			executionInfo.DomainID,
@@ -942,8 +1173,8 @@ func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionR
 		permanentRunID,
 		rowTypeExecutionTaskID)
 
-	var currentRunID string
-	if err := query.Scan(&currentRunID); err != nil {
+	result := make(map[string]interface{})
+	if err := query.MapScan(result); err != nil {
 		if err == gocql.ErrNotFound {
 			return nil, &workflow.EntityNotExistsError{
 				Message: fmt.Sprintf("Workflow execution not found.  WorkflowId: %v",
@@ -956,7 +1187,14 @@ func (d *cassandraPersistence) GetCurrentExecution(request *GetCurrentExecutionR
 		}
 	}
 
-	return &GetCurrentExecutionResponse{RunID: currentRunID}, nil
+	currentRunID := result["current_run_id"].(gocql.UUID).String()
+	execution := createWorkflowExecutionInfo(result["execution"].(map[string]interface{}))
+	return &GetCurrentExecutionResponse{
+		RunID:          currentRunID,
+		StartRequestID: execution.CreateRequestID,
+		State:          execution.State,
+		CloseStatus:    execution.CloseStatus,
+	}, nil
 }
 
 func (d *cassandraPersistence) GetTransferTasks(request *GetTransferTasksRequest) (*GetTransferTasksResponse, error) {
@@ -1165,7 +1403,8 @@ func (d *cassandraPersistence) CreateTasks(request *CreateTasksRequest) (*Create
 				domainID,
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
-				scheduleID)
+				scheduleID,
+				task.Data.ScheduleAttempt)
 		} else {
 			batch.Query(templateCreateTaskWithTTLQuery,
 				domainID,
@@ -1177,6 +1416,7 @@ func (d *cassandraPersistence) CreateTasks(request *CreateTasksRequest) (*Create
 				task.Execution.GetWorkflowId(),
 				task.Execution.GetRunId(),
 				scheduleID,
+				task.Data.ScheduleAttempt,
 				task.Data.ScheduleToStartTimeout)
 		}
 	}
@@ -1345,6 +1585,24 @@ func (d *cassandraPersistence) createTransferTasks(batch *gocql.Batch, transferT
 			targetDomainID = task.(*StartChildExecutionTask).TargetDomainID
 			targetWorkflowID = task.(*StartChildExecutionTask).TargetWorkflowID
 			scheduleID = task.(*StartChildExecutionTask).InitiatedID
+
+		case TransferTaskTypeSignalExecution:
+			targetDomainID = task.(*SignalExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*SignalExecutionTask).TargetWorkflowID
+			targetRunID = task.(*SignalExecutionTask).TargetRunID
+			scheduleID = task.(*SignalExecutionTask).InitiatedID
+
+		case TransferTaskTypeTerminateChildExecution:
+			targetDomainID = task.(*TerminateChildExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*TerminateChildExecutionTask).TargetWorkflowID
+			targetRunID = task.(*TerminateChildExecutionTask).TargetRunID
+			scheduleID = task.(*TerminateChildExecutionTask).InitiatedID
+
+		case TransferTaskTypeCancelChildExecution:
+			targetDomainID = task.(*CancelChildExecutionTask).TargetDomainID
+			targetWorkflowID = task.(*CancelChildExecutionTask).TargetWorkflowID
+			targetRunID = task.(*CancelChildExecutionTask).TargetRunID
+			scheduleID = task.(*CancelChildExecutionTask).InitiatedID
 		}
 
 		batch.Query(templateCreateTransferTaskQuery,
@@ -1372,19 +1630,27 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 
 	for _, task := range timerTasks {
 		var eventID int64
+		var attempt int64
 
 		timeoutType := 0
 
 		switch task.GetType() {
 		case TaskTypeDecisionTimeout:
 			eventID = task.(*DecisionTimeoutTask).EventID
+			timeoutType = task.(*DecisionTimeoutTask).TimeoutType
+			attempt = task.(*DecisionTimeoutTask).ScheduleAttempt
 
 		case TaskTypeActivityTimeout:
 			eventID = task.(*ActivityTimeoutTask).EventID
 			timeoutType = task.(*ActivityTimeoutTask).TimeoutType
+			attempt = task.(*ActivityTimeoutTask).Attempt
 
 		case TaskTypeUserTimer:
 			eventID = task.(*UserTimerTask).EventID
+
+		case TaskTypeActivityRetryTimer:
+			eventID = task.(*ActivityRetryTimerTask).EventID
+			attempt = task.(*ActivityRetryTimerTask).Attempt
 		}
 
 		batch.Query(templateCreateTimerTaskQuery,
@@ -1400,6 +1666,7 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 			task.GetType(),
 			timeoutType,
 			eventID,
+			attempt,
 			task.GetTaskID())
 	}
 
@@ -1414,26 +1681,27 @@ func (d *cassandraPersistence) createTimerTasks(batch *gocql.Batch, timerTasks [
 	}
 }
 
+// createActivityInfos writes the activities of an execution created within the batch.  The conditions of the batch
+// already guard the creation of the execution, so the writes are not conditional.
+func (d *cassandraPersistence) createActivityInfos(batch *gocql.Batch, activityInfos []*ActivityInfo,
+	domainID, workflowID, runID string) {
+
+	for _, a := range activityInfos {
+		batch.Query(templateCreateActivityInfoQuery, d.activityInfoArgs(a, domainID, workflowID, runID)...)
+	}
+}
+
 func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityInfos []*ActivityInfo, deleteInfo *int64,
 	domainID, workflowID, runID string, condition int64, rangeID int64) {
 
 	for _, a := range activityInfos {
 		batch.Query(templateUpdateActivityInfoQuery,
-			a.ScheduleID,
-			a.ScheduleID,
-			a.ScheduledEvent,
-			a.StartedID,
-			a.StartedEvent,
-			a.ActivityID,
-			a.RequestID,
-			a.Details,
-			a.ScheduleToStartTimeout,
-			a.ScheduleToCloseTimeout,
-			a.StartToCloseTimeout,
-			a.HeartbeatTimeout,
-			a.CancelRequested,
-			a.CancelRequestID,
-			a.LastHeartBeatUpdatedTime,
+			append(d.activityInfoArgs(a, domainID, workflowID, runID), condition, rangeID)...)
+	}
+
+	if deleteInfo != nil {
+		batch.Query(templateDeleteActivityInfoQuery,
+			*deleteInfo,
 			d.shardID,
 			rowTypeExecution,
 			domainID,
@@ -1443,18 +1711,59 @@ func (d *cassandraPersistence) updateActivityInfos(batch *gocql.Batch, activityI
 			condition,
 			rangeID)
 	}
+}
 
-	if deleteInfo != nil {
-		batch.Query(templateDeleteActivityInfoQuery,
-			*deleteInfo,
+func (d *cassandraPersistence) activityInfoArgs(a *ActivityInfo, domainID, workflowID, runID string) []interface{} {
+	return []interface{}{
+		a.ScheduleID,
+		a.ScheduleID,
+		a.ScheduledEvent,
+		a.StartedID,
+		a.StartedEvent,
+		a.ActivityID,
+		a.RequestID,
+		a.Details,
+		a.ScheduleToStartTimeout,
+		a.ScheduleToCloseTimeout,
+		a.StartToCloseTimeout,
+		a.HeartbeatTimeout,
+		a.CancelRequested,
+		a.CancelRequestID,
+		a.LastHeartBeatUpdatedTime,
+		a.Attempt,
+		a.HasRetryPolicy,
+		a.InitialInterval,
+		a.BackoffCoefficient,
+		a.MaximumInterval,
+		a.MaximumAttempts,
+		a.ExpirationTime,
+		a.NonRetriableErrors,
+		d.shardID,
+		rowTypeExecution,
+		domainID,
+		workflowID,
+		runID,
+		rowTypeExecutionTaskID,
+	}
+}
+
+// createTimerInfos writes the user timers of an execution created within the batch
+func (d *cassandraPersistence) createTimerInfos(batch *gocql.Batch, timerInfos []*TimerInfo,
+	domainID, workflowID, runID string) {
+
+	for _, a := range timerInfos {
+		batch.Query(templateCreateTimerInfoQuery,
+			a.TimerID,
+			a.TimerID,
+			a.StartedID,
+			a.ExpiryTime,
+			a.TaskID,
 			d.shardID,
 			rowTypeExecution,
 			domainID,
 			workflowID,
 			runID,
-			rowTypeExecutionTaskID,
-			condition,
-			rangeID)
+			rowTypeExecutionTaskID)
 	}
 }
 
@@ -1528,6 +1837,89 @@ func (d *cassandraPersistence) updateChildExecutionInfos(batch *gocql.Batch, chi
 	}
 }
 
+func (d *cassandraPersistence) updateSignalsRequested(batch *gocql.Batch, signalRequestedIDs []string,
+	domainID, workflowID, runID string, condition int64, rangeID int64) {
+
+	if len(signalRequestedIDs) > 0 {
+		batch.Query(templateUpdateSignalRequestedQuery,
+			signalRequestedIDs,
+			d.shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			rowTypeExecutionTaskID,
+			condition,
+			rangeID)
+	}
+}
+
+func (d *cassandraPersistence) updateSignalInfos(batch *gocql.Batch, signalInfos []*SignalInfo,
+	deleteInfo *int64, domainID, workflowID, runID string, condition int64, rangeID int64) {
+
+	for _, c := range signalInfos {
+		batch.Query(templateUpdateSignalInfoQuery,
+			c.InitiatedID,
+			c.InitiatedID,
+			c.SignalName,
+			c.Input,
+			c.Control,
+			d.shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			rowTypeExecutionTaskID,
+			condition,
+			rangeID)
+	}
+
+	// deleteInfo is the initiatedID for SignalInfo being deleted
+	if deleteInfo != nil {
+		batch.Query(templateDeleteSignalInfoQuery,
+			*deleteInfo,
+			d.shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			rowTypeExecutionTaskID,
+			condition,
+			rangeID)
+	}
+}
+
+func (d *cassandraPersistence) updateBufferedEvents(batch *gocql.Batch, newBufferedEvents *SerializedHistoryEventBatch,
+	clearBufferedEvents bool, domainID, workflowID, runID string, condition int64, rangeID int64) {
+
+	// Buffered events are cleared when they are flushed into the history, and no new ones are buffered by the
+	// same update
+	if clearBufferedEvents {
+		batch.Query(templateDeleteBufferedEventsQuery,
+			d.shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			rowTypeExecutionTaskID,
+			condition,
+			rangeID)
+	} else if newBufferedEvents != nil {
+		batch.Query(templateAppendBufferedEventsQuery,
+			newBufferedEvents.EncodingType,
+			newBufferedEvents.Version,
+			newBufferedEvents.Data,
+			d.shardID,
+			rowTypeExecution,
+			domainID,
+			workflowID,
+			runID,
+			rowTypeExecutionTaskID,
+			condition,
+			rangeID)
+	}
+}
+
 func createShardInfo(result map[string]interface{}) *ShardInfo {
 	info := &ShardInfo{}
 	for k, v := range result {
@@ -1600,6 +1992,52 @@ func createWorkflowExecutionInfo(result map[string]interface{}) *WorkflowExecuti
 			info.DecisionRequestID = v.(string)
 		case "decision_timeout":
 			info.DecisionTimeout = int32(v.(int))
+		case "attempt":
+			info.Attempt = int32(v.(int))
+		case "has_retry_policy":
+			info.HasRetryPolicy = v.(bool)
+		case "init_interval":
+			info.InitialInterval = int32(v.(int))
+		case "backoff_coefficient":
+			info.BackoffCoefficient = v.(float64)
+		case "max_interval":
+			info.MaximumInterval = int32(v.(int))
+		case "max_attempts":
+			info.MaximumAttempts = int32(v.(int))
+		case "expiration_seconds":
+			info.ExpirationSeconds = int32(v.(int))
+		case "expiration_time":
+			info.ExpirationTime = v.(time.Time)
+		case "non_retriable_errors":
+			info.NonRetriableErrors = v.([]string)
+		case "cron_schedule":
+			info.CronSchedule = v.(string)
+		case "workflow_timeout":
+			info.WorkflowTimeout = int32(v.(int))
+		case "sticky_task_list":
+			info.StickyTaskList = v.(string)
+		case "sticky_schedule_to_start_timeout":
+			info.StickyScheduleToStartTimeout = int32(v.(int))
+		case "first_decision_backoff_time":
+			info.FirstDecisionBackoffTime = v.(time.Time)
+		case "memo":
+			info.Memo = v.(map[string][]byte)
+		case "search_attributes":
+			info.SearchAttributes = v.(map[string][]byte)
+		case "decision_attempt":
+			info.DecisionAttempt = v.(int64)
+		case "decision_timestamp":
+			info.DecisionTimestamp = v.(int64)
+		case "sticky_timer_schedule_id":
+			info.StickyTimerScheduleID = v.(int64)
+		case "sticky_timer_attempt":
+			info.StickyTimerAttempt = v.(int64)
+		case "history_size":
+			info.HistorySize = v.(int64)
+		case "cancel_requested":
+			info.CancelRequested = v.(bool)
+		case "cancel_request_id":
+			info.CancelRequestID = v.(string)
 		}
 	}
 
@@ -1623,7 +2061,10 @@ func createTransferTaskInfo(result map[string]interface{}) *TransferTaskInfo {
 		case "target_workflow_id":
 			info.TargetWorkflowID = v.(string)
 		case "target_run_id":
-			info.TargetRunID = v.(gocql.UUID).String()
+			// An empty target run ID is written as null and targets the current run of the workflow
+			if runID := v.(gocql.UUID); runID != (gocql.UUID{}) {
+				info.TargetRunID = runID.String()
+			}
 		case "task_list":
 			info.TaskList = v.(string)
 		case "type":
@@ -1668,6 +2109,22 @@ func createActivityInfo(result map[string]interface{}) *ActivityInfo {
 			info.CancelRequestID = v.(int64)
 		case "last_hb_updated_time":
 			info.LastHeartBeatUpdatedTime = v.(time.Time)
+		case "attempt":
+			info.Attempt = int32(v.(int))
+		case "has_retry_policy":
+			info.HasRetryPolicy = v.(bool)
+		case "init_interval":
+			info.InitialInterval = int32(v.(int))
+		case "backoff_coefficient":
+			info.BackoffCoefficient = v.(float64)
+		case "max_interval":
+			info.MaximumInterval = int32(v.(int))
+		case "max_attempts":
+			info.MaximumAttempts = int32(v.(int))
+		case "expiration_time":
+			info.ExpirationTime = v.(time.Time)
+		case "non_retriable_errors":
+			info.NonRetriableErrors = v.([]string)
 		}
 	}
 
@@ -1711,6 +2168,40 @@ func createChildExecutionInfo(result map[string]interface{}) *ChildExecutionInfo
 	return info
 }
 
+func createSignalInfo(result map[string]interface{}) *SignalInfo {
+	info := &SignalInfo{}
+	for k, v := range result {
+		switch k {
+		case "initiated_id":
+			info.InitiatedID = v.(int64)
+		case "signal_name":
+			info.SignalName = v.(string)
+		case "input":
+			info.Input = v.([]byte)
+		case "control":
+			info.Control = v.([]byte)
+		}
+	}
+
+	return info
+}
+
+func createSerializedHistoryEventBatch(result map[string]interface{}) *SerializedHistoryEventBatch {
+	eventBatch := &SerializedHistoryEventBatch{}
+	for k, v := range result {
+		switch k {
+		case "encoding_type":
+			eventBatch.EncodingType = common.EncodingType(v.(string))
+		case "version":
+			eventBatch.Version = v.(int)
+		case "data":
+			eventBatch.Data = v.([]byte)
+		}
+	}
+
+	return eventBatch
+}
+
 func createTaskInfo(result map[string]interface{}) *TaskInfo {
 	info := &TaskInfo{}
 	for k, v := range result {
@@ -1723,6 +2214,8 @@ func createTaskInfo(result map[string]interface{}) *TaskInfo {
 			info.RunID = v.(gocql.UUID).String()
 		case "schedule_id":
 			info.ScheduleID = v.(int64)
+		case "schedule_attempt":
+			info.ScheduleAttempt = v.(int64)
 		}
 	}
 
@@ -1747,6 +2240,8 @@ func createTimerTaskInfo(result map[string]interface{}) *TimerTaskInfo {
 			info.TimeoutType = v.(int)
 		case "event_id":
 			info.EventID = v.(int64)
+		case "attempt":
+			info.Attempt = v.(int64)
 		}
 	}
 
@@ -183,6 +183,8 @@ func (s *cassandraPersistenceSuite) TestUpdateWorkflow() {
 	updatedInfo := copyWorkflowExecutionInfo(info0)
 	updatedInfo.NextEventID = int64(5)
 	updatedInfo.LastProcessedEvent = int64(2)
+	updatedInfo.CancelRequested = true
+	updatedInfo.CancelRequestID = "cancel-request-id"
 	err2 := s.UpdateWorkflowExecution(updatedInfo, []int64{int64(4)}, nil, int64(3), nil, nil, nil, nil, nil, nil)
 	s.Nil(err2, "No error expected.")
 
@@ -204,6 +206,8 @@ func (s *cassandraPersistenceSuite) TestUpdateWorkflow() {
 	s.Equal(int64(2), info1.DecisionScheduleID)
 	s.Equal(common.EmptyEventID, info1.DecisionStartedID)
 	s.Equal(int32(1), info1.DecisionTimeout)
+	s.True(info1.CancelRequested)
+	s.Equal("cancel-request-id", info1.CancelRequestID)
 
 	log.Infof("Workflow execution last updated: %v", info1.LastUpdatedTimestamp)
 
@@ -328,21 +332,45 @@ func (s *cassandraPersistenceSuite) TestGetCurrentWorkflow() {
 	updatedInfo1 := copyWorkflowExecutionInfo(info0.ExecutionInfo)
 	updatedInfo1.NextEventID = int64(6)
 	updatedInfo1.LastProcessedEvent = int64(2)
+	updatedInfo1.State = WorkflowStateCompleted
+	updatedInfo1.CloseStatus = WorkflowCloseStatusFailed
 	err3 := s.UpdateWorkflowExecutionAndDelete(updatedInfo1, int64(3))
 	s.Nil(err3, "No error expected.")
 
-	_, err4 := s.GetCurrentWorkflow(domainID, workflowExecution.GetWorkflowId())
-	s.NotNil(err4, "No error expected.")
-	s.IsType(&gen.EntityNotExistsError{}, err4)
+	current, err4 := s.WorkflowMgr.GetCurrentExecution(&GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: workflowExecution.GetWorkflowId(),
+	})
+	s.Nil(err4, "No error expected.")
+	s.Equal(workflowExecution.GetRunId(), current.RunID)
+	s.Equal(info0.ExecutionInfo.CreateRequestID, current.StartRequestID)
+	s.Equal(WorkflowStateCompleted, current.State)
+	s.Equal(WorkflowCloseStatusFailed, current.CloseStatus)
 
 	workflowExecution2 := gen.WorkflowExecution{
 		WorkflowId: common.StringPtr("get-current-workflow-test"),
 		RunId:      common.StringPtr("c3ff4bc6-de18-4643-83b2-037a33f45322"),
 	}
-	task1, err5 := s.CreateWorkflowExecution(domainID, workflowExecution2, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	_, err5 := s.CreateWorkflowExecution(domainID, workflowExecution2, "queue1", "wType", 13, nil, 3, 0, 2, nil)
+	s.NotNil(err5, "Expected workflow already started error.")
+	s.IsType(&gen.WorkflowExecutionAlreadyStartedError{}, err5)
+	s.Equal(workflowExecution.GetRunId(), err5.(*gen.WorkflowExecutionAlreadyStartedError).GetRunId())
+
+	task1, err5 := s.CreateWorkflowExecutionWithPreviousRun(domainID, workflowExecution2,
+		workflowExecution.GetRunId(), "queue1", "wType", 13, 3, 0, 2)
 	s.Nil(err5, "No error expected.")
 	s.NotEmpty(task1, "Expected non empty task identifier.")
 
+	workflowExecution3 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("get-current-workflow-test"),
+		RunId:      common.StringPtr("3a4e4a4c-5d8e-4f1b-9c0b-0f6a3d4e8b21"),
+	}
+	_, err7 := s.CreateWorkflowExecutionWithPreviousRun(domainID, workflowExecution3,
+		workflowExecution.GetRunId(), "queue1", "wType", 13, 3, 0, 2)
+	s.NotNil(err7, "Expected workflow already started error.")
+	s.IsType(&gen.WorkflowExecutionAlreadyStartedError{}, err7)
+	s.Equal(workflowExecution2.GetRunId(), err7.(*gen.WorkflowExecutionAlreadyStartedError).GetRunId())
+
 	runID1, err6 := s.GetCurrentWorkflow(domainID, workflowExecution2.GetWorkflowId())
 	s.Nil(err6, "No error expected.")
 	s.Equal(workflowExecution2.GetRunId(), runID1)
@@ -641,7 +669,7 @@ func (s *cassandraPersistenceSuite) TestTimerTasks() {
 	updatedInfo := copyWorkflowExecutionInfo(info0)
 	updatedInfo.NextEventID = int64(5)
 	updatedInfo.LastProcessedEvent = int64(2)
-	tasks := []Task{&DecisionTimeoutTask{1, 2}}
+	tasks := []Task{&DecisionTimeoutTask{TaskID: 1, EventID: 2}}
 	err2 := s.UpdateWorkflowExecution(updatedInfo, []int64{int64(4)}, nil, int64(3), tasks, nil, nil, nil, nil, nil)
 	s.Nil(err2, "No error expected.")
 
@@ -761,6 +789,54 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableState_Timers() {
 	s.Equal(0, len(state.TimerInfos))
 }
 
+func (s *cassandraPersistenceSuite) TestWorkflowMutableState_CreateWithActivitiesAndTimers() {
+	domainID := "3c4d2bc7-5dc5-4d2b-a1a4-a0b5f0b1b7d9"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow-mutable-create-test"),
+		RunId:      common.StringPtr("aaaaaaaa-aaaa-aaaa-aaaa-aaaaaaaaaaaa"),
+	}
+
+	currentTime := time.Now().UTC()
+	timerID := "id_1"
+	_, err0 := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		RequestID:            uuid.New(),
+		DomainID:             domainID,
+		Execution:            workflowExecution,
+		TaskList:             "taskList",
+		WorkflowTypeName:     "wType",
+		DecisionTimeoutValue: 13,
+		NextEventID:          5,
+		LastProcessedEvent:   2,
+		RangeID:              s.ShardContext.GetRangeID(),
+		ActivityInfos: []*ActivityInfo{{
+			ScheduleID:             3,
+			ScheduledEvent:         []byte("scheduled_event_3"),
+			StartedID:              common.EmptyEventID,
+			ScheduleToCloseTimeout: 1,
+			ScheduleToStartTimeout: 2,
+			StartToCloseTimeout:    3,
+			HeartbeatTimeout:       4,
+		}},
+		TimerInfos:                  []*TimerInfo{{TimerID: timerID, ExpiryTime: currentTime, TaskID: 2, StartedID: 4}},
+		DecisionScheduleID:          common.EmptyEventID,
+		DecisionStartedID:           common.EmptyEventID,
+		DecisionStartToCloseTimeout: 1,
+	})
+	s.Nil(err0, "No error expected.")
+
+	state, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.Equal(1, len(state.ActivitInfos))
+	ai, ok := state.ActivitInfos[3]
+	s.True(ok)
+	s.Equal([]byte("scheduled_event_3"), ai.ScheduledEvent)
+	s.Equal(common.EmptyEventID, ai.StartedID)
+	s.Equal(int32(2), ai.ScheduleToStartTimeout)
+	s.Equal(1, len(state.TimerInfos))
+	s.Equal(currentTime.Unix(), state.TimerInfos[timerID].ExpiryTime.Unix())
+	s.Equal(int64(4), state.TimerInfos[timerID].StartedID)
+}
+
 func (s *cassandraPersistenceSuite) TestWorkflowMutableState_ChildExecutions() {
 	domainID := "88236cd2-c439-4cec-9957-2748ce3be074"
 	workflowExecution := gen.WorkflowExecution{
@@ -824,6 +900,101 @@ func (s *cassandraPersistenceSuite) TestWorkflowMutableState_ChildExecutions() {
 	s.Equal(0, len(state.ChildExecutionInfos))
 }
 
+func (s *cassandraPersistenceSuite) TestWorkflowMutableState_SignalInfos() {
+	domainID := "1c8a0b7e-5d7a-4e0f-9f4e-4f6fd1b6a6e2"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow-mutable-signal-infos-test"),
+		RunId:      common.StringPtr("5b6c8e1e-6a3f-4f71-9c55-0c3a0d5b7f0a"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	info0 := state0.ExecutionInfo
+	s.NotNil(info0, "Valid Workflow info expected.")
+
+	updatedInfo := copyWorkflowExecutionInfo(info0)
+	updatedInfo.NextEventID = int64(5)
+	updatedInfo.LastProcessedEvent = int64(2)
+	signalInfos := []*SignalInfo{
+		{
+			InitiatedID: 1,
+			SignalName:  "signal_1",
+			Input:       []byte("input_1"),
+			Control:     []byte("control_1"),
+		}}
+	err2 := s.UpsertSignalInfoState(updatedInfo, int64(3), signalInfos)
+	s.Nil(err2, "No error expected.")
+
+	state, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.NotNil(state, "expected valid state.")
+	s.Equal(1, len(state.SignalInfos))
+	si, ok := state.SignalInfos[1]
+	s.True(ok)
+	s.NotNil(si)
+	s.Equal(int64(1), si.InitiatedID)
+	s.Equal("signal_1", si.SignalName)
+	s.Equal([]byte("input_1"), si.Input)
+	s.Equal([]byte("control_1"), si.Control)
+
+	err2 = s.DeleteSignalInfoState(updatedInfo, int64(5), int64(1))
+	s.Nil(err2, "No error expected.")
+
+	state, err1 = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.NotNil(state, "expected valid state.")
+	s.Equal(0, len(state.SignalInfos))
+}
+
+func (s *cassandraPersistenceSuite) TestWorkflowMutableState_BufferedEvents() {
+	domainID := "5d0f9e3c-8d4c-4b2a-9a0e-6d7b2f3e1c4a"
+	workflowExecution := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("test-workflow-mutable-buffered-events-test"),
+		RunId:      common.StringPtr("8e2b7a6d-3c1f-4e5a-b9d0-7f4c2a1e6b3d"),
+	}
+
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, "taskList", "wType", 13, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	state0, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	info0 := state0.ExecutionInfo
+	s.NotNil(info0, "Valid Workflow info expected.")
+	s.Equal(0, len(state0.BufferedEvents))
+
+	updatedInfo := copyWorkflowExecutionInfo(info0)
+	err2 := s.AppendBufferedEvents(updatedInfo, int64(3),
+		NewSerializedHistoryEventBatch([]byte("event1;event2"), common.EncodingTypeJSON, 1))
+	s.Nil(err2, "No error expected.")
+	err2 = s.AppendBufferedEvents(updatedInfo, int64(3),
+		NewSerializedHistoryEventBatch([]byte("event3"), common.EncodingTypeJSON, 1))
+	s.Nil(err2, "No error expected.")
+
+	state, err1 := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.NotNil(state, "expected valid state.")
+	s.Equal(2, len(state.BufferedEvents))
+	s.Equal([]byte("event1;event2"), state.BufferedEvents[0].Data)
+	s.Equal(common.EncodingTypeJSON, state.BufferedEvents[0].EncodingType)
+	s.Equal(1, state.BufferedEvents[0].Version)
+	s.Equal([]byte("event3"), state.BufferedEvents[1].Data)
+
+	updatedInfo.NextEventID = int64(6)
+	err2 = s.ClearBufferedEvents(updatedInfo, int64(3))
+	s.Nil(err2, "No error expected.")
+
+	state, err1 = s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err1, "No error expected.")
+	s.NotNil(state, "expected valid state.")
+	s.Equal(0, len(state.BufferedEvents))
+	s.Equal(int64(6), state.ExecutionInfo.NextEventID)
+}
+
 func (s *cassandraPersistenceSuite) TestWorkflowMutableStateInfo() {
 	domainID := "9ed8818b-3090-4160-9f21-c6b70e64d2dd"
 	workflowExecution := gen.WorkflowExecution{
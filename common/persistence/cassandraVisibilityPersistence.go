@@ -21,7 +21,9 @@
 package persistence
 
 import (
+	"encoding/json"
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/gocql/gocql"
@@ -39,8 +41,8 @@ const (
 
 const (
 	templateCreateWorkflowExecutionStarted = `INSERT INTO open_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name) ` +
-		`VALUES (?, ?, ?, ?, ?, ?)`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?)`
 
 	templateDeleteWorkflowExecutionStarted = `DELETE FROM open_executions ` +
 		`WHERE domain_id = ? ` +
@@ -49,24 +51,24 @@ const (
 		`AND run_id = ?`
 
 	templateCreateWorkflowExecutionClosed = `INSERT INTO closed_executions (` +
-		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status) ` +
-		`VALUES (?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
+		`domain_id, domain_partition, workflow_id, run_id, start_time, close_time, workflow_type_name, status, memo, search_attributes) ` +
+		`VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?) using TTL ?`
 
-	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutions = `SELECT workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status ` +
+	templateGetClosedWorkflowExecutions = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition IN (?) ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? `
 
-	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -74,7 +76,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status ` +
+	templateGetClosedWorkflowExecutionsByType = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -82,7 +84,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_type_name = ? `
 
-	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name ` +
+	templateGetOpenWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, workflow_type_name, memo, search_attributes ` +
 		`FROM open_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -90,7 +92,7 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status ` +
+	templateGetClosedWorkflowExecutionsByID = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
@@ -98,13 +100,33 @@ const (
 		`AND start_time <= ? ` +
 		`AND workflow_id = ? `
 
-	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status ` +
+	templateGetClosedWorkflowExecutionsByStatus = `SELECT workflow_id, run_id, start_time, close_time, workflow_type_name, status, memo, search_attributes ` +
 		`FROM closed_executions ` +
 		`WHERE domain_id = ? ` +
 		`AND domain_partition = ? ` +
 		`AND start_time >= ? ` +
 		`AND start_time <= ? ` +
 		`AND status = ? `
+
+	templateCountOpenWorkflowExecutions = `SELECT COUNT(*) ` +
+		`FROM open_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time >= ? ` +
+		`AND start_time <= ? `
+
+	templateCountClosedWorkflowExecutions = `SELECT COUNT(*) ` +
+		`FROM closed_executions ` +
+		`WHERE domain_id = ? ` +
+		`AND domain_partition = ? ` +
+		`AND start_time >= ? ` +
+		`AND start_time <= ? `
+
+	templateWorkflowIDCondition = `AND workflow_id = ? `
+
+	templateWorkflowTypeCondition = `AND workflow_type_name = ? `
+
+	templateCloseStatusCondition = `AND status = ? `
 )
 
 type (
@@ -113,6 +135,28 @@ type (
 		lowConslevel gocql.Consistency
 		logger       bark.Logger
 	}
+
+	// cassandraVisibilityQueryConditions are the parts of a visibility query which are pushed down to the indexes
+	// of the visibility tables, the whole query is still evaluated against every record read
+	cassandraVisibilityQueryConditions struct {
+		earliestStartTime int64
+		latestStartTime   int64
+		workflowID        *string
+		workflowTypeName  *string
+		closeStatus       *workflow.WorkflowExecutionCloseStatus
+		// openOnly and closedOnly are set when the query has a condition which closed or open executions never match
+		openOnly   bool
+		closedOnly bool
+		// exact is cleared when the query has a condition which the indexes can not answer
+		exact bool
+	}
+
+	// visibilityQueryPageToken is the page token of ListWorkflowExecutionsByQuery, which lists the open executions
+	// first and then the closed ones
+	visibilityQueryPageToken struct {
+		Closed    bool
+		PageState []byte
+	}
 )
 
 // NewCassandraVisibilityPersistence is used to create an instance of VisibilityManager implementation
@@ -142,8 +186,14 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionStarted(
 		request.Execution.GetRunId(),
 		common.UnixNanoToCQLTimestamp(request.StartTimestamp),
 		request.WorkflowTypeName,
+		request.Memo,
+		request.SearchAttributes,
 	)
-	query = query.WithTimestamp(common.UnixNanoToCQLTimestamp(request.StartTimestamp))
+	writeTimestamp := request.StartTimestamp
+	if request.UpdateTimestamp > writeTimestamp {
+		writeTimestamp = request.UpdateTimestamp
+	}
+	query = query.WithTimestamp(common.UnixNanoToCQLTimestamp(writeTimestamp))
 	err := query.Exec()
 	if err != nil {
 		return &workflow.InternalServiceError{
@@ -183,6 +233,8 @@ func (v *cassandraVisibilityPersistence) RecordWorkflowExecutionClosed(
 		common.UnixNanoToCQLTimestamp(request.CloseTimestamp),
 		request.WorkflowTypeName,
 		request.Status,
+		request.Memo,
+		request.SearchAttributes,
 		retention,
 	)
 
@@ -446,12 +498,352 @@ func (v *cassandraVisibilityPersistence) ListClosedWorkflowExecutionsByStatus(
 	return response, nil
 }
 
+func (v *cassandraVisibilityPersistence) ListWorkflowExecutionsByQuery(
+	request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error) {
+	conditions, err := getCassandraVisibilityQueryConditions(request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListWorkflowExecutionsResponse{}
+	response.Executions = make([]*workflow.WorkflowExecutionInfo, 0)
+	if conditions.openOnly && conditions.closedOnly {
+		return response, nil
+	}
+
+	token := &visibilityQueryPageToken{}
+	if len(request.NextPageToken) > 0 {
+		if err := json.Unmarshal(request.NextPageToken, token); err != nil {
+			return nil, &workflow.BadRequestError{Message: "Invalid NextPageToken for ListWorkflowExecutionsByQuery."}
+		}
+	}
+	if conditions.closedOnly {
+		token.Closed = true
+	}
+
+	// Only one page is read per request, so a page can come back short or even empty when the conditions which are not
+	// pushed down filter out its records, while the token still points to the rest of the executions
+	executions, pageState, err := v.listWorkflowExecutionsPageByQuery(request, conditions, token)
+	if err != nil {
+		return nil, err
+	}
+	response.Executions = append(response.Executions, executions...)
+
+	token.PageState = pageState
+	if len(pageState) == 0 {
+		if token.Closed || conditions.openOnly {
+			token = nil
+		} else {
+			token.Closed = true
+		}
+	}
+
+	if token != nil {
+		response.NextPageToken, err = json.Marshal(token)
+		if err != nil {
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("ListWorkflowExecutionsByQuery operation failed. Error: %v", err),
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (v *cassandraVisibilityPersistence) CountWorkflowExecutionsByQuery(
+	request *CountWorkflowExecutionsByQueryRequest) (*CountWorkflowExecutionsResponse, error) {
+	conditions, err := getCassandraVisibilityQueryConditions(request.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	// Conditions the indexes can not answer would have to be evaluated on every record of the domain
+	if !conditions.isExact() {
+		return nil, &workflow.BadRequestError{
+			Message: "Cassandra visibility store can only count executions by start time, execution status and one " +
+				"of WorkflowID, WorkflowType or CloseStatus.",
+		}
+	}
+
+	response := &CountWorkflowExecutionsResponse{}
+	if !conditions.closedOnly {
+		count, err := v.countWorkflowExecutionsByQuery(request, conditions, false)
+		if err != nil {
+			return nil, err
+		}
+		response.Count += count
+	}
+	if !conditions.openOnly {
+		count, err := v.countWorkflowExecutionsByQuery(request, conditions, true)
+		if err != nil {
+			return nil, err
+		}
+		response.Count += count
+	}
+
+	return response, nil
+}
+
+func (v *cassandraVisibilityPersistence) countWorkflowExecutionsByQuery(request *CountWorkflowExecutionsByQueryRequest,
+	conditions *cassandraVisibilityQueryConditions, closed bool) (int64, error) {
+	template, args := conditions.getCountQuery(request.DomainUUID, closed)
+	var count int64
+	query := v.session.Query(template, args...).Consistency(v.lowConslevel)
+	if err := query.Scan(&count); err != nil {
+		return 0, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("CountWorkflowExecutionsByQuery operation failed. Error: %v", err),
+		}
+	}
+	return count, nil
+}
+
+func (v *cassandraVisibilityPersistence) listWorkflowExecutionsPageByQuery(request *ListWorkflowExecutionsByQueryRequest,
+	conditions *cassandraVisibilityQueryConditions, token *visibilityQueryPageToken) (
+	[]*workflow.WorkflowExecutionInfo, []byte, error) {
+	template, args := conditions.getListQuery(request.DomainUUID, token.Closed)
+	query := v.session.Query(template, args...).Consistency(v.lowConslevel)
+	iter := query.PageSize(request.PageSize).PageState(token.PageState).Iter()
+	if iter == nil {
+		return nil, nil, &workflow.InternalServiceError{
+			Message: "ListWorkflowExecutionsByQuery operation failed.  Not able to create query iterator.",
+		}
+	}
+
+	readRecord := readOpenWorkflowExecutionRecord
+	if token.Closed {
+		readRecord = readClosedWorkflowExecutionRecord
+	}
+	executions := make([]*workflow.WorkflowExecutionInfo, 0)
+	wfexecution, has := readRecord(iter)
+	for has {
+		if request.Query == nil || request.Query.Matches(wfexecution) {
+			executions = append(executions, wfexecution)
+		}
+		wfexecution, has = readRecord(iter)
+	}
+
+	nextPageState := iter.PageState()
+	pageState := make([]byte, len(nextPageState))
+	copy(pageState, nextPageState)
+	if err := iter.Close(); err != nil {
+		return nil, nil, &workflow.InternalServiceError{
+			Message: fmt.Sprintf("ListWorkflowExecutionsByQuery operation failed. Error: %v", err),
+		}
+	}
+
+	return executions, pageState, nil
+}
+
+// getCassandraVisibilityQueryConditions picks the conditions of the query which can be answered by the indexes of the
+// visibility tables.  Only conjunctions can be pushed down, as Cassandra has no way to serve a disjunction other than
+// reading every record of the domain.
+func getCassandraVisibilityQueryConditions(query VisibilityQuery) (*cassandraVisibilityQueryConditions, error) {
+	conditions := &cassandraVisibilityQueryConditions{
+		earliestStartTime: 0,
+		latestStartTime:   math.MaxInt64,
+		exact:             true,
+	}
+
+	var addConditions func(query VisibilityQuery) error
+	addConditions = func(query VisibilityQuery) error {
+		switch q := query.(type) {
+		case nil:
+		case *VisibilityQueryAnd:
+			for _, operand := range q.Operands {
+				if err := addConditions(operand); err != nil {
+					return err
+				}
+			}
+		case *VisibilityQueryComparison:
+			conditions.addComparison(q)
+		default:
+			return &workflow.BadRequestError{
+				Message: "Cassandra visibility store only supports queries which are a conjunction of comparisons, " +
+					"OR is not supported.",
+			}
+		}
+		return nil
+	}
+
+	if err := addConditions(query); err != nil {
+		return nil, err
+	}
+	return conditions, nil
+}
+
+func (c *cassandraVisibilityQueryConditions) addComparison(comparison *VisibilityQueryComparison) {
+	isEqual := comparison.Operator == VisibilityQueryOperatorEqual
+	switch comparison.Field {
+	case VisibilityQueryFieldStartTime:
+		value, ok := comparison.Value.(int64)
+		if !ok {
+			c.exact = false
+			return
+		}
+		// Start times are stored with millisecond precision, so the bounds are rounded to whole milliseconds
+		switch comparison.Operator {
+		case VisibilityQueryOperatorEqual:
+			c.setEarliestStartTime(ceilToMillis(value))
+			c.setLatestStartTime(floorToMillis(value))
+		case VisibilityQueryOperatorGreater:
+			c.setEarliestStartTime(floorToMillis(value) + int64(time.Millisecond))
+		case VisibilityQueryOperatorGreaterOrEqual:
+			c.setEarliestStartTime(ceilToMillis(value))
+		case VisibilityQueryOperatorLess:
+			c.setLatestStartTime(ceilToMillis(value) - int64(time.Millisecond))
+		case VisibilityQueryOperatorLessOrEqual:
+			c.setLatestStartTime(floorToMillis(value))
+		default:
+			c.exact = false
+		}
+
+	case VisibilityQueryFieldWorkflowID:
+		value, ok := comparison.Value.(string)
+		if !ok || !isEqual || c.workflowID != nil {
+			c.exact = false
+			return
+		}
+		c.workflowID = common.StringPtr(value)
+
+	case VisibilityQueryFieldWorkflowType:
+		value, ok := comparison.Value.(string)
+		if !ok || !isEqual || c.workflowTypeName != nil {
+			c.exact = false
+			return
+		}
+		c.workflowTypeName = common.StringPtr(value)
+
+	case VisibilityQueryFieldCloseStatus:
+		c.closedOnly = true
+		value, ok := comparison.Value.(workflow.WorkflowExecutionCloseStatus)
+		if !ok || !isEqual || c.closeStatus != nil {
+			c.exact = false
+			return
+		}
+		c.closeStatus = workflow.WorkflowExecutionCloseStatusPtr(value)
+
+	case VisibilityQueryFieldExecutionStatus:
+		value, _ := comparison.Value.(string)
+		isOpen := value == VisibilityQueryExecutionStatusOpen
+		switch {
+		case value != VisibilityQueryExecutionStatusOpen && value != VisibilityQueryExecutionStatusClosed:
+			c.openOnly = true
+			c.closedOnly = true
+		case comparison.Operator == VisibilityQueryOperatorNotEqual:
+			c.openOnly = c.openOnly || !isOpen
+			c.closedOnly = c.closedOnly || isOpen
+		case isEqual:
+			c.openOnly = c.openOnly || isOpen
+			c.closedOnly = c.closedOnly || !isOpen
+		default:
+			c.exact = false
+		}
+
+	case VisibilityQueryFieldCloseTime:
+		c.closedOnly = true
+		c.exact = false
+
+	default:
+		c.exact = false
+	}
+}
+
+// isExact returns true if the records returned by the indexes all match the query, only one equality condition can be
+// pushed down along with the start time range
+func (c *cassandraVisibilityQueryConditions) isExact() bool {
+	equalities := 0
+	for _, isSet := range []bool{c.workflowID != nil, c.workflowTypeName != nil, c.closeStatus != nil} {
+		if isSet {
+			equalities++
+		}
+	}
+	return c.exact && equalities <= 1
+}
+
+func (c *cassandraVisibilityQueryConditions) getListQuery(domainUUID string, closed bool) (string, []interface{}) {
+	args := []interface{}{
+		domainUUID,
+		domainPartition,
+		common.UnixNanoToCQLTimestamp(c.earliestStartTime),
+		common.UnixNanoToCQLTimestamp(c.latestStartTime),
+	}
+	switch {
+	case c.workflowID != nil:
+		if closed {
+			return templateGetClosedWorkflowExecutionsByID, append(args, *c.workflowID)
+		}
+		return templateGetOpenWorkflowExecutionsByID, append(args, *c.workflowID)
+	case c.workflowTypeName != nil:
+		if closed {
+			return templateGetClosedWorkflowExecutionsByType, append(args, *c.workflowTypeName)
+		}
+		return templateGetOpenWorkflowExecutionsByType, append(args, *c.workflowTypeName)
+	case c.closeStatus != nil && closed:
+		return templateGetClosedWorkflowExecutionsByStatus, append(args, *c.closeStatus)
+	case closed:
+		return templateGetClosedWorkflowExecutions, args
+	default:
+		return templateGetOpenWorkflowExecutions, args
+	}
+}
+
+func (c *cassandraVisibilityQueryConditions) getCountQuery(domainUUID string, closed bool) (string, []interface{}) {
+	template := templateCountOpenWorkflowExecutions
+	if closed {
+		template = templateCountClosedWorkflowExecutions
+	}
+	args := []interface{}{
+		domainUUID,
+		domainPartition,
+		common.UnixNanoToCQLTimestamp(c.earliestStartTime),
+		common.UnixNanoToCQLTimestamp(c.latestStartTime),
+	}
+	switch {
+	case c.workflowID != nil:
+		return template + templateWorkflowIDCondition, append(args, *c.workflowID)
+	case c.workflowTypeName != nil:
+		return template + templateWorkflowTypeCondition, append(args, *c.workflowTypeName)
+	case c.closeStatus != nil && closed:
+		return template + templateCloseStatusCondition, append(args, *c.closeStatus)
+	default:
+		return template, args
+	}
+}
+
+func (c *cassandraVisibilityQueryConditions) setEarliestStartTime(earliestStartTime int64) {
+	if earliestStartTime > c.earliestStartTime {
+		c.earliestStartTime = earliestStartTime
+	}
+}
+
+func (c *cassandraVisibilityQueryConditions) setLatestStartTime(latestStartTime int64) {
+	if latestStartTime < c.latestStartTime {
+		c.latestStartTime = latestStartTime
+	}
+}
+
+// floorToMillis rounds the time in nanoseconds down to a whole millisecond
+func floorToMillis(nanos int64) int64 {
+	millis := nanos / int64(time.Millisecond)
+	if nanos%int64(time.Millisecond) < 0 {
+		millis--
+	}
+	return millis * int64(time.Millisecond)
+}
+
+// ceilToMillis rounds the time in nanoseconds up to a whole millisecond
+func ceilToMillis(nanos int64) int64 {
+	return -floorToMillis(-nanos)
+}
+
 func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecutionInfo, bool) {
 	var workflowID string
 	var runID gocql.UUID
 	var typeName string
 	var startTime time.Time
-	if iter.Scan(&workflowID, &runID, &startTime, &typeName) {
+	var memo map[string][]byte
+	var searchAttributes map[string][]byte
+	if iter.Scan(&workflowID, &runID, &startTime, &typeName, &memo, &searchAttributes) {
 		execution := workflow.NewWorkflowExecution()
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -463,6 +855,8 @@ func readOpenWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExecut
 		record.Execution = execution
 		record.StartTime = common.Int64Ptr(startTime.UnixNano())
 		record.Type = wfType
+		record.Memo = &workflow.Memo{Fields: memo}
+		record.SearchAttributes = &workflow.SearchAttributes{IndexedFields: searchAttributes}
 		return record, true
 	}
 	return nil, false
@@ -475,7 +869,9 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 	var startTime time.Time
 	var closeTime time.Time
 	var status workflow.WorkflowExecutionCloseStatus
-	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status) {
+	var memo map[string][]byte
+	var searchAttributes map[string][]byte
+	if iter.Scan(&workflowID, &runID, &startTime, &closeTime, &typeName, &status, &memo, &searchAttributes) {
 		execution := workflow.NewWorkflowExecution()
 		execution.WorkflowId = common.StringPtr(workflowID)
 		execution.RunId = common.StringPtr(runID.String())
@@ -489,6 +885,8 @@ func readClosedWorkflowExecutionRecord(iter *gocql.Iter) (*workflow.WorkflowExec
 		record.CloseTime = common.Int64Ptr(closeTime.UnixNano())
 		record.Type = wfType
 		record.CloseStatus = workflow.WorkflowExecutionCloseStatusPtr(status)
+		record.Memo = &workflow.Memo{Fields: memo}
+		record.SearchAttributes = &workflow.SearchAttributes{IndexedFields: searchAttributes}
 		return record, true
 	}
 	return nil, false
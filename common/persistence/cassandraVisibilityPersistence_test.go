@@ -404,3 +404,212 @@ func (s *visibilityPersistenceSuite) TestFilteringByCloseStatus() {
 	s.Equal(1, len(resp.Executions))
 	s.Equal(workflowExecution2.GetWorkflowId(), resp.Executions[0].Execution.GetWorkflowId())
 }
+
+func (s *visibilityPersistenceSuite) TestListWorkflowExecutionsByQuery() {
+	testDomainUUID := uuid.New()
+	startTime := time.Now().UnixNano()
+
+	// Start 3 executions, one of them of a different type, and close one of them as failed
+	workflowExecution1 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-query-test1"),
+		RunId:      common.StringPtr("fb15e4b5-356f-466d-8c6d-a29223e5c536"),
+	}
+	workflowExecution2 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-query-test2"),
+		RunId:      common.StringPtr("843f6fc7-102a-4c63-a2d4-7c653b01bf52"),
+	}
+	workflowExecution3 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-query-test3"),
+		RunId:      common.StringPtr("3f7e3d5c-6a6a-4f2a-9b3c-2a6c3ad1c1e4"),
+	}
+	for i, execution := range []gen.WorkflowExecution{workflowExecution1, workflowExecution2, workflowExecution3} {
+		typeName := "visibility-workflow"
+		if i == 2 {
+			typeName = "visibility-workflow-other"
+		}
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        execution,
+			WorkflowTypeName: typeName,
+			StartTimestamp:   startTime,
+			SearchAttributes: map[string][]byte{"CustomerId": []byte(`"customer1"`)},
+		})
+		s.Nil(err)
+	}
+
+	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatus_FAILED,
+		SearchAttributes: map[string][]byte{"CustomerId": []byte(`"customer1"`)},
+	})
+	s.Nil(err)
+
+	// Open and closed executions of a type
+	typeQuery := &VisibilityQueryAnd{Operands: []VisibilityQuery{
+		&VisibilityQueryComparison{
+			Field:    VisibilityQueryFieldWorkflowType,
+			Operator: VisibilityQueryOperatorEqual,
+			Value:    "visibility-workflow",
+		},
+		&VisibilityQueryComparison{
+			Field:    "CustomerId",
+			Operator: VisibilityQueryOperatorEqual,
+			Value:    "customer1",
+		},
+	}}
+	var executions []*gen.WorkflowExecutionInfo
+	var nextPageToken []byte
+	for {
+		resp, err := s.VisibilityMgr.ListWorkflowExecutionsByQuery(&ListWorkflowExecutionsByQueryRequest{
+			DomainUUID:    testDomainUUID,
+			Query:         typeQuery,
+			PageSize:      1,
+			NextPageToken: nextPageToken,
+		})
+		s.Nil(err)
+		// Pages filtered down to nothing still come back with a token for the rest of the executions
+		s.True(len(resp.Executions) <= 1)
+		executions = append(executions, resp.Executions...)
+		nextPageToken = resp.NextPageToken
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+	s.Equal(2, len(executions))
+	s.Equal(workflowExecution1.GetWorkflowId(), executions[0].Execution.GetWorkflowId())
+	s.Equal(workflowExecution2.GetWorkflowId(), executions[1].Execution.GetWorkflowId())
+	s.Equal([]byte(`"customer1"`), executions[1].SearchAttributes.IndexedFields["CustomerId"])
+
+	// Failed executions of a type started in the last hour only look at the closed executions
+	resp, err := s.VisibilityMgr.ListWorkflowExecutionsByQuery(&ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		Query: &VisibilityQueryAnd{Operands: []VisibilityQuery{
+			typeQuery,
+			&VisibilityQueryComparison{
+				Field:    VisibilityQueryFieldCloseStatus,
+				Operator: VisibilityQueryOperatorEqual,
+				Value:    gen.WorkflowExecutionCloseStatus_FAILED,
+			},
+			&VisibilityQueryComparison{
+				Field:    VisibilityQueryFieldStartTime,
+				Operator: VisibilityQueryOperatorGreater,
+				Value:    time.Unix(0, startTime).Add(-time.Hour).UnixNano(),
+			},
+		}},
+		PageSize: 10,
+	})
+	s.Nil(err)
+	s.Equal(1, len(resp.Executions))
+	s.Equal(workflowExecution2.GetWorkflowId(), resp.Executions[0].Execution.GetWorkflowId())
+	s.Empty(resp.NextPageToken)
+
+	// Disjunctions can not be pushed down to Cassandra
+	_, err = s.VisibilityMgr.ListWorkflowExecutionsByQuery(&ListWorkflowExecutionsByQueryRequest{
+		DomainUUID: testDomainUUID,
+		Query:      &VisibilityQueryOr{Operands: []VisibilityQuery{typeQuery, typeQuery}},
+		PageSize:   10,
+	})
+	s.IsType(&gen.BadRequestError{}, err)
+}
+
+func (s *visibilityPersistenceSuite) TestCountWorkflowExecutionsByQuery() {
+	testDomainUUID := uuid.New()
+	startTime := time.Now().UnixNano()
+
+	// Start 3 executions, one of them with a different search attribute, and close one of them
+	workflowExecution1 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-count-test1"),
+		RunId:      common.StringPtr("7bd3c4a6-7f5e-4bc2-9d1e-6f3b8b0c2a11"),
+	}
+	workflowExecution2 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-count-test2"),
+		RunId:      common.StringPtr("1c9e2f4d-3a5b-4e6c-8d7f-9a0b1c2d3e4f"),
+	}
+	workflowExecution3 := gen.WorkflowExecution{
+		WorkflowId: common.StringPtr("visibility-count-test3"),
+		RunId:      common.StringPtr("5e6f7a8b-9c0d-4e1f-a2b3-c4d5e6f7a8b9"),
+	}
+	for i, execution := range []gen.WorkflowExecution{workflowExecution1, workflowExecution2, workflowExecution3} {
+		customerID := []byte(`"customer1"`)
+		if i == 2 {
+			customerID = []byte(`"customer2"`)
+		}
+		err := s.VisibilityMgr.RecordWorkflowExecutionStarted(&RecordWorkflowExecutionStartedRequest{
+			DomainUUID:       testDomainUUID,
+			Execution:        execution,
+			WorkflowTypeName: "visibility-workflow",
+			StartTimestamp:   startTime,
+			SearchAttributes: map[string][]byte{"CustomerId": customerID},
+		})
+		s.Nil(err)
+	}
+
+	err := s.VisibilityMgr.RecordWorkflowExecutionClosed(&RecordWorkflowExecutionClosedRequest{
+		DomainUUID:       testDomainUUID,
+		Execution:        workflowExecution2,
+		WorkflowTypeName: "visibility-workflow",
+		StartTimestamp:   startTime,
+		CloseTimestamp:   time.Now().UnixNano(),
+		Status:           gen.WorkflowExecutionCloseStatus_COMPLETED,
+		SearchAttributes: map[string][]byte{"CustomerId": []byte(`"customer1"`)},
+	})
+	s.Nil(err)
+
+	count := func(query VisibilityQuery) int64 {
+		resp, err := s.VisibilityMgr.CountWorkflowExecutionsByQuery(&CountWorkflowExecutionsByQueryRequest{
+			DomainUUID: testDomainUUID,
+			Query:      query,
+		})
+		s.Nil(err)
+		return resp.Count
+	}
+	typeComparison := &VisibilityQueryComparison{
+		Field:    VisibilityQueryFieldWorkflowType,
+		Operator: VisibilityQueryOperatorEqual,
+		Value:    "visibility-workflow",
+	}
+	openComparison := &VisibilityQueryComparison{
+		Field:    VisibilityQueryFieldExecutionStatus,
+		Operator: VisibilityQueryOperatorEqual,
+		Value:    VisibilityQueryExecutionStatusOpen,
+	}
+	customerComparison := &VisibilityQueryComparison{
+		Field:    "CustomerId",
+		Operator: VisibilityQueryOperatorEqual,
+		Value:    "customer1",
+	}
+
+	// Counted by Cassandra
+	s.Equal(int64(3), count(nil))
+	s.Equal(int64(3), count(typeComparison))
+	s.Equal(int64(2), count(&VisibilityQueryAnd{Operands: []VisibilityQuery{typeComparison, openComparison}}))
+
+	// Start times are stored with millisecond precision, a bound within the same millisecond is not rounded onto it
+	storedStartTime := startTime / int64(time.Millisecond) * int64(time.Millisecond)
+	startTimeComparison := func(operator VisibilityQueryOperator, value int64) VisibilityQuery {
+		return &VisibilityQueryComparison{Field: VisibilityQueryFieldStartTime, Operator: operator, Value: value}
+	}
+	s.Equal(int64(3), count(startTimeComparison(VisibilityQueryOperatorEqual, storedStartTime)))
+	s.Equal(int64(0), count(startTimeComparison(VisibilityQueryOperatorEqual, storedStartTime+1)))
+	s.Equal(int64(0), count(startTimeComparison(VisibilityQueryOperatorGreater, storedStartTime)))
+	s.Equal(int64(3), count(startTimeComparison(VisibilityQueryOperatorGreater, storedStartTime-1)))
+	s.Equal(int64(0), count(startTimeComparison(VisibilityQueryOperatorLess, storedStartTime)))
+	s.Equal(int64(3), count(startTimeComparison(VisibilityQueryOperatorLess, storedStartTime+1)))
+
+	// Conditions which can not be pushed down to Cassandra are rejected rather than read from every record
+	for _, query := range []VisibilityQuery{
+		customerComparison,
+		&VisibilityQueryAnd{Operands: []VisibilityQuery{customerComparison, openComparison}},
+		&VisibilityQueryOr{Operands: []VisibilityQuery{typeComparison, openComparison}},
+	} {
+		_, err = s.VisibilityMgr.CountWorkflowExecutionsByQuery(&CountWorkflowExecutionsByQueryRequest{
+			DomainUUID: testDomainUUID,
+			Query:      query,
+		})
+		s.IsType(&gen.BadRequestError{}, err)
+	}
+}
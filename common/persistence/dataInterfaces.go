@@ -51,6 +51,8 @@ const (
 	WorkflowCloseStatusTerminated
 	WorkflowCloseStatusContinuedAsNew
 	WorkflowCloseStatusTimedOut
+	// WorkflowCloseStatusReset is a run terminated by a reset, the new run takes over its place with the parent
+	WorkflowCloseStatusReset
 )
 
 // Types of task lists
@@ -66,6 +68,10 @@ const (
 	TransferTaskTypeDeleteExecution
 	TransferTaskTypeCancelExecution
 	TransferTaskTypeStartChildExecution
+	TransferTaskTypeSignalExecution
+	TransferTaskTypeRecordWorkflowStarted
+	TransferTaskTypeTerminateChildExecution
+	TransferTaskTypeCancelChildExecution
 )
 
 // Types of timers
@@ -73,6 +79,9 @@ const (
 	TaskTypeDecisionTimeout = iota
 	TaskTypeActivityTimeout
 	TaskTypeUserTimer
+	TaskTypeWorkflowTimeout
+	TaskTypeActivityRetryTimer
+	TaskTypeWorkflowBackoffTimer
 )
 
 type (
@@ -132,6 +141,39 @@ type (
 		DecisionStartedID    int64
 		DecisionRequestID    string
 		DecisionTimeout      int32
+		Attempt              int32
+		HasRetryPolicy       bool
+		InitialInterval      int32
+		BackoffCoefficient   float64
+		MaximumInterval      int32
+		MaximumAttempts      int32
+		ExpirationSeconds    int32
+		ExpirationTime       time.Time
+		NonRetriableErrors   []string
+		CronSchedule         string
+		WorkflowTimeout      int32
+		// StickyTaskList is the worker specific task list the next decision is dispatched to, if set
+		StickyTaskList               string
+		StickyScheduleToStartTimeout int32
+		// FirstDecisionBackoffTime is the time the first decision of a run started with a backoff is held back until
+		FirstDecisionBackoffTime time.Time
+		Memo                     map[string][]byte
+		SearchAttributes         map[string][]byte
+		// DecisionAttempt counts the failures of the decision in a row, the retries after the first failure are
+		// transient and only written to the history once they complete
+		DecisionAttempt int64
+		// DecisionTimestamp is the time the transient decision started, in nanoseconds
+		DecisionTimestamp int64
+		// StickyTimerScheduleID and StickyTimerAttempt identify the decision the sticky schedule to start timer was
+		// created for, so that a retried dispatch of the decision does not create it again
+		StickyTimerScheduleID int64
+		StickyTimerAttempt    int64
+		// HistorySize is the total size of the serialized history events of the execution, in bytes
+		HistorySize int64
+		// CancelRequested is set once cancellation of the execution is requested, along with the ID of the request
+		// so that a retried request does not record it again
+		CancelRequested bool
+		CancelRequestID string
 	}
 
 	// TransferTaskInfo describes a transfer task
@@ -157,6 +199,7 @@ type (
 		TaskType    int
 		TimeoutType int
 		EventID     int64
+		Attempt     int64
 	}
 
 	// TaskListInfo describes a state of a task list implementation.
@@ -175,6 +218,7 @@ type (
 		RunID                  string
 		TaskID                 int64
 		ScheduleID             int64
+		ScheduleAttempt        int64
 		ScheduleToStartTimeout int32
 	}
 
@@ -206,10 +250,17 @@ type (
 		TaskID int64
 	}
 
+	// RecordWorkflowStartedTask identifies a transfer task to record a run started with a backoff in visibility
+	RecordWorkflowStartedTask struct {
+		TaskID int64
+	}
+
 	// DecisionTimeoutTask identifies a timeout task.
 	DecisionTimeoutTask struct {
-		TaskID  int64
-		EventID int64
+		TaskID          int64
+		EventID         int64
+		ScheduleAttempt int64
+		TimeoutType     int
 	}
 
 	// CancelExecutionTask identifies a transfer task for cancel of execution
@@ -229,11 +280,39 @@ type (
 		InitiatedID      int64
 	}
 
+	// SignalExecutionTask identifies a transfer task for signaling an external execution
+	SignalExecutionTask struct {
+		TaskID           int64
+		TargetDomainID   string
+		TargetWorkflowID string
+		TargetRunID      string
+		InitiatedID      int64
+	}
+
+	// TerminateChildExecutionTask identifies a transfer task for terminating a child execution on parent close
+	TerminateChildExecutionTask struct {
+		TaskID           int64
+		TargetDomainID   string
+		TargetWorkflowID string
+		TargetRunID      string
+		InitiatedID      int64
+	}
+
+	// CancelChildExecutionTask identifies a transfer task for cancelling a child execution on parent close
+	CancelChildExecutionTask struct {
+		TaskID           int64
+		TargetDomainID   string
+		TargetWorkflowID string
+		TargetRunID      string
+		InitiatedID      int64
+	}
+
 	// ActivityTimeoutTask identifies a timeout task.
 	ActivityTimeoutTask struct {
 		TaskID      int64
 		TimeoutType int
 		EventID     int64
+		Attempt     int64
 	}
 
 	// UserTimerTask identifies a timeout task.
@@ -242,12 +321,34 @@ type (
 		EventID int64
 	}
 
+	// WorkflowTimeoutTask identifies a timeout task.
+	WorkflowTimeoutTask struct {
+		TaskID int64
+	}
+
+	// ActivityRetryTimerTask identifies a timer task to reschedule a failed activity attempt.
+	ActivityRetryTimerTask struct {
+		TaskID  int64
+		EventID int64
+		Attempt int64
+	}
+
+	// WorkflowBackoffTimerTask identifies a timer task to schedule the first decision of a delayed run.
+	WorkflowBackoffTimerTask struct {
+		TaskID int64
+	}
+
 	// WorkflowMutableState indicates workflow related state
 	WorkflowMutableState struct {
 		ActivitInfos        map[int64]*ActivityInfo
 		TimerInfos          map[string]*TimerInfo
 		ChildExecutionInfos map[int64]*ChildExecutionInfo
+		SignalInfos         map[int64]*SignalInfo
+		SignalRequestedIDs  map[string]struct{}
 		ExecutionInfo       *WorkflowExecutionInfo
+		// BufferedEvents are the batches of events received while a decision was in flight, in the order they
+		// were received.  They are not part of the history yet and have no event IDs assigned.
+		BufferedEvents []*SerializedHistoryEventBatch
 	}
 
 	// ActivityInfo details.
@@ -266,6 +367,14 @@ type (
 		CancelRequested          bool
 		CancelRequestID          int64
 		LastHeartBeatUpdatedTime time.Time
+		Attempt                  int32
+		HasRetryPolicy           bool
+		InitialInterval          int32
+		BackoffCoefficient       float64
+		MaximumInterval          int32
+		MaximumAttempts          int32
+		ExpirationTime           time.Time
+		NonRetriableErrors       []string
 	}
 
 	// TimerInfo details - metadata about user timer info.
@@ -285,6 +394,14 @@ type (
 		CreateRequestID string
 	}
 
+	// SignalInfo has details for pending external workflow signals.
+	SignalInfo struct {
+		InitiatedID int64
+		SignalName  string
+		Input       []byte
+		Control     []byte
+	}
+
 	// CreateShardRequest is used to create a shard in executions table
 	CreateShardRequest struct {
 		ShardInfo *ShardInfo
@@ -322,11 +439,31 @@ type (
 		LastProcessedEvent          int64
 		TransferTasks               []Task
 		TimerTasks                  []Task
+		ActivityInfos               []*ActivityInfo
+		TimerInfos                  []*TimerInfo
 		RangeID                     int64
 		DecisionScheduleID          int64
 		DecisionStartedID           int64
 		DecisionStartToCloseTimeout int32
 		ContinueAsNew               bool
+		PreviousRunID               string
+		Attempt                     int32
+		HasRetryPolicy              bool
+		InitialInterval             int32
+		BackoffCoefficient          float64
+		MaximumInterval             int32
+		MaximumAttempts             int32
+		ExpirationSeconds           int32
+		ExpirationTime              time.Time
+		NonRetriableErrors          []string
+		CronSchedule                string
+		WorkflowTimeout             int32
+		FirstDecisionBackoffTime    time.Time
+		Memo                        map[string][]byte
+		SearchAttributes            map[string][]byte
+		HistorySize                 int64
+		CancelRequested             bool
+		CancelRequestID             string
 	}
 
 	// CreateWorkflowExecutionResponse is the response to CreateWorkflowExecutionRequest
@@ -353,7 +490,10 @@ type (
 
 	// GetCurrentExecutionResponse is the response to GetCurrentExecution
 	GetCurrentExecutionResponse struct {
-		RunID string
+		RunID          string
+		StartRequestID string
+		State          int
+		CloseStatus    int
 	}
 
 	// UpdateWorkflowExecutionRequest is used to update a workflow execution
@@ -374,6 +514,11 @@ type (
 		DeleteTimerInfos          []string
 		UpsertChildExecutionInfos []*ChildExecutionInfo
 		DeleteChildExecutionInfo  *int64
+		UpsertSignalInfos         []*SignalInfo
+		DeleteSignalInfo          *int64
+		UpsertSignalRequestedIDs  []string
+		NewBufferedEvents         *SerializedHistoryEventBatch
+		ClearBufferedEvents       bool
 	}
 
 	// DeleteWorkflowExecutionRequest is used to delete a workflow execution
@@ -507,6 +652,8 @@ type (
 	GetWorkflowExecutionHistoryRequest struct {
 		DomainID  string
 		Execution workflow.WorkflowExecution
+		// Get the history events from FirstEventID.  Inclusive.
+		FirstEventID int64
 		// Get the history events upto NextEventID.  Not Inclusive.
 		NextEventID int64
 		// Maximum number of history append transactions per page
@@ -543,6 +690,22 @@ type (
 	DomainConfig struct {
 		Retention  int32
 		EmitMetric bool
+		// Limits on the history and the pending activities, timers and children of each execution.  Crossing a warn
+		// limit is logged, crossing an error limit terminates the execution.  Zero uses the service default.
+		HistorySizeLimitWarn   int32
+		HistorySizeLimitError  int32
+		HistoryCountLimitWarn  int32
+		HistoryCountLimitError int32
+		PendingLimitWarn       int32
+		PendingLimitError      int32
+		// Limits on the size of each input, result and details payload.  Crossing a warn limit is logged, crossing an
+		// error limit rejects the request or fails the decision.  Zero uses the service default.
+		BlobSizeLimitWarn  int32
+		BlobSizeLimitError int32
+		// Requests per second the frontend accepts from the domain for task polls and for starts, signals and other
+		// workflow mutations.  Zero uses the service default.
+		PollRateLimit     int32
+		MutationRateLimit int32
 	}
 
 	// CreateDomainRequest is used to create the domain
@@ -704,6 +867,21 @@ func (a *DeleteExecutionTask) SetTaskID(id int64) {
 	a.TaskID = id
 }
 
+// GetType returns the type of the record workflow started task
+func (r *RecordWorkflowStartedTask) GetType() int {
+	return TransferTaskTypeRecordWorkflowStarted
+}
+
+// GetTaskID returns the sequence ID of the record workflow started task
+func (r *RecordWorkflowStartedTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID of the record workflow started task
+func (r *RecordWorkflowStartedTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
 // GetType returns the type of the timer task
 func (d *DecisionTimeoutTask) GetType() int {
 	return TaskTypeDecisionTimeout
@@ -749,6 +927,51 @@ func (u *UserTimerTask) SetTaskID(id int64) {
 	u.TaskID = id
 }
 
+// GetType returns the type of the timer task
+func (u *WorkflowTimeoutTask) GetType() int {
+	return TaskTypeWorkflowTimeout
+}
+
+// GetTaskID returns the sequence ID of the timer task.
+func (u *WorkflowTimeoutTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the timer task.
+func (u *WorkflowTimeoutTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetType returns the type of the timer task
+func (r *ActivityRetryTimerTask) GetType() int {
+	return TaskTypeActivityRetryTimer
+}
+
+// GetTaskID returns the sequence ID of the timer task.
+func (r *ActivityRetryTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID of the timer task.
+func (r *ActivityRetryTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
+// GetType returns the type of the timer task
+func (r *WorkflowBackoffTimerTask) GetType() int {
+	return TaskTypeWorkflowBackoffTimer
+}
+
+// GetTaskID returns the sequence ID of the timer task.
+func (r *WorkflowBackoffTimerTask) GetTaskID() int64 {
+	return r.TaskID
+}
+
+// SetTaskID sets the sequence ID of the timer task.
+func (r *WorkflowBackoffTimerTask) SetTaskID(id int64) {
+	r.TaskID = id
+}
+
 // GetType returns the type of the cancel transfer task
 func (u *CancelExecutionTask) GetType() int {
 	return TransferTaskTypeCancelExecution
@@ -779,6 +1002,51 @@ func (u *StartChildExecutionTask) SetTaskID(id int64) {
 	u.TaskID = id
 }
 
+// GetType returns the type of the signal transfer task
+func (u *SignalExecutionTask) GetType() int {
+	return TransferTaskTypeSignalExecution
+}
+
+// GetTaskID returns the sequence ID of the signal transfer task.
+func (u *SignalExecutionTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the signal transfer task.
+func (u *SignalExecutionTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetType returns the type of the terminate child transfer task
+func (u *TerminateChildExecutionTask) GetType() int {
+	return TransferTaskTypeTerminateChildExecution
+}
+
+// GetTaskID returns the sequence ID of the terminate child transfer task.
+func (u *TerminateChildExecutionTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the terminate child transfer task.
+func (u *TerminateChildExecutionTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
+// GetType returns the type of the cancel child transfer task
+func (u *CancelChildExecutionTask) GetType() int {
+	return TransferTaskTypeCancelChildExecution
+}
+
+// GetTaskID returns the sequence ID of the cancel child transfer task.
+func (u *CancelChildExecutionTask) GetTaskID() int64 {
+	return u.TaskID
+}
+
+// SetTaskID sets the sequence ID of the cancel child transfer task.
+func (u *CancelChildExecutionTask) SetTaskID(id int64) {
+	u.TaskID = id
+}
+
 // NewHistoryEventBatch returns a new instance of HistoryEventBatch
 func NewHistoryEventBatch(version int, events []*workflow.HistoryEvent) *HistoryEventBatch {
 	return &HistoryEventBatch{
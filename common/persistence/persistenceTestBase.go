@@ -310,6 +310,41 @@ func (s *TestBase) CreateWorkflowExecution(domainID string, workflowExecution wo
 	return response.TaskID, nil
 }
 
+// CreateWorkflowExecutionWithPreviousRun is a utility method to create a workflow execution replacing a closed run
+func (s *TestBase) CreateWorkflowExecutionWithPreviousRun(domainID string, workflowExecution workflow.WorkflowExecution,
+	previousRunID, taskList, wType string, decisionTimeout int32, nextEventID int64, lastProcessedEventID int64,
+	decisionScheduleID int64) (string, error) {
+	response, err := s.WorkflowMgr.CreateWorkflowExecution(&CreateWorkflowExecutionRequest{
+		RequestID:            uuid.New(),
+		DomainID:             domainID,
+		Execution:            workflowExecution,
+		PreviousRunID:        previousRunID,
+		TaskList:             taskList,
+		WorkflowTypeName:     wType,
+		DecisionTimeoutValue: decisionTimeout,
+		NextEventID:          nextEventID,
+		LastProcessedEvent:   lastProcessedEventID,
+		RangeID:              s.ShardContext.GetRangeID(),
+		TransferTasks: []Task{
+			&DecisionTask{
+				TaskID:     s.GetNextSequenceNumber(),
+				DomainID:   domainID,
+				TaskList:   taskList,
+				ScheduleID: decisionScheduleID,
+			},
+		},
+		DecisionScheduleID:          decisionScheduleID,
+		DecisionStartedID:           common.EmptyEventID,
+		DecisionStartToCloseTimeout: 1,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	return response.TaskID, nil
+}
+
 // CreateWorkflowExecutionManyTasks is a utility method to create workflow executions
 func (s *TestBase) CreateWorkflowExecutionManyTasks(domainID string, workflowExecution workflow.WorkflowExecution,
 	taskList string, executionContext []byte, nextEventID int64, lastProcessedEventID int64,
@@ -513,6 +548,49 @@ func (s *TestBase) DeleteChildExecutionsState(updatedInfo *WorkflowExecutionInfo
 		nil, nil, nil, &deleteChildInfo)
 }
 
+// UpsertSignalInfoState is a utility method to update mutable state of workflow execution
+func (s *TestBase) UpsertSignalInfoState(updatedInfo *WorkflowExecutionInfo, condition int64,
+	upsertSignalInfos []*SignalInfo) error {
+	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:     updatedInfo,
+		Condition:         condition,
+		RangeID:           s.ShardContext.GetRangeID(),
+		UpsertSignalInfos: upsertSignalInfos,
+	})
+}
+
+// DeleteSignalInfoState is a utility method to delete signal info from mutable state
+func (s *TestBase) DeleteSignalInfoState(updatedInfo *WorkflowExecutionInfo, condition int64,
+	deleteSignalInfo int64) error {
+	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:    updatedInfo,
+		Condition:        condition,
+		RangeID:          s.ShardContext.GetRangeID(),
+		DeleteSignalInfo: &deleteSignalInfo,
+	})
+}
+
+// AppendBufferedEvents is a utility method to buffer a batch of events in mutable state of workflow execution
+func (s *TestBase) AppendBufferedEvents(updatedInfo *WorkflowExecutionInfo, condition int64,
+	newBufferedEvents *SerializedHistoryEventBatch) error {
+	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:     updatedInfo,
+		Condition:         condition,
+		RangeID:           s.ShardContext.GetRangeID(),
+		NewBufferedEvents: newBufferedEvents,
+	})
+}
+
+// ClearBufferedEvents is a utility method to delete the buffered events from mutable state of workflow execution
+func (s *TestBase) ClearBufferedEvents(updatedInfo *WorkflowExecutionInfo, condition int64) error {
+	return s.WorkflowMgr.UpdateWorkflowExecution(&UpdateWorkflowExecutionRequest{
+		ExecutionInfo:       updatedInfo,
+		Condition:           condition,
+		RangeID:             s.ShardContext.GetRangeID(),
+		ClearBufferedEvents: true,
+	})
+}
+
 // UpdateWorkflowExecutionWithRangeID is a utility method to update workflow execution
 func (s *TestBase) UpdateWorkflowExecutionWithRangeID(updatedInfo *WorkflowExecutionInfo, decisionScheduleIDs []int64,
 	activityScheduleIDs []int64, rangeID, condition int64, timerTasks []Task, deleteTimerTask Task,
@@ -36,6 +36,11 @@ type (
 		Execution        s.WorkflowExecution
 		WorkflowTypeName string
 		StartTimestamp   int64
+		// UpdateTimestamp is set when an already recorded execution is recorded again with new search
+		// attributes, the update wins over the original record but not over the record of its close
+		UpdateTimestamp  int64
+		Memo             map[string][]byte
+		SearchAttributes map[string][]byte
 	}
 
 	// RecordWorkflowExecutionClosedRequest is used to add a record of a newly
@@ -48,6 +53,8 @@ type (
 		CloseTimestamp   int64
 		Status           s.WorkflowExecutionCloseStatus
 		RetentionSeconds int64
+		Memo             map[string][]byte
+		SearchAttributes map[string][]byte
 	}
 
 	// ListWorkflowExecutionsRequest is used to list executions in a domain
@@ -91,6 +98,33 @@ type (
 		Status s.WorkflowExecutionCloseStatus
 	}
 
+	// ListWorkflowExecutionsByQueryRequest is used to list both open and closed executions in a domain which match
+	// a query
+	ListWorkflowExecutionsByQueryRequest struct {
+		DomainUUID string
+		// Query is nil to list all the executions of the domain
+		Query VisibilityQuery
+		// Maximum number of workflow executions per page.  A page can hold fewer executions, or none at all, while
+		// there are more to read.
+		PageSize int
+		// Token to continue reading next page of workflow executions.
+		// Pass in empty slice for first page.
+		NextPageToken []byte
+	}
+
+	// CountWorkflowExecutionsByQueryRequest is used to count both open and closed executions in a domain which match
+	// a query
+	CountWorkflowExecutionsByQueryRequest struct {
+		DomainUUID string
+		// Query is nil to count all the executions of the domain
+		Query VisibilityQuery
+	}
+
+	// CountWorkflowExecutionsResponse is the response to CountWorkflowExecutionsByQueryRequest
+	CountWorkflowExecutionsResponse struct {
+		Count int64
+	}
+
 	// VisibilityManager is used to manage the visibility store
 	VisibilityManager interface {
 		RecordWorkflowExecutionStarted(request *RecordWorkflowExecutionStartedRequest) error
@@ -102,5 +136,7 @@ type (
 		ListOpenWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByWorkflowID(request *ListWorkflowExecutionsByWorkflowIDRequest) (*ListWorkflowExecutionsResponse, error)
 		ListClosedWorkflowExecutionsByStatus(request *ListClosedWorkflowExecutionsByStatusRequest) (*ListWorkflowExecutionsResponse, error)
+		ListWorkflowExecutionsByQuery(request *ListWorkflowExecutionsByQueryRequest) (*ListWorkflowExecutionsResponse, error)
+		CountWorkflowExecutionsByQuery(request *CountWorkflowExecutionsByQueryRequest) (*CountWorkflowExecutionsResponse, error)
 	}
 )
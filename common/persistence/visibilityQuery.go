@@ -0,0 +1,213 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package persistence
+
+import (
+	"encoding/json"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+)
+
+// Fields of the visibility record which can be used in a query.  Any other field name refers to a search attribute.
+const (
+	VisibilityQueryFieldWorkflowID   = "WorkflowId"
+	VisibilityQueryFieldWorkflowType = "WorkflowType"
+	VisibilityQueryFieldCloseStatus  = "CloseStatus"
+	VisibilityQueryFieldStartTime    = "StartTime"
+	VisibilityQueryFieldCloseTime    = "CloseTime"
+	// VisibilityQueryFieldExecutionStatus is either OPEN or CLOSED
+	VisibilityQueryFieldExecutionStatus = "ExecutionStatus"
+)
+
+// Values of the ExecutionStatus field of the visibility record
+const (
+	VisibilityQueryExecutionStatusOpen   = "OPEN"
+	VisibilityQueryExecutionStatusClosed = "CLOSED"
+)
+
+// Comparison operators supported by visibility queries
+const (
+	VisibilityQueryOperatorEqual VisibilityQueryOperator = iota
+	VisibilityQueryOperatorNotEqual
+	VisibilityQueryOperatorLess
+	VisibilityQueryOperatorLessOrEqual
+	VisibilityQueryOperatorGreater
+	VisibilityQueryOperatorGreaterOrEqual
+)
+
+type (
+	// VisibilityQueryOperator is the operator of a comparison in a visibility query
+	VisibilityQueryOperator int
+
+	// VisibilityQuery is a filter over the visibility records of a domain.  It is built by the frontend from the
+	// query of a ListWorkflowExecutions request, and visibility stores push down as much of it as they can and use
+	// Matches for the rest.
+	VisibilityQuery interface {
+		// Matches evaluates the query against the visibility record of an execution
+		Matches(info *s.WorkflowExecutionInfo) bool
+	}
+
+	// VisibilityQueryAnd matches the executions which match all of its operands
+	VisibilityQueryAnd struct {
+		Operands []VisibilityQuery
+	}
+
+	// VisibilityQueryOr matches the executions which match any of its operands
+	VisibilityQueryOr struct {
+		Operands []VisibilityQuery
+	}
+
+	// VisibilityQueryComparison compares a field of the visibility record with a value.  The value is a string for
+	// WorkflowId, WorkflowType and ExecutionStatus, a WorkflowExecutionCloseStatus for CloseStatus, the unix time in
+	// nanoseconds for StartTime and CloseTime, and a string, float64 or bool for search attributes.  Open executions
+	// never match a comparison on CloseStatus or CloseTime, and executions never match a comparison on a search
+	// attribute they do not have or which has a value of a different type.
+	VisibilityQueryComparison struct {
+		Field    string
+		Operator VisibilityQueryOperator
+		Value    interface{}
+	}
+)
+
+// Matches returns true if the execution matches all of the operands
+func (q *VisibilityQueryAnd) Matches(info *s.WorkflowExecutionInfo) bool {
+	for _, operand := range q.Operands {
+		if !operand.Matches(info) {
+			return false
+		}
+	}
+	return true
+}
+
+// Matches returns true if the execution matches any of the operands
+func (q *VisibilityQueryOr) Matches(info *s.WorkflowExecutionInfo) bool {
+	for _, operand := range q.Operands {
+		if operand.Matches(info) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the field of the execution compares with the value as the operator requires
+func (q *VisibilityQueryComparison) Matches(info *s.WorkflowExecutionInfo) bool {
+	switch q.Field {
+	case VisibilityQueryFieldWorkflowID:
+		return q.compare(info.GetExecution().GetWorkflowId())
+	case VisibilityQueryFieldWorkflowType:
+		return q.compare(info.GetType().GetName())
+	case VisibilityQueryFieldCloseStatus:
+		return info.IsSetCloseStatus() && q.compare(info.GetCloseStatus())
+	case VisibilityQueryFieldStartTime:
+		return q.compare(info.GetStartTime())
+	case VisibilityQueryFieldCloseTime:
+		return info.IsSetCloseTime() && q.compare(info.GetCloseTime())
+	case VisibilityQueryFieldExecutionStatus:
+		if info.IsSetCloseStatus() {
+			return q.compare(VisibilityQueryExecutionStatusClosed)
+		}
+		return q.compare(VisibilityQueryExecutionStatusOpen)
+	}
+
+	if !info.IsSetSearchAttributes() {
+		return false
+	}
+	encoded, ok := info.GetSearchAttributes().GetIndexedFields()[q.Field]
+	if !ok {
+		return false
+	}
+	var value interface{}
+	if err := json.Unmarshal(encoded, &value); err != nil {
+		return false
+	}
+	return q.compare(value)
+}
+
+func (q *VisibilityQueryComparison) compare(value interface{}) bool {
+	var cmp int
+	switch v := value.(type) {
+	case string:
+		expected, ok := q.Value.(string)
+		if !ok {
+			return false
+		}
+		cmp = compareOrdered(v < expected, v > expected)
+	case int64:
+		expected, ok := q.Value.(int64)
+		if !ok {
+			return false
+		}
+		cmp = compareOrdered(v < expected, v > expected)
+	case float64:
+		expected, ok := q.Value.(float64)
+		if !ok {
+			return false
+		}
+		cmp = compareOrdered(v < expected, v > expected)
+	case s.WorkflowExecutionCloseStatus:
+		expected, ok := q.Value.(s.WorkflowExecutionCloseStatus)
+		if !ok {
+			return false
+		}
+		cmp = compareOrdered(v < expected, v > expected)
+	case bool:
+		expected, ok := q.Value.(bool)
+		if !ok {
+			return false
+		}
+		// Bools only have an equality
+		switch q.Operator {
+		case VisibilityQueryOperatorEqual:
+			return v == expected
+		case VisibilityQueryOperatorNotEqual:
+			return v != expected
+		}
+		return false
+	default:
+		return false
+	}
+
+	switch q.Operator {
+	case VisibilityQueryOperatorEqual:
+		return cmp == 0
+	case VisibilityQueryOperatorNotEqual:
+		return cmp != 0
+	case VisibilityQueryOperatorLess:
+		return cmp < 0
+	case VisibilityQueryOperatorLessOrEqual:
+		return cmp <= 0
+	case VisibilityQueryOperatorGreater:
+		return cmp > 0
+	case VisibilityQueryOperatorGreaterOrEqual:
+		return cmp >= 0
+	}
+	return false
+}
+
+func compareOrdered(less, greater bool) int {
+	switch {
+	case less:
+		return -1
+	case greater:
+		return 1
+	}
+	return 0
+}
@@ -44,6 +44,10 @@ type (
 		// tokens were acquired before timeout, false
 		// otherwise
 		Consume(count int, timeout time.Duration) bool
+		// Return puts back count tokens taken by TryConsume
+		// which ended up unused. The bucket never holds more
+		// than the tokens of one refill
+		Return(count int)
 	}
 
 	tokenBucketFactoryImpl struct{}
@@ -158,6 +162,19 @@ func (tb *tokenBucketImpl) Consume(count int, timeout time.Duration) bool {
 	}
 }
 
+func (tb *tokenBucketImpl) Return(count int) {
+	maxTokens := tb.fillRate
+	if tb.overflowRps > 0 {
+		maxTokens++
+	}
+	tb.Lock()
+	tb.tokens += count
+	if tb.tokens > maxTokens {
+		tb.tokens = maxTokens
+	}
+	tb.Unlock()
+}
+
 func (tb *tokenBucketImpl) refill(now int64) {
 	tb.refillOverFlow(now)
 	if tb.isRefillDue(now) {
@@ -104,3 +104,23 @@ func (s *TokenBucketSuite) TestLowRpsEnforced() {
 	s.Equal(3, total, "Token bucket failed to enforce limit")
 	s.Equal(3, attempts, "Token bucket gave out tokens too quickly")
 }
+
+func (s *TokenBucketSuite) TestReturn() {
+	ts := &mockTimeSource{currTime: time.Now()}
+	tb := NewTokenBucket(100, ts)
+	ok, _ := tb.TryConsume(10)
+	s.True(ok)
+	ok, _ = tb.TryConsume(1)
+	s.False(ok)
+
+	tb.Return(2)
+	ok, _ = tb.TryConsume(2)
+	s.True(ok)
+
+	// Returned tokens never exceed the tokens of one refill
+	tb.Return(20)
+	ok, _ = tb.TryConsume(11)
+	s.False(ok)
+	ok, _ = tb.TryConsume(10)
+	s.True(ok)
+}
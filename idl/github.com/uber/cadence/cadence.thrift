@@ -87,11 +87,15 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      4: shared.ServiceBusyError serviceBusyError,
     )
 
   /**
   * Returns the history of specified workflow execution.  It fails with 'EntityNotExistError' if speficied workflow
-  * execution in unknown to the service.
+  * execution in unknown to the service.  When waitForNewEvent is set, the call long polls for events past the end of
+  * the history read so far, and the returned nextPageToken can be used to wait for more events until the execution
+  * closes.  The CLOSE_EVENT filter only returns the close event of the execution, waiting for it when
+  * waitForNewEvent is set.
   **/
   shared.GetWorkflowExecutionHistoryResponse GetWorkflowExecutionHistory(1: shared.GetWorkflowExecutionHistoryRequest getRequest)
     throws (
@@ -111,6 +115,7 @@ service WorkflowService {
     throws (
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
     )
 
   /**
@@ -127,6 +132,19 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in
+  * DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to
+  * report any failures like non-determinism or panics during DecisionTask processing.  Use the 'taskToken' provided as
+  * response of PollForDecisionTask API call for failing the DecisionTask.
+  **/
+  void RespondDecisionTaskFailed(1: shared.RespondDecisionTaskFailedRequest failedRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * PollForActivityTask is called by application worker to process ActivityTask from a specific taskList.  ActivityTask
   * is dispatched to callers whenever a ScheduleTask decision is made for a workflow execution.
@@ -140,6 +158,7 @@ service WorkflowService {
     throws (
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
+      3: shared.ServiceBusyError serviceBusyError,
     )
 
   /**
@@ -154,6 +173,20 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * RecordActivityTaskHeartbeatByID is called by application worker while it is processing an ActivityTask.  It is
+  * same as 'RecordActivityTaskHeartbeat' but identifies the ActivityTask by the domain, workflowId, runId and
+  * activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  **/
+  shared.RecordActivityTaskHeartbeatResponse RecordActivityTaskHeartbeatByID(1: shared.RecordActivityTaskHeartbeatByIDRequest heartbeatRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
     )
 
   /**
@@ -170,6 +203,18 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RespondActivityTaskCompletedByID is called by application worker when it is done processing an ActivityTask.  It is
+  * same as 'RespondActivityTaskCompleted' but identifies the ActivityTask by the domain, workflowId, runId and
+  * activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  **/
+  void  RespondActivityTaskCompletedByID(1: shared.RespondActivityTaskCompletedByIDRequest completeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * RespondActivityTaskFailed is called by application worker when it is done processing an ActivityTask.  It will
   * result in a new 'ActivityTaskFailed' event being written to the workflow history and a new DecisionTask
@@ -184,6 +229,18 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RespondActivityTaskFailedByID is called by application worker when it is done processing an ActivityTask.  It is
+  * same as 'RespondActivityTaskFailed' but identifies the ActivityTask by the domain, workflowId, runId and
+  * activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  **/
+  void  RespondActivityTaskFailedByID(1: shared.RespondActivityTaskFailedByIDRequest failRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * RespondActivityTaskCanceled is called by application worker when it is successfully canceled an ActivityTask.  It will
   * result in a new 'ActivityTaskCanceled' event being written to the workflow history and a new DecisionTask
@@ -198,6 +255,18 @@ service WorkflowService {
       3: shared.EntityNotExistsError entityNotExistError,
     )
 
+  /**
+  * RespondActivityTaskCanceledByID is called by application worker when it is successfully canceled an ActivityTask.
+  * It is same as 'RespondActivityTaskCanceled' but identifies the ActivityTask by the domain, workflowId, runId and
+  * activityId instead of the 'taskToken'.  The runId can be left empty to refer to the current run of the workflow.
+  **/
+  void RespondActivityTaskCanceledByID(1: shared.RespondActivityTaskCanceledByIDRequest canceledRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
   /**
   * RequestCancelWorkflowExecution is called by application worker when it wants to request cancellation of a workflow instance.
   * It will result in a new 'WorkflowExecutionCancelRequested' event being written to the workflow history and a new DecisionTask
@@ -209,6 +278,7 @@ service WorkflowService {
         1: shared.BadRequestError badRequestError,
         2: shared.InternalServiceError internalServiceError,
         3: shared.EntityNotExistsError entityNotExistError,
+        4: shared.ServiceBusyError serviceBusyError,
       )
 
   /**
@@ -220,6 +290,21 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+  * is running, this results in WorkflowExecutionSignaled event recorded in the history and a decision task being
+  * created for the execution.  If the workflow is not running, a new workflow execution is started with the
+  * WorkflowExecutionSignaled event recorded right after its WorkflowExecutionStarted event.
+  **/
+  shared.StartWorkflowExecutionResponse SignalWithStartWorkflowExecution(1: shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      4: shared.ServiceBusyError serviceBusyError,
     )
 
   /**
@@ -231,6 +316,21 @@ service WorkflowService {
       1: shared.BadRequestError badRequestError,
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.ServiceBusyError serviceBusyError,
+    )
+
+  /**
+  * ResetWorkflowExecution resets an existing workflow execution to the point right before the given
+  * DecisionTaskCompleted event.  A new run is started with a copy of the history up to that point, the signals received
+  * after it are reapplied and the decision is retried, and the current run is terminated with the reset reason.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: shared.ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      5: shared.ServiceBusyError serviceBusyError,
     )
 
   /**
@@ -252,4 +352,74 @@ service WorkflowService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.EntityNotExistsError entityNotExistError,
     )
-}
\ No newline at end of file
+
+  /**
+  * ListWorkflowExecutions is a visibility API to list the open and closed executions in a specific domain which
+  * match a query.  The query is a SQL like WHERE clause over WorkflowId, WorkflowType, CloseStatus, ExecutionStatus,
+  * StartTime, CloseTime and the search attributes of the executions, e.g.
+  * WorkflowType = 'orderProcessing' AND CloseStatus = 'FAILED' AND StartTime > '2018-01-01T00:00:00Z'
+  **/
+  shared.ListWorkflowExecutionsResponse ListWorkflowExecutions(1: shared.ListWorkflowExecutionsRequest listRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * CountWorkflowExecutions is a visibility API to count the open and closed executions in a specific domain which
+  * match a query.  The query has the same syntax as the query of ListWorkflowExecutions.
+  **/
+  shared.CountWorkflowExecutionsResponse CountWorkflowExecutions(1: shared.CountWorkflowExecutionsRequest countRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * RespondQueryTaskCompleted is called by application worker to complete a QueryTask (which is a DecisionTask for query)
+  * as a result of 'PollForDecisionTask' API call. Completing a QueryTask will unblock the client call to 'QueryWorkflow'
+  * API and return the query result to client as a response to 'QueryWorkflow' API call.
+  **/
+  void RespondQueryTaskCompleted(1: shared.RespondQueryTaskCompletedRequest completeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * QueryWorkflow returns query result for a specified workflow execution.  The query is dispatched as a QueryTask to a
+  * worker polling on the decision task list of the workflow, and the call blocks until the worker answers it.
+  **/
+  shared.QueryWorkflowResponse QueryWorkflow(1: shared.QueryWorkflowRequest queryRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.QueryFailedError queryFailedError,
+    )
+
+  /**
+  * DescribeWorkflowExecution returns information about the specified workflow execution, including its configuration
+  * and what it is currently waiting on: pending activities, timers, child executions and the outstanding decision.
+  **/
+  shared.DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: shared.DescribeWorkflowExecutionRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * DescribeTaskList returns the pollers which polled the target task list in the last few minutes, along with its ack
+  * level, read levels and approximate backlog.
+  **/
+  shared.DescribeTaskListResponse DescribeTaskList(1: shared.DescribeTaskListRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+}
@@ -46,11 +46,15 @@ struct StartWorkflowExecutionRequest {
 struct GetWorkflowExecutionNextEventIDRequest {
   10: optional string domainUUID
   20: optional shared.WorkflowExecution execution
+  30: optional i64 (js.type = "Long") expectedNextEventId
 }
 
 struct GetWorkflowExecutionNextEventIDResponse {
   10: optional i64 (js.type = "Long") eventId
   20: optional string runId
+  30: optional shared.WorkflowType workflowType
+  40: optional shared.TaskList taskList
+  50: optional bool isWorkflowRunning
 }
 
 struct RespondDecisionTaskCompletedRequest {
@@ -58,6 +62,11 @@ struct RespondDecisionTaskCompletedRequest {
   20: optional shared.RespondDecisionTaskCompletedRequest completeRequest
 }
 
+struct RespondDecisionTaskFailedRequest {
+  10: optional string domainUUID
+  20: optional shared.RespondDecisionTaskFailedRequest failedRequest
+}
+
 struct RecordActivityTaskHeartbeatRequest {
   10: optional string domainUUID
   20: optional shared.RecordActivityTaskHeartbeatRequest heartbeatRequest
@@ -85,11 +94,13 @@ struct RecordActivityTaskStartedRequest {
   40: optional i64 (js.type = "Long") taskId
   45: optional string requestId // Unique id of each poll request. Used to ensure at most once delivery of tasks.
   50: optional shared.PollForActivityTaskRequest pollRequest
+  60: optional i64 (js.type = "Long") scheduleAttempt
 }
 
 struct RecordActivityTaskStartedResponse {
   10: optional shared.HistoryEvent startedEvent
   20: optional shared.HistoryEvent scheduledEvent
+  30: optional i32 attempt
 }
 
 struct RecordDecisionTaskStartedRequest {
@@ -99,12 +110,17 @@ struct RecordDecisionTaskStartedRequest {
   40: optional i64 (js.type = "Long") taskId
   45: optional string requestId // Unique id of each poll request. Used to ensure at most once delivery of tasks.
   50: optional shared.PollForDecisionTaskRequest pollRequest
+  60: optional i64 (js.type = "Long") scheduleAttempt
 }
 
 struct RecordDecisionTaskStartedResponse {
   10: optional shared.WorkflowType workflowType
   20: optional i64 (js.type = "Long") previousStartedEventId
   30: optional i64 (js.type = "Long") startedEventId
+  40: optional bool stickyExecutionEnabled
+  50: optional i64 (js.type = "Long") scheduledEventId
+  60: optional i64 (js.type = "Long") attempt
+  70: optional shared.TransientDecisionInfo decisionInfo
 }
 
 struct SignalWorkflowExecutionRequest {
@@ -112,9 +128,16 @@ struct SignalWorkflowExecutionRequest {
   20: optional shared.SignalWorkflowExecutionRequest signalRequest
 }
 
+struct SignalWithStartWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.SignalWithStartWorkflowExecutionRequest signalWithStartRequest
+}
+
 struct TerminateWorkflowExecutionRequest {
   10: optional string domainUUID
   20: optional shared.TerminateWorkflowExecutionRequest terminateRequest
+  30: optional shared.WorkflowExecution externalWorkflowExecution
+  40: optional bool childWorkflowOnly
 }
 
 struct RequestCancelWorkflowExecutionRequest {
@@ -122,6 +145,7 @@ struct RequestCancelWorkflowExecutionRequest {
   20: optional shared.RequestCancelWorkflowExecutionRequest cancelRequest
   30: optional i64 (js.type = "Long") externalInitiatedEventId
   40: optional shared.WorkflowExecution externalWorkflowExecution
+  50: optional bool childWorkflowOnly
 }
 
 struct ScheduleDecisionTaskRequest {
@@ -144,6 +168,16 @@ struct RecordChildExecutionCompletedRequest {
   50: optional shared.HistoryEvent completionEvent
 }
 
+struct DescribeWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.DescribeWorkflowExecutionRequest request
+}
+
+struct ResetWorkflowExecutionRequest {
+  10: optional string domainUUID
+  20: optional shared.ResetWorkflowExecutionRequest resetRequest
+}
+
 /**
 * HistoryService provides API to start a new long running workflow instance, as well as query and update the history
 * of workflow instances already created.
@@ -166,6 +200,7 @@ service HistoryService {
   /**
   * Returns the nextEventID of the history of workflow execution. Only events in the history with Ids below the returned Id are
   * guaranteed to be valid, so the first step of reading an execution's history is to retrieve this event Id.
+  * When expectedNextEventId is set, the call long polls until the nextEventID moves past it or the execution closes.
   * It fails with 'EntityNotExistError' if specified workflow execution in unknown to the service.
   **/
   GetWorkflowExecutionNextEventIDResponse GetWorkflowExecutionNextEventID(1: GetWorkflowExecutionNextEventIDRequest getRequest)
@@ -219,6 +254,19 @@ service HistoryService {
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
 
+  /**
+  * RespondDecisionTaskFailed is called by application worker to indicate failure.  This results in
+  * DecisionTaskFailedEvent written to the history and a new DecisionTask created.  This API can be used by client to
+  * report any failures like non-determinism or panics during DecisionTask processing.
+  **/
+  void RespondDecisionTaskFailed(1: RespondDecisionTaskFailedRequest failedRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
   /**
   * RecordActivityTaskHeartbeat is called by application worker while it is processing an ActivityTask.  If worker fails
   * to heartbeat within 'heartbeatTimeoutSeconds' interval for the ActivityTask, then it will be marked as timedout and
@@ -291,6 +339,20 @@ service HistoryService {
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
 
+  /**
+  * SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+  * is running, this results in WorkflowExecutionSignaled event recorded in the history and a decision task being
+  * created for the execution.  If the workflow is not running, a new workflow execution is started with the
+  * WorkflowExecutionSignaled event recorded right after its WorkflowExecutionStarted event.
+  **/
+  shared.StartWorkflowExecutionResponse SignalWithStartWorkflowExecution(1: SignalWithStartWorkflowExecutionRequest signalWithStartRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
   /**
   * TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
   * in the history and immediately terminating the execution instance.
@@ -342,4 +404,30 @@ service HistoryService {
       3: shared.EntityNotExistsError entityNotExistError,
       4: ShardOwnershipLostError shardOwnershipLostError,
     )
+
+  /**
+  * DescribeWorkflowExecution returns information about the specified workflow execution, including its configuration
+  * and the pending activities, timers, child executions and decision taken from its mutable state.
+  **/
+  shared.DescribeWorkflowExecutionResponse DescribeWorkflowExecution(1: DescribeWorkflowExecutionRequest describeRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+    )
+
+  /**
+  * ResetWorkflowExecution starts a new run of the workflow execution whose history is a copy of the current run up to
+  * the given DecisionTaskCompleted event, reapplies the signals received after that point and terminates the current
+  * run if it is still open.
+  **/
+  shared.ResetWorkflowExecutionResponse ResetWorkflowExecution(1: ResetWorkflowExecutionRequest resetRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: ShardOwnershipLostError shardOwnershipLostError,
+      5: shared.WorkflowExecutionAlreadyStartedError sessionAlreadyExistError,
+    )
 }
@@ -33,6 +33,10 @@ struct PollForDecisionTaskResponse {
   30: optional shared.WorkflowType workflowType
   40: optional i64 (js.type = "Long") previousStartedEventId
   50: optional i64 (js.type = "Long") startedEventId
+  60: optional shared.WorkflowQuery query
+  70: optional bool stickyExecutionEnabled
+  80: optional i64 (js.type = "Long") attempt
+  90: optional shared.TransientDecisionInfo decisionInfo
 }
 
 struct PollForActivityTaskRequest {
@@ -45,6 +49,8 @@ struct AddDecisionTaskRequest {
   20: optional shared.WorkflowExecution execution
   30: optional shared.TaskList taskList
   40: optional i64 (js.type = "Long") scheduleId
+  50: optional i32 scheduleToStartTimeoutSeconds
+  60: optional i64 (js.type = "Long") scheduleAttempt
 }
 
 struct AddActivityTaskRequest {
@@ -54,6 +60,25 @@ struct AddActivityTaskRequest {
   40: optional shared.TaskList taskList
   50: optional i64 (js.type = "Long") scheduleId
   60: optional i32 scheduleToStartTimeoutSeconds
+  70: optional i64 (js.type = "Long") scheduleAttempt
+}
+
+struct QueryWorkflowRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional shared.QueryWorkflowRequest queryRequest
+}
+
+struct RespondQueryTaskCompletedRequest {
+  10: optional string domainUUID
+  20: optional shared.TaskList taskList
+  30: optional string taskID
+  40: optional shared.RespondQueryTaskCompletedRequest completedRequest
+}
+
+struct DescribeTaskListRequest {
+  10: optional string domainUUID
+  20: optional shared.DescribeTaskListRequest descRequest
 }
 
 /**
@@ -105,4 +130,37 @@ service MatchingService {
       2: shared.InternalServiceError internalServiceError,
       3: shared.ServiceBusyError serviceBusyError,
     )
-}
\ No newline at end of file
+
+  /**
+  * QueryWorkflow is called by frontend to query a workflow.  The query is dispatched as a QueryTask to a poller on the
+  * decision task list of the workflow, and the call blocks until the worker responds with RespondQueryTaskCompleted.
+  **/
+  shared.QueryWorkflowResponse QueryWorkflow(1: QueryWorkflowRequest queryRequest)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+      4: shared.QueryFailedError queryFailedError,
+    )
+
+  /**
+  * RespondQueryTaskCompleted is called by frontend to respond query completed.
+  **/
+  void RespondQueryTaskCompleted(1: RespondQueryTaskCompletedRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+
+  /**
+  * DescribeTaskList returns the pollers which polled the target task list in the last few minutes, along with its ack
+  * level, read levels and approximate backlog.
+  **/
+  shared.DescribeTaskListResponse DescribeTaskList(1: DescribeTaskListRequest request)
+    throws (
+      1: shared.BadRequestError badRequestError,
+      2: shared.InternalServiceError internalServiceError,
+      3: shared.EntityNotExistsError entityNotExistError,
+    )
+}
@@ -46,6 +46,10 @@ exception ServiceBusyError {
   1: required string message
 }
 
+exception QueryFailedError {
+  1: required string message
+}
+
 enum DomainStatus {
   REGISTERED,
   DEPRECATED,
@@ -71,6 +75,8 @@ enum DecisionType {
   RecordMarker,
   ContinueAsNewWorkflowExecution,
   StartChildWorkflowExecution,
+  SignalExternalWorkflowExecution,
+  UpsertWorkflowSearchAttributes,
 }
 
 enum EventType {
@@ -112,6 +118,10 @@ enum EventType {
   ChildWorkflowExecutionCanceled,
   ChildWorkflowExecutionTimedOut,
   ChildWorkflowExecutionTerminated,
+  SignalExternalWorkflowExecutionInitiated,
+  SignalExternalWorkflowExecutionFailed,
+  ExternalWorkflowExecutionSignaled,
+  UpsertWorkflowSearchAttributes,
 }
 
 enum DecisionTaskFailedCause {
@@ -126,12 +136,22 @@ enum DecisionTaskFailedCause {
   BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES,
   BAD_REQUEST_CANCEL_EXTERNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
   BAD_CONTINUE_AS_NEW_ATTRIBUTES,
+  BAD_START_CHILD_EXECUTION_ATTRIBUTES,
+  BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES,
+  RESET_WORKFLOW,
+  WORKFLOW_WORKER_UNHANDLED_FAILURE,
+  BAD_SEARCH_ATTRIBUTES,
+  BLOB_SIZE_LIMIT_EXCEEDED,
 }
 
 enum CancelExternalWorkflowExecutionFailedCause {
   UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,
 }
 
+enum SignalExternalWorkflowExecutionFailedCause {
+  UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION,
+}
+
 enum ChildWorkflowExecutionFailedCause {
   WORKFLOW_ALREADY_RUNNING,
 }
@@ -151,6 +171,46 @@ enum ChildPolicy {
   ABANDON,
 }
 
+enum QueryTaskCompletedType {
+  COMPLETED,
+  FAILED,
+}
+
+enum ContinueAsNewInitiator {
+  Decider,
+  RetryPolicy,
+  CronSchedule,
+}
+
+// WorkflowIdReusePolicy controls whether a workflowId can be used for a new run once the previous run has closed.
+// A running workflow always rejects a new run with the same workflowId.
+enum WorkflowIdReusePolicy {
+  // Allow starting a new run regardless of how the previous run closed
+  ALLOW_DUPLICATE,
+  // Allow starting a new run only if the previous run failed, was canceled, terminated or timed out
+  ALLOW_DUPLICATE_FAILED_ONLY,
+  // Never allow starting a new run with the same workflowId
+  REJECT_DUPLICATE,
+}
+
+enum TaskListType {
+  // Decision type of tasklist
+  DECISION,
+  // Activity type of tasklist
+  ACTIVITY,
+}
+
+enum PendingActivityState {
+  SCHEDULED,
+  STARTED,
+  CANCEL_REQUESTED,
+}
+
+enum HistoryEventFilterType {
+  ALL_EVENT,
+  CLOSE_EVENT,
+}
+
 struct WorkflowType {
   10: optional string name
 }
@@ -168,12 +228,38 @@ struct WorkflowExecution {
   20: optional string runId
 }
 
+struct Memo {
+  10: optional map<string,binary> fields
+}
+
+struct SearchAttributes {
+  10: optional map<string,binary> indexedFields
+}
+
 struct WorkflowExecutionInfo {
   10: optional WorkflowExecution execution
   20: optional WorkflowType type
   30: optional i64 (js.type = "Long") startTime
   40: optional i64 (js.type = "Long") closeTime
   50: optional WorkflowExecutionCloseStatus closeStatus
+  60: optional i64 (js.type = "Long") historyLength
+  70: optional Memo memo
+  80: optional SearchAttributes searchAttributes
+}
+
+struct WorkflowExecutionConfiguration {
+  10: optional TaskList taskList
+  20: optional i32 executionStartToCloseTimeoutSeconds
+  30: optional i32 taskStartToCloseTimeoutSeconds
+}
+
+struct RetryPolicy {
+  10: optional i32 initialIntervalInSeconds
+  20: optional double backoffCoefficient
+  30: optional i32 maximumIntervalInSeconds
+  40: optional i32 maximumAttempts
+  50: optional list<string> nonRetriableErrorReasons
+  60: optional i32 expirationIntervalInSeconds
 }
 
 struct ScheduleActivityTaskDecisionAttributes {
@@ -186,6 +272,7 @@ struct ScheduleActivityTaskDecisionAttributes {
   50: optional i32 scheduleToStartTimeoutSeconds
   55: optional i32 startToCloseTimeoutSeconds
   60: optional i32 heartbeatTimeoutSeconds
+  70: optional RetryPolicy retryPolicy
 }
 
 struct RequestCancelActivityTaskDecisionAttributes {
@@ -221,6 +308,14 @@ struct RequestCancelExternalWorkflowExecutionDecisionAttributes {
   40: optional binary control
 }
 
+struct SignalExternalWorkflowExecutionDecisionAttributes {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional string signalName
+  40: optional binary input
+  50: optional binary control
+}
+
 struct RecordMarkerDecisionAttributes {
   10: optional string markerName
   20: optional binary details
@@ -232,6 +327,13 @@ struct ContinueAsNewWorkflowExecutionDecisionAttributes {
   30: optional binary input
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
+  60: optional i32 backoffStartIntervalInSeconds
+  70: optional RetryPolicy retryPolicy
+  80: optional ContinueAsNewInitiator initiator
+  90: optional string failureReason
+  100: optional binary failureDetails
+  110: optional Memo memo
+  120: optional SearchAttributes searchAttributes
 }
 
 struct StartChildWorkflowExecutionDecisionAttributes {
@@ -244,6 +346,14 @@ struct StartChildWorkflowExecutionDecisionAttributes {
   70: optional i32 taskStartToCloseTimeoutSeconds
   80: optional ChildPolicy childPolicy
   90: optional binary control
+  100: optional RetryPolicy retryPolicy
+  110: optional i32 delayStartSeconds
+  120: optional Memo memo
+  130: optional SearchAttributes searchAttributes
+}
+
+struct UpsertWorkflowSearchAttributesDecisionAttributes {
+  10: optional SearchAttributes searchAttributes
 }
 
 struct Decision {
@@ -259,6 +369,8 @@ struct Decision {
   80:  optional RecordMarkerDecisionAttributes recordMarkerDecisionAttributes
   90:  optional ContinueAsNewWorkflowExecutionDecisionAttributes continueAsNewWorkflowExecutionDecisionAttributes
   100: optional StartChildWorkflowExecutionDecisionAttributes startChildWorkflowExecutionDecisionAttributes
+  110: optional SignalExternalWorkflowExecutionDecisionAttributes signalExternalWorkflowExecutionDecisionAttributes
+  120: optional UpsertWorkflowSearchAttributesDecisionAttributes upsertWorkflowSearchAttributesDecisionAttributes
 }
 
 struct WorkflowExecutionStartedEventAttributes {
@@ -268,6 +380,17 @@ struct WorkflowExecutionStartedEventAttributes {
   40: optional i32 executionStartToCloseTimeoutSeconds
   50: optional i32 taskStartToCloseTimeoutSeconds
   60: optional string identity
+  70: optional string continuedExecutionRunId
+  80: optional ContinueAsNewInitiator initiator
+  90: optional string continuedFailureReason
+  100: optional binary continuedFailureDetails
+  110: optional i32 attempt
+  120: optional i64 (js.type = "Long") expirationTimestamp
+  130: optional i32 firstDecisionTaskBackoffSeconds
+  140: optional RetryPolicy retryPolicy
+  150: optional string cronSchedule
+  160: optional Memo memo
+  170: optional SearchAttributes searchAttributes
 }
 
 struct WorkflowExecutionCompletedEventAttributes {
@@ -293,11 +416,16 @@ struct WorkflowExecutionContinuedAsNewEventAttributes {
   50: optional i32 executionStartToCloseTimeoutSeconds
   60: optional i32 taskStartToCloseTimeoutSeconds
   70: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  80: optional i32 backoffStartIntervalInSeconds
+  90: optional ContinueAsNewInitiator initiator
+  100: optional string failureReason
+  110: optional binary failureDetails
 }
 
 struct DecisionTaskScheduledEventAttributes {
   10: optional TaskList taskList
   20: optional i32 startToCloseTimeoutSeconds
+  30: optional i64 (js.type = "Long") attempt
 }
 
 struct DecisionTaskStartedEventAttributes {
@@ -323,6 +451,7 @@ struct DecisionTaskFailedEventAttributes {
   10: optional i64 (js.type = "Long") scheduledEventId
   20: optional i64 (js.type = "Long") startedEventId
   30: optional DecisionTaskFailedCause cause
+  35: optional binary details
   40: optional string identity
 }
 
@@ -337,12 +466,14 @@ struct ActivityTaskScheduledEventAttributes {
   55: optional i32 startToCloseTimeoutSeconds
   60: optional i32 heartbeatTimeoutSeconds
   90: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  110: optional RetryPolicy retryPolicy
 }
 
 struct ActivityTaskStartedEventAttributes {
   10: optional i64 (js.type = "Long") scheduledEventId
   20: optional string identity
   30: optional string requestId
+  40: optional i32 attempt
 }
 
 struct ActivityTaskCompletedEventAttributes {
@@ -416,6 +547,7 @@ struct WorkflowExecutionCancelRequestedEventAttributes {
   20: optional i64 (js.type = "Long") externalInitiatedEventId
   30: optional WorkflowExecution externalWorkflowExecution
   40: optional string identity
+  50: optional string requestId
 }
 
 struct WorkflowExecutionCanceledEventAttributes {
@@ -463,6 +595,36 @@ struct ExternalWorkflowExecutionCancelRequestedEventAttributes {
   30: optional WorkflowExecution workflowExecution
 }
 
+struct SignalExternalWorkflowExecutionInitiatedEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional string domain
+  30: optional WorkflowExecution workflowExecution
+  40: optional string signalName
+  50: optional binary input
+  60: optional binary control
+}
+
+struct SignalExternalWorkflowExecutionFailedEventAttributes {
+  10: optional SignalExternalWorkflowExecutionFailedCause cause
+  20: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  30: optional string domain
+  40: optional WorkflowExecution workflowExecution
+  50: optional i64 (js.type = "Long") initiatedEventId
+  60: optional binary control
+}
+
+struct ExternalWorkflowExecutionSignaledEventAttributes {
+  10: optional i64 (js.type = "Long") initiatedEventId
+  20: optional string domain
+  30: optional WorkflowExecution workflowExecution
+  40: optional binary control
+}
+
+struct UpsertWorkflowSearchAttributesEventAttributes {
+  10: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  20: optional SearchAttributes searchAttributes
+}
+
 struct StartChildWorkflowExecutionInitiatedEventAttributes {
   10:  optional string domain
   20:  optional string workflowId
@@ -474,6 +636,10 @@ struct StartChildWorkflowExecutionInitiatedEventAttributes {
   80:  optional ChildPolicy childPolicy
   90:  optional binary control
   100: optional i64 (js.type = "Long") decisionTaskCompletedEventId
+  110: optional RetryPolicy retryPolicy
+  120: optional i32 delayStartSeconds
+  130: optional Memo memo
+  140: optional SearchAttributes searchAttributes
 }
 
 struct StartChildWorkflowExecutionFailedEventAttributes {
@@ -580,6 +746,10 @@ struct HistoryEvent {
   390: optional ChildWorkflowExecutionCanceledEventAttributes childWorkflowExecutionCanceledEventAttributes
   400: optional ChildWorkflowExecutionTimedOutEventAttributes childWorkflowExecutionTimedOutEventAttributes
   410: optional ChildWorkflowExecutionTerminatedEventAttributes childWorkflowExecutionTerminatedEventAttributes
+  420: optional SignalExternalWorkflowExecutionInitiatedEventAttributes signalExternalWorkflowExecutionInitiatedEventAttributes
+  430: optional SignalExternalWorkflowExecutionFailedEventAttributes signalExternalWorkflowExecutionFailedEventAttributes
+  440: optional ExternalWorkflowExecutionSignaledEventAttributes externalWorkflowExecutionSignaledEventAttributes
+  450: optional UpsertWorkflowSearchAttributesEventAttributes upsertWorkflowSearchAttributesEventAttributes
 }
 
 struct History {
@@ -609,6 +779,16 @@ struct DomainInfo {
 struct DomainConfiguration {
   10: optional i32 workflowExecutionRetentionPeriodInDays
   20: optional bool emitMetric
+  30: optional i32 historySizeLimitWarnInBytes
+  40: optional i32 historySizeLimitErrorInBytes
+  50: optional i32 historyCountLimitWarn
+  60: optional i32 historyCountLimitError
+  70: optional i32 pendingLimitWarn
+  80: optional i32 pendingLimitError
+  90: optional i32 blobSizeLimitWarnInBytes
+  100: optional i32 blobSizeLimitErrorInBytes
+  110: optional i32 pollRateLimitPerSecond
+  120: optional i32 mutationRateLimitPerSecond
 }
 
 struct UpdateDomainInfo {
@@ -658,6 +838,12 @@ struct StartWorkflowExecutionRequest {
   70: optional i32 taskStartToCloseTimeoutSeconds
   80: optional string identity
   90: optional string requestId
+  100: optional RetryPolicy retryPolicy
+  110: optional string cronSchedule
+  120: optional WorkflowIdReusePolicy workflowIdReusePolicy
+  130: optional i32 delayStartSeconds
+  140: optional Memo memo
+  150: optional SearchAttributes searchAttributes
 }
 
 struct StartWorkflowExecutionResponse {
@@ -670,6 +856,11 @@ struct PollForDecisionTaskRequest {
   30: optional string identity
 }
 
+struct TransientDecisionInfo {
+  10: optional HistoryEvent scheduledEvent
+  20: optional HistoryEvent startedEvent
+}
+
 struct PollForDecisionTaskResponse {
   10: optional binary taskToken
   20: optional WorkflowExecution workflowExecution
@@ -678,6 +869,13 @@ struct PollForDecisionTaskResponse {
   50: optional i64 (js.type = "Long") startedEventId
   60: optional History history
   70: optional binary nextPageToken
+  80: optional WorkflowQuery query
+  90: optional i64 (js.type = "Long") attempt
+}
+
+struct StickyExecutionAttributes {
+  10: optional TaskList workerTaskList
+  20: optional i32 scheduleToStartTimeoutSeconds
 }
 
 struct RespondDecisionTaskCompletedRequest {
@@ -685,6 +883,14 @@ struct RespondDecisionTaskCompletedRequest {
   20: optional list<Decision> decisions
   30: optional binary executionContext
   40: optional string identity
+  50: optional StickyExecutionAttributes stickyAttributes
+}
+
+struct RespondDecisionTaskFailedRequest {
+  10: optional binary taskToken
+  20: optional DecisionTaskFailedCause cause
+  30: optional binary details
+  40: optional string identity
 }
 
 struct PollForActivityTaskRequest {
@@ -705,6 +911,7 @@ struct PollForActivityTaskResponse {
   90:  optional i64 (js.type = "Long") startedTimestamp
   100: optional i32 startToCloseTimeoutSeconds
   110: optional i32 heartbeatTimeoutSeconds
+  120: optional i32 attempt
 }
 
 struct RecordActivityTaskHeartbeatRequest {
@@ -713,6 +920,15 @@ struct RecordActivityTaskHeartbeatRequest {
   30: optional string identity
 }
 
+struct RecordActivityTaskHeartbeatByIDRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional string runId
+  40: optional string activityId
+  50: optional binary details
+  60: optional string identity
+}
+
 struct RecordActivityTaskHeartbeatResponse {
   10: optional bool cancelRequested
 }
@@ -736,10 +952,39 @@ struct RespondActivityTaskCanceledRequest {
   30: optional string identity
 }
 
+struct RespondActivityTaskCompletedByIDRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional string runId
+  40: optional string activityId
+  50: optional binary result
+  60: optional string identity
+}
+
+struct RespondActivityTaskFailedByIDRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional string runId
+  40: optional string activityId
+  50: optional string reason
+  60: optional binary details
+  70: optional string identity
+}
+
+struct RespondActivityTaskCanceledByIDRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional string runId
+  40: optional string activityId
+  50: optional binary details
+  60: optional string identity
+}
+
 struct RequestCancelWorkflowExecutionRequest {
   10: optional string domain
   20: optional WorkflowExecution workflowExecution
   30: optional string identity
+  40: optional string requestId
 }
 
 struct GetWorkflowExecutionHistoryRequest {
@@ -747,6 +992,8 @@ struct GetWorkflowExecutionHistoryRequest {
   20: optional WorkflowExecution execution
   30: optional i32 maximumPageSize
   40: optional binary nextPageToken
+  50: optional bool waitForNewEvent
+  60: optional HistoryEventFilterType HistoryEventFilterType
 }
 
 struct GetWorkflowExecutionHistoryResponse {
@@ -760,6 +1007,24 @@ struct SignalWorkflowExecutionRequest {
   30: optional string signalName
   40: optional binary input
   50: optional string identity
+  60: optional string requestId
+}
+
+struct SignalWithStartWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional string workflowId
+  30: optional WorkflowType workflowType
+  40: optional TaskList taskList
+  50: optional binary input
+  60: optional i32 executionStartToCloseTimeoutSeconds
+  70: optional i32 taskStartToCloseTimeoutSeconds
+  80: optional string identity
+  90: optional string requestId
+  100: optional string signalName
+  110: optional binary signalInput
+  120: optional RetryPolicy retryPolicy
+  130: optional string cronSchedule
+  140: optional WorkflowIdReusePolicy workflowIdReusePolicy
 }
 
 struct TerminateWorkflowExecutionRequest {
@@ -770,6 +1035,19 @@ struct TerminateWorkflowExecutionRequest {
   50: optional string identity
 }
 
+struct ResetWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution workflowExecution
+  30: optional string reason
+  40: optional i64 (js.type = "Long") decisionFinishEventId
+  50: optional string requestId
+  60: optional string identity
+}
+
+struct ResetWorkflowExecutionResponse {
+  10: optional string runId
+}
+
 struct ListOpenWorkflowExecutionsRequest {
   10: optional string domain
   20: optional i32 maximumPageSize
@@ -798,3 +1076,113 @@ struct ListClosedWorkflowExecutionsResponse {
   10: optional list<WorkflowExecutionInfo> executions
   20: optional binary nextPageToken
 }
+
+struct ListWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional i32 maximumPageSize
+  30: optional binary nextPageToken
+  40: optional string query
+}
+
+struct ListWorkflowExecutionsResponse {
+  10: optional list<WorkflowExecutionInfo> executions
+  20: optional binary nextPageToken
+}
+
+struct CountWorkflowExecutionsRequest {
+  10: optional string domain
+  20: optional string query
+}
+
+struct CountWorkflowExecutionsResponse {
+  10: optional i64 count
+}
+
+struct WorkflowQuery {
+  10: optional string queryType
+  20: optional binary queryArgs
+}
+
+struct QueryWorkflowRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+  30: optional WorkflowQuery query
+}
+
+struct QueryWorkflowResponse {
+  10: optional binary queryResult
+}
+
+struct RespondQueryTaskCompletedRequest {
+  10: optional binary taskToken
+  20: optional QueryTaskCompletedType completedType
+  30: optional binary queryResult
+  40: optional string errorMessage
+}
+
+struct DescribeWorkflowExecutionRequest {
+  10: optional string domain
+  20: optional WorkflowExecution execution
+}
+
+struct PendingActivityInfo {
+  10: optional string activityID
+  20: optional ActivityType activityType
+  30: optional PendingActivityState state
+  40: optional binary heartbeatDetails
+  50: optional i64 (js.type = "Long") lastHeartbeatTimestamp
+  60: optional i32 attempt
+}
+
+struct PendingTimerInfo {
+  10: optional string timerId
+  20: optional i64 (js.type = "Long") startedEventId
+  30: optional i64 (js.type = "Long") expiryTimestamp
+}
+
+struct PendingChildExecutionInfo {
+  10: optional string workflowID
+  20: optional string runID
+  30: optional string workflowTypeName
+  40: optional i64 (js.type = "Long") initiatedID
+}
+
+struct PendingDecisionInfo {
+  10: optional i64 (js.type = "Long") scheduledEventId
+  20: optional i64 (js.type = "Long") startedEventId
+  30: optional i32 startToCloseTimeoutSeconds
+}
+
+struct DescribeWorkflowExecutionResponse {
+  10: optional WorkflowExecutionConfiguration executionConfiguration
+  20: optional WorkflowExecutionInfo workflowExecutionInfo
+  30: optional i64 (js.type = "Long") nextEventId
+  40: optional list<PendingActivityInfo> pendingActivities
+  50: optional list<PendingTimerInfo> pendingTimers
+  60: optional list<PendingChildExecutionInfo> pendingChildren
+  70: optional PendingDecisionInfo pendingDecision
+}
+
+struct DescribeTaskListRequest {
+  10: optional string domain
+  20: optional TaskList taskList
+  30: optional TaskListType taskListType
+}
+
+struct PollerInfo {
+  // Unix Nano
+  10: optional i64 (js.type = "Long") lastAccessTime
+  20: optional string identity
+}
+
+struct TaskListStatus {
+  10: optional i64 (js.type = "Long") backlogCountHint
+  20: optional i64 (js.type = "Long") readLevel
+  30: optional i64 (js.type = "Long") ackLevel
+  40: optional i64 (js.type = "Long") maxReadLevel
+}
+
+struct DescribeTaskListResponse {
+  10: optional list<PollerInfo> pollers
+  20: optional TaskListStatus taskListStatus
+}
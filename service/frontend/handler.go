@@ -22,8 +22,11 @@ package frontend
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
+	"math"
 	"sync"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber/cadence/.gen/go/cadence"
@@ -34,6 +37,8 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 	"github.com/uber/cadence/common/service"
 
@@ -54,20 +59,29 @@ type (
 		matching           matching.Client
 		tokenSerializer    common.TaskTokenSerializer
 		hSerializerFactory persistence.HistorySerializerFactory
+		rateLimiter        *rateLimiter
 		startWG            sync.WaitGroup
 		service.Service
 	}
 
+	// getHistoryContinuationToken is the nextPageToken of GetWorkflowExecutionHistory.  It reads the events in
+	// [FirstEventID, NextEventID), and once they are all read a long poll waits for events past NextEventID.  The
+	// events of a retried decision, which are not in the history yet, are appended to the last page.
 	getHistoryContinuationToken struct {
-		runID            string
-		nextEventID      int64
-		persistenceToken []byte
+		RunID             string
+		FirstEventID      int64
+		NextEventID       int64
+		IsWorkflowRunning bool
+		PersistenceToken  []byte
+		TransientDecision *gen.TransientDecisionInfo
 	}
 )
 
 const (
 	defaultVisibilityMaxPageSize = 1000
 	defaultHistoryMaxPageSize    = 1000
+	// longPollTailRoom is the time a long poll for history events leaves to the request after it is answered
+	longPollTailRoom = 2 * time.Second
 )
 
 var (
@@ -77,8 +91,11 @@ var (
 	errExecutionNotSet      = &gen.BadRequestError{Message: "Execution is not set on request."}
 	errWorkflowIDNotSet     = &gen.BadRequestError{Message: "WorkflowId is not set on request."}
 	errRunIDNotSet          = &gen.BadRequestError{Message: "RunId is not set on request."}
+	errActivityIDNotSet     = &gen.BadRequestError{Message: "ActivityId is not set on request."}
 	errInvalidRunID         = &gen.BadRequestError{Message: "Invalid RunId."}
 	errInvalidNextPageToken = &gen.BadRequestError{Message: "Invalid NextPageToken."}
+	errNegativeDomainLimit  = &gen.BadRequestError{Message: "Domain limits can not be negative."}
+	errBlobSizeExceedsLimit = &gen.BadRequestError{Message: "Blob data size exceeds limit."}
 )
 
 // NewWorkflowHandler creates a thrift handler for the cadence service
@@ -93,6 +110,7 @@ func NewWorkflowHandler(
 		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
 		hSerializerFactory: persistence.NewHistorySerializerFactory(),
 		domainCache:        cache.NewDomainCache(metadataMgr, sVice.GetLogger()),
+		rateLimiter:        newRateLimiter(globalPollRPS, globalMutationRPS, common.NewRealTimeSource()),
 	}
 	// prevent us from trying to serve requests before handler's Start() is complete
 	handler.startWG.Add(1)
@@ -218,6 +236,40 @@ func (wh *WorkflowHandler) UpdateDomain(ctx thrift.Context,
 		if updatedConfig.IsSetWorkflowExecutionRetentionPeriodInDays() {
 			config.Retention = updatedConfig.GetWorkflowExecutionRetentionPeriodInDays()
 		}
+		if updatedConfig.IsSetHistorySizeLimitWarnInBytes() {
+			config.HistorySizeLimitWarn = updatedConfig.GetHistorySizeLimitWarnInBytes()
+		}
+		if updatedConfig.IsSetHistorySizeLimitErrorInBytes() {
+			config.HistorySizeLimitError = updatedConfig.GetHistorySizeLimitErrorInBytes()
+		}
+		if updatedConfig.IsSetHistoryCountLimitWarn() {
+			config.HistoryCountLimitWarn = updatedConfig.GetHistoryCountLimitWarn()
+		}
+		if updatedConfig.IsSetHistoryCountLimitError() {
+			config.HistoryCountLimitError = updatedConfig.GetHistoryCountLimitError()
+		}
+		if updatedConfig.IsSetPendingLimitWarn() {
+			config.PendingLimitWarn = updatedConfig.GetPendingLimitWarn()
+		}
+		if updatedConfig.IsSetPendingLimitError() {
+			config.PendingLimitError = updatedConfig.GetPendingLimitError()
+		}
+		if updatedConfig.IsSetBlobSizeLimitWarnInBytes() {
+			config.BlobSizeLimitWarn = updatedConfig.GetBlobSizeLimitWarnInBytes()
+		}
+		if updatedConfig.IsSetBlobSizeLimitErrorInBytes() {
+			config.BlobSizeLimitError = updatedConfig.GetBlobSizeLimitErrorInBytes()
+		}
+		if updatedConfig.IsSetPollRateLimitPerSecond() {
+			config.PollRateLimit = updatedConfig.GetPollRateLimitPerSecond()
+		}
+		if updatedConfig.IsSetMutationRateLimitPerSecond() {
+			config.MutationRateLimit = updatedConfig.GetMutationRateLimitPerSecond()
+		}
+	}
+
+	if err := validateDomainLimits(config); err != nil {
+		return nil, err
 	}
 
 	err := wh.metadataMgr.UpdateDomain(&persistence.UpdateDomainRequest{
@@ -284,6 +336,10 @@ func (wh *WorkflowHandler) PollForActivityTask(
 		return nil, wrapError(err)
 	}
 
+	if err := wh.checkRateLimit(info.ID, requestKindPoll); err != nil {
+		return nil, err
+	}
+
 	resp, err := wh.matching.PollForActivityTask(ctx, &m.PollForActivityTaskRequest{
 		DomainUUID:  common.StringPtr(info.ID),
 		PollRequest: pollRequest,
@@ -316,6 +372,10 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 		return nil, wrapError(err)
 	}
 
+	if err := wh.checkRateLimit(info.ID, requestKindPoll); err != nil {
+		return nil, err
+	}
+
 	wh.Service.GetLogger().Infof("Poll for decision domain name: %v", domainName)
 	wh.Service.GetLogger().Infof("Poll for decision request domainID: %v", info.ID)
 
@@ -333,18 +393,32 @@ func (wh *WorkflowHandler) PollForDecisionTask(
 	var persistenceToken []byte
 	var continuation []byte
 	if matchingResp.IsSetWorkflowExecution() {
-		// Non-empty response. Get the history
-		history, persistenceToken, err = wh.getHistory(
-			info.ID, *matchingResp.GetWorkflowExecution(), matchingResp.GetStartedEventId()+1, defaultHistoryMaxPageSize, nil)
+		// Non-empty response. Get the history.  A worker polling its sticky task list only needs the events after the
+		// previous decision it processed.
+		firstEventID := common.FirstEventID
+		if matchingResp.GetStickyExecutionEnabled() && matchingResp.IsSetPreviousStartedEventId() {
+			firstEventID = matchingResp.GetPreviousStartedEventId() + 1
+		}
+		nextEventID := matchingResp.GetStartedEventId() + 1
+		// The history only goes up to the events of a retried decision, they are appended to its last page
+		transientDecision := matchingResp.DecisionInfo
+		if transientDecision != nil {
+			nextEventID = transientDecision.GetScheduledEvent().GetEventId()
+		}
+		history, persistenceToken, err = wh.getHistory(info.ID, *matchingResp.GetWorkflowExecution(), firstEventID,
+			nextEventID, defaultHistoryMaxPageSize, nil)
 		if err != nil {
 			return nil, wrapError(err)
 		}
 
-		continuation, err =
-			getSerializedGetHistoryToken(persistenceToken, matchingResp.GetWorkflowExecution().GetRunId(), history, matchingResp.GetStartedEventId()+1)
+		continuation, err = getSerializedGetHistoryToken(persistenceToken, matchingResp.GetWorkflowExecution().GetRunId(),
+			history, firstEventID, nextEventID, true, transientDecision)
 		if err != nil {
 			return nil, wrapError(err)
 		}
+		if continuation == nil && transientDecision != nil {
+			history.Events = append(history.Events, transientDecision.ScheduledEvent, transientDecision.StartedEvent)
+		}
 	}
 
 	return createPollForDecisionTaskResponse(matchingResp, history, continuation), nil
@@ -367,6 +441,12 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	if taskToken.DomainID == "" {
 		return nil, errDomainNotSet
 	}
+	if err := wh.checkRateLimit(taskToken.DomainID, requestKindMutation); err != nil {
+		return nil, err
+	}
+	if err := wh.checkBlobSize(taskToken.DomainID, heartbeatRequest.GetDetails()); err != nil {
+		return nil, err
+	}
 
 	resp, err := wh.history.RecordActivityTaskHeartbeat(ctx, &h.RecordActivityTaskHeartbeatRequest{
 		DomainUUID:       common.StringPtr(taskToken.DomainID),
@@ -375,6 +455,36 @@ func (wh *WorkflowHandler) RecordActivityTaskHeartbeat(
 	return resp, wrapError(err)
 }
 
+// RecordActivityTaskHeartbeatByID - Record Activity Task Heart beat, identifying the activity by its ID
+func (wh *WorkflowHandler) RecordActivityTaskHeartbeatByID(
+	ctx thrift.Context,
+	heartbeatRequest *gen.RecordActivityTaskHeartbeatByIDRequest) (*gen.RecordActivityTaskHeartbeatResponse, error) {
+	wh.startWG.Wait()
+
+	wh.Service.GetLogger().Debug("Received RecordActivityTaskHeartbeatByID")
+	domainID, taskToken, err := wh.createTaskTokenByID(heartbeatRequest.GetDomain(), heartbeatRequest.GetWorkflowId(),
+		heartbeatRequest.GetRunId(), heartbeatRequest.GetActivityId())
+	if err != nil {
+		return nil, err
+	}
+	if err := wh.checkRateLimit(domainID, requestKindMutation); err != nil {
+		return nil, err
+	}
+	if err := wh.checkBlobSize(domainID, heartbeatRequest.GetDetails()); err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.history.RecordActivityTaskHeartbeat(ctx, &h.RecordActivityTaskHeartbeatRequest{
+		DomainUUID: common.StringPtr(domainID),
+		HeartbeatRequest: &gen.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Details:   heartbeatRequest.Details,
+			Identity:  heartbeatRequest.Identity,
+		},
+	})
+	return resp, wrapError(err)
+}
+
 // RespondActivityTaskCompleted - response to an activity task
 func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	ctx thrift.Context,
@@ -391,6 +501,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	if taskToken.DomainID == "" {
 		return errDomainNotSet
 	}
+	if err := wh.checkBlobSize(taskToken.DomainID, completeRequest.GetResult_()); err != nil {
+		return err
+	}
 
 	err = wh.history.RespondActivityTaskCompleted(ctx, &h.RespondActivityTaskCompletedRequest{
 		DomainUUID:      common.StringPtr(taskToken.DomainID),
@@ -403,6 +516,36 @@ func (wh *WorkflowHandler) RespondActivityTaskCompleted(
 	return wrapError(err)
 }
 
+// RespondActivityTaskCompletedByID - response to an activity task, identifying the activity by its ID
+func (wh *WorkflowHandler) RespondActivityTaskCompletedByID(
+	ctx thrift.Context,
+	completeRequest *gen.RespondActivityTaskCompletedByIDRequest) error {
+	wh.startWG.Wait()
+
+	domainID, taskToken, err := wh.createTaskTokenByID(completeRequest.GetDomain(), completeRequest.GetWorkflowId(),
+		completeRequest.GetRunId(), completeRequest.GetActivityId())
+	if err != nil {
+		return err
+	}
+	if err := wh.checkBlobSize(domainID, completeRequest.GetResult_()); err != nil {
+		return err
+	}
+
+	err = wh.history.RespondActivityTaskCompleted(ctx, &h.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &gen.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result_:   completeRequest.Result_,
+			Identity:  completeRequest.Identity,
+		},
+	})
+	if err != nil {
+		logger := wh.getLoggerForTask(taskToken)
+		logger.Errorf("RespondActivityTaskCompletedByID. Error: %v", err)
+	}
+	return wrapError(err)
+}
+
 // RespondActivityTaskFailed - response to an activity task failure
 func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	ctx thrift.Context,
@@ -419,6 +562,9 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 	if taskToken.DomainID == "" {
 		return errDomainNotSet
 	}
+	if err := wh.checkBlobSize(taskToken.DomainID, failedRequest.GetDetails()); err != nil {
+		return err
+	}
 
 	err = wh.history.RespondActivityTaskFailed(ctx, &h.RespondActivityTaskFailedRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
@@ -432,6 +578,37 @@ func (wh *WorkflowHandler) RespondActivityTaskFailed(
 
 }
 
+// RespondActivityTaskFailedByID - response to an activity task failure, identifying the activity by its ID
+func (wh *WorkflowHandler) RespondActivityTaskFailedByID(
+	ctx thrift.Context,
+	failedRequest *gen.RespondActivityTaskFailedByIDRequest) error {
+	wh.startWG.Wait()
+
+	domainID, taskToken, err := wh.createTaskTokenByID(failedRequest.GetDomain(), failedRequest.GetWorkflowId(),
+		failedRequest.GetRunId(), failedRequest.GetActivityId())
+	if err != nil {
+		return err
+	}
+	if err := wh.checkBlobSize(domainID, failedRequest.GetDetails()); err != nil {
+		return err
+	}
+
+	err = wh.history.RespondActivityTaskFailed(ctx, &h.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &gen.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    failedRequest.Reason,
+			Details:   failedRequest.Details,
+			Identity:  failedRequest.Identity,
+		},
+	})
+	if err != nil {
+		logger := wh.getLoggerForTask(taskToken)
+		logger.Errorf("RespondActivityTaskFailedByID. Error: %v", err)
+	}
+	return wrapError(err)
+}
+
 // RespondActivityTaskCanceled - called to cancel an activity task
 func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	ctx thrift.Context,
@@ -448,6 +625,9 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 	if taskToken.DomainID == "" {
 		return errDomainNotSet
 	}
+	if err := wh.checkBlobSize(taskToken.DomainID, cancelRequest.GetDetails()); err != nil {
+		return err
+	}
 
 	err = wh.history.RespondActivityTaskCanceled(ctx, &h.RespondActivityTaskCanceledRequest{
 		DomainUUID:    common.StringPtr(taskToken.DomainID),
@@ -461,6 +641,36 @@ func (wh *WorkflowHandler) RespondActivityTaskCanceled(
 
 }
 
+// RespondActivityTaskCanceledByID - called to cancel an activity task, identifying the activity by its ID
+func (wh *WorkflowHandler) RespondActivityTaskCanceledByID(
+	ctx thrift.Context,
+	cancelRequest *gen.RespondActivityTaskCanceledByIDRequest) error {
+	wh.startWG.Wait()
+
+	domainID, taskToken, err := wh.createTaskTokenByID(cancelRequest.GetDomain(), cancelRequest.GetWorkflowId(),
+		cancelRequest.GetRunId(), cancelRequest.GetActivityId())
+	if err != nil {
+		return err
+	}
+	if err := wh.checkBlobSize(domainID, cancelRequest.GetDetails()); err != nil {
+		return err
+	}
+
+	err = wh.history.RespondActivityTaskCanceled(ctx, &h.RespondActivityTaskCanceledRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CancelRequest: &gen.RespondActivityTaskCanceledRequest{
+			TaskToken: taskToken,
+			Details:   cancelRequest.Details,
+			Identity:  cancelRequest.Identity,
+		},
+	})
+	if err != nil {
+		logger := wh.getLoggerForTask(taskToken)
+		logger.Errorf("RespondActivityTaskCanceledByID. Error: %v", err)
+	}
+	return wrapError(err)
+}
+
 // RespondDecisionTaskCompleted - response to a decision task
 func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	ctx thrift.Context,
@@ -489,6 +699,34 @@ func (wh *WorkflowHandler) RespondDecisionTaskCompleted(
 	return wrapError(err)
 }
 
+// RespondDecisionTaskFailed - failed response to a decision task
+func (wh *WorkflowHandler) RespondDecisionTaskFailed(
+	ctx thrift.Context,
+	failedRequest *gen.RespondDecisionTaskFailedRequest) error {
+	wh.startWG.Wait()
+
+	if !failedRequest.IsSetTaskToken() {
+		return errTaskTokenNotSet
+	}
+	taskToken, err := wh.tokenSerializer.Deserialize(failedRequest.GetTaskToken())
+	if err != nil {
+		return wrapError(err)
+	}
+	if taskToken.DomainID == "" {
+		return errDomainNotSet
+	}
+
+	err = wh.history.RespondDecisionTaskFailed(ctx, &h.RespondDecisionTaskFailedRequest{
+		DomainUUID:    common.StringPtr(taskToken.DomainID),
+		FailedRequest: failedRequest,
+	})
+	if err != nil {
+		logger := wh.getLoggerForTask(failedRequest.GetTaskToken())
+		logger.Errorf("RespondDecisionTaskFailed. Error: %v", err)
+	}
+	return wrapError(err)
+}
+
 // StartWorkflowExecution - Creates a new workflow execution
 func (wh *WorkflowHandler) StartWorkflowExecution(
 	ctx thrift.Context,
@@ -521,6 +759,10 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 		return nil, &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
 	}
 
+	if startRequest.GetDelayStartSeconds() < 0 {
+		return nil, &gen.BadRequestError{Message: "DelayStartSeconds on request cannot be negative."}
+	}
+
 	domainName := startRequest.GetDomain()
 	wh.Service.GetLogger().Infof("Start workflow execution request domain: %v", domainName)
 	info, _, err := wh.domainCache.GetDomain(domainName)
@@ -529,6 +771,12 @@ func (wh *WorkflowHandler) StartWorkflowExecution(
 	}
 
 	wh.Service.GetLogger().Infof("Start workflow execution request domainID: %v", info.ID)
+	if err := wh.checkRateLimit(info.ID, requestKindMutation); err != nil {
+		return nil, err
+	}
+	if err := wh.checkBlobSize(info.ID, startRequest.GetInput()); err != nil {
+		return nil, err
+	}
 
 	resp, err := wh.history.StartWorkflowExecution(ctx, &h.StartWorkflowExecutionRequest{
 		DomainUUID:   common.StringPtr(info.ID),
@@ -576,12 +824,23 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		return nil, wrapError(err)
 	}
 
+	isLongPoll := getRequest.GetWaitForNewEvent()
+	isCloseEventOnly := getRequest.GetHistoryEventFilterType() == gen.HistoryEventFilterType_CLOSE_EVENT
+
 	token := &getHistoryContinuationToken{}
 	if getRequest.IsSetNextPageToken() {
 		token, err = deserializeGetHistoryToken(getRequest.GetNextPageToken())
 		if err != nil {
 			return nil, errInvalidNextPageToken
 		}
+		// All the events known when the token was handed out were read, wait for new ones
+		if isLongPoll && token.IsWorkflowRunning && len(token.PersistenceToken) == 0 {
+			token.NextEventID, token.IsWorkflowRunning, err = wh.waitForHistoryUpdate(ctx, info.ID,
+				token.RunID, getRequest.GetExecution(), token.NextEventID, isCloseEventOnly)
+			if err != nil {
+				return nil, wrapError(err)
+			}
+		}
 	} else {
 		response, err := wh.history.GetWorkflowExecutionNextEventID(ctx, &h.GetWorkflowExecutionNextEventIDRequest{
 			DomainUUID: common.StringPtr(info.ID),
@@ -590,26 +849,137 @@ func (wh *WorkflowHandler) GetWorkflowExecutionHistory(
 		if err != nil {
 			return nil, wrapError(err)
 		}
-		token.nextEventID = response.GetEventId()
-		token.runID = response.GetRunId()
+		token.RunID = response.GetRunId()
+		token.FirstEventID = common.FirstEventID
+		token.NextEventID = response.GetEventId()
+		token.IsWorkflowRunning = response.GetIsWorkflowRunning()
+		if isLongPoll && isCloseEventOnly && token.IsWorkflowRunning {
+			token.NextEventID, token.IsWorkflowRunning, err = wh.waitForHistoryUpdate(ctx, info.ID,
+				token.RunID, getRequest.GetExecution(), token.NextEventID, isCloseEventOnly)
+			if err != nil {
+				return nil, wrapError(err)
+			}
+		}
 	}
 
 	we := gen.WorkflowExecution{
 		WorkflowId: getRequest.GetExecution().WorkflowId,
-		RunId:      common.StringPtr(token.runID),
+		RunId:      common.StringPtr(token.RunID),
+	}
+
+	if isCloseEventOnly {
+		history := gen.NewHistory()
+		history.Events = []*gen.HistoryEvent{}
+		var nextToken []byte
+		if !token.IsWorkflowRunning {
+			closeEvent, err := wh.getLastHistoryEvent(info.ID, we, token.NextEventID)
+			if err != nil {
+				return nil, wrapError(err)
+			}
+			history.Events = append(history.Events, closeEvent)
+		} else if isLongPoll {
+			token.FirstEventID = token.NextEventID
+			token.PersistenceToken = nil
+			if nextToken, err = serializeGetHistoryToken(token); err != nil {
+				return nil, wrapError(err)
+			}
+		}
+		return createGetWorkflowExecutionHistoryResponse(history, token.NextEventID, nextToken), nil
 	}
-	history, persistenceToken, err :=
-		wh.getHistory(info.ID, we, token.nextEventID, getRequest.GetMaximumPageSize(), getRequest.GetNextPageToken())
-	if err != nil {
-		return nil, wrapError(err)
+
+	history := gen.NewHistory()
+	history.Events = []*gen.HistoryEvent{}
+	var persistenceToken []byte
+	if token.FirstEventID < token.NextEventID {
+		history, persistenceToken, err = wh.getHistory(info.ID, we, token.FirstEventID, token.NextEventID,
+			getRequest.GetMaximumPageSize(), token.PersistenceToken)
+		if err != nil {
+			return nil, wrapError(err)
+		}
 	}
 
-	nextToken, err := getSerializedGetHistoryToken(persistenceToken, token.runID, history, token.nextEventID)
+	nextToken, err := getSerializedGetHistoryToken(persistenceToken, token.RunID, history, token.FirstEventID,
+		token.NextEventID, token.IsWorkflowRunning, token.TransientDecision)
 	if err != nil {
 		return nil, wrapError(err)
 	}
+	if nextToken == nil && token.TransientDecision != nil {
+		history.Events = append(history.Events, token.TransientDecision.ScheduledEvent,
+			token.TransientDecision.StartedEvent)
+	}
+	if nextToken == nil && isLongPoll && token.IsWorkflowRunning {
+		// Hand out a token which waits for the events past the ones read so far
+		token.FirstEventID = token.NextEventID
+		token.PersistenceToken = nil
+		token.TransientDecision = nil
+		if nextToken, err = serializeGetHistoryToken(token); err != nil {
+			return nil, wrapError(err)
+		}
+	}
 
-	return createGetWorkflowExecutionHistoryResponse(history, token.nextEventID, nextToken), nil
+	return createGetWorkflowExecutionHistoryResponse(history, token.NextEventID, nextToken), nil
+}
+
+// waitForHistoryUpdate long polls history until the nextEventID of the run moves past expectedNextEventID, or the
+// run closes when waitForClose is set.  It gives up and returns the latest state when the deadline of the request
+// gets close.
+func (wh *WorkflowHandler) waitForHistoryUpdate(ctx thrift.Context, domainID, runID string,
+	execution *gen.WorkflowExecution, expectedNextEventID int64, waitForClose bool) (int64, bool, error) {
+	we := gen.WorkflowExecution{
+		WorkflowId: execution.WorkflowId,
+		RunId:      common.StringPtr(runID),
+	}
+	for {
+		response, err := wh.history.GetWorkflowExecutionNextEventID(ctx, &h.GetWorkflowExecutionNextEventIDRequest{
+			DomainUUID:          common.StringPtr(domainID),
+			Execution:           &we,
+			ExpectedNextEventId: common.Int64Ptr(expectedNextEventID),
+		})
+		if err != nil {
+			if _, ok := err.(*gen.EntityNotExistsError); ok {
+				// The mutable state of a run is deleted once it is closed, its history is read to the end instead
+				lastEvent, err := wh.getLastHistoryEvent(domainID, we, math.MaxInt64)
+				if err != nil {
+					return 0, false, err
+				}
+				return lastEvent.GetEventId() + 1, false, nil
+			}
+			return 0, false, err
+		}
+
+		nextEventID := response.GetEventId()
+		isWorkflowRunning := response.GetIsWorkflowRunning()
+		if !isWorkflowRunning || (!waitForClose && nextEventID > expectedNextEventID) || !hasTimeForLongPoll(ctx) {
+			return nextEventID, isWorkflowRunning, nil
+		}
+		expectedNextEventID = nextEventID
+	}
+}
+
+// getLastHistoryEvent returns the last event of the history of a run, which is its close event once it is closed
+func (wh *WorkflowHandler) getLastHistoryEvent(domainID string, execution gen.WorkflowExecution,
+	nextEventID int64) (*gen.HistoryEvent, error) {
+	var lastEvent *gen.HistoryEvent
+	var persistenceToken []byte
+	for {
+		history, nextPersistenceToken, err := wh.getHistory(domainID, execution, common.FirstEventID, nextEventID,
+			defaultHistoryMaxPageSize, persistenceToken)
+		if err != nil {
+			return nil, err
+		}
+		if events := history.GetEvents(); len(events) > 0 {
+			lastEvent = events[len(events)-1]
+		}
+		if len(nextPersistenceToken) == 0 || (lastEvent != nil && lastEvent.GetEventId() >= nextEventID-1) {
+			break
+		}
+		persistenceToken = nextPersistenceToken
+	}
+
+	if lastEvent == nil {
+		return nil, &gen.InternalServiceError{Message: "History of the workflow execution is empty."}
+	}
+	return lastEvent, nil
 }
 
 // SignalWorkflowExecution is used to send a signal event to running workflow execution.  This results in
@@ -645,6 +1015,14 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx thrift.Context,
 		return wrapError(err)
 	}
 
+	if err := wh.checkRateLimit(info.ID, requestKindMutation); err != nil {
+		return err
+	}
+
+	if err := wh.checkBlobSize(info.ID, signalRequest.GetInput()); err != nil {
+		return err
+	}
+
 	err = wh.history.SignalWorkflowExecution(ctx, &h.SignalWorkflowExecutionRequest{
 		DomainUUID:    common.StringPtr(info.ID),
 		SignalRequest: signalRequest,
@@ -653,6 +1031,72 @@ func (wh *WorkflowHandler) SignalWorkflowExecution(ctx thrift.Context,
 	return wrapError(err)
 }
 
+// SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+// is running, this results in WorkflowExecutionSignaled event recorded in the history and a decision task being
+// created for the execution.  If the workflow is not running, a new workflow execution is started with the
+// WorkflowExecutionSignaled event recorded right after its WorkflowExecutionStarted event.
+func (wh *WorkflowHandler) SignalWithStartWorkflowExecution(ctx thrift.Context,
+	signalWithStartRequest *gen.SignalWithStartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+	wh.startWG.Wait()
+
+	if !signalWithStartRequest.IsSetDomain() {
+		return nil, errDomainNotSet
+	}
+
+	if !signalWithStartRequest.IsSetWorkflowId() || signalWithStartRequest.GetWorkflowId() == "" {
+		return nil, errWorkflowIDNotSet
+	}
+
+	if !signalWithStartRequest.IsSetSignalName() || signalWithStartRequest.GetSignalName() == "" {
+		return nil, &gen.BadRequestError{Message: "SignalName is not set on request."}
+	}
+
+	if !signalWithStartRequest.IsSetWorkflowType() || !signalWithStartRequest.GetWorkflowType().IsSetName() ||
+		signalWithStartRequest.GetWorkflowType().GetName() == "" {
+		return nil, &gen.BadRequestError{Message: "WorkflowType is not set on request."}
+	}
+
+	if !signalWithStartRequest.IsSetTaskList() || !signalWithStartRequest.GetTaskList().IsSetName() ||
+		signalWithStartRequest.GetTaskList().GetName() == "" {
+		return nil, errTaskListNotSet
+	}
+
+	if !signalWithStartRequest.IsSetExecutionStartToCloseTimeoutSeconds() ||
+		signalWithStartRequest.GetExecutionStartToCloseTimeoutSeconds() <= 0 {
+		return nil, &gen.BadRequestError{Message: "A valid ExecutionStartToCloseTimeoutSeconds is not set on request."}
+	}
+
+	if !signalWithStartRequest.IsSetTaskStartToCloseTimeoutSeconds() ||
+		signalWithStartRequest.GetTaskStartToCloseTimeoutSeconds() <= 0 {
+		return nil, &gen.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on request."}
+	}
+
+	domainName := signalWithStartRequest.GetDomain()
+	info, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	if err := wh.checkRateLimit(info.ID, requestKindMutation); err != nil {
+		return nil, err
+	}
+
+	if err := wh.checkBlobSize(info.ID, signalWithStartRequest.GetInput(),
+		signalWithStartRequest.GetSignalInput()); err != nil {
+		return nil, err
+	}
+
+	resp, err := wh.history.SignalWithStartWorkflowExecution(ctx, &h.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID:             common.StringPtr(info.ID),
+		SignalWithStartRequest: signalWithStartRequest,
+	})
+	if err != nil {
+		wh.Service.GetLogger().Errorf("SignalWithStartWorkflowExecution failed. WorkflowID: %v. Error: %v",
+			signalWithStartRequest.GetWorkflowId(), err)
+	}
+	return resp, wrapError(err)
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx thrift.Context,
@@ -682,6 +1126,10 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx thrift.Context,
 		return wrapError(err)
 	}
 
+	if err := wh.checkRateLimit(info.ID, requestKindMutation); err != nil {
+		return err
+	}
+
 	err = wh.history.TerminateWorkflowExecution(ctx, &h.TerminateWorkflowExecutionRequest{
 		DomainUUID:       common.StringPtr(info.ID),
 		TerminateRequest: terminateRequest,
@@ -690,6 +1138,53 @@ func (wh *WorkflowHandler) TerminateWorkflowExecution(ctx thrift.Context,
 	return wrapError(err)
 }
 
+// ResetWorkflowExecution - resets a workflow execution to the point before the given decision completed
+func (wh *WorkflowHandler) ResetWorkflowExecution(ctx thrift.Context,
+	resetRequest *gen.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+	wh.startWG.Wait()
+
+	if !resetRequest.IsSetDomain() {
+		return nil, errDomainNotSet
+	}
+
+	if !resetRequest.IsSetWorkflowExecution() {
+		return nil, errExecutionNotSet
+	}
+
+	if !resetRequest.GetWorkflowExecution().IsSetWorkflowId() {
+		return nil, errWorkflowIDNotSet
+	}
+
+	if resetRequest.GetWorkflowExecution().IsSetRunId() &&
+		uuid.Parse(resetRequest.GetWorkflowExecution().GetRunId()) == nil {
+		return nil, errInvalidRunID
+	}
+
+	if resetRequest.GetDecisionFinishEventId() <= 0 {
+		return nil, &gen.BadRequestError{Message: "A valid DecisionFinishEventId is not set on request."}
+	}
+
+	domainName := resetRequest.GetDomain()
+	info, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	if err := wh.checkRateLimit(info.ID, requestKindMutation); err != nil {
+		return nil, err
+	}
+
+	response, err := wh.history.ResetWorkflowExecution(ctx, &h.ResetWorkflowExecutionRequest{
+		DomainUUID:   common.StringPtr(info.ID),
+		ResetRequest: resetRequest,
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return response, nil
+}
+
 // RequestCancelWorkflowExecution - requests to cancel a workflow execution
 func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	ctx thrift.Context,
@@ -722,6 +1217,10 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 		return wrapError(err)
 	}
 
+	if err := wh.checkRateLimit(info.ID, requestKindMutation); err != nil {
+		return err
+	}
+
 	err = wh.history.RequestCancelWorkflowExecution(ctx, &h.RequestCancelWorkflowExecutionRequest{
 		DomainUUID:    common.StringPtr(info.ID),
 		CancelRequest: cancelRequest,
@@ -730,6 +1229,174 @@ func (wh *WorkflowHandler) RequestCancelWorkflowExecution(
 	return wrapError(err)
 }
 
+// RespondQueryTaskCompleted - called when the worker has finished answering a query task
+func (wh *WorkflowHandler) RespondQueryTaskCompleted(
+	ctx thrift.Context,
+	completeRequest *gen.RespondQueryTaskCompletedRequest) error {
+	wh.startWG.Wait()
+
+	if !completeRequest.IsSetTaskToken() {
+		return errTaskTokenNotSet
+	}
+	queryTaskToken, err := wh.tokenSerializer.DeserializeQueryTaskToken(completeRequest.GetTaskToken())
+	if err != nil {
+		return wrapError(err)
+	}
+	if queryTaskToken.DomainID == "" {
+		return errDomainNotSet
+	}
+	if queryTaskToken.TaskList == "" || queryTaskToken.TaskID == "" {
+		return &gen.BadRequestError{Message: "Invalid query task token."}
+	}
+
+	err = wh.matching.RespondQueryTaskCompleted(ctx, &m.RespondQueryTaskCompletedRequest{
+		DomainUUID:       common.StringPtr(queryTaskToken.DomainID),
+		TaskList:         &gen.TaskList{Name: common.StringPtr(queryTaskToken.TaskList)},
+		TaskID:           common.StringPtr(queryTaskToken.TaskID),
+		CompletedRequest: completeRequest,
+	})
+	if err != nil {
+		wh.Service.GetLogger().Errorf("RespondQueryTaskCompleted. Error: %v", err)
+	}
+	return wrapError(err)
+}
+
+// QueryWorkflow returns the result of the query answered by the decider of the workflow execution.  The query is
+// dispatched to a worker polling on the decision task list of the workflow and the call blocks until it answers.
+func (wh *WorkflowHandler) QueryWorkflow(ctx thrift.Context,
+	queryRequest *gen.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+	wh.startWG.Wait()
+
+	if !queryRequest.IsSetDomain() {
+		return nil, errDomainNotSet
+	}
+
+	if !queryRequest.IsSetExecution() {
+		return nil, errExecutionNotSet
+	}
+
+	if !queryRequest.GetExecution().IsSetWorkflowId() {
+		return nil, errWorkflowIDNotSet
+	}
+
+	if queryRequest.GetExecution().IsSetRunId() &&
+		uuid.Parse(queryRequest.GetExecution().GetRunId()) == nil {
+		return nil, errInvalidRunID
+	}
+
+	if !queryRequest.IsSetQuery() {
+		return nil, &gen.BadRequestError{Message: "WorkflowQuery is not set on request."}
+	}
+
+	if !queryRequest.GetQuery().IsSetQueryType() || queryRequest.GetQuery().GetQueryType() == "" {
+		return nil, &gen.BadRequestError{Message: "QueryType is not set on request."}
+	}
+
+	domainName := queryRequest.GetDomain()
+	info, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	// Resolve the current run and the decision task list of the workflow
+	response, err := wh.history.GetWorkflowExecutionNextEventID(ctx, &h.GetWorkflowExecutionNextEventIDRequest{
+		DomainUUID: common.StringPtr(info.ID),
+		Execution:  queryRequest.GetExecution(),
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	queryRequest.Execution = &gen.WorkflowExecution{
+		WorkflowId: queryRequest.GetExecution().WorkflowId,
+		RunId:      common.StringPtr(response.GetRunId()),
+	}
+	queryResponse, err := wh.matching.QueryWorkflow(ctx, &m.QueryWorkflowRequest{
+		DomainUUID:   common.StringPtr(info.ID),
+		TaskList:     response.GetTaskList(),
+		QueryRequest: queryRequest,
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return queryResponse, nil
+}
+
+// DescribeWorkflowExecution returns information about the specified workflow execution.
+func (wh *WorkflowHandler) DescribeWorkflowExecution(ctx thrift.Context,
+	request *gen.DescribeWorkflowExecutionRequest) (*gen.DescribeWorkflowExecutionResponse, error) {
+	wh.startWG.Wait()
+
+	if !request.IsSetDomain() {
+		return nil, errDomainNotSet
+	}
+
+	if !request.IsSetExecution() {
+		return nil, errExecutionNotSet
+	}
+
+	if !request.GetExecution().IsSetWorkflowId() {
+		return nil, errWorkflowIDNotSet
+	}
+
+	if request.GetExecution().IsSetRunId() &&
+		uuid.Parse(request.GetExecution().GetRunId()) == nil {
+		return nil, errInvalidRunID
+	}
+
+	domainName := request.GetDomain()
+	info, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	response, err := wh.history.DescribeWorkflowExecution(ctx, &h.DescribeWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(info.ID),
+		Request:    request,
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return response, nil
+}
+
+// DescribeTaskList returns the pollers which polled the target task list in the last few minutes, along with its
+// backlog status.
+func (wh *WorkflowHandler) DescribeTaskList(ctx thrift.Context,
+	request *gen.DescribeTaskListRequest) (*gen.DescribeTaskListResponse, error) {
+	wh.startWG.Wait()
+
+	if !request.IsSetDomain() {
+		return nil, errDomainNotSet
+	}
+
+	if !request.IsSetTaskList() || !request.GetTaskList().IsSetName() || request.GetTaskList().GetName() == "" {
+		return nil, errTaskListNotSet
+	}
+
+	if !request.IsSetTaskListType() {
+		return nil, &gen.BadRequestError{Message: "TaskListType is not set on request."}
+	}
+
+	domainName := request.GetDomain()
+	info, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	response, err := wh.matching.DescribeTaskList(ctx, &m.DescribeTaskListRequest{
+		DomainUUID:  common.StringPtr(info.ID),
+		DescRequest: request,
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return response, nil
+}
+
 // ListOpenWorkflowExecutions - retrieves info for open workflow executions in a domain
 func (wh *WorkflowHandler) ListOpenWorkflowExecutions(ctx thrift.Context,
 	listRequest *gen.ListOpenWorkflowExecutionsRequest) (*gen.ListOpenWorkflowExecutionsResponse, error) {
@@ -897,8 +1564,81 @@ func (wh *WorkflowHandler) ListClosedWorkflowExecutions(ctx thrift.Context,
 	return resp, nil
 }
 
+// ListWorkflowExecutions - retrieves info for open and closed workflow executions in a domain which match a query
+func (wh *WorkflowHandler) ListWorkflowExecutions(ctx thrift.Context,
+	listRequest *gen.ListWorkflowExecutionsRequest) (*gen.ListWorkflowExecutionsResponse, error) {
+	if !listRequest.IsSetDomain() {
+		return nil, errDomainNotSet
+	}
+
+	query, err := parseVisibilityQuery(listRequest.GetQuery())
+	if err != nil {
+		return nil, &gen.BadRequestError{
+			Message: fmt.Sprintf("Invalid query: %v", err),
+		}
+	}
+
+	if !listRequest.IsSetMaximumPageSize() || listRequest.GetMaximumPageSize() == 0 {
+		listRequest.MaximumPageSize = common.Int32Ptr(defaultVisibilityMaxPageSize)
+	}
+
+	domainName := listRequest.GetDomain()
+	domainInfo, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.ListWorkflowExecutionsByQuery(&persistence.ListWorkflowExecutionsByQueryRequest{
+		DomainUUID:    domainInfo.ID,
+		Query:         query,
+		PageSize:      int(listRequest.GetMaximumPageSize()),
+		NextPageToken: listRequest.GetNextPageToken(),
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	resp := gen.NewListWorkflowExecutionsResponse()
+	resp.Executions = persistenceResp.Executions
+	resp.NextPageToken = persistenceResp.NextPageToken
+	return resp, nil
+}
+
+// CountWorkflowExecutions - counts the open and closed workflow executions in a domain which match a query
+func (wh *WorkflowHandler) CountWorkflowExecutions(ctx thrift.Context,
+	countRequest *gen.CountWorkflowExecutionsRequest) (*gen.CountWorkflowExecutionsResponse, error) {
+	if !countRequest.IsSetDomain() {
+		return nil, errDomainNotSet
+	}
+
+	query, err := parseVisibilityQuery(countRequest.GetQuery())
+	if err != nil {
+		return nil, &gen.BadRequestError{
+			Message: fmt.Sprintf("Invalid query: %v", err),
+		}
+	}
+
+	domainName := countRequest.GetDomain()
+	domainInfo, _, err := wh.domainCache.GetDomain(domainName)
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	persistenceResp, err := wh.visibitiltyMgr.CountWorkflowExecutionsByQuery(&persistence.CountWorkflowExecutionsByQueryRequest{
+		DomainUUID: domainInfo.ID,
+		Query:      query,
+	})
+	if err != nil {
+		return nil, wrapError(err)
+	}
+
+	return &gen.CountWorkflowExecutionsResponse{
+		Count: common.Int64Ptr(persistenceResp.Count),
+	}, nil
+}
+
 func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExecution,
-	nextEventID int64, pageSize int32, nextPageToken []byte) (*gen.History, []byte, error) {
+	firstEventID, nextEventID int64, pageSize int32, nextPageToken []byte) (*gen.History, []byte, error) {
 
 	if nextPageToken == nil {
 		nextPageToken = []byte{}
@@ -908,6 +1648,7 @@ func (wh *WorkflowHandler) getHistory(domainID string, execution gen.WorkflowExe
 	response, err := wh.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
 		DomainID:      domainID,
 		Execution:     execution,
+		FirstEventID:  firstEventID,
 		NextEventID:   nextEventID,
 		PageSize:      int(pageSize),
 		NextPageToken: nextPageToken,
@@ -946,6 +1687,53 @@ func setSerializedHistoryDefaults(history *persistence.SerializedHistoryEventBat
 	}
 }
 
+// checkRateLimit rejects the request with ServiceBusyError if its domain, or the service as a whole, is over the quota
+// for its kind of request.  Task completions are not limited, their rate is already bounded by the polls.
+func (wh *WorkflowHandler) checkRateLimit(domainID string, kind requestKind) error {
+	_, config, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	if err := wh.rateLimiter.allow(domainID, config, kind); err != nil {
+		wh.GetMetricsClient().IncCounter(metrics.RateLimitScope, metrics.CadenceErrServiceBusyCounter)
+		return err
+	}
+	return nil
+}
+
+// checkBlobSize rejects the request if one of its payloads is larger than the blob size error limit of the domain.
+// Payloads larger than the warn limit are only logged.
+func (wh *WorkflowHandler) checkBlobSize(domainID string, blobs ...[]byte) error {
+	_, config, err := wh.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		return wrapError(err)
+	}
+
+	warnLimit := common.DefaultBlobSizeLimitWarn
+	if config.BlobSizeLimitWarn > 0 {
+		warnLimit = int(config.BlobSizeLimitWarn)
+	}
+	errorLimit := common.DefaultBlobSizeLimitError
+	if config.BlobSizeLimitError > 0 {
+		errorLimit = int(config.BlobSizeLimitError)
+	}
+
+	for _, blob := range blobs {
+		size := len(blob)
+		if size > errorLimit {
+			wh.GetMetricsClient().IncCounter(metrics.BlobSizeLimitScope, metrics.BlobSizeLimitErrorCounter)
+			logging.LogBlobSizeLimitErrorEvent(wh.GetLogger(), domainID, size, errorLimit)
+			return errBlobSizeExceedsLimit
+		}
+		if size > warnLimit {
+			wh.GetMetricsClient().IncCounter(metrics.BlobSizeLimitScope, metrics.BlobSizeLimitWarnCounter)
+			logging.LogBlobSizeLimitWarnEvent(wh.GetLogger(), domainID, size, warnLimit)
+		}
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) bark.Logger {
 	logger := wh.Service.GetLogger()
 	task, err := wh.tokenSerializer.Deserialize(taskToken)
@@ -954,11 +1742,46 @@ func (wh *WorkflowHandler) getLoggerForTask(taskToken []byte) bark.Logger {
 			"WorkflowID": task.WorkflowID,
 			"RunID":      task.RunID,
 			"ScheduleID": task.ScheduleID,
+			"ActivityID": task.ActivityID,
 		})
 	}
 	return logger
 }
 
+// createTaskTokenByID creates the task token of an activity which is identified by its ID instead, so the request can
+// go through the same history APIs as the ones made with the task token handed to the worker
+func (wh *WorkflowHandler) createTaskTokenByID(domain, workflowID, runID, activityID string) (string, []byte, error) {
+	if domain == "" {
+		return "", nil, errDomainNotSet
+	}
+	if workflowID == "" {
+		return "", nil, errWorkflowIDNotSet
+	}
+	if runID != "" && uuid.Parse(runID) == nil {
+		return "", nil, errInvalidRunID
+	}
+	if activityID == "" {
+		return "", nil, errActivityIDNotSet
+	}
+
+	info, _, err := wh.domainCache.GetDomain(domain)
+	if err != nil {
+		return "", nil, wrapError(err)
+	}
+
+	taskToken, err := wh.tokenSerializer.Serialize(&common.TaskToken{
+		DomainID:   info.ID,
+		WorkflowID: workflowID,
+		RunID:      runID,
+		ScheduleID: common.EmptyEventID,
+		ActivityID: activityID,
+	})
+	if err != nil {
+		return "", nil, wrapError(err)
+	}
+	return info.ID, taskToken, nil
+}
+
 func wrapError(err error) error {
 	if err != nil && shouldWrapInInternalServiceError(err) {
 		return &gen.InternalServiceError{Message: err.Error()}
@@ -978,6 +1801,10 @@ func shouldWrapInInternalServiceError(err error) bool {
 		return false
 	case *gen.DomainAlreadyExistsError:
 		return false
+	case *gen.QueryFailedError:
+		return false
+	case *gen.ServiceBusyError:
+		return false
 	}
 
 	return true
@@ -1008,10 +1835,33 @@ func createDomainResponse(info *persistence.DomainInfo, config *persistence.Doma
 	c := gen.NewDomainConfiguration()
 	c.EmitMetric = common.BoolPtr(config.EmitMetric)
 	c.WorkflowExecutionRetentionPeriodInDays = common.Int32Ptr(config.Retention)
+	c.HistorySizeLimitWarnInBytes = common.Int32Ptr(config.HistorySizeLimitWarn)
+	c.HistorySizeLimitErrorInBytes = common.Int32Ptr(config.HistorySizeLimitError)
+	c.HistoryCountLimitWarn = common.Int32Ptr(config.HistoryCountLimitWarn)
+	c.HistoryCountLimitError = common.Int32Ptr(config.HistoryCountLimitError)
+	c.PendingLimitWarn = common.Int32Ptr(config.PendingLimitWarn)
+	c.PendingLimitError = common.Int32Ptr(config.PendingLimitError)
+	c.BlobSizeLimitWarnInBytes = common.Int32Ptr(config.BlobSizeLimitWarn)
+	c.BlobSizeLimitErrorInBytes = common.Int32Ptr(config.BlobSizeLimitError)
+	c.PollRateLimitPerSecond = common.Int32Ptr(config.PollRateLimit)
+	c.MutationRateLimitPerSecond = common.Int32Ptr(config.MutationRateLimit)
 
 	return i, c
 }
 
+// validateDomainLimits checks the limits of the domain, which are unset when zero
+func validateDomainLimits(config *persistence.DomainConfig) error {
+	limits := []int32{config.HistorySizeLimitWarn, config.HistorySizeLimitError, config.HistoryCountLimitWarn,
+		config.HistoryCountLimitError, config.PendingLimitWarn, config.PendingLimitError, config.BlobSizeLimitWarn,
+		config.BlobSizeLimitError, config.PollRateLimit, config.MutationRateLimit}
+	for _, limit := range limits {
+		if limit < 0 {
+			return errNegativeDomainLimit
+		}
+	}
+	return nil
+}
+
 func createPollForDecisionTaskResponse(
 	matchingResponse *m.PollForDecisionTaskResponse, history *gen.History, nextPageToken []byte) *gen.PollForDecisionTaskResponse {
 	resp := gen.NewPollForDecisionTaskResponse()
@@ -1021,6 +1871,8 @@ func createPollForDecisionTaskResponse(
 		resp.WorkflowType = matchingResponse.WorkflowType
 		resp.PreviousStartedEventId = matchingResponse.PreviousStartedEventId
 		resp.StartedEventId = matchingResponse.StartedEventId
+		resp.Query = matchingResponse.Query
+		resp.Attempt = matchingResponse.Attempt
 	}
 	resp.History = history
 	resp.NextPageToken = nextPageToken
@@ -1042,7 +1894,8 @@ func deserializeGetHistoryToken(data []byte) (*getHistoryContinuationToken, erro
 	return &token, err
 }
 
-func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history *gen.History, nextEventID int64) ([]byte, error) {
+func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history *gen.History, firstEventID,
+	nextEventID int64, isWorkflowRunning bool, transientDecision *gen.TransientDecisionInfo) ([]byte, error) {
 	// create token if there are more events to read
 	if history == nil {
 		return nil, nil
@@ -1050,13 +1903,24 @@ func getSerializedGetHistoryToken(persistenceToken []byte, runID string, history
 	events := history.GetEvents()
 	if len(persistenceToken) > 0 && len(events) > 0 && events[len(events)-1].GetEventId() < nextEventID-1 {
 		token := &getHistoryContinuationToken{
-			runID:            runID,
-			nextEventID:      nextEventID,
-			persistenceToken: persistenceToken,
+			RunID:             runID,
+			FirstEventID:      firstEventID,
+			NextEventID:       nextEventID,
+			IsWorkflowRunning: isWorkflowRunning,
+			PersistenceToken:  persistenceToken,
+			TransientDecision: transientDecision,
 		}
-		data, err := json.Marshal(token)
-
-		return data, err
+		return serializeGetHistoryToken(token)
 	}
 	return nil, nil
 }
+
+func serializeGetHistoryToken(token *getHistoryContinuationToken) ([]byte, error) {
+	return json.Marshal(token)
+}
+
+// hasTimeForLongPoll returns true if the deadline of the request leaves enough time to wait for history events
+func hasTimeForLongPoll(ctx thrift.Context) bool {
+	deadline, ok := ctx.Deadline()
+	return ok && deadline.Sub(time.Now()) > longPollTailRoom
+}
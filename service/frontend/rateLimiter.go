@@ -0,0 +1,154 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"sync"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	// requestKind is the budget a request is charged to.  Polls and mutations have separate budgets so that a burst of
+	// starts or signals does not starve the workers of a domain, and the other way around.
+	requestKind int
+
+	// rateLimiterKey identifies the bucket of a domain for one kind of request
+	rateLimiterKey struct {
+		domainID string
+		kind     requestKind
+	}
+
+	// domainBucket is the token bucket of a domain together with the rate it was created with
+	domainBucket struct {
+		rps    int
+		bucket common.TokenBucket
+	}
+
+	// rateLimiter enforces the request quotas of the frontend.  Every request consumes a token from the bucket of its
+	// domain and from the global bucket of its kind.  The rates of a domain come from its configuration, which is read
+	// on every request, so a rate changed with UpdateDomain takes effect once the domain cache is refreshed.
+	rateLimiter struct {
+		sync.RWMutex
+		timeSource    common.TimeSource
+		globalBuckets map[requestKind]common.TokenBucket
+		domainBuckets map[rateLimiterKey]*domainBucket
+	}
+)
+
+const (
+	requestKindPoll requestKind = iota
+	requestKindMutation
+)
+
+const (
+	// globalPollRPS is the number of polls per second a frontend host accepts across all domains
+	globalPollRPS = 10000
+	// globalMutationRPS is the number of starts, signals and other mutations per second a frontend host accepts
+	// across all domains
+	globalMutationRPS = 10000
+	// defaultDomainPollRPS is the number of polls per second accepted from a domain which does not configure its own
+	defaultDomainPollRPS = 1000
+	// defaultDomainMutationRPS is the number of mutations per second accepted from a domain which does not configure
+	// its own
+	defaultDomainMutationRPS = 1000
+)
+
+func newRateLimiter(globalPollRPS, globalMutationRPS int, timeSource common.TimeSource) *rateLimiter {
+	return &rateLimiter{
+		timeSource: timeSource,
+		globalBuckets: map[requestKind]common.TokenBucket{
+			requestKindPoll:     common.NewTokenBucket(globalPollRPS, timeSource),
+			requestKindMutation: common.NewTokenBucket(globalMutationRPS, timeSource),
+		},
+		domainBuckets: make(map[rateLimiterKey]*domainBucket),
+	}
+}
+
+// allow consumes a token for the request from the bucket of the domain and from the global bucket.  It returns
+// ServiceBusyError if either of them is exhausted.  The domain bucket is checked first so that a domain over its own
+// quota does not use up the global one, and its token is put back if the global bucket rejects the request.
+func (r *rateLimiter) allow(domainID string, config *persistence.DomainConfig, kind requestKind) error {
+	bucket := r.getDomainBucket(domainID, getDomainRPS(config, kind), kind)
+	if ok, _ := bucket.TryConsume(1); !ok {
+		return &gen.ServiceBusyError{
+			Message: fmt.Sprintf("Domain %v exceeded its %v rate limit.", domainID, kind),
+		}
+	}
+	if ok, _ := r.globalBuckets[kind].TryConsume(1); !ok {
+		bucket.Return(1)
+		return &gen.ServiceBusyError{
+			Message: fmt.Sprintf("Service exceeded its %v rate limit.", kind),
+		}
+	}
+	return nil
+}
+
+// getDomainBucket returns the bucket of the domain.  The bucket is only replaced when the configured rate of the domain
+// changes, and the new one starts full, so a domain may be allowed up to one refill of the new rate on top of what it
+// already used in the current 100 millisecond interval.
+func (r *rateLimiter) getDomainBucket(domainID string, rps int, kind requestKind) common.TokenBucket {
+	key := rateLimiterKey{domainID: domainID, kind: kind}
+	r.RLock()
+	b, ok := r.domainBuckets[key]
+	r.RUnlock()
+	if ok && b.rps == rps {
+		return b.bucket
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	b, ok = r.domainBuckets[key]
+	if !ok || b.rps != rps {
+		b = &domainBucket{rps: rps, bucket: common.NewTokenBucket(rps, r.timeSource)}
+		r.domainBuckets[key] = b
+	}
+	return b.bucket
+}
+
+func getDomainRPS(config *persistence.DomainConfig, kind requestKind) int {
+	switch kind {
+	case requestKindPoll:
+		if config.PollRateLimit > 0 {
+			return int(config.PollRateLimit)
+		}
+		return defaultDomainPollRPS
+	default:
+		if config.MutationRateLimit > 0 {
+			return int(config.MutationRateLimit)
+		}
+		return defaultDomainMutationRPS
+	}
+}
+
+func (k requestKind) String() string {
+	switch k {
+	case requestKindPoll:
+		return "poll"
+	case requestKindMutation:
+		return "mutation"
+	default:
+		return "unknown"
+	}
+}
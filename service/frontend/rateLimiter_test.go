@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	rateLimiterSuite struct {
+		suite.Suite
+		*require.Assertions
+		timeSource *mockTimeSource
+	}
+
+	mockTimeSource struct {
+		currTime time.Time
+	}
+)
+
+func TestRateLimiterSuite(t *testing.T) {
+	suite.Run(t, new(rateLimiterSuite))
+}
+
+func (s *rateLimiterSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.timeSource = &mockTimeSource{currTime: time.Now()}
+}
+
+func (ts *mockTimeSource) Now() time.Time {
+	return ts.currTime
+}
+
+func (ts *mockTimeSource) advance(d time.Duration) {
+	ts.currTime = ts.currTime.Add(d)
+}
+
+func (s *rateLimiterSuite) TestDomainLimitEnforced() {
+	limiter := newRateLimiter(globalPollRPS, globalMutationRPS, s.timeSource)
+	config := &persistence.DomainConfig{PollRateLimit: 100}
+
+	// A bucket of 100 rps hands out 10 tokens every 100 milliseconds
+	s.Equal(10, s.consumeAll(limiter, "domain1", config, requestKindPoll))
+	err := limiter.allow("domain1", config, requestKindPoll)
+	s.IsType(&gen.ServiceBusyError{}, err)
+
+	// Other domains and the mutation budget of the same domain are not affected
+	s.Nil(limiter.allow("domain2", config, requestKindPoll))
+	s.Nil(limiter.allow("domain1", config, requestKindMutation))
+
+	s.timeSource.advance(101 * time.Millisecond)
+	s.Equal(10, s.consumeAll(limiter, "domain1", config, requestKindPoll))
+}
+
+func (s *rateLimiterSuite) TestDomainDefaultLimit() {
+	limiter := newRateLimiter(globalPollRPS, globalMutationRPS, s.timeSource)
+	config := &persistence.DomainConfig{}
+
+	s.Equal(defaultDomainMutationRPS/10, s.consumeAll(limiter, "domain1", config, requestKindMutation))
+}
+
+func (s *rateLimiterSuite) TestDomainLimitUpdated() {
+	limiter := newRateLimiter(globalPollRPS, globalMutationRPS, s.timeSource)
+	config := &persistence.DomainConfig{MutationRateLimit: 100}
+	s.Equal(10, s.consumeAll(limiter, "domain1", config, requestKindMutation))
+
+	updatedConfig := &persistence.DomainConfig{MutationRateLimit: 200}
+	s.Equal(20, s.consumeAll(limiter, "domain1", updatedConfig, requestKindMutation))
+}
+
+func (s *rateLimiterSuite) TestGlobalLimitEnforced() {
+	limiter := newRateLimiter(100, 100, s.timeSource)
+	config := &persistence.DomainConfig{PollRateLimit: 100}
+
+	s.Equal(10, s.consumeAll(limiter, "domain1", config, requestKindPoll))
+	err := limiter.allow("domain2", config, requestKindPoll)
+	s.IsType(&gen.ServiceBusyError{}, err)
+	s.Nil(limiter.allow("domain2", config, requestKindMutation))
+}
+
+func (s *rateLimiterSuite) TestGlobalLimitKeepsDomainTokens() {
+	limiter := newRateLimiter(100, 100, s.timeSource)
+	config := &persistence.DomainConfig{PollRateLimit: 20}
+
+	s.Equal(10, s.consumeAll(limiter, "domain1", &persistence.DomainConfig{PollRateLimit: 100}, requestKindPoll))
+	for i := 0; i < 5; i++ {
+		err := limiter.allow("domain2", config, requestKindPoll)
+		s.IsType(&gen.ServiceBusyError{}, err)
+	}
+
+	// The requests rejected by the global bucket did not use up the tokens of the domain
+	limiter.globalBuckets[requestKindPoll] = common.NewTokenBucket(100, s.timeSource)
+	s.Equal(2, s.consumeAll(limiter, "domain2", config, requestKindPoll))
+}
+
+// consumeAll takes tokens for the domain until it is rejected and returns the number of requests allowed
+func (s *rateLimiterSuite) consumeAll(limiter *rateLimiter, domainID string, config *persistence.DomainConfig,
+	kind requestKind) int {
+	allowed := 0
+	for ; allowed < 10000; allowed++ {
+		if err := limiter.allow(domainID, config, kind); err != nil {
+			break
+		}
+	}
+	return allowed
+}
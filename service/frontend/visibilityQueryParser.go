@@ -0,0 +1,377 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+)
+
+// The query of ListWorkflowExecutions and CountWorkflowExecutions is a SQL like WHERE clause:
+//
+//   query      := or
+//   or         := and { OR and }
+//   and        := primary { AND primary }
+//   primary    := '(' or ')' | comparison
+//   comparison := field operator value
+//   operator   := '=' | '!=' | '<>' | '<' | '<=' | '>' | '>='
+//   value      := 'string' | "string" | number | TRUE | FALSE
+//
+// Keywords are case insensitive.  WorkflowId and WorkflowType take a string, CloseStatus takes the name of a close
+// status, ExecutionStatus takes either OPEN or CLOSED and StartTime and CloseTime take either the unix time in
+// nanoseconds or an RFC3339 timestamp.  Any other field is a search attribute and takes a string, number or bool.
+
+const (
+	queryTokenEOF = iota
+	queryTokenIdentifier
+	queryTokenString
+	queryTokenNumber
+	queryTokenOperator
+	queryTokenLeftParen
+	queryTokenRightParen
+)
+
+type (
+	queryToken struct {
+		kind int
+		text string
+	}
+
+	visibilityQueryParser struct {
+		tokens []queryToken
+		pos    int
+	}
+)
+
+var queryOperators = map[string]persistence.VisibilityQueryOperator{
+	"=":  persistence.VisibilityQueryOperatorEqual,
+	"!=": persistence.VisibilityQueryOperatorNotEqual,
+	"<>": persistence.VisibilityQueryOperatorNotEqual,
+	"<":  persistence.VisibilityQueryOperatorLess,
+	"<=": persistence.VisibilityQueryOperatorLessOrEqual,
+	">":  persistence.VisibilityQueryOperatorGreater,
+	">=": persistence.VisibilityQueryOperatorGreaterOrEqual,
+}
+
+var queryFields = []string{
+	persistence.VisibilityQueryFieldWorkflowID,
+	persistence.VisibilityQueryFieldWorkflowType,
+	persistence.VisibilityQueryFieldCloseStatus,
+	persistence.VisibilityQueryFieldStartTime,
+	persistence.VisibilityQueryFieldCloseTime,
+	persistence.VisibilityQueryFieldExecutionStatus,
+}
+
+// parseVisibilityQuery parses and validates the query of a visibility request, an empty query matches
+// all the executions and is returned as nil
+func parseVisibilityQuery(query string) (persistence.VisibilityQuery, error) {
+	tokens, err := tokenizeVisibilityQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 1 {
+		return nil, nil
+	}
+
+	p := &visibilityQueryParser{tokens: tokens}
+	result, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != queryTokenEOF {
+		return nil, fmt.Errorf("unexpected %q", p.peek().text)
+	}
+	return result, nil
+}
+
+func tokenizeVisibilityQuery(query string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+
+		case r == '(':
+			tokens = append(tokens, queryToken{kind: queryTokenLeftParen, text: "("})
+			i++
+
+		case r == ')':
+			tokens = append(tokens, queryToken{kind: queryTokenRightParen, text: ")"})
+			i++
+
+		case r == '\'' || r == '"':
+			var text []rune
+			j := i + 1
+			for ; j < len(runes) && runes[j] != r; j++ {
+				if runes[j] == '\\' && j+1 < len(runes) {
+					j++
+				}
+				text = append(text, runes[j])
+			}
+			if j == len(runes) {
+				return nil, fmt.Errorf("unterminated string starting at position %v", i)
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenString, text: string(text)})
+			i = j + 1
+
+		case strings.ContainsRune("=!<>", r):
+			j := i + 1
+			if j < len(runes) && strings.ContainsRune("=>", runes[j]) {
+				j++
+			}
+			text := string(runes[i:j])
+			if _, ok := queryOperators[text]; !ok {
+				return nil, fmt.Errorf("unknown operator %q", text)
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenOperator, text: text})
+			i = j
+
+		case r == '-' || unicode.IsDigit(r):
+			j := i + 1
+			for j < len(runes) && (unicode.IsDigit(runes[j]) || runes[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenNumber, text: string(runes[i:j])})
+			i = j
+
+		case r == '_' || unicode.IsLetter(r):
+			j := i + 1
+			for j < len(runes) && (runes[j] == '_' || unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j])) {
+				j++
+			}
+			tokens = append(tokens, queryToken{kind: queryTokenIdentifier, text: string(runes[i:j])})
+			i = j
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %v", r, i)
+		}
+	}
+
+	return append(tokens, queryToken{kind: queryTokenEOF, text: "end of query"}), nil
+}
+
+func (p *visibilityQueryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+func (p *visibilityQueryParser) next() queryToken {
+	token := p.tokens[p.pos]
+	if token.kind != queryTokenEOF {
+		p.pos++
+	}
+	return token
+}
+
+func (p *visibilityQueryParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return token.kind == queryTokenIdentifier && strings.EqualFold(token.text, keyword)
+}
+
+func (p *visibilityQueryParser) parseOr() (persistence.VisibilityQuery, error) {
+	operand, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	operands := []persistence.VisibilityQuery{operand}
+	for p.isKeyword("OR") {
+		p.next()
+		operand, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &persistence.VisibilityQueryOr{Operands: operands}, nil
+}
+
+func (p *visibilityQueryParser) parseAnd() (persistence.VisibilityQuery, error) {
+	operand, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	operands := []persistence.VisibilityQuery{operand}
+	for p.isKeyword("AND") {
+		p.next()
+		operand, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		operands = append(operands, operand)
+	}
+
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return &persistence.VisibilityQueryAnd{Operands: operands}, nil
+}
+
+func (p *visibilityQueryParser) parsePrimary() (persistence.VisibilityQuery, error) {
+	if p.peek().kind == queryTokenLeftParen {
+		p.next()
+		query, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if token := p.next(); token.kind != queryTokenRightParen {
+			return nil, fmt.Errorf("expected ')' but found %q", token.text)
+		}
+		return query, nil
+	}
+
+	return p.parseComparison()
+}
+
+func (p *visibilityQueryParser) parseComparison() (persistence.VisibilityQuery, error) {
+	fieldToken := p.next()
+	if fieldToken.kind != queryTokenIdentifier || p.isKeywordToken(fieldToken) {
+		return nil, fmt.Errorf("expected a field name but found %q", fieldToken.text)
+	}
+	operatorToken := p.next()
+	if operatorToken.kind != queryTokenOperator {
+		return nil, fmt.Errorf("expected a comparison operator after %v but found %q", fieldToken.text,
+			operatorToken.text)
+	}
+	valueToken := p.next()
+
+	field := fieldToken.text
+	for _, f := range queryFields {
+		if strings.EqualFold(field, f) {
+			field = f
+		}
+	}
+	comparison := &persistence.VisibilityQueryComparison{
+		Field:    field,
+		Operator: queryOperators[operatorToken.text],
+	}
+
+	var err error
+	switch field {
+	case persistence.VisibilityQueryFieldWorkflowID, persistence.VisibilityQueryFieldWorkflowType:
+		if err = validateEqualityOperator(field, operatorToken.text); err != nil {
+			return nil, err
+		}
+		if valueToken.kind != queryTokenString {
+			return nil, fmt.Errorf("%v must be compared with a string", field)
+		}
+		comparison.Value = valueToken.text
+
+	case persistence.VisibilityQueryFieldCloseStatus:
+		if err = validateEqualityOperator(field, operatorToken.text); err != nil {
+			return nil, err
+		}
+		status, err := gen.WorkflowExecutionCloseStatusFromString(strings.ToUpper(valueToken.text))
+		if valueToken.kind != queryTokenString || err != nil {
+			return nil, fmt.Errorf("%v must be compared with the name of a close status", field)
+		}
+		comparison.Value = status
+
+	case persistence.VisibilityQueryFieldExecutionStatus:
+		if err = validateEqualityOperator(field, operatorToken.text); err != nil {
+			return nil, err
+		}
+		status := strings.ToUpper(valueToken.text)
+		if valueToken.kind != queryTokenString || (status != persistence.VisibilityQueryExecutionStatusOpen &&
+			status != persistence.VisibilityQueryExecutionStatusClosed) {
+			return nil, fmt.Errorf("%v must be compared with either 'OPEN' or 'CLOSED'", field)
+		}
+		comparison.Value = status
+
+	case persistence.VisibilityQueryFieldStartTime, persistence.VisibilityQueryFieldCloseTime:
+		if comparison.Value, err = parseQueryTime(field, valueToken); err != nil {
+			return nil, err
+		}
+
+	default:
+		if comparison.Value, err = parseQuerySearchAttribute(field, valueToken); err != nil {
+			return nil, err
+		}
+		if _, ok := comparison.Value.(bool); ok {
+			if err = validateEqualityOperator(field, operatorToken.text); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return comparison, nil
+}
+
+func (p *visibilityQueryParser) isKeywordToken(token queryToken) bool {
+	for _, keyword := range []string{"AND", "OR", "TRUE", "FALSE"} {
+		if strings.EqualFold(token.text, keyword) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateEqualityOperator(field, operator string) error {
+	switch queryOperators[operator] {
+	case persistence.VisibilityQueryOperatorEqual, persistence.VisibilityQueryOperatorNotEqual:
+		return nil
+	}
+	return fmt.Errorf("%v can only be compared with '=' or '!='", field)
+}
+
+func parseQueryTime(field string, token queryToken) (int64, error) {
+	switch token.kind {
+	case queryTokenNumber:
+		if value, err := strconv.ParseInt(token.text, 10, 64); err == nil {
+			return value, nil
+		}
+	case queryTokenString:
+		if value, err := time.Parse(time.RFC3339Nano, token.text); err == nil {
+			return value.UnixNano(), nil
+		}
+	}
+	return 0, fmt.Errorf("%v must be compared with the unix time in nanoseconds or an RFC3339 timestamp", field)
+}
+
+func parseQuerySearchAttribute(field string, token queryToken) (interface{}, error) {
+	switch token.kind {
+	case queryTokenString:
+		return token.text, nil
+	case queryTokenNumber:
+		if value, err := strconv.ParseFloat(token.text, 64); err == nil {
+			return value, nil
+		}
+	case queryTokenIdentifier:
+		switch {
+		case strings.EqualFold(token.text, "TRUE"):
+			return true, nil
+		case strings.EqualFold(token.text, "FALSE"):
+			return false, nil
+		}
+	}
+	return nil, fmt.Errorf("search attribute %v must be compared with a string, a number or a bool but found %q",
+		field, token.text)
+}
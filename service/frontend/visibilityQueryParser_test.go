@@ -0,0 +1,152 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package frontend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	gen "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/persistence"
+)
+
+type visibilityQueryParserSuite struct {
+	suite.Suite
+}
+
+func TestVisibilityQueryParserSuite(t *testing.T) {
+	suite.Run(t, new(visibilityQueryParserSuite))
+}
+
+func (s *visibilityQueryParserSuite) TestEmptyQuery() {
+	query, err := parseVisibilityQuery("  ")
+	s.Nil(err)
+	s.Nil(query)
+}
+
+func (s *visibilityQueryParserSuite) TestConjunction() {
+	startTime, _ := time.Parse(time.RFC3339, "2018-01-01T00:00:00Z")
+	query, err := parseVisibilityQuery(
+		"workflowtype = 'orderProcessing' and CloseStatus = 'failed' AND StartTime >= '2018-01-01T00:00:00Z'")
+	s.Nil(err)
+	s.Equal(&persistence.VisibilityQueryAnd{Operands: []persistence.VisibilityQuery{
+		&persistence.VisibilityQueryComparison{
+			Field:    persistence.VisibilityQueryFieldWorkflowType,
+			Operator: persistence.VisibilityQueryOperatorEqual,
+			Value:    "orderProcessing",
+		},
+		&persistence.VisibilityQueryComparison{
+			Field:    persistence.VisibilityQueryFieldCloseStatus,
+			Operator: persistence.VisibilityQueryOperatorEqual,
+			Value:    gen.WorkflowExecutionCloseStatus_FAILED,
+		},
+		&persistence.VisibilityQueryComparison{
+			Field:    persistence.VisibilityQueryFieldStartTime,
+			Operator: persistence.VisibilityQueryOperatorGreaterOrEqual,
+			Value:    startTime.UnixNano(),
+		},
+	}}, query)
+}
+
+func (s *visibilityQueryParserSuite) TestPrecedence() {
+	query, err := parseVisibilityQuery(`CustomerId = "c1" OR (OrderId > 10 AND Shipped != false)`)
+	s.Nil(err)
+	s.Equal(&persistence.VisibilityQueryOr{Operands: []persistence.VisibilityQuery{
+		&persistence.VisibilityQueryComparison{
+			Field:    "CustomerId",
+			Operator: persistence.VisibilityQueryOperatorEqual,
+			Value:    "c1",
+		},
+		&persistence.VisibilityQueryAnd{Operands: []persistence.VisibilityQuery{
+			&persistence.VisibilityQueryComparison{
+				Field:    "OrderId",
+				Operator: persistence.VisibilityQueryOperatorGreater,
+				Value:    float64(10),
+			},
+			&persistence.VisibilityQueryComparison{
+				Field:    "Shipped",
+				Operator: persistence.VisibilityQueryOperatorNotEqual,
+				Value:    false,
+			},
+		}},
+	}}, query)
+}
+
+func (s *visibilityQueryParserSuite) TestMatches() {
+	query, err := parseVisibilityQuery("WorkflowId = 'wid' AND (CloseTime < 100 OR CustomerId = 'c1')")
+	s.Nil(err)
+
+	info := &gen.WorkflowExecutionInfo{
+		Execution: &gen.WorkflowExecution{WorkflowId: common.StringPtr("wid")},
+		StartTime: common.Int64Ptr(10),
+	}
+	s.False(query.Matches(info))
+
+	info.SearchAttributes = &gen.SearchAttributes{IndexedFields: map[string][]byte{"CustomerId": []byte(`"c1"`)}}
+	s.True(query.Matches(info))
+
+	info.SearchAttributes = nil
+	info.CloseTime = common.Int64Ptr(50)
+	s.True(query.Matches(info))
+}
+
+func (s *visibilityQueryParserSuite) TestExecutionStatus() {
+	query, err := parseVisibilityQuery("executionstatus = 'open'")
+	s.Nil(err)
+	s.Equal(&persistence.VisibilityQueryComparison{
+		Field:    persistence.VisibilityQueryFieldExecutionStatus,
+		Operator: persistence.VisibilityQueryOperatorEqual,
+		Value:    persistence.VisibilityQueryExecutionStatusOpen,
+	}, query)
+
+	info := &gen.WorkflowExecutionInfo{StartTime: common.Int64Ptr(10)}
+	s.True(query.Matches(info))
+	info.CloseStatus = gen.WorkflowExecutionCloseStatusPtr(gen.WorkflowExecutionCloseStatus_COMPLETED)
+	s.False(query.Matches(info))
+}
+
+func (s *visibilityQueryParserSuite) TestInvalidQueries() {
+	for _, query := range []string{
+		"WorkflowId",
+		"WorkflowId = ",
+		"WorkflowId == 'wid'",
+		"WorkflowId = 'wid",
+		"WorkflowId = 'wid' AND",
+		"WorkflowId = 'wid' OR OR CloseTime > 1",
+		"(WorkflowId = 'wid'",
+		"WorkflowId = 'wid')",
+		"WorkflowId > 'wid'",
+		"WorkflowId = 10",
+		"CloseStatus = 'UNKNOWN'",
+		"ExecutionStatus = 'RUNNING'",
+		"ExecutionStatus > 'OPEN'",
+		"StartTime > 'yesterday'",
+		"Shipped > true",
+		"CustomerId = c1",
+		"AND = 'c1'",
+		"CustomerId = 'c1' ;",
+	} {
+		_, err := parseVisibilityQuery(query)
+		s.NotNil(err, query)
+	}
+}
@@ -23,6 +23,7 @@ package history
 import "github.com/stretchr/testify/mock"
 import gohistory "github.com/uber/cadence/.gen/go/history"
 import "github.com/uber/cadence/.gen/go/shared"
+import "github.com/uber/tchannel-go/thrift"
 
 // MockHistoryEngine is used as mock implementation for HistoryEngine
 type MockHistoryEngine struct {
@@ -63,12 +64,12 @@ func (_m *MockHistoryEngine) StartWorkflowExecution(request *gohistory.StartWork
 }
 
 // GetWorkflowExecutionNextEventID is mock implementation for GetWorkflowExecutionNextEventID of HistoryEngine
-func (_m *MockHistoryEngine) GetWorkflowExecutionNextEventID(request *gohistory.GetWorkflowExecutionNextEventIDRequest) (*gohistory.GetWorkflowExecutionNextEventIDResponse, error) {
-	ret := _m.Called(request)
+func (_m *MockHistoryEngine) GetWorkflowExecutionNextEventID(ctx thrift.Context, request *gohistory.GetWorkflowExecutionNextEventIDRequest) (*gohistory.GetWorkflowExecutionNextEventIDResponse, error) {
+	ret := _m.Called(ctx, request)
 
 	var r0 *gohistory.GetWorkflowExecutionNextEventIDResponse
-	if rf, ok := ret.Get(0).(func(*gohistory.GetWorkflowExecutionNextEventIDRequest) *gohistory.GetWorkflowExecutionNextEventIDResponse); ok {
-		r0 = rf(request)
+	if rf, ok := ret.Get(0).(func(thrift.Context, *gohistory.GetWorkflowExecutionNextEventIDRequest) *gohistory.GetWorkflowExecutionNextEventIDResponse); ok {
+		r0 = rf(ctx, request)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gohistory.GetWorkflowExecutionNextEventIDResponse)
@@ -76,8 +77,8 @@ func (_m *MockHistoryEngine) GetWorkflowExecutionNextEventID(request *gohistory.
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*gohistory.GetWorkflowExecutionNextEventIDRequest) error); ok {
-		r1 = rf(request)
+	if rf, ok := ret.Get(1).(func(thrift.Context, *gohistory.GetWorkflowExecutionNextEventIDRequest) error); ok {
+		r1 = rf(ctx, request)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -145,6 +146,20 @@ func (_m *MockHistoryEngine) RespondDecisionTaskCompleted(request *gohistory.Res
 	return r0
 }
 
+// RespondDecisionTaskFailed is mock implementation for RespondDecisionTaskFailed of HistoryEngine
+func (_m *MockHistoryEngine) RespondDecisionTaskFailed(request *gohistory.RespondDecisionTaskFailedRequest) error {
+	ret := _m.Called(request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*gohistory.RespondDecisionTaskFailedRequest) error); ok {
+		r0 = rf(request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RespondActivityTaskCompleted is mock implementation for RespondActivityTaskCompleted of HistoryEngine
 func (_m *MockHistoryEngine) RespondActivityTaskCompleted(request *gohistory.RespondActivityTaskCompletedRequest) error {
 	ret := _m.Called(request)
@@ -238,6 +253,29 @@ func (_m *MockHistoryEngine) SignalWorkflowExecution(request *gohistory.SignalWo
 	return r0
 }
 
+// SignalWithStartWorkflowExecution is mock implementation for SignalWithStartWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) SignalWithStartWorkflowExecution(request *gohistory.SignalWithStartWorkflowExecutionRequest) (*shared.StartWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.StartWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.SignalWithStartWorkflowExecutionRequest) *shared.StartWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.StartWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.SignalWithStartWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TerminateWorkflowExecution is mock implementation for TerminateWorkflowExecution of HistoryEngine
 func (_m *MockHistoryEngine) TerminateWorkflowExecution(request *gohistory.TerminateWorkflowExecutionRequest) error {
 	ret := _m.Called(request)
@@ -280,4 +318,50 @@ func (_m *MockHistoryEngine) RecordChildExecutionCompleted(request *gohistory.Re
 	return r0
 }
 
+// DescribeWorkflowExecution is mock implementation for DescribeWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) DescribeWorkflowExecution(request *gohistory.DescribeWorkflowExecutionRequest) (*shared.DescribeWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.DescribeWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.DescribeWorkflowExecutionRequest) *shared.DescribeWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.DescribeWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.DescribeWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetWorkflowExecution is mock implementation for ResetWorkflowExecution of HistoryEngine
+func (_m *MockHistoryEngine) ResetWorkflowExecution(request *gohistory.ResetWorkflowExecutionRequest) (*shared.ResetWorkflowExecutionResponse, error) {
+	ret := _m.Called(request)
+
+	var r0 *shared.ResetWorkflowExecutionResponse
+	if rf, ok := ret.Get(0).(func(*gohistory.ResetWorkflowExecutionRequest) *shared.ResetWorkflowExecutionResponse); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*shared.ResetWorkflowExecutionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*gohistory.ResetWorkflowExecutionRequest) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 var _ Engine = (*MockHistoryEngine)(nil)
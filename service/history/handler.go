@@ -379,6 +379,48 @@ func (h *Handler) RespondDecisionTaskCompleted(ctx thrift.Context,
 	return nil
 }
 
+// RespondDecisionTaskFailed - failed response to a decision task
+func (h *Handler) RespondDecisionTaskFailed(ctx thrift.Context,
+	wrappedRequest *hist.RespondDecisionTaskFailedRequest) error {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryRespondDecisionTaskFailedScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryRespondDecisionTaskFailedScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if !wrappedRequest.IsSetDomainUUID() {
+		return errDomainNotSet
+	}
+
+	failedRequest := wrappedRequest.GetFailedRequest()
+	token, err0 := h.tokenSerializer.Deserialize(failedRequest.GetTaskToken())
+	if err0 != nil {
+		err0 = &gen.BadRequestError{Message: fmt.Sprintf("Error deserializing task token. Error: %v", err0)}
+		h.updateErrorMetric(metrics.HistoryRespondDecisionTaskFailedScope, err0)
+		return err0
+	}
+
+	h.Service.GetLogger().Debugf("RespondDecisionTaskFailed. DomainID: %v, WorkflowID: %v, RunID: %v, ScheduleID: %v",
+		token.DomainID,
+		token.WorkflowID,
+		token.RunID,
+		token.ScheduleID)
+
+	engine, err1 := h.controller.GetEngine(token.WorkflowID)
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryRespondDecisionTaskFailedScope, err1)
+		return err1
+	}
+
+	err2 := engine.RespondDecisionTaskFailed(wrappedRequest)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryRespondDecisionTaskFailedScope, h.convertError(err2))
+		return h.convertError(err2)
+	}
+
+	return nil
+}
+
 // StartWorkflowExecution - creates a new workflow execution
 func (h *Handler) StartWorkflowExecution(ctx thrift.Context,
 	wrappedRequest *hist.StartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
@@ -428,7 +470,7 @@ func (h *Handler) GetWorkflowExecutionNextEventID(ctx thrift.Context,
 		return nil, err1
 	}
 
-	resp, err2 := engine.GetWorkflowExecutionNextEventID(getRequest)
+	resp, err2 := engine.GetWorkflowExecutionNextEventID(ctx, getRequest)
 	if err2 != nil {
 		h.updateErrorMetric(metrics.HistoryGetWorkflowExecutionNextEventIDScope, h.convertError(err2))
 		return nil, h.convertError(err2)
@@ -436,6 +478,62 @@ func (h *Handler) GetWorkflowExecutionNextEventID(ctx thrift.Context,
 	return resp, nil
 }
 
+// DescribeWorkflowExecution - returns information about the specified workflow execution
+func (h *Handler) DescribeWorkflowExecution(ctx thrift.Context,
+	request *hist.DescribeWorkflowExecutionRequest) (*gen.DescribeWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryDescribeWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryDescribeWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if !request.IsSetDomainUUID() {
+		return nil, errDomainNotSet
+	}
+
+	workflowExecution := request.GetRequest().GetExecution()
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.DescribeWorkflowExecution(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryDescribeWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
+// ResetWorkflowExecution - resets a workflow execution to a prior decision in a new run
+func (h *Handler) ResetWorkflowExecution(ctx thrift.Context,
+	request *hist.ResetWorkflowExecutionRequest) (*gen.ResetWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistoryResetWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if !request.IsSetDomainUUID() {
+		return nil, errDomainNotSet
+	}
+
+	workflowExecution := request.GetResetRequest().GetWorkflowExecution()
+	engine, err1 := h.controller.GetEngine(workflowExecution.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	resp, err2 := engine.ResetWorkflowExecution(request)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistoryResetWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+	return resp, nil
+}
+
 // RequestCancelWorkflowExecution - requests cancellation of a workflow
 func (h *Handler) RequestCancelWorkflowExecution(ctx thrift.Context,
 	request *hist.RequestCancelWorkflowExecutionRequest) error {
@@ -498,6 +596,36 @@ func (h *Handler) SignalWorkflowExecution(ctx thrift.Context,
 	return nil
 }
 
+// SignalWithStartWorkflowExecution is used to ensure sending a signal event to a workflow execution.  If the workflow
+// is not running, a new execution is started with the signal recorded right after its started event.
+func (h *Handler) SignalWithStartWorkflowExecution(ctx thrift.Context,
+	wrappedRequest *hist.SignalWithStartWorkflowExecutionRequest) (*gen.StartWorkflowExecutionResponse, error) {
+	h.startWG.Wait()
+
+	h.metricsClient.IncCounter(metrics.HistorySignalWithStartWorkflowExecutionScope, metrics.CadenceRequests)
+	sw := h.metricsClient.StartTimer(metrics.HistorySignalWithStartWorkflowExecutionScope, metrics.CadenceLatency)
+	defer sw.Stop()
+
+	if !wrappedRequest.IsSetDomainUUID() {
+		return nil, errDomainNotSet
+	}
+
+	signalWithStartRequest := wrappedRequest.GetSignalWithStartRequest()
+	engine, err1 := h.controller.GetEngine(signalWithStartRequest.GetWorkflowId())
+	if err1 != nil {
+		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, err1)
+		return nil, err1
+	}
+
+	response, err2 := engine.SignalWithStartWorkflowExecution(wrappedRequest)
+	if err2 != nil {
+		h.updateErrorMetric(metrics.HistorySignalWithStartWorkflowExecutionScope, h.convertError(err2))
+		return nil, h.convertError(err2)
+	}
+
+	return response, nil
+}
+
 // TerminateWorkflowExecution terminates an existing workflow execution by recording WorkflowExecutionTerminated event
 // in the history and immediately terminating the execution instance.
 func (h *Handler) TerminateWorkflowExecution(ctx thrift.Context,
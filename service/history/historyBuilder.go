@@ -21,6 +21,8 @@
 package history
 
 import (
+	"time"
+
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
@@ -32,6 +34,12 @@ import (
 const (
 	firstEventID int64 = 1
 	emptyEventID int64 = -23
+	// bufferedEventID is the placeholder ID of the events which are buffered while a decision is in flight, they
+	// get their actual IDs once they are flushed into the history
+	bufferedEventID int64 = -123
+	// transientEventID is the started ID of an attempt of an activity with a retry policy, whose started event is only
+	// kept in mutable state until the final attempt closes the activity
+	transientEventID int64 = -124
 )
 
 type (
@@ -69,8 +77,8 @@ func (b *historyBuilder) AddWorkflowExecutionStartedEvent(
 }
 
 func (b *historyBuilder) AddDecisionTaskScheduledEvent(taskList string,
-	startToCloseTimeoutSeconds int32) *workflow.HistoryEvent {
-	event := b.newDecisionTaskScheduledEvent(taskList, startToCloseTimeoutSeconds)
+	startToCloseTimeoutSeconds int32, attempt int64) *workflow.HistoryEvent {
+	event := b.newDecisionTaskScheduledEvent(taskList, startToCloseTimeoutSeconds, attempt)
 
 	return b.addEventToHistory(event)
 }
@@ -90,15 +98,15 @@ func (b *historyBuilder) AddDecisionTaskCompletedEvent(scheduleEventID, startedE
 }
 
 func (b *historyBuilder) AddDecisionTaskTimedOutEvent(scheduleEventID int64,
-	startedEventID int64) *workflow.HistoryEvent {
-	event := b.newDecisionTaskTimedOutEvent(scheduleEventID, startedEventID)
+	startedEventID int64, timeoutType workflow.TimeoutType) *workflow.HistoryEvent {
+	event := b.newDecisionTaskTimedOutEvent(scheduleEventID, startedEventID, timeoutType)
 
 	return b.addEventToHistory(event)
 }
 
 func (b *historyBuilder) AddDecisionTaskFailedEvent(scheduleEventID int64, startedEventID int64,
-	cause workflow.DecisionTaskFailedCause, request *workflow.RespondDecisionTaskCompletedRequest) *workflow.HistoryEvent {
-	event := b.newDecisionTaskFailedEvent(scheduleEventID, startedEventID, cause, request)
+	cause workflow.DecisionTaskFailedCause, details []byte, identity string) *workflow.HistoryEvent {
+	event := b.newDecisionTaskFailedEvent(scheduleEventID, startedEventID, cause, details, identity)
 
 	return b.addEventToHistory(event)
 }
@@ -110,9 +118,9 @@ func (b *historyBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID
 	return b.addEventToHistory(event)
 }
 
-func (b *historyBuilder) AddActivityTaskStartedEvent(scheduleEventID int64, requestID string,
+func (b *historyBuilder) AddActivityTaskStartedEvent(scheduleEventID int64, attempt int32, requestID string,
 	request *workflow.PollForActivityTaskRequest) *workflow.HistoryEvent {
-	event := b.newActivityTaskStartedEvent(scheduleEventID, requestID, request)
+	event := b.newActivityTaskStartedEvent(scheduleEventID, attempt, requestID, request)
 
 	return b.addEventToHistory(event)
 }
@@ -159,6 +167,12 @@ func (b *historyBuilder) AddWorkflowExecutionTerminatedEvent(
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddTimeoutWorkflowEvent() *workflow.HistoryEvent {
+	event := b.newWorkflowExecutionTimedOutEvent()
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddContinuedAsNewEvent(decisionCompletedEventID int64, newRunID string,
 	attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes)
@@ -303,6 +317,30 @@ func (b *historyBuilder) AddExternalWorkflowExecutionCancelRequested(initiatedEv
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddSignalExternalWorkflowExecutionInitiatedEvent(decisionTaskCompletedEventID int64,
+	attributes *workflow.SignalExternalWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newSignalExternalWorkflowExecutionInitiatedEvent(decisionTaskCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
+	domain, workflowID, runID string, control []byte,
+	cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
+	event := b.newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID,
+		domain, workflowID, runID, control, cause)
+
+	return b.addEventToHistory(event)
+}
+
+func (b *historyBuilder) AddExternalWorkflowExecutionSignaled(initiatedEventID int64,
+	domain, workflowID, runID string, control []byte) *workflow.HistoryEvent {
+	event := b.newExternalWorkflowExecutionSignaledEvent(initiatedEventID,
+		domain, workflowID, runID, control)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 	attributes *workflow.RecordMarkerDecisionAttributes) *workflow.HistoryEvent {
 	event := b.newMarkerRecordedEventAttributes(decisionCompletedEventID, attributes)
@@ -310,6 +348,13 @@ func (b *historyBuilder) AddMarkerRecordedEvent(decisionCompletedEventID int64,
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	event := b.newUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) AddWorkflowExecutionSignaledEvent(
 	request *workflow.SignalWorkflowExecutionRequest) *workflow.HistoryEvent {
 	event := b.newWorkflowExecutionSignaledEvent(request)
@@ -375,6 +420,15 @@ func (b *historyBuilder) AddChildWorkflowExecutionTerminatedEvent(domain string,
 	return b.addEventToHistory(event)
 }
 
+func (b *historyBuilder) AddChildWorkflowExecutionTimedOutEvent(domain string, execution *workflow.WorkflowExecution,
+	workflowType *workflow.WorkflowType, initiatedID, startedID int64,
+	timedOutAttributes *workflow.WorkflowExecutionTimedOutEventAttributes) *workflow.HistoryEvent {
+	event := b.newChildWorkflowExecutionTimedOutEvent(domain, execution, workflowType, initiatedID, startedID,
+		timedOutAttributes)
+
+	return b.addEventToHistory(event)
+}
+
 func (b *historyBuilder) addEventToHistory(event *workflow.HistoryEvent) *workflow.HistoryEvent {
 	b.history = append(b.history, event)
 	return event
@@ -390,33 +444,31 @@ func (b *historyBuilder) newWorkflowExecutionStartedEvent(
 	attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(request.GetExecutionStartToCloseTimeoutSeconds())
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(request.GetTaskStartToCloseTimeoutSeconds())
 	attributes.Identity = common.StringPtr(request.GetIdentity())
+	attributes.RetryPolicy = request.GetRetryPolicy()
+	attributes.CronSchedule = request.CronSchedule
+	attributes.Memo = request.GetMemo()
+	attributes.SearchAttributes = request.GetSearchAttributes()
+	attributes.Attempt = common.Int32Ptr(b.msBuilder.executionInfo.Attempt)
+	if !b.msBuilder.executionInfo.ExpirationTime.IsZero() {
+		attributes.ExpirationTimestamp = common.Int64Ptr(b.msBuilder.executionInfo.ExpirationTime.UnixNano())
+	}
 	historyEvent.WorkflowExecutionStartedEventAttributes = attributes
 
 	return historyEvent
 }
 
 func (b *historyBuilder) newDecisionTaskScheduledEvent(taskList string,
-	startToCloseTimeoutSeconds int32) *workflow.HistoryEvent {
+	startToCloseTimeoutSeconds int32, attempt int64) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_DecisionTaskScheduled)
-	attributes := workflow.NewDecisionTaskScheduledEventAttributes()
-	attributes.TaskList = workflow.NewTaskList()
-	attributes.TaskList.Name = common.StringPtr(taskList)
-	attributes.StartToCloseTimeoutSeconds = common.Int32Ptr(startToCloseTimeoutSeconds)
-	historyEvent.DecisionTaskScheduledEventAttributes = attributes
 
-	return historyEvent
+	return setDecisionTaskScheduledEventInfo(historyEvent, taskList, startToCloseTimeoutSeconds, attempt)
 }
 
 func (b *historyBuilder) newDecisionTaskStartedEvent(scheduledEventID int64, requestID string,
 	request *workflow.PollForDecisionTaskRequest) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_DecisionTaskStarted)
-	attributes := workflow.NewDecisionTaskStartedEventAttributes()
-	attributes.ScheduledEventId = common.Int64Ptr(scheduledEventID)
-	attributes.Identity = common.StringPtr(request.GetIdentity())
-	attributes.RequestId = common.StringPtr(requestID)
-	historyEvent.DecisionTaskStartedEventAttributes = attributes
 
-	return historyEvent
+	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, request.GetIdentity())
 }
 
 func (b *historyBuilder) newDecisionTaskCompletedEvent(scheduleEventID, startedEventID int64,
@@ -432,25 +484,117 @@ func (b *historyBuilder) newDecisionTaskCompletedEvent(scheduleEventID, startedE
 	return historyEvent
 }
 
-func (b *historyBuilder) newDecisionTaskTimedOutEvent(scheduleEventID int64, startedEventID int64) *workflow.HistoryEvent {
+func (b *historyBuilder) newDecisionTaskTimedOutEvent(scheduleEventID int64, startedEventID int64,
+	timeoutType workflow.TimeoutType) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_DecisionTaskTimedOut)
+
+	return setDecisionTaskTimedOutEventInfo(historyEvent, scheduleEventID, startedEventID, timeoutType)
+}
+
+func (b *historyBuilder) newDecisionTaskFailedEvent(scheduleEventID int64, startedEventID int64,
+	cause workflow.DecisionTaskFailedCause, details []byte, identity string) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_DecisionTaskFailed)
+
+	return setDecisionTaskFailedEventInfo(historyEvent, scheduleEventID, startedEventID, cause, details, identity)
+}
+
+// newTransientDecisionTaskScheduledEvent creates the scheduled event of a retried decision.  The event is not added to
+// the history, it is only handed to the worker until the decision completes.
+func (b *historyBuilder) newTransientDecisionTaskScheduledEvent(eventID, timestamp int64, taskList string,
+	startToCloseTimeoutSeconds int32, attempt int64) *workflow.HistoryEvent {
+	historyEvent := createTransientHistoryEvent(eventID, workflow.EventType_DecisionTaskScheduled, timestamp)
+
+	return setDecisionTaskScheduledEventInfo(historyEvent, taskList, startToCloseTimeoutSeconds, attempt)
+}
+
+// newTransientDecisionTaskStartedEvent creates the started event of a retried decision, which is not added to the history
+func (b *historyBuilder) newTransientDecisionTaskStartedEvent(eventID, timestamp int64, scheduledEventID int64,
+	requestID string, identity string) *workflow.HistoryEvent {
+	historyEvent := createTransientHistoryEvent(eventID, workflow.EventType_DecisionTaskStarted, timestamp)
+
+	return setDecisionTaskStartedEventInfo(historyEvent, scheduledEventID, requestID, identity)
+}
+
+// newTransientDecisionTaskTimedOutEvent creates the timeout event of a retried decision, which is not added to the history
+func (b *historyBuilder) newTransientDecisionTaskTimedOutEvent(eventID int64, scheduleEventID int64,
+	startedEventID int64, timeoutType workflow.TimeoutType) *workflow.HistoryEvent {
+	historyEvent := createTransientHistoryEvent(eventID, workflow.EventType_DecisionTaskTimedOut, time.Now().UnixNano())
+
+	return setDecisionTaskTimedOutEventInfo(historyEvent, scheduleEventID, startedEventID, timeoutType)
+}
+
+// newTransientDecisionTaskFailedEvent creates the failure event of a retried decision, which is not added to the history
+func (b *historyBuilder) newTransientDecisionTaskFailedEvent(eventID int64, scheduleEventID int64,
+	startedEventID int64, cause workflow.DecisionTaskFailedCause, details []byte,
+	identity string) *workflow.HistoryEvent {
+	historyEvent := createTransientHistoryEvent(eventID, workflow.EventType_DecisionTaskFailed, time.Now().UnixNano())
+
+	return setDecisionTaskFailedEventInfo(historyEvent, scheduleEventID, startedEventID, cause, details, identity)
+}
+
+func createTransientHistoryEvent(eventID int64, eventType workflow.EventType, timestamp int64) *workflow.HistoryEvent {
+	historyEvent := workflow.NewHistoryEvent()
+	historyEvent.EventId = common.Int64Ptr(eventID)
+	historyEvent.Timestamp = common.Int64Ptr(timestamp)
+	historyEvent.EventType = workflow.EventTypePtr(eventType)
+
+	return historyEvent
+}
+
+func setActivityTaskStartedEventInfo(historyEvent *workflow.HistoryEvent, scheduledEventID int64, attempt int32,
+	requestID string, identity string) *workflow.HistoryEvent {
+	attributes := workflow.NewActivityTaskStartedEventAttributes()
+	attributes.ScheduledEventId = common.Int64Ptr(scheduledEventID)
+	attributes.Identity = common.StringPtr(identity)
+	attributes.RequestId = common.StringPtr(requestID)
+	attributes.Attempt = common.Int32Ptr(attempt)
+	historyEvent.ActivityTaskStartedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func setDecisionTaskScheduledEventInfo(historyEvent *workflow.HistoryEvent, taskList string,
+	startToCloseTimeoutSeconds int32, attempt int64) *workflow.HistoryEvent {
+	attributes := workflow.NewDecisionTaskScheduledEventAttributes()
+	attributes.TaskList = workflow.NewTaskList()
+	attributes.TaskList.Name = common.StringPtr(taskList)
+	attributes.StartToCloseTimeoutSeconds = common.Int32Ptr(startToCloseTimeoutSeconds)
+	attributes.Attempt = common.Int64Ptr(attempt)
+	historyEvent.DecisionTaskScheduledEventAttributes = attributes
+
+	return historyEvent
+}
+
+func setDecisionTaskStartedEventInfo(historyEvent *workflow.HistoryEvent, scheduledEventID int64, requestID string,
+	identity string) *workflow.HistoryEvent {
+	attributes := workflow.NewDecisionTaskStartedEventAttributes()
+	attributes.ScheduledEventId = common.Int64Ptr(scheduledEventID)
+	attributes.Identity = common.StringPtr(identity)
+	attributes.RequestId = common.StringPtr(requestID)
+	historyEvent.DecisionTaskStartedEventAttributes = attributes
+
+	return historyEvent
+}
+
+func setDecisionTaskTimedOutEventInfo(historyEvent *workflow.HistoryEvent, scheduleEventID int64,
+	startedEventID int64, timeoutType workflow.TimeoutType) *workflow.HistoryEvent {
 	attributes := workflow.NewDecisionTaskTimedOutEventAttributes()
 	attributes.ScheduledEventId = common.Int64Ptr(scheduleEventID)
 	attributes.StartedEventId = common.Int64Ptr(startedEventID)
-	attributes.TimeoutType = workflow.TimeoutTypePtr(workflow.TimeoutType_START_TO_CLOSE)
+	attributes.TimeoutType = workflow.TimeoutTypePtr(timeoutType)
 	historyEvent.DecisionTaskTimedOutEventAttributes = attributes
 
 	return historyEvent
 }
 
-func (b *historyBuilder) newDecisionTaskFailedEvent(scheduleEventID int64, startedEventID int64,
-	cause workflow.DecisionTaskFailedCause, request *workflow.RespondDecisionTaskCompletedRequest) *workflow.HistoryEvent {
-	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_DecisionTaskFailed)
+func setDecisionTaskFailedEventInfo(historyEvent *workflow.HistoryEvent, scheduleEventID int64, startedEventID int64,
+	cause workflow.DecisionTaskFailedCause, details []byte, identity string) *workflow.HistoryEvent {
 	attributes := workflow.NewDecisionTaskFailedEventAttributes()
 	attributes.ScheduledEventId = common.Int64Ptr(scheduleEventID)
 	attributes.StartedEventId = common.Int64Ptr(startedEventID)
 	attributes.Cause = workflow.DecisionTaskFailedCausePtr(cause)
-	attributes.Identity = common.StringPtr(request.GetIdentity())
+	attributes.Details = details
+	attributes.Identity = common.StringPtr(identity)
 	historyEvent.DecisionTaskFailedEventAttributes = attributes
 
 	return historyEvent
@@ -462,6 +606,7 @@ func (b *historyBuilder) newActivityTaskScheduledEvent(decisionTaskCompletedEven
 	attributes := workflow.NewActivityTaskScheduledEventAttributes()
 	attributes.ActivityId = common.StringPtr(scheduleAttributes.GetActivityId())
 	attributes.ActivityType = scheduleAttributes.GetActivityType()
+	attributes.Domain = scheduleAttributes.Domain
 	attributes.TaskList = scheduleAttributes.GetTaskList()
 	attributes.Input = scheduleAttributes.GetInput()
 	attributes.ScheduleToCloseTimeoutSeconds = common.Int32Ptr(scheduleAttributes.GetScheduleToCloseTimeoutSeconds())
@@ -469,21 +614,27 @@ func (b *historyBuilder) newActivityTaskScheduledEvent(decisionTaskCompletedEven
 	attributes.StartToCloseTimeoutSeconds = common.Int32Ptr(scheduleAttributes.GetStartToCloseTimeoutSeconds())
 	attributes.HeartbeatTimeoutSeconds = common.Int32Ptr(scheduleAttributes.GetHeartbeatTimeoutSeconds())
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.RetryPolicy = scheduleAttributes.GetRetryPolicy()
 	historyEvent.ActivityTaskScheduledEventAttributes = attributes
 
 	return historyEvent
 }
 
-func (b *historyBuilder) newActivityTaskStartedEvent(scheduledEventID int64, requestID string,
+func (b *historyBuilder) newActivityTaskStartedEvent(scheduledEventID int64, attempt int32, requestID string,
 	request *workflow.PollForActivityTaskRequest) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_ActivityTaskStarted)
-	attributes := workflow.NewActivityTaskStartedEventAttributes()
-	attributes.ScheduledEventId = common.Int64Ptr(scheduledEventID)
-	attributes.Identity = common.StringPtr(request.GetIdentity())
-	attributes.RequestId = common.StringPtr(requestID)
-	historyEvent.ActivityTaskStartedEventAttributes = attributes
 
-	return historyEvent
+	return setActivityTaskStartedEventInfo(historyEvent, scheduledEventID, attempt, requestID, request.GetIdentity())
+}
+
+// newTransientActivityTaskStartedEvent creates the started event of an attempt of an activity with a retry policy,
+// which is not added to the history
+func (b *historyBuilder) newTransientActivityTaskStartedEvent(scheduledEventID int64, attempt int32, requestID string,
+	request *workflow.PollForActivityTaskRequest) *workflow.HistoryEvent {
+	historyEvent := createTransientHistoryEvent(transientEventID, workflow.EventType_ActivityTaskStarted,
+		time.Now().UnixNano())
+
+	return setActivityTaskStartedEventInfo(historyEvent, scheduledEventID, attempt, requestID, request.GetIdentity())
 }
 
 func (b *historyBuilder) newActivityTaskCompletedEvent(scheduleEventID, startedEventID int64,
@@ -549,6 +700,15 @@ func (b *historyBuilder) newFailWorkflowExecutionEvent(decisionTaskCompletedEven
 	return historyEvent
 }
 
+func (b *historyBuilder) newWorkflowExecutionTimedOutEvent() *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_WorkflowExecutionTimedOut)
+	attributes := workflow.NewWorkflowExecutionTimedOutEventAttributes()
+	attributes.TimeoutType = workflow.TimeoutTypePtr(workflow.TimeoutType_START_TO_CLOSE)
+	historyEvent.WorkflowExecutionTimedOutEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionSignaledEvent(
 	request *workflow.SignalWorkflowExecutionRequest) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_WorkflowExecutionSignaled)
@@ -585,12 +745,26 @@ func (b *historyBuilder) newMarkerRecordedEventAttributes(decisionTaskCompletedE
 	return historyEvent
 }
 
+func (b *historyBuilder) newUpsertWorkflowSearchAttributesEvent(decisionTaskCompletedEventID int64,
+	request *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_UpsertWorkflowSearchAttributes)
+	attributes := workflow.NewUpsertWorkflowSearchAttributesEventAttributes()
+	attributes.SearchAttributes = request.GetSearchAttributes()
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	historyEvent.UpsertWorkflowSearchAttributesEventAttributes = attributes
+
+	return historyEvent
+}
+
 func (b *historyBuilder) newWorkflowExecutionCancelRequestedEvent(cause string,
 	request *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent {
 	event := b.msBuilder.createNewHistoryEvent(workflow.EventType_WorkflowExecutionCancelRequested)
 	attributes := workflow.NewWorkflowExecutionCancelRequestedEventAttributes()
 	attributes.Cause = common.StringPtr(cause)
 	attributes.Identity = common.StringPtr(request.GetCancelRequest().GetIdentity())
+	if request.GetCancelRequest().IsSetRequestId() {
+		attributes.RequestId = common.StringPtr(request.GetCancelRequest().GetRequestId())
+	}
 	if request.IsSetExternalInitiatedEventId() {
 		attributes.ExternalInitiatedEventId = common.Int64Ptr(request.GetExternalInitiatedEventId())
 	}
@@ -661,6 +835,59 @@ func (b *historyBuilder) newExternalWorkflowExecutionCancelRequestedEvent(initia
 	return event
 }
 
+func (b *historyBuilder) newSignalExternalWorkflowExecutionInitiatedEvent(decisionTaskCompletedEventID int64,
+	request *workflow.SignalExternalWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
+	event := b.msBuilder.createNewHistoryEvent(workflow.EventType_SignalExternalWorkflowExecutionInitiated)
+	attributes := workflow.NewSignalExternalWorkflowExecutionInitiatedEventAttributes()
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.Domain = common.StringPtr(request.GetDomain())
+	attributes.WorkflowExecution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.GetExecution().GetWorkflowId()),
+		RunId:      common.StringPtr(request.GetExecution().GetRunId()),
+	}
+	attributes.SignalName = common.StringPtr(request.GetSignalName())
+	attributes.Input = request.Input
+	attributes.Control = request.Control
+	event.SignalExternalWorkflowExecutionInitiatedEventAttributes = attributes
+
+	return event
+}
+
+func (b *historyBuilder) newSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedEventID int64,
+	domain, workflowID, runID string, control []byte,
+	cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
+	event := b.msBuilder.createNewHistoryEvent(workflow.EventType_SignalExternalWorkflowExecutionFailed)
+	attributes := workflow.NewSignalExternalWorkflowExecutionFailedEventAttributes()
+	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.InitiatedEventId = common.Int64Ptr(initiatedEventID)
+	attributes.Domain = common.StringPtr(domain)
+	attributes.WorkflowExecution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	attributes.Control = control
+	attributes.Cause = workflow.SignalExternalWorkflowExecutionFailedCausePtr(cause)
+	event.SignalExternalWorkflowExecutionFailedEventAttributes = attributes
+
+	return event
+}
+
+func (b *historyBuilder) newExternalWorkflowExecutionSignaledEvent(initiatedEventID int64,
+	domain, workflowID, runID string, control []byte) *workflow.HistoryEvent {
+	event := b.msBuilder.createNewHistoryEvent(workflow.EventType_ExternalWorkflowExecutionSignaled)
+	attributes := workflow.NewExternalWorkflowExecutionSignaledEventAttributes()
+	attributes.InitiatedEventId = common.Int64Ptr(initiatedEventID)
+	attributes.Domain = common.StringPtr(domain)
+	attributes.WorkflowExecution = &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(workflowID),
+		RunId:      common.StringPtr(runID),
+	}
+	attributes.Control = control
+	event.ExternalWorkflowExecutionSignaledEventAttributes = attributes
+
+	return event
+}
+
 func (b *historyBuilder) newWorkflowExecutionContinuedAsNewEvent(decisionTaskCompletedEventID int64,
 	newRunID string, request *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_WorkflowExecutionContinuedAsNew)
@@ -672,6 +899,10 @@ func (b *historyBuilder) newWorkflowExecutionContinuedAsNewEvent(decisionTaskCom
 	attributes.ExecutionStartToCloseTimeoutSeconds = common.Int32Ptr(request.GetExecutionStartToCloseTimeoutSeconds())
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(request.GetTaskStartToCloseTimeoutSeconds())
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
+	attributes.BackoffStartIntervalInSeconds = common.Int32Ptr(request.GetBackoffStartIntervalInSeconds())
+	attributes.Initiator = workflow.ContinueAsNewInitiatorPtr(request.GetInitiator())
+	attributes.FailureReason = request.FailureReason
+	attributes.FailureDetails = request.FailureDetails
 	historyEvent.WorkflowExecutionContinuedAsNewEventAttributes = attributes
 
 	return historyEvent
@@ -690,6 +921,10 @@ func (b *historyBuilder) newStartChildWorkflowExecutionInitiatedEvent(decisionTa
 	attributes.TaskStartToCloseTimeoutSeconds = common.Int32Ptr(startAttributes.GetTaskStartToCloseTimeoutSeconds())
 	attributes.ChildPolicy = workflow.ChildPolicyPtr(startAttributes.GetChildPolicy())
 	attributes.Control = startAttributes.Control
+	attributes.RetryPolicy = startAttributes.GetRetryPolicy()
+	attributes.DelayStartSeconds = common.Int32Ptr(startAttributes.GetDelayStartSeconds())
+	attributes.Memo = startAttributes.GetMemo()
+	attributes.SearchAttributes = startAttributes.GetSearchAttributes()
 	attributes.DecisionTaskCompletedEventId = common.Int64Ptr(decisionTaskCompletedEventID)
 	historyEvent.StartChildWorkflowExecutionInitiatedEventAttributes = attributes
 
@@ -789,3 +1024,19 @@ func (b *historyBuilder) newChildWorkflowExecutionTerminatedEvent(domain string,
 
 	return historyEvent
 }
+
+func (b *historyBuilder) newChildWorkflowExecutionTimedOutEvent(domain string, execution *workflow.WorkflowExecution,
+	workflowType *workflow.WorkflowType, initiatedID, startedID int64,
+	timedOutAttributes *workflow.WorkflowExecutionTimedOutEventAttributes) *workflow.HistoryEvent {
+	historyEvent := b.msBuilder.createNewHistoryEvent(workflow.EventType_ChildWorkflowExecutionTimedOut)
+	attributes := workflow.NewChildWorkflowExecutionTimedOutEventAttributes()
+	attributes.Domain = common.StringPtr(domain)
+	attributes.WorkflowExecution = execution
+	attributes.WorkflowType = workflowType
+	attributes.InitiatedEventId = common.Int64Ptr(initiatedID)
+	attributes.StartedEventId = common.Int64Ptr(startedID)
+	attributes.TimeoutType = workflow.TimeoutTypePtr(timedOutAttributes.GetTimeoutType())
+	historyEvent.ChildWorkflowExecutionTimedOutEventAttributes = attributes
+
+	return historyEvent
+}
@@ -327,7 +327,9 @@ func (s *historyBuilderSuite) addWorkflowExecutionStartedEvent(we workflow.Workf
 }
 
 func (s *historyBuilderSuite) addDecisionTaskScheduledEvent() (*workflow.HistoryEvent, *decisionInfo) {
-	return s.msBuilder.AddDecisionTaskScheduledEvent()
+	e, di, err := s.msBuilder.AddDecisionTaskScheduledEvent()
+	s.Nil(err)
+	return e, di
 }
 
 func (s *historyBuilderSuite) addDecisionTaskStartedEvent(scheduleID int64,
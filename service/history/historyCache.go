@@ -46,6 +46,7 @@ type (
 		cache.Cache
 		shard            ShardContext
 		executionManager persistence.ExecutionManager
+		domainCache      cache.DomainCache
 		disabled         bool
 		logger           bark.Logger
 	}
@@ -57,7 +58,8 @@ var (
 	ErrTryLock = &workflow.InternalServiceError{Message: "Failed to acquire lock, backoff and retry"}
 )
 
-func newHistoryCache(maxSize int, shard ShardContext, logger bark.Logger) *historyCache {
+func newHistoryCache(maxSize int, shard ShardContext, domainCache cache.DomainCache,
+	logger bark.Logger) *historyCache {
 	opts := &cache.Options{}
 	opts.InitialCapacity = historyCacheInitialSize
 	opts.TTL = historyCacheTTL
@@ -67,6 +69,7 @@ func newHistoryCache(maxSize int, shard ShardContext, logger bark.Logger) *histo
 		Cache:            cache.New(maxSize, opts),
 		shard:            shard,
 		executionManager: shard.GetExecutionManager(),
+		domainCache:      domainCache,
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueHistoryCacheComponent,
 		}),
@@ -95,14 +98,16 @@ func (c *historyCache) getOrCreateWorkflowExecution(domainID string,
 
 	// Test hook for disabling the cache
 	if c.disabled {
-		return newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger), func() {}, nil
+		return newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.domainCache,
+			c.logger), func() {}, nil
 	}
 
 	key := execution.GetRunId()
 	context, cacheHit := c.Get(key).(*workflowExecutionContext)
 	if !cacheHit {
 		// Let's create the workflow execution context
-		context = newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.logger)
+		context = newWorkflowExecutionContext(domainID, execution, c.shard, c.executionManager, c.domainCache,
+			c.logger)
 		elem, err := c.PutIfNotExist(key, context)
 		if err != nil {
 			return nil, nil, err
@@ -32,6 +32,7 @@ import (
 
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
 )
@@ -45,6 +46,7 @@ type (
 		logger           bark.Logger
 		mockExecutionMgr *mocks.ExecutionManager
 		mockShard        *shardContextImpl
+		domainCache      cache.DomainCache
 		cache            *historyCache
 	}
 )
@@ -69,12 +71,13 @@ func (s *historyCacheSuite) SetupTest() {
 		closeCh:                   make(chan int, 100),
 		logger:                    s.logger,
 	}
-	s.cache = newHistoryCache(historyCacheMaxSize, s.mockShard, s.logger)
+	s.domainCache = cache.NewDomainCache(&mocks.MetadataManager{}, s.logger)
+	s.cache = newHistoryCache(historyCacheMaxSize, s.mockShard, s.domainCache, s.logger)
 }
 
 func (s *historyCacheSuite) TestHistoryCachePinning() {
 	domain := "test_domain"
-	s.cache = newHistoryCache(2, s.mockShard, s.logger)
+	s.cache = newHistoryCache(2, s.mockShard, s.domainCache, s.logger)
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wf-cache-test"),
 		RunId:      common.StringPtr(uuid.New()),
@@ -21,8 +21,11 @@
 package history
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/uber-common/bark"
@@ -32,9 +35,11 @@ import (
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/cache"
+	"github.com/uber/cadence/common/cron"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/tchannel-go/thrift"
 )
 
 const (
@@ -42,30 +47,41 @@ const (
 	activityCancelationMsgActivityIDUnknown  = "ACTIVITY_ID_UNKNOWN"
 	activityCancelationMsgActivityNotStarted = "ACTIVITY_ID_NOT_STARTED"
 	timerCancelationMsgTimerIDUnknown        = "TIMER_ID_UNKNOWN"
+	// activityFailureReasonReset is the failure reason of the activities which were running when the workflow
+	// execution was reset, as their result could only be reported to the run that scheduled them.
+	activityFailureReasonReset = "cadenceInternal:ResetWorkflow"
+	historyPageSize            = 1000
+	// historyLongPollExpirationInterval bounds how long GetWorkflowExecutionNextEventID waits for new events, and
+	// historyLongPollTailRoom is the time left to the caller to use the response before its deadline
+	historyLongPollExpirationInterval = 20 * time.Second
+	historyLongPollTailRoom           = time.Second
 )
 
 type (
 	historyEngineImpl struct {
-		shard              ShardContext
-		metadataMgr        persistence.MetadataManager
-		historyMgr         persistence.HistoryManager
-		executionManager   persistence.ExecutionManager
-		txProcessor        transferQueueProcessor
-		timerProcessor     timerQueueProcessor
-		tokenSerializer    common.TaskTokenSerializer
-		hSerializerFactory persistence.HistorySerializerFactory
-		metricsReporter    metrics.Client
-		historyCache       *historyCache
-		domainCache        cache.DomainCache
-		metricsClient      metrics.Client
-		logger             bark.Logger
-	}
-
-	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor on new tasks.
-	// TODO: use to notify timerQueueProcessor as well.
+		shard                ShardContext
+		metadataMgr          persistence.MetadataManager
+		historyMgr           persistence.HistoryManager
+		executionManager     persistence.ExecutionManager
+		txProcessor          transferQueueProcessor
+		timerProcessor       timerQueueProcessor
+		tokenSerializer      common.TaskTokenSerializer
+		hSerializerFactory   persistence.HistorySerializerFactory
+		metricsReporter      metrics.Client
+		historyCache         *historyCache
+		domainCache          cache.DomainCache
+		metricsClient        metrics.Client
+		logger               bark.Logger
+		historyEventNotifier historyEventNotifier
+	}
+
+	// shardContextWrapper wraps ShardContext to notify transferQueueProcessor and timerQueueProcessor on new tasks,
+	// and the long polls waiting on the history of an execution on its updates.
 	shardContextWrapper struct {
 		ShardContext
-		txProcessor transferQueueProcessor
+		txProcessor          transferQueueProcessor
+		timerProcessor       timerQueueProcessor
+		historyEventNotifier historyEventNotifier
 	}
 )
 
@@ -78,6 +94,8 @@ var (
 	ErrConflict = errors.New("Conditional update failed")
 	// ErrMaxAttemptsExceeded is exported temporarily for integration test
 	ErrMaxAttemptsExceeded = errors.New("Maximum attempts exceeded to update history")
+
+	errNotChildOfParent = &workflow.EntityNotExistsError{Message: "Workflow execution is not a child of the parent."}
 )
 
 // NewEngineWithShardContext creates an instance of history engine
@@ -88,8 +106,8 @@ func NewEngineWithShardContext(shard ShardContext, metadataMgr persistence.Metad
 	logger := shard.GetLogger()
 	executionManager := shard.GetExecutionManager()
 	historyManager := shard.GetHistoryManager()
-	historyCache := newHistoryCache(historyCacheMaxSize, shard, logger)
 	domainCache := cache.NewDomainCache(metadataMgr, logger)
+	historyCache := newHistoryCache(historyCacheMaxSize, shard, domainCache, logger)
 	txProcessor := newTransferQueueProcessor(shard, visibilityMgr, matching, historyClient, historyCache, domainCache)
 	historyEngImpl := &historyEngineImpl{
 		shard:              shard,
@@ -104,10 +122,13 @@ func NewEngineWithShardContext(shard ShardContext, metadataMgr persistence.Metad
 		logger: logger.WithFields(bark.Fields{
 			logging.TagWorkflowComponent: logging.TagValueHistoryEngineComponent,
 		}),
-		metricsClient: shard.GetMetricsClient(),
+		metricsClient:        shard.GetMetricsClient(),
+		historyEventNotifier: newHistoryEventNotifier(),
 	}
 	historyEngImpl.timerProcessor = newTimerQueueProcessor(historyEngImpl, executionManager, logger)
 	shardWrapper.txProcessor = txProcessor
+	shardWrapper.timerProcessor = historyEngImpl.timerProcessor
+	shardWrapper.historyEventNotifier = historyEngImpl.historyEventNotifier
 	return historyEngImpl
 }
 
@@ -134,6 +155,13 @@ func (e *historyEngineImpl) Stop() {
 // StartWorkflowExecution starts a workflow execution
 func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflowExecutionRequest) (
 	*workflow.StartWorkflowExecutionResponse, error) {
+	return e.startWorkflowExecution(startRequest, nil)
+}
+
+// startWorkflowExecution creates a new run of the workflow.  When a signal request is passed in, the signal is
+// recorded in the history of the new run right after its started event.
+func (e *historyEngineImpl) startWorkflowExecution(startRequest *h.StartWorkflowExecutionRequest,
+	signalRequest *workflow.SignalWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse, error) {
 	domainID := startRequest.GetDomainUUID()
 	request := startRequest.GetStartRequest()
 	executionID := request.GetWorkflowId()
@@ -155,6 +183,46 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		initiatedID = parentInfo.GetInitiatedId()
 	}
 
+	if request.IsSetRetryPolicy() {
+		if err := validateRetryPolicy(request.GetRetryPolicy()); err != nil {
+			return nil, err
+		}
+	}
+	if request.IsSetCronSchedule() {
+		if err := validateCronSchedule(request.GetCronSchedule()); err != nil {
+			return nil, err
+		}
+	}
+	if request.IsSetSearchAttributes() {
+		if err := validateSearchAttributes(request.GetSearchAttributes()); err != nil {
+			return nil, err
+		}
+	}
+
+	// The workflowId can only be reused if the previous run has closed and the reuse policy allows it
+	prevRunID := ""
+	currentExecution, err := e.executionManager.GetCurrentExecution(&persistence.GetCurrentExecutionRequest{
+		DomainID:   domainID,
+		WorkflowID: executionID,
+	})
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			return nil, err
+		}
+	} else {
+		if currentExecution.StartRequestID == request.GetRequestId() {
+			// Duplicate request for the run which is already recorded as current
+			return &workflow.StartWorkflowExecutionResponse{
+				RunId: common.StringPtr(currentExecution.RunID),
+			}, nil
+		}
+		if err := validateWorkflowIDReusePolicy(currentExecution, request.GetWorkflowIdReusePolicy(),
+			executionID); err != nil {
+			return nil, err
+		}
+		prevRunID = currentExecution.RunID
+	}
+
 	// Generate first decision task event.
 	taskList := request.GetTaskList().GetName()
 	msBuilder := newMutableStateBuilder(e.logger)
@@ -162,14 +230,37 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 	if startedEvent == nil {
 		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
+	if signalRequest != nil && msBuilder.AddWorkflowExecutionSignaled(signalRequest) == nil {
+		return nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution signaled event."}
+	}
+
+	// The first decision of the workflow waits for the requested delay, and for the first scheduled time after it if
+	// the workflow has a cron schedule
+	backoffSeconds := request.GetDelayStartSeconds()
+	if parentInfo == nil && request.GetCronSchedule() != "" {
+		delayStart := time.Now().Add(time.Duration(backoffSeconds) * time.Second)
+		cronBackoff, _ := getCronBackoffDuration(request.GetCronSchedule(), delayStart)
+		backoffSeconds += int32(math.Ceil(cronBackoff.Seconds()))
+	}
+	if backoffSeconds > 0 {
+		startedEvent.GetWorkflowExecutionStartedEventAttributes().FirstDecisionTaskBackoffSeconds =
+			common.Int32Ptr(backoffSeconds)
+	}
 
 	var transferTasks []persistence.Task
 	decisionScheduleID := emptyEventID
 	decisionStartID := emptyEventID
 	decisionTimeout := int32(0)
-	if parentInfo == nil {
+	if backoffSeconds > 0 {
+		// The run is recorded as started right away, while its first decision is scheduled by the backoff timer
+		msBuilder.executionInfo.FirstDecisionBackoffTime = time.Now().Add(time.Duration(backoffSeconds) * time.Second)
+		transferTasks = []persistence.Task{&persistence.RecordWorkflowStartedTask{}}
+	} else if parentInfo == nil {
 		// DecisionTask is only created when it is not a Child Workflow Execution
-		_, di := msBuilder.AddDecisionTaskScheduledEvent()
+		_, di, err := msBuilder.AddDecisionTaskScheduledEvent()
+		if err != nil {
+			return nil, err
+		}
 		if di == nil {
 			return nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
 		}
@@ -182,6 +273,10 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		decisionTimeout = di.DecisionTimeout
 	}
 
+	// Start a timer to enforce the execution timeout of the workflow
+	tBuilder := newTimerBuilder(&shardSeqNumGenerator{context: e.shard}, e.logger)
+	timerTasks := createWorkflowTimerTasks(tBuilder, request.GetExecutionStartToCloseTimeoutSeconds(), backoffSeconds)
+
 	// Serialize the history
 	serializedHistory, serializedError := msBuilder.hBuilder.Serialize()
 	if serializedError != nil {
@@ -203,10 +298,11 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		return nil, err1
 	}
 
-	_, err := e.shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
+	_, err = e.shard.CreateWorkflowExecution(&persistence.CreateWorkflowExecutionRequest{
 		RequestID:                   request.GetRequestId(),
 		DomainID:                    domainID,
 		Execution:                   workflowExecution,
+		PreviousRunID:               prevRunID,
 		ParentDomainID:              parentDomainID,
 		ParentExecution:             parentExecution,
 		InitiatedID:                 initiatedID,
@@ -216,11 +312,27 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		ExecutionContext:            nil,
 		NextEventID:                 msBuilder.GetNextEventID(),
 		LastProcessedEvent:          emptyEventID,
+		HistorySize:                 int64(len(serializedHistory.Data)),
 		TransferTasks:               transferTasks,
+		TimerTasks:                  timerTasks,
 		DecisionScheduleID:          decisionScheduleID,
 		DecisionStartedID:           decisionStartID,
 		DecisionStartToCloseTimeout: decisionTimeout,
 		ContinueAsNew:               false,
+		Attempt:                     msBuilder.executionInfo.Attempt,
+		HasRetryPolicy:              msBuilder.executionInfo.HasRetryPolicy,
+		InitialInterval:             msBuilder.executionInfo.InitialInterval,
+		BackoffCoefficient:          msBuilder.executionInfo.BackoffCoefficient,
+		MaximumInterval:             msBuilder.executionInfo.MaximumInterval,
+		MaximumAttempts:             msBuilder.executionInfo.MaximumAttempts,
+		ExpirationSeconds:           msBuilder.executionInfo.ExpirationSeconds,
+		ExpirationTime:              msBuilder.executionInfo.ExpirationTime,
+		NonRetriableErrors:          msBuilder.executionInfo.NonRetriableErrors,
+		CronSchedule:                msBuilder.executionInfo.CronSchedule,
+		WorkflowTimeout:             msBuilder.executionInfo.WorkflowTimeout,
+		FirstDecisionBackoffTime:    msBuilder.executionInfo.FirstDecisionBackoffTime,
+		Memo:                        msBuilder.executionInfo.Memo,
+		SearchAttributes:            msBuilder.executionInfo.SearchAttributes,
 	})
 
 	if err != nil {
@@ -256,13 +368,18 @@ func (e *historyEngineImpl) StartWorkflowExecution(startRequest *h.StartWorkflow
 		return nil, err
 	}
 
+	for _, task := range timerTasks {
+		e.timerProcessor.NotifyNewTimer(task.GetTaskID())
+	}
+
 	return &workflow.StartWorkflowExecutionResponse{
 		RunId: workflowExecution.RunId,
 	}, nil
 }
 
-// GetWorkflowExecutionNextEventID retrieves the nextEventId of the workflow execution history
-func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(
+// GetWorkflowExecutionNextEventID retrieves the nextEventId of the workflow execution history.  When the request has
+// an expected nextEventId, it waits for the history to move past it or for the execution to close.
+func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(ctx thrift.Context,
 	request *h.GetWorkflowExecutionNextEventIDRequest) (*h.GetWorkflowExecutionNextEventIDResponse, error) {
 	domainID := request.GetDomainUUID()
 	execution := workflow.WorkflowExecution{
@@ -270,6 +387,51 @@ func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(
 		RunId:      common.StringPtr(request.GetExecution().GetRunId()),
 	}
 
+	result, err := e.getWorkflowExecutionNextEventID(domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	if !request.IsSetExpectedNextEventId() {
+		return result, nil
+	}
+	expectedNextEventID := request.GetExpectedNextEventId()
+	if expectedNextEventID < result.GetEventId() || !result.GetIsWorkflowRunning() {
+		return result, nil
+	}
+
+	// Subscribe before reading the mutable state again, so an update committed in between is not missed
+	subscriberID, channel := e.historyEventNotifier.WatchHistoryEvent(result.GetRunId())
+	defer e.historyEventNotifier.UnwatchHistoryEvent(result.GetRunId(), subscriberID)
+
+	execution.RunId = result.RunId
+	result, err = e.getWorkflowExecutionNextEventID(domainID, execution)
+	if err != nil {
+		return nil, err
+	}
+	if expectedNextEventID < result.GetEventId() || !result.GetIsWorkflowRunning() {
+		return result, nil
+	}
+
+	timer := time.NewTimer(getHistoryLongPollExpirationInterval(ctx))
+	defer timer.Stop()
+	for {
+		select {
+		case event := <-channel:
+			result.EventId = common.Int64Ptr(event.nextEventID)
+			result.IsWorkflowRunning = common.BoolPtr(event.isWorkflowRunning)
+			if expectedNextEventID < event.nextEventID || !event.isWorkflowRunning {
+				return result, nil
+			}
+		case <-timer.C:
+			return result, nil
+		case <-ctx.Done():
+			return result, nil
+		}
+	}
+}
+
+func (e *historyEngineImpl) getWorkflowExecutionNextEventID(domainID string,
+	execution workflow.WorkflowExecution) (*h.GetWorkflowExecutionNextEventIDResponse, error) {
 	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
 	if err0 != nil {
 		return nil, err0
@@ -284,6 +446,131 @@ func (e *historyEngineImpl) GetWorkflowExecutionNextEventID(
 	result := h.NewGetWorkflowExecutionNextEventIDResponse()
 	result.EventId = common.Int64Ptr(msBuilder.GetNextEventID())
 	result.RunId = context.workflowExecution.RunId
+	result.WorkflowType = &workflow.WorkflowType{Name: common.StringPtr(msBuilder.executionInfo.WorkflowTypeName)}
+	result.TaskList = &workflow.TaskList{Name: common.StringPtr(msBuilder.executionInfo.TaskList)}
+	result.IsWorkflowRunning = common.BoolPtr(msBuilder.isWorkflowExecutionRunning())
+
+	return result, nil
+}
+
+// getHistoryLongPollExpirationInterval returns how long a long poll can wait for new events and still reply before
+// the deadline of the caller
+func getHistoryLongPollExpirationInterval(ctx thrift.Context) time.Duration {
+	interval := historyLongPollExpirationInterval
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := deadline.Sub(time.Now()) - historyLongPollTailRoom; remaining < interval {
+			interval = remaining
+		}
+	}
+	return interval
+}
+
+// DescribeWorkflowExecution returns the configuration and the pending work of the workflow execution from its
+// mutable state.
+func (e *historyEngineImpl) DescribeWorkflowExecution(
+	request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error) {
+	domainID := request.GetDomainUUID()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.GetRequest().GetExecution().GetWorkflowId()),
+		RunId:      common.StringPtr(request.GetRequest().GetExecution().GetRunId()),
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer release()
+
+	msBuilder, err1 := context.loadWorkflowExecution()
+	if err1 != nil {
+		return nil, err1
+	}
+	executionInfo := msBuilder.executionInfo
+
+	result := workflow.NewDescribeWorkflowExecutionResponse()
+	result.ExecutionConfiguration = &workflow.WorkflowExecutionConfiguration{
+		TaskList:                            &workflow.TaskList{Name: common.StringPtr(executionInfo.TaskList)},
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(executionInfo.WorkflowTimeout),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(executionInfo.DecisionTimeoutValue),
+	}
+	result.WorkflowExecutionInfo = &workflow.WorkflowExecutionInfo{
+		Execution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(executionInfo.WorkflowID),
+			RunId:      common.StringPtr(executionInfo.RunID),
+		},
+		Type:          msBuilder.getWorkflowType(),
+		StartTime:     common.Int64Ptr(executionInfo.StartTimestamp.UnixNano()),
+		HistoryLength: common.Int64Ptr(msBuilder.GetNextEventID() - firstEventID),
+	}
+	if executionInfo.Memo != nil {
+		result.WorkflowExecutionInfo.Memo = &workflow.Memo{Fields: executionInfo.Memo}
+	}
+	if executionInfo.SearchAttributes != nil {
+		result.WorkflowExecutionInfo.SearchAttributes = &workflow.SearchAttributes{
+			IndexedFields: executionInfo.SearchAttributes,
+		}
+	}
+	if executionInfo.State == persistence.WorkflowStateCompleted {
+		// Close time is the last time mutable state was updated, which is when the close event was written
+		closeStatus := getWorkflowExecutionCloseStatus(executionInfo.CloseStatus)
+		result.WorkflowExecutionInfo.CloseStatus = &closeStatus
+		result.WorkflowExecutionInfo.CloseTime = common.Int64Ptr(executionInfo.LastUpdatedTimestamp.UnixNano())
+	}
+	result.NextEventId = common.Int64Ptr(msBuilder.GetNextEventID())
+
+	for _, ai := range msBuilder.pendingActivityInfoIDs {
+		p := &workflow.PendingActivityInfo{
+			ActivityID: common.StringPtr(ai.ActivityID),
+			Attempt:    common.Int32Ptr(ai.Attempt),
+		}
+		state := workflow.PendingActivityState_SCHEDULED
+		if ai.CancelRequested {
+			state = workflow.PendingActivityState_CANCEL_REQUESTED
+		} else if ai.StartedID != emptyEventID {
+			state = workflow.PendingActivityState_STARTED
+		}
+		p.State = &state
+		if !ai.LastHeartBeatUpdatedTime.IsZero() {
+			p.HeartbeatDetails = ai.Details
+			p.LastHeartbeatTimestamp = common.Int64Ptr(ai.LastHeartBeatUpdatedTime.UnixNano())
+		}
+		if scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID); ok {
+			p.ActivityType = scheduledEvent.GetActivityTaskScheduledEventAttributes().GetActivityType()
+		}
+		result.PendingActivities = append(result.PendingActivities, p)
+	}
+
+	for _, ti := range msBuilder.pendingTimerInfoIDs {
+		result.PendingTimers = append(result.PendingTimers, &workflow.PendingTimerInfo{
+			TimerId:         common.StringPtr(ti.TimerID),
+			StartedEventId:  common.Int64Ptr(ti.StartedID),
+			ExpiryTimestamp: common.Int64Ptr(ti.ExpiryTime.UnixNano()),
+		})
+	}
+
+	for _, ci := range msBuilder.pendingChildExecutionInfoIDs {
+		p := &workflow.PendingChildExecutionInfo{
+			InitiatedID: common.Int64Ptr(ci.InitiatedID),
+		}
+		if initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(ci.InitiatedID); ok {
+			attributes := initiatedEvent.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+			p.WorkflowID = common.StringPtr(attributes.GetWorkflowId())
+			p.WorkflowTypeName = common.StringPtr(attributes.GetWorkflowType().GetName())
+		}
+		if startedEvent, ok := msBuilder.GetChildExecutionStartedEvent(ci.InitiatedID); ok {
+			p.RunID = common.StringPtr(
+				startedEvent.GetChildWorkflowExecutionStartedEventAttributes().GetWorkflowExecution().GetRunId())
+		}
+		result.PendingChildren = append(result.PendingChildren, p)
+	}
+
+	if msBuilder.HasPendingDecisionTask() {
+		result.PendingDecision = &workflow.PendingDecisionInfo{
+			ScheduledEventId:           common.Int64Ptr(executionInfo.DecisionScheduleID),
+			StartedEventId:             common.Int64Ptr(executionInfo.DecisionStartedID),
+			StartToCloseTimeoutSeconds: common.Int32Ptr(executionInfo.DecisionTimeout),
+		}
+	}
 
 	return result, nil
 }
@@ -307,21 +594,22 @@ Update_History_Loop:
 			return nil, err0
 		}
 
+		// Check execution state to make sure task is in the list of outstanding tasks and it is not yet started.  If
+		// task is not outstanding than it is most probably a duplicate and complete the task.
+		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
+
 		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
-		// some extreme cassandra failure cases.
-		if scheduleID >= msBuilder.GetNextEventID() {
+		// some extreme cassandra failure cases.  A retried decision is scheduled with the next event ID.
+		if !isRunning && scheduleID >= msBuilder.GetNextEventID() {
 			// Reload workflow execution history
 			context.clear()
 			continue Update_History_Loop
 		}
 
-		// Check execution state to make sure task is in the list of outstanding tasks and it is not yet started.  If
-		// task is not outstanding than it is most probably a duplicate and complete the task.
-		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
-
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning {
-			// Looks like DecisionTask already completed as a result of another call.
-			// It is OK to drop the task at this point.
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning ||
+			(request.IsSetScheduleAttempt() && request.GetScheduleAttempt() != di.Attempt) {
+			// Looks like DecisionTask already completed as a result of another call, or the task was added for an
+			// earlier attempt of the decision.  It is OK to drop the task at this point.
 			logging.LogDuplicateTaskEvent(context.logger, persistence.TransferTaskTypeDecisionTask, request.GetTaskId(), requestID,
 				scheduleID, emptyEventID, isRunning)
 
@@ -331,7 +619,7 @@ Update_History_Loop:
 		if di.StartedID != emptyEventID {
 			// If decision is started as part of the current request scope then return a positive response
 			if di.RequestID == requestID {
-				return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, di.StartedID), nil
+				return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, request.PollRequest), nil
 			}
 
 			// Looks like DecisionTask already started as a result of another call.
@@ -348,10 +636,11 @@ Update_History_Loop:
 			return nil, &workflow.InternalServiceError{Message: "Unable to add DecisionTaskStarted event to history."}
 		}
 
-		// Start a timer for the decision task.
-		timeOutTask := context.tBuilder.AddDecisionTimoutTask(scheduleID, di.DecisionTimeout)
+		// Start a timer for the decision task.  The decision is scheduled again if new events arrived while its retry
+		// was pending, so the timer uses the schedule ID after it was started.
+		timeOutTask := context.tBuilder.AddDecisionTimoutTask(msBuilder.executionInfo.DecisionScheduleID,
+			msBuilder.executionInfo.DecisionAttempt, di.DecisionTimeout)
 		timerTasks := []persistence.Task{timeOutTask}
-		defer e.timerProcessor.NotifyNewTimer(timeOutTask.GetTaskID())
 
 		// Generate a transaction ID for appending events to history
 		transactionID, err2 := e.shard.GetNextTransferTaskID()
@@ -368,7 +657,7 @@ Update_History_Loop:
 			return nil, err3
 		}
 
-		return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, event.GetEventId()), nil
+		return e.createRecordDecisionTaskStartedResponse(domainID, msBuilder, request.PollRequest), nil
 	}
 
 	return nil, ErrMaxAttemptsExceeded
@@ -404,9 +693,10 @@ Update_History_Loop:
 		// Check execution state to make sure task is in the list of outstanding tasks and it is not yet started.  If
 		// task is not outstanding than it is most probably a duplicate and complete the task.
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning {
-			// Looks like ActivityTask already completed as a result of another call.
-			// It is OK to drop the task at this point.
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning ||
+			(request.IsSetScheduleAttempt() && request.GetScheduleAttempt() != int64(ai.Attempt)) {
+			// Looks like ActivityTask already completed as a result of another call, or the task was added for an
+			// earlier attempt of the activity.  It is OK to drop the task at this point.
 			logging.LogDuplicateTaskEvent(context.logger, persistence.TransferTaskTypeActivityTask, request.GetTaskId(), requestID,
 				scheduleID, emptyEventID, isRunning)
 
@@ -428,6 +718,7 @@ Update_History_Loop:
 				}
 				response.ScheduledEvent = scheduledEvent
 				response.StartedEvent = startedEvent
+				response.Attempt = common.Int32Ptr(ai.Attempt)
 				return response, nil
 			}
 
@@ -452,7 +743,6 @@ Update_History_Loop:
 			return nil, err
 		}
 		timerTasks = append(timerTasks, start2CloseTimeoutTask)
-		defer e.timerProcessor.NotifyNewTimer(start2CloseTimeoutTask.GetTaskID())
 
 		start2HeartBeatTimeoutTask, err := context.tBuilder.AddHeartBeatActivityTimeout(ai)
 		if err != nil {
@@ -460,7 +750,6 @@ Update_History_Loop:
 		}
 		if start2HeartBeatTimeoutTask != nil {
 			timerTasks = append(timerTasks, start2HeartBeatTimeoutTask)
-			defer e.timerProcessor.NotifyNewTimer(start2HeartBeatTimeoutTask.GetTaskID())
 		}
 
 		// Generate a transaction ID for appending events to history
@@ -482,6 +771,7 @@ Update_History_Loop:
 		response := h.NewRecordActivityTaskStartedResponse()
 		response.ScheduledEvent = scheduledEvent
 		response.StartedEvent = startedEvent
+		response.Attempt = common.Int32Ptr(ai.Attempt)
 		return response, nil
 	}
 
@@ -516,16 +806,17 @@ Update_History_Loop:
 		}
 
 		scheduleID := token.ScheduleID
+		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
 		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
-		// some extreme cassandra failure cases.
-		if scheduleID >= msBuilder.GetNextEventID() {
+		// some extreme cassandra failure cases.  A retried decision is scheduled with the next event ID.
+		if !isRunning && scheduleID >= msBuilder.GetNextEventID() {
 			// Reload workflow execution history
 			context.clear()
 			continue Update_History_Loop
 		}
 
-		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || di.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || di.StartedID == emptyEventID ||
+			di.Attempt != token.ScheduleAttempt {
 			return &workflow.EntityNotExistsError{Message: "Decision task not found."}
 		}
 
@@ -539,7 +830,7 @@ Update_History_Loop:
 		var failCause workflow.DecisionTaskFailedCause
 		var err error
 		completedID := completedEvent.GetEventId()
-		hasUnhandledEvents := ((completedID - startedID) > 1)
+		hasUnhandledEvents := msBuilder.HasBufferedEvents()
 		isComplete := false
 		transferTasks := []persistence.Task{}
 		timerTasks := []persistence.Task{}
@@ -565,6 +856,11 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_SCHEDULE_ACTIVITY_ATTRIBUTES
 					break Process_Decision_Loop
 				}
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetInput()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
 
 				scheduleEvent, ai := msBuilder.AddActivityTaskScheduledEvent(completedID, attributes)
 				transferTasks = append(transferTasks, &persistence.ActivityTask{
@@ -576,14 +872,12 @@ Update_History_Loop:
 				// Create activity timeouts.
 				Schedule2StartTimeoutTask := context.tBuilder.AddScheduleToStartActivityTimeout(ai)
 				timerTasks = append(timerTasks, Schedule2StartTimeoutTask)
-				defer e.timerProcessor.NotifyNewTimer(Schedule2StartTimeoutTask.GetTaskID())
 
 				Schedule2CloseTimeoutTask, err := context.tBuilder.AddScheduleToCloseActivityTimeout(ai)
 				if err != nil {
 					return err
 				}
 				timerTasks = append(timerTasks, Schedule2CloseTimeoutTask)
-				defer e.timerProcessor.NotifyNewTimer(Schedule2CloseTimeoutTask.GetTaskID())
 
 			case workflow.DecisionType_CompleteWorkflowExecution:
 				if hasUnhandledEvents {
@@ -605,7 +899,23 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_COMPLETE_WORKFLOW_EXECUTION_ATTRIBUTES
 					break Process_Decision_Loop
 				}
-				msBuilder.AddCompletedWorkflowEvent(completedID, attributes)
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetResult_()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
+
+				// Workflows with a cron schedule start their next run instead of completing
+				if cronBackoff, ok := msBuilder.GetCronBackoffDuration(); ok {
+					newStateBuilder, err := e.continueAsNewWithBackoff(context, msBuilder, completedID, cronBackoff,
+						workflow.ContinueAsNewInitiator_CronSchedule, nil, nil)
+					if err != nil {
+						return err
+					}
+					continueAsNewBuilder = newStateBuilder
+				} else {
+					msBuilder.AddCompletedWorkflowEvent(completedID, attributes)
+				}
 				isComplete = true
 			case workflow.DecisionType_FailWorkflowExecution:
 				if hasUnhandledEvents {
@@ -627,7 +937,24 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_FAIL_WORKFLOW_EXECUTION_ATTRIBUTES
 					break Process_Decision_Loop
 				}
-				msBuilder.AddFailWorkflowEvent(completedID, attributes)
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetDetails()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
+
+				// Start the next run of the execution instead of failing it if the retry policy or cron schedule allows it
+				backoffInterval, initiator, ok := msBuilder.GetFailureBackoff(attributes.GetReason())
+				if ok {
+					newStateBuilder, err := e.continueAsNewWithBackoff(context, msBuilder, completedID, backoffInterval,
+						initiator, attributes.Reason, attributes.GetDetails())
+					if err != nil {
+						return err
+					}
+					continueAsNewBuilder = newStateBuilder
+				} else {
+					msBuilder.AddFailWorkflowEvent(completedID, attributes)
+				}
 				isComplete = true
 			case workflow.DecisionType_CancelWorkflowExecution:
 				// If new events came while we are processing the decision, we would fail this and give a chance to client
@@ -651,6 +978,11 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_CANCEL_WORKFLOW_EXECUTION_ATTRIBUTES
 					break Process_Decision_Loop
 				}
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetDetails()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
 				msBuilder.AddWorkflowExecutionCanceledEvent(completedID, attributes)
 				isComplete = true
 
@@ -665,7 +997,6 @@ Update_History_Loop:
 				nextTimerTask := context.tBuilder.AddUserTimer(ti, msBuilder)
 				if nextTimerTask != nil {
 					timerTasks = append(timerTasks, nextTimerTask)
-					defer e.timerProcessor.NotifyNewTimer(nextTimerTask.GetTaskID())
 				}
 			case workflow.DecisionType_RequestCancelActivityTask:
 				attributes := d.GetRequestCancelActivityTaskDecisionAttributes()
@@ -707,8 +1038,24 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_RECORD_MARKER_ATTRIBUTES
 					break Process_Decision_Loop
 				}
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetDetails()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
 				msBuilder.AddRecordMarkerEvent(completedID, attributes)
 
+			case workflow.DecisionType_UpsertWorkflowSearchAttributes:
+				attributes := d.GetUpsertWorkflowSearchAttributesDecisionAttributes()
+				if err = validateUpsertWorkflowSearchAttributes(attributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BAD_SEARCH_ATTRIBUTES
+					break Process_Decision_Loop
+				}
+				msBuilder.AddUpsertWorkflowSearchAttributesEvent(completedID, attributes)
+				// Visibility record of the open execution is written again to pick up the new search attributes
+				transferTasks = append(transferTasks, &persistence.RecordWorkflowStartedTask{})
+
 			case workflow.DecisionType_RequestCancelExternalWorkflowExecution:
 
 				attributes := d.GetRequestCancelExternalWorkflowExecutionDecisionAttributes()
@@ -758,17 +1105,36 @@ Update_History_Loop:
 					failCause = workflow.DecisionTaskFailedCause_BAD_CONTINUE_AS_NEW_ATTRIBUTES
 					break Process_Decision_Loop
 				}
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetInput()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
+				attributes.Initiator = workflow.ContinueAsNewInitiatorPtr(workflow.ContinueAsNewInitiator_Decider)
 				runID := uuid.New()
 				_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(completedID, domainID, runID, attributes)
 				if err != nil {
 					return nil
 				}
+
+				msBuilder.continueAsNew.TimerTasks = createWorkflowTimerTasks(context.tBuilder,
+					attributes.GetExecutionStartToCloseTimeoutSeconds(), attributes.GetBackoffStartIntervalInSeconds())
 				isComplete = true
 				continueAsNewBuilder = newStateBuilder
 
 			case workflow.DecisionType_StartChildWorkflowExecution:
 				targetDomainID := domainID
 				attributes := d.GetStartChildWorkflowExecutionDecisionAttributes()
+				if err = validateStartChildExecutionAttributes(attributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BAD_START_CHILD_EXECUTION_ATTRIBUTES
+					break Process_Decision_Loop
+				}
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetInput()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
 				// First check if we need to use a different target domain to schedule child execution
 				if attributes.IsSetDomain() {
 					// TODO: Error handling for DecisionType_StartChildWorkflowExecution failed when domain lookup fails
@@ -787,6 +1153,42 @@ Update_History_Loop:
 					InitiatedID:      initiatedEvent.GetEventId(),
 				})
 
+			case workflow.DecisionType_SignalExternalWorkflowExecution:
+				targetDomainID := domainID
+				attributes := d.GetSignalExternalWorkflowExecutionDecisionAttributes()
+				if err = validateSignalExternalWorkflowExecutionAttributes(attributes); err != nil {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BAD_SIGNAL_WORKFLOW_EXECUTION_ATTRIBUTES
+					break Process_Decision_Loop
+				}
+				if e.isBlobSizeLimitExceeded(domainID, attributes.GetInput()) {
+					failDecision = true
+					failCause = workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+					break Process_Decision_Loop
+				}
+				// First check if we need to use a different target domain to signal the execution
+				if attributes.IsSetDomain() {
+					info, _, err := e.domainCache.GetDomain(attributes.GetDomain())
+					if err != nil {
+						return &workflow.InternalServiceError{
+							Message: fmt.Sprintf("Unable to signal workflow execution across domain: %v.",
+								attributes.GetDomain())}
+					}
+					targetDomainID = info.ID
+				}
+
+				signalEvent, _ := msBuilder.AddSignalExternalWorkflowExecutionInitiatedEvent(completedID, attributes)
+				if signalEvent == nil {
+					return &workflow.InternalServiceError{Message: "Unable to add external signal workflow request."}
+				}
+
+				transferTasks = append(transferTasks, &persistence.SignalExecutionTask{
+					TargetDomainID:   targetDomainID,
+					TargetWorkflowID: attributes.GetExecution().GetWorkflowId(),
+					TargetRunID:      attributes.GetExecution().GetRunId(),
+					InitiatedID:      signalEvent.GetEventId(),
+				})
+
 			default:
 				return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown decision type: %v", d.GetDecisionType())}
 			}
@@ -807,7 +1209,10 @@ Update_History_Loop:
 
 		// Schedule another decision task if new events came in during this decision
 		if hasUnhandledEvents {
-			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+			newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+			if err != nil {
+				return err
+			}
 			transferTasks = append(transferTasks, &persistence.DecisionTask{
 				DomainID:   domainID,
 				TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
@@ -851,6 +1256,42 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+// RespondDecisionTaskFailed fails the decision and schedules a new one right away, instead of waiting for the decision
+// to timeout
+func (e *historyEngineImpl) RespondDecisionTaskFailed(req *h.RespondDecisionTaskFailedRequest) error {
+	domainID := req.GetDomainUUID()
+	request := req.GetFailedRequest()
+	token, err0 := e.tokenSerializer.Deserialize(request.GetTaskToken())
+	if err0 != nil {
+		return &workflow.BadRequestError{Message: "Error deserializing task token."}
+	}
+
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(token.WorkflowID),
+		RunId:      common.StringPtr(token.RunID),
+	}
+
+	return e.updateWorkflowExecution(domainID, workflowExecution, false, true,
+		func(msBuilder *mutableStateBuilder) error {
+			if !msBuilder.isWorkflowExecutionRunning() {
+				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+			}
+
+			scheduleID := token.ScheduleID
+			di, isRunning := msBuilder.GetPendingDecision(scheduleID)
+			if !isRunning || di.StartedID == emptyEventID || di.Attempt != token.ScheduleAttempt {
+				return &workflow.EntityNotExistsError{Message: "Decision task not found."}
+			}
+
+			if msBuilder.AddDecisionTaskFailedEvent(di.ScheduleID, di.StartedID, request.GetCause(),
+				request.Details, request.GetIdentity()) == nil {
+				return &workflow.InternalServiceError{Message: "Unable to add DecisionTaskFailed event to history."}
+			}
+
+			return nil
+		})
+}
+
 // RespondActivityTaskCompleted completes an activity task.
 func (e *historyEngineImpl) RespondActivityTaskCompleted(req *h.RespondActivityTaskCompletedRequest) error {
 	domainID := req.GetDomainUUID()
@@ -879,6 +1320,12 @@ Update_History_Loop:
 		}
 
 		scheduleID := token.ScheduleID
+		if scheduleID == common.EmptyEventID { // activityID is set, resolve the scheduleID from it
+			scheduleID, err1 = getScheduleID(token.ActivityID, msBuilder)
+			if err1 != nil {
+				return err1
+			}
+		}
 
 		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
 		// some extreme cassandra failure cases.
@@ -889,7 +1336,8 @@ Update_History_Loop:
 		}
 
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			isStaleActivityAttempt(token, ai) {
 			return &workflow.EntityNotExistsError{Message: "Activity task not found."}
 		}
 
@@ -901,7 +1349,10 @@ Update_History_Loop:
 
 		var transferTasks []persistence.Task
 		if !msBuilder.HasPendingDecisionTask() {
-			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+			newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+			if err != nil {
+				return err
+			}
 			transferTasks = []persistence.Task{&persistence.DecisionTask{
 				DomainID:   domainID,
 				TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
@@ -959,6 +1410,12 @@ Update_History_Loop:
 		}
 
 		scheduleID := token.ScheduleID
+		if scheduleID == common.EmptyEventID { // activityID is set, resolve the scheduleID from it
+			scheduleID, err1 = getScheduleID(token.ActivityID, msBuilder)
+			if err1 != nil {
+				return err1
+			}
+		}
 
 		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
 		// some extreme cassandra failure cases.
@@ -969,24 +1426,35 @@ Update_History_Loop:
 		}
 
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			isStaleActivityAttempt(token, ai) {
 			return &workflow.EntityNotExistsError{Message: "Activity task not found."}
 		}
 
-		startedID := ai.StartedID
-		if msBuilder.AddActivityTaskFailedEvent(scheduleID, startedID, request) == nil {
-			// Unable to add ActivityTaskFailed event to history
-			return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
-		}
-
 		var transferTasks []persistence.Task
-		if !msBuilder.HasPendingDecisionTask() {
-			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
-			transferTasks = []persistence.Task{&persistence.DecisionTask{
-				DomainID:   domainID,
-				TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
-				ScheduleID: newDecisionEvent.GetEventId(),
-			}}
+		var timerTasks []persistence.Task
+		if backoffInterval, retry := msBuilder.RetryActivity(ai, request.GetReason()); retry {
+			// Reschedule the activity once the backoff interval elapses, without recording the failure.
+			retryTask := context.tBuilder.AddActivityRetryTimer(ai, backoffInterval)
+			timerTasks = append(timerTasks, retryTask)
+		} else {
+			startedID := ai.StartedID
+			if msBuilder.AddActivityTaskFailedEvent(scheduleID, startedID, request) == nil {
+				// Unable to add ActivityTaskFailed event to history
+				return &workflow.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
+			}
+
+			if !msBuilder.HasPendingDecisionTask() {
+				newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+				if err != nil {
+					return err
+				}
+				transferTasks = []persistence.Task{&persistence.DecisionTask{
+					DomainID:   domainID,
+					TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
+					ScheduleID: newDecisionEvent.GetEventId(),
+				}}
+			}
 		}
 
 		// Generate a transaction ID for appending events to history
@@ -997,7 +1465,7 @@ Update_History_Loop:
 
 		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
 		// the history and try the operation again.
-		if err := context.updateWorkflowExecution(transferTasks, nil, transactionID); err != nil {
+		if err := context.updateWorkflowExecution(transferTasks, timerTasks, transactionID); err != nil {
 			if err == ErrConflict {
 				continue Update_History_Loop
 			}
@@ -1039,6 +1507,12 @@ Update_History_Loop:
 		}
 
 		scheduleID := token.ScheduleID
+		if scheduleID == common.EmptyEventID { // activityID is set, resolve the scheduleID from it
+			scheduleID, err1 = getScheduleID(token.ActivityID, msBuilder)
+			if err1 != nil {
+				return err1
+			}
+		}
 		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
 		// some extreme cassandra failure cases.
 		if scheduleID >= msBuilder.GetNextEventID() {
@@ -1050,7 +1524,8 @@ Update_History_Loop:
 		// Check execution state to make sure task is in the list of outstanding tasks and it is not yet started.  If
 		// task is not outstanding than it is most probably a duplicate and complete the task.
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			isStaleActivityAttempt(token, ai) {
 			return &workflow.EntityNotExistsError{Message: "Activity task not found."}
 		}
 
@@ -1062,7 +1537,10 @@ Update_History_Loop:
 
 		var transferTasks []persistence.Task
 		if !msBuilder.HasPendingDecisionTask() {
-			newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+			newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+			if err != nil {
+				return err
+			}
 			transferTasks = []persistence.Task{&persistence.DecisionTask{
 				DomainID:   domainID,
 				TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
@@ -1124,6 +1602,12 @@ Update_History_Loop:
 		}
 
 		scheduleID := token.ScheduleID
+		if scheduleID == common.EmptyEventID { // activityID is set, resolve the scheduleID from it
+			scheduleID, err1 = getScheduleID(token.ActivityID, msBuilder)
+			if err1 != nil {
+				return nil, err1
+			}
+		}
 		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
 		// some extreme cassandra failure cases.
 		if scheduleID >= msBuilder.GetNextEventID() {
@@ -1133,7 +1617,8 @@ Update_History_Loop:
 		}
 
 		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
-		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID {
+		if !msBuilder.isWorkflowExecutionRunning() || !isRunning || ai.StartedID == emptyEventID ||
+			isStaleActivityAttempt(token, ai) {
 			e.logger.Debugf("Activity HeartBeat: scheduleEventID: %v, ActivityInfo: %+v, Exist: %v",
 				scheduleID, ai, isRunning)
 			return nil, &workflow.EntityNotExistsError{Message: "Activity task not found."}
@@ -1190,6 +1675,17 @@ func (e *historyEngineImpl) RequestCancelWorkflowExecution(
 				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
 			}
 
+			if req.GetChildWorkflowOnly() && !isChildOf(msBuilder, req.GetExternalWorkflowExecution()) {
+				return errNotChildOfParent
+			}
+
+			// A retried request must not record the cancellation again
+			executionInfo := msBuilder.executionInfo
+			if executionInfo.CancelRequested && request.GetRequestId() != "" &&
+				executionInfo.CancelRequestID == request.GetRequestId() {
+				return nil
+			}
+
 			if msBuilder.AddWorkflowExecutionCancelRequestedEvent("", req) == nil {
 				return &workflow.InternalServiceError{Message: "Unable to cancel workflow execution."}
 			}
@@ -1212,35 +1708,490 @@ func (e *historyEngineImpl) SignalWorkflowExecution(signalRequest *h.SignalWorkf
 				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
 			}
 
+			// A retried request must not record the signal again
+			requestID := request.GetRequestId()
+			if requestID != "" && msBuilder.IsSignalRequested(requestID) {
+				return nil
+			}
+
 			if msBuilder.AddWorkflowExecutionSignaled(request) == nil {
 				return &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
 			}
+			if requestID != "" {
+				msBuilder.AddSignalRequested(requestID)
+			}
 
 			return nil
 		})
 }
 
-func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.TerminateWorkflowExecutionRequest) error {
-	domainID := terminateRequest.GetDomainUUID()
-	request := terminateRequest.GetTerminateRequest()
+// SignalWithStartWorkflowExecution signals the current run of the workflow if it is running, otherwise it starts a new
+// run with the signal recorded right after the started event.
+func (e *historyEngineImpl) SignalWithStartWorkflowExecution(signalWithStartRequest *h.SignalWithStartWorkflowExecutionRequest) (
+	*workflow.StartWorkflowExecutionResponse, error) {
+	domainID := signalWithStartRequest.GetDomainUUID()
+	request := signalWithStartRequest.GetSignalWithStartRequest()
 	execution := workflow.WorkflowExecution{
-		WorkflowId: common.StringPtr(request.GetWorkflowExecution().GetWorkflowId()),
-		RunId:      common.StringPtr(request.GetWorkflowExecution().GetRunId()),
+		WorkflowId: common.StringPtr(request.GetWorkflowId()),
+	}
+
+	startRequest := &h.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:                              request.Domain,
+			WorkflowId:                          request.WorkflowId,
+			WorkflowType:                        request.WorkflowType,
+			TaskList:                            request.TaskList,
+			Input:                               request.Input,
+			ExecutionStartToCloseTimeoutSeconds: request.ExecutionStartToCloseTimeoutSeconds,
+			TaskStartToCloseTimeoutSeconds:      request.TaskStartToCloseTimeoutSeconds,
+			Identity:                            request.Identity,
+			RequestId:                           request.RequestId,
+			RetryPolicy:                         request.RetryPolicy,
+			CronSchedule:                        request.CronSchedule,
+			WorkflowIdReusePolicy:               request.WorkflowIdReusePolicy,
+		},
+	}
+	signalRequest := &workflow.SignalWorkflowExecutionRequest{
+		Domain: request.Domain,
+		WorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(request.GetWorkflowId()),
+		},
+		SignalName: request.SignalName,
+		Input:      request.SignalInput,
+		Identity:   request.Identity,
 	}
 
-	return e.updateWorkflowExecution(domainID, execution, true, false,
-		func(msBuilder *mutableStateBuilder) error {
-			if !msBuilder.isWorkflowExecutionRunning() {
-				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		context, release, err := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+		if err != nil {
+			if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+				return nil, err
 			}
 
-			if msBuilder.AddWorkflowExecutionTerminatedEvent(request) == nil {
-				return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+			// There is no run for this workflow, start one with the signal
+			response, err := e.startWorkflowExecution(startRequest, signalRequest)
+			if _, ok := err.(*workflow.WorkflowExecutionAlreadyStartedError); ok {
+				// Another run was started concurrently, signal that one instead
+				continue
 			}
+			return response, err
+		}
 
-			return nil
-		})
-}
+		loadedRunID := context.workflowExecution.GetRunId()
+		response, err := e.signalOrStartWorkflowExecution(context, startRequest, signalRequest)
+		release()
+		if t, ok := err.(*workflow.WorkflowExecutionAlreadyStartedError); ok && t.GetRunId() != loadedRunID {
+			// Another run was started after the loaded one closed, signal that one instead
+			continue
+		}
+		return response, err
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// signalOrStartWorkflowExecution signals the run loaded by the context, or starts a new run with the signal if it
+// has already closed.  The lock on the context is held by the caller for the whole operation.
+func (e *historyEngineImpl) signalOrStartWorkflowExecution(context *workflowExecutionContext,
+	startRequest *h.StartWorkflowExecutionRequest, signalRequest *workflow.SignalWorkflowExecutionRequest) (
+	*workflow.StartWorkflowExecutionResponse, error) {
+	domainID := context.domainID
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			if _, ok := err1.(*workflow.EntityNotExistsError); ok {
+				return e.startWorkflowExecution(startRequest, signalRequest)
+			}
+			return nil, err1
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() {
+			return e.startWorkflowExecution(startRequest, signalRequest)
+		}
+
+		if msBuilder.AddWorkflowExecutionSignaled(signalRequest) == nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to signal workflow execution."}
+		}
+
+		var transferTasks []persistence.Task
+		if !msBuilder.HasPendingDecisionTask() && !msBuilder.isFirstDecisionDelayed() {
+			newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+			if err != nil {
+				return nil, err
+			}
+			transferTasks = append(transferTasks, &persistence.DecisionTask{
+				DomainID:   domainID,
+				TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
+				ScheduleID: newDecisionEvent.GetEventId(),
+			})
+		}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := e.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return nil, err2
+		}
+
+		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict then reload
+		// the history and try the operation again.
+		if err := context.updateWorkflowExecution(transferTasks, nil, transactionID); err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+			return nil, err
+		}
+
+		return &workflow.StartWorkflowExecutionResponse{
+			RunId: context.workflowExecution.RunId,
+		}, nil
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
+func (e *historyEngineImpl) TerminateWorkflowExecution(terminateRequest *h.TerminateWorkflowExecutionRequest) error {
+	domainID := terminateRequest.GetDomainUUID()
+	request := terminateRequest.GetTerminateRequest()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.GetWorkflowExecution().GetWorkflowId()),
+		RunId:      common.StringPtr(request.GetWorkflowExecution().GetRunId()),
+	}
+
+	return e.updateWorkflowExecution(domainID, execution, true, false,
+		func(msBuilder *mutableStateBuilder) error {
+			if !msBuilder.isWorkflowExecutionRunning() {
+				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+			}
+
+			if terminateRequest.GetChildWorkflowOnly() &&
+				!isChildOf(msBuilder, terminateRequest.GetExternalWorkflowExecution()) {
+				return errNotChildOfParent
+			}
+
+			event, err := msBuilder.AddWorkflowExecutionTerminatedEvent(request)
+			if err != nil {
+				return err
+			}
+			if event == nil {
+				return &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+			}
+
+			return nil
+		})
+}
+
+// ResetWorkflowExecution starts a new run of the workflow execution with a copy of its history up to the given
+// DecisionTaskCompleted event.  That decision is failed so the worker makes it again, activities which were running
+// are failed and the signals received after the reset point are reapplied.  Only a running execution can be reset, it
+// is terminated along with the creation of the new run.  A closed run has no mutable state left to reset from.
+func (e *historyEngineImpl) ResetWorkflowExecution(
+	resetRequest *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error) {
+	domainID := resetRequest.GetDomainUUID()
+	request := resetRequest.GetResetRequest()
+	execution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(request.GetWorkflowExecution().GetWorkflowId()),
+		RunId:      common.StringPtr(request.GetWorkflowExecution().GetRunId()),
+	}
+	resetEventID := request.GetDecisionFinishEventId()
+	requestID := request.GetRequestId()
+	if requestID == "" {
+		requestID = uuid.New()
+	}
+
+	context, release, err0 := e.historyCache.getOrCreateWorkflowExecution(domainID, execution)
+	if err0 != nil {
+		return nil, err0
+	}
+	defer release()
+
+Reset_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return nil, err1
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() {
+			return nil, &workflow.BadRequestError{Message: "Unable to reset a closed workflow execution."}
+		}
+
+		if resetEventID <= firstEventID || resetEventID >= msBuilder.GetNextEventID() {
+			return nil, &workflow.BadRequestError{Message: "DecisionFinishEventId is not in the history of the execution."}
+		}
+
+		events, err1 := e.getWorkflowHistoryEvents(domainID, context.workflowExecution, msBuilder.GetNextEventID())
+		if err1 != nil {
+			return nil, err1
+		}
+		if int64(len(events)) < resetEventID || events[resetEventID-firstEventID].GetEventId() != resetEventID {
+			return nil, &workflow.InternalServiceError{Message: "Unable to find reset event in history."}
+		}
+		resetEvent := events[resetEventID-firstEventID]
+		if resetEvent.GetEventType() != workflow.EventType_DecisionTaskCompleted {
+			return nil, &workflow.BadRequestError{Message: "DecisionFinishEventId is not a DecisionTaskCompleted event."}
+		}
+
+		newExecution := workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(msBuilder.executionInfo.WorkflowID),
+			RunId:      common.StringPtr(uuid.New()),
+		}
+		newStateBuilder := newMutableStateBuilder(e.logger)
+		rebuilder := newStateRebuilder(newStateBuilder)
+		if err := rebuilder.applyEvents(domainID, requestID, newExecution,
+			events[:resetEventID-firstEventID]); err != nil {
+			return nil, err
+		}
+		if rebuilder.hasPendingExternalRequests() {
+			return nil, &workflow.BadRequestError{
+				Message: "Unable to reset to a point with pending child executions or signals to other executions."}
+		}
+
+		// The new run keeps reporting to the parent of the execution
+		newStateBuilder.executionInfo.ParentDomainID = msBuilder.executionInfo.ParentDomainID
+		newStateBuilder.executionInfo.ParentWorkflowID = msBuilder.executionInfo.ParentWorkflowID
+		newStateBuilder.executionInfo.ParentRunID = msBuilder.executionInfo.ParentRunID
+		newStateBuilder.executionInfo.InitiatedID = msBuilder.executionInfo.InitiatedID
+		newStateBuilder.executionInfo.StartTimestamp = time.Now()
+
+		// Fail the decision completed at the reset point so the worker makes it again on the new run
+		completedAttributes := resetEvent.GetDecisionTaskCompletedEventAttributes()
+		if newStateBuilder.AddDecisionTaskFailedEvent(completedAttributes.GetScheduledEventId(),
+			completedAttributes.GetStartedEventId(), workflow.DecisionTaskFailedCause_RESET_WORKFLOW, nil,
+			request.GetIdentity()) == nil {
+			return nil, &workflow.InternalServiceError{Message: "Unable to add DecisionTaskFailed event to history."}
+		}
+
+		tBuilder := newTimerBuilder(&shardSeqNumGenerator{context: e.shard}, e.logger)
+		transferTasks, timerTasks, err1 := e.scheduleResetWorkflowTasks(domainID, request, newStateBuilder, tBuilder)
+		if err1 != nil {
+			return nil, err1
+		}
+
+		for _, event := range events[resetEventID-firstEventID+1:] {
+			if event.GetEventType() != workflow.EventType_WorkflowExecutionSignaled {
+				continue
+			}
+			attributes := event.GetWorkflowExecutionSignaledEventAttributes()
+			if newStateBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+				SignalName: attributes.SignalName,
+				Input:      attributes.Input,
+				Identity:   attributes.Identity,
+			}) == nil {
+				return nil, &workflow.InternalServiceError{Message: "Unable to reapply signal to the new run."}
+			}
+		}
+
+		_, di, err := newStateBuilder.AddDecisionTaskScheduledEvent()
+		if err != nil {
+			return nil, err
+		}
+		if di == nil {
+			return nil, &workflow.InternalServiceError{Message: "Failed to add decision scheduled event."}
+		}
+		transferTasks = append(transferTasks, &persistence.DecisionTask{
+			DomainID: domainID, TaskList: newStateBuilder.executionInfo.TaskList, ScheduleID: di.ScheduleID,
+		})
+		timerTasks = append(timerTasks, createWorkflowTimerTasks(tBuilder,
+			newStateBuilder.executionInfo.WorkflowTimeout, 0)...)
+
+		err1 = e.persistResetWorkflowExecution(context, msBuilder, request, newStateBuilder, rebuilder,
+			transferTasks, timerTasks)
+		if err1 != nil {
+			if err1 == ErrConflict {
+				continue Reset_Loop
+			}
+			return nil, err1
+		}
+
+		for _, task := range timerTasks {
+			e.timerProcessor.NotifyNewTimer(task.GetTaskID())
+		}
+
+		return &workflow.ResetWorkflowExecutionResponse{
+			RunId: newExecution.RunId,
+		}, nil
+	}
+
+	return nil, ErrMaxAttemptsExceeded
+}
+
+// scheduleResetWorkflowTasks fails the activities of the new run which were started by the previous run, and creates
+// the tasks to dispatch the ones which are still scheduled along with a task to fire the next user timer.
+func (e *historyEngineImpl) scheduleResetWorkflowTasks(domainID string, request *workflow.ResetWorkflowExecutionRequest,
+	msBuilder *mutableStateBuilder, tBuilder *timerBuilder) ([]persistence.Task, []persistence.Task, error) {
+	var transferTasks []persistence.Task
+	var timerTasks []persistence.Task
+	for _, ai := range msBuilder.pendingActivityInfoIDs {
+		if ai.StartedID != emptyEventID {
+			if msBuilder.AddActivityTaskFailedEvent(ai.ScheduleID, ai.StartedID, &workflow.RespondActivityTaskFailedRequest{
+				Reason:   common.StringPtr(activityFailureReasonReset),
+				Identity: request.Identity,
+			}) == nil {
+				return nil, nil, &workflow.InternalServiceError{Message: "Unable to add ActivityTaskFailed event to history."}
+			}
+			continue
+		}
+
+		scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(ai.ScheduleID)
+		if !ok {
+			return nil, nil, &workflow.InternalServiceError{Message: "Unable to get activity scheduled event."}
+		}
+		attributes := scheduledEvent.GetActivityTaskScheduledEventAttributes()
+		targetDomainID := domainID
+		if attributes.IsSetDomain() {
+			info, _, err := e.domainCache.GetDomain(attributes.GetDomain())
+			if err != nil {
+				return nil, nil, err
+			}
+			targetDomainID = info.ID
+		}
+
+		transferTasks = append(transferTasks, &persistence.ActivityTask{
+			DomainID:   targetDomainID,
+			TaskList:   attributes.GetTaskList().GetName(),
+			ScheduleID: ai.ScheduleID,
+		})
+		timerTasks = append(timerTasks, tBuilder.AddScheduleToStartActivityTimeout(ai))
+		scheduleToCloseTimeoutTask, err := tBuilder.AddScheduleToCloseActivityTimeout(ai)
+		if err != nil {
+			return nil, nil, err
+		}
+		timerTasks = append(timerTasks, scheduleToCloseTimeoutTask)
+	}
+
+	for _, ti := range msBuilder.pendingTimerInfoIDs {
+		// Only the task of the timer which expires first is created, which creates the task for the next one once fired
+		if timerTask := tBuilder.AddUserTimer(ti, msBuilder); timerTask != nil {
+			timerTasks = append(timerTasks, timerTask)
+		}
+		break
+	}
+
+	return transferTasks, timerTasks, nil
+}
+
+// persistResetWorkflowExecution writes the history and mutable state of the new run.  The new run is created along
+// with the termination of the previous run, which is closed as reset so it does not report to its parent.
+func (e *historyEngineImpl) persistResetWorkflowExecution(context *workflowExecutionContext,
+	msBuilder *mutableStateBuilder, request *workflow.ResetWorkflowExecutionRequest,
+	newStateBuilder *mutableStateBuilder, rebuilder *stateRebuilder, transferTasks []persistence.Task,
+	timerTasks []persistence.Task) error {
+	newInfo := newStateBuilder.executionInfo
+	newExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr(newInfo.WorkflowID),
+		RunId:      common.StringPtr(newInfo.RunID),
+	}
+
+	serializedHistory, serializedError := newStateBuilder.hBuilder.Serialize()
+	if serializedError != nil {
+		logging.LogHistorySerializationErrorEvent(e.logger, serializedError, fmt.Sprintf(
+			"HistoryEventBatch serialization error on reset workflow.  WorkflowID: %v, RunID: %v", newInfo.WorkflowID,
+			newInfo.RunID))
+		return serializedError
+	}
+
+	if err := e.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
+		DomainID:  newInfo.DomainID,
+		Execution: newExecution,
+		// It is ok to use 0 for TransactionID because RunID is unique so there are
+		// no potential duplicates to override.
+		TransactionID: 0,
+		FirstEventID:  firstEventID,
+		Events:        serializedHistory,
+	}); err != nil {
+		return err
+	}
+
+	parentDomainID := ""
+	var parentExecution *workflow.WorkflowExecution
+	if newStateBuilder.hasParentExecution() {
+		parentDomainID = newInfo.ParentDomainID
+		parentExecution = &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(newInfo.ParentWorkflowID),
+			RunId:      common.StringPtr(newInfo.ParentRunID),
+		}
+	}
+
+	createRequest := &persistence.CreateWorkflowExecutionRequest{
+		RequestID:                   newInfo.CreateRequestID,
+		DomainID:                    newInfo.DomainID,
+		Execution:                   newExecution,
+		ParentDomainID:              parentDomainID,
+		ParentExecution:             parentExecution,
+		InitiatedID:                 newInfo.InitiatedID,
+		TaskList:                    newInfo.TaskList,
+		WorkflowTypeName:            newInfo.WorkflowTypeName,
+		DecisionTimeoutValue:        newInfo.DecisionTimeoutValue,
+		ExecutionContext:            nil,
+		NextEventID:                 newStateBuilder.GetNextEventID(),
+		LastProcessedEvent:          newInfo.LastProcessedEvent,
+		TransferTasks:               transferTasks,
+		TimerTasks:                  timerTasks,
+		ActivityInfos:               rebuilder.getPendingActivityInfos(),
+		TimerInfos:                  rebuilder.getPendingTimerInfos(),
+		HistorySize:                 int64(len(serializedHistory.Data)),
+		DecisionScheduleID:          newInfo.DecisionScheduleID,
+		DecisionStartedID:           newInfo.DecisionStartedID,
+		DecisionStartToCloseTimeout: newInfo.DecisionTimeout,
+		Attempt:                     newInfo.Attempt,
+		HasRetryPolicy:              newInfo.HasRetryPolicy,
+		InitialInterval:             newInfo.InitialInterval,
+		BackoffCoefficient:          newInfo.BackoffCoefficient,
+		MaximumInterval:             newInfo.MaximumInterval,
+		MaximumAttempts:             newInfo.MaximumAttempts,
+		ExpirationSeconds:           newInfo.ExpirationSeconds,
+		ExpirationTime:              newInfo.ExpirationTime,
+		NonRetriableErrors:          newInfo.NonRetriableErrors,
+		CronSchedule:                newInfo.CronSchedule,
+		WorkflowTimeout:             newInfo.WorkflowTimeout,
+		Memo:                        newInfo.Memo,
+		SearchAttributes:            newInfo.SearchAttributes,
+		CancelRequested:             newInfo.CancelRequested,
+		CancelRequestID:             newInfo.CancelRequestID,
+	}
+
+	event, err := msBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+		Reason:   request.Reason,
+		Details:  []byte(fmt.Sprintf("Reset to run %v", newInfo.RunID)),
+		Identity: request.Identity,
+	})
+	if err == nil && event == nil {
+		err = &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+	} else if err == nil {
+		msBuilder.executionInfo.CloseStatus = persistence.WorkflowCloseStatusReset
+		createRequest.ContinueAsNew = true
+		msBuilder.continueAsNew = createRequest
+		var transactionID int64
+		transactionID, err = e.shard.GetNextTransferTaskID()
+		if err == nil {
+			err = context.updateWorkflowExecution([]persistence.Task{&persistence.DeleteExecutionTask{}}, nil,
+				transactionID)
+		}
+	}
+
+	if err != nil {
+		// The new run was not created, so cleanup the history which was written for it.  Cleaning up the events is
+		// safe as they are created for a unique run_id which is not visible beyond this call.
+		cleanupHistory := err == ErrConflict
+		switch err.(type) {
+		case *workflow.WorkflowExecutionAlreadyStartedError, *persistence.ShardOwnershipLostError:
+			cleanupHistory = true
+		}
+		if cleanupHistory {
+			// TODO: Handle error on deletion of execution history
+			e.historyMgr.DeleteWorkflowExecutionHistory(&persistence.DeleteWorkflowExecutionHistoryRequest{
+				DomainID:  newInfo.DomainID,
+				Execution: newExecution,
+			})
+		}
+		return err
+	}
+
+	return nil
+}
 
 // ScheduleDecisionTask schedules a decision if no outstanding decision found
 func (e *historyEngineImpl) ScheduleDecisionTask(scheduleRequest *h.ScheduleDecisionTaskRequest) error {
@@ -1299,6 +2250,9 @@ func (e *historyEngineImpl) RecordChildExecutionCompleted(completionRequest *h.R
 			case workflow.EventType_WorkflowExecutionTerminated:
 				attributes := completionEvent.GetWorkflowExecutionTerminatedEventAttributes()
 				msBuilder.AddChildWorkflowExecutionTerminatedEvent(initiatedID, completedExecution, attributes)
+			case workflow.EventType_WorkflowExecutionTimedOut:
+				attributes := completionEvent.GetWorkflowExecutionTimedOutEventAttributes()
+				msBuilder.AddChildWorkflowExecutionTimedOutEvent(initiatedID, completedExecution, attributes)
 			}
 
 			return nil
@@ -1333,9 +2287,12 @@ Update_History_Loop:
 		}
 
 		if createDecisionTask {
-			// Create a transfer task to schedule a decision task
-			if !msBuilder.HasPendingDecisionTask() {
-				newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+			// Create a transfer task to schedule a decision task, unless the run still waits for its delayed start
+			if !msBuilder.HasPendingDecisionTask() && !msBuilder.isFirstDecisionDelayed() {
+				newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+				if err != nil {
+					return err
+				}
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
 					TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
@@ -1364,17 +2321,146 @@ Update_History_Loop:
 }
 
 func (e *historyEngineImpl) createRecordDecisionTaskStartedResponse(domainID string, msBuilder *mutableStateBuilder,
-	startedEventID int64) *h.RecordDecisionTaskStartedResponse {
+	pollRequest *workflow.PollForDecisionTaskRequest) *h.RecordDecisionTaskStartedResponse {
+	executionInfo := msBuilder.executionInfo
 	response := h.NewRecordDecisionTaskStartedResponse()
 	response.WorkflowType = msBuilder.getWorkflowType()
 	if msBuilder.previousDecisionStartedEvent() != emptyEventID {
 		response.PreviousStartedEventId = common.Int64Ptr(msBuilder.previousDecisionStartedEvent())
 	}
-	response.StartedEventId = common.Int64Ptr(startedEventID)
+	response.ScheduledEventId = common.Int64Ptr(executionInfo.DecisionScheduleID)
+	response.StartedEventId = common.Int64Ptr(executionInfo.DecisionStartedID)
+	response.Attempt = common.Int64Ptr(executionInfo.DecisionAttempt)
+	// The events of a retried decision are not in the history yet, they are handed to the worker along with it
+	if msBuilder.isTransientDecision() {
+		response.DecisionInfo = msBuilder.getTransientDecisionInfo(pollRequest.GetIdentity())
+	}
+	// The worker polling its sticky task list still has the history up to the previous decision cached
+	if msBuilder.isStickyTaskListEnabled() && pollRequest != nil && pollRequest.IsSetTaskList() &&
+		pollRequest.GetTaskList().GetName() == msBuilder.executionInfo.StickyTaskList {
+		response.StickyExecutionEnabled = common.BoolPtr(true)
+	}
 
 	return response
 }
 
+// continueAsNewWithBackoff closes the run and chains the next run of the execution, which is started with the same
+// input once the backoff interval of the retry policy or the cron schedule elapses.
+func (e *historyEngineImpl) continueAsNewWithBackoff(context *workflowExecutionContext, msBuilder *mutableStateBuilder,
+	decisionCompletedEventID int64, backoffInterval time.Duration, initiator workflow.ContinueAsNewInitiator,
+	failureReason *string, failureDetails []byte) (*mutableStateBuilder, error) {
+	startAttributes, err := e.getWorkflowStartedEventAttributes(context.domainID, context.workflowExecution)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := &workflow.ContinueAsNewWorkflowExecutionDecisionAttributes{
+		WorkflowType:                        startAttributes.WorkflowType,
+		TaskList:                            startAttributes.TaskList,
+		Input:                               startAttributes.Input,
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(startAttributes.GetExecutionStartToCloseTimeoutSeconds()),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(startAttributes.GetTaskStartToCloseTimeoutSeconds()),
+		BackoffStartIntervalInSeconds:       common.Int32Ptr(int32(math.Ceil(backoffInterval.Seconds()))),
+		Initiator:                           workflow.ContinueAsNewInitiatorPtr(initiator),
+		FailureReason:                       failureReason,
+		FailureDetails:                      failureDetails,
+	}
+
+	_, newStateBuilder, err := msBuilder.AddContinueAsNewEvent(decisionCompletedEventID, context.domainID, uuid.New(),
+		attributes)
+	if err != nil {
+		return nil, err
+	}
+	msBuilder.continueAsNew.TimerTasks = createWorkflowTimerTasks(context.tBuilder,
+		attributes.GetExecutionStartToCloseTimeoutSeconds(), attributes.GetBackoffStartIntervalInSeconds())
+
+	return newStateBuilder, nil
+}
+
+// getWorkflowStartedEventAttributes reads the started event of the run from history, which has the input and the
+// options needed to start another run of the same execution.
+func (e *historyEngineImpl) getWorkflowStartedEventAttributes(domainID string,
+	execution workflow.WorkflowExecution) (*workflow.WorkflowExecutionStartedEventAttributes, error) {
+	response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+		DomainID:      domainID,
+		Execution:     execution,
+		NextEventID:   firstEventID + 1,
+		PageSize:      1,
+		NextPageToken: []byte{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, batch := range response.Events {
+		setSerializedHistoryDefaults(&batch)
+		serializer, err1 := e.hSerializerFactory.Get(batch.EncodingType)
+		if err1 != nil {
+			return nil, err1
+		}
+		history, err1 := serializer.Deserialize(&batch)
+		if err1 != nil {
+			return nil, err1
+		}
+		for _, event := range history.Events {
+			if event.GetEventType() == workflow.EventType_WorkflowExecutionStarted {
+				return event.GetWorkflowExecutionStartedEventAttributes(), nil
+			}
+		}
+	}
+
+	return nil, &workflow.InternalServiceError{Message: "Unable to find workflow execution started event."}
+}
+
+// getWorkflowHistoryEvents reads the history of the run, up to but not including nextEventID.
+func (e *historyEngineImpl) getWorkflowHistoryEvents(domainID string, execution workflow.WorkflowExecution,
+	nextEventID int64) ([]*workflow.HistoryEvent, error) {
+	var events []*workflow.HistoryEvent
+	token := []byte{}
+	for {
+		response, err := e.historyMgr.GetWorkflowExecutionHistory(&persistence.GetWorkflowExecutionHistoryRequest{
+			DomainID:      domainID,
+			Execution:     execution,
+			NextEventID:   nextEventID,
+			PageSize:      historyPageSize,
+			NextPageToken: token,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, batch := range response.Events {
+			setSerializedHistoryDefaults(&batch)
+			serializer, err1 := e.hSerializerFactory.Get(batch.EncodingType)
+			if err1 != nil {
+				return nil, err1
+			}
+			history, err1 := serializer.Deserialize(&batch)
+			if err1 != nil {
+				return nil, err1
+			}
+			events = append(events, history.Events...)
+		}
+
+		token = response.NextPageToken
+		if len(token) == 0 || (len(events) > 0 && events[len(events)-1].GetEventId() >= nextEventID-1) {
+			return events, nil
+		}
+	}
+}
+
+// createWorkflowTimerTasks creates the timers for a new run.  The execution timeout starts counting after the backoff,
+// and a backoff timer schedules the first decision once the backoff elapses.
+func createWorkflowTimerTasks(tBuilder *timerBuilder, executionTimeoutSeconds, backoffSeconds int32) []persistence.Task {
+	timeoutTask := tBuilder.AddWorkflowTimeoutTask(executionTimeoutSeconds + backoffSeconds)
+	timerTasks := []persistence.Task{timeoutTask}
+	if backoffSeconds > 0 {
+		timerTasks = append(timerTasks, tBuilder.AddWorkflowBackoffTimer(time.Duration(backoffSeconds)*time.Second))
+	}
+
+	return timerTasks
+}
+
 // sets the version and encoding types to defaults if they
 // are missing from persistence. This is purely for backwards
 // compatibility
@@ -1387,6 +2473,56 @@ func setSerializedHistoryDefaults(history *persistence.SerializedHistoryEventBat
 	}
 }
 
+func getScheduleID(activityID string, msBuilder *mutableStateBuilder) (int64, error) {
+	if activityID == "" {
+		return 0, &workflow.BadRequestError{Message: "Neither ActivityID nor ScheduleID is provided"}
+	}
+	ai, ok := msBuilder.GetActivityByActivityID(activityID)
+	if !ok {
+		return 0, &workflow.EntityNotExistsError{Message: "Activity task not found."}
+	}
+	return ai.ScheduleID, nil
+}
+
+// isStaleActivityAttempt checks whether the task token was handed out for an earlier attempt of a retried activity.
+// Tokens created from an activityID do not carry the attempt, they always address the current one.
+func isStaleActivityAttempt(token *common.TaskToken, ai *persistence.ActivityInfo) bool {
+	return token.ScheduleID != common.EmptyEventID && token.ScheduleAttempt != int64(ai.Attempt)
+}
+
+// isBlobSizeLimitExceeded checks the payload of a decision against the blob size limits of the domain.  Decisions with
+// a payload larger than the error limit are failed, without failing the request which completes the decision, while
+// payloads larger than the warn limit are only logged.
+func (e *historyEngineImpl) isBlobSizeLimitExceeded(domainID string, blob []byte) bool {
+	warnLimit := common.DefaultBlobSizeLimitWarn
+	errorLimit := common.DefaultBlobSizeLimitError
+	_, config, err := e.domainCache.GetDomainByID(domainID)
+	if err != nil {
+		// Do not fail the decision because of the domain lookup, fallback to the default limits instead
+		e.logger.Warnf("Unable to load blob size limits of domain, using default limits.  DomainID: %v, Error: %v",
+			domainID, err)
+	} else {
+		if config.BlobSizeLimitWarn > 0 {
+			warnLimit = int(config.BlobSizeLimitWarn)
+		}
+		if config.BlobSizeLimitError > 0 {
+			errorLimit = int(config.BlobSizeLimitError)
+		}
+	}
+
+	size := len(blob)
+	if size > errorLimit {
+		e.metricsClient.IncCounter(metrics.HistoryBlobSizeLimitScope, metrics.BlobSizeLimitErrorCounter)
+		logging.LogBlobSizeLimitErrorEvent(e.logger, domainID, size, errorLimit)
+		return true
+	}
+	if size > warnLimit {
+		e.metricsClient.IncCounter(metrics.HistoryBlobSizeLimitScope, metrics.BlobSizeLimitWarnCounter)
+		logging.LogBlobSizeLimitWarnEvent(e.logger, domainID, size, warnLimit)
+	}
+	return false
+}
+
 func (e *historyEngineImpl) failDecision(context *workflowExecutionContext, scheduleID, startedID int64,
 	cause workflow.DecisionTaskFailedCause, request *workflow.RespondDecisionTaskCompletedRequest) (*mutableStateBuilder,
 	error) {
@@ -1399,7 +2535,7 @@ func (e *historyEngineImpl) failDecision(context *workflowExecutionContext, sche
 		return nil, err
 	}
 
-	msBuilder.AddDecisionTaskFailedEvent(scheduleID, startedID, cause, request)
+	msBuilder.AddDecisionTaskFailedEvent(scheduleID, startedID, cause, nil, request.GetIdentity())
 
 	// Return new builder back to the caller for further updates
 	return msBuilder, nil
@@ -1411,6 +2547,15 @@ func (s *shardContextWrapper) UpdateWorkflowExecution(request *persistence.Updat
 		if len(request.TransferTasks) > 0 {
 			s.txProcessor.NotifyNewTask()
 		}
+		for _, task := range request.TimerTasks {
+			s.timerProcessor.NotifyNewTimer(task.GetTaskID())
+		}
+		if request.ContinueAsNew != nil {
+			for _, task := range request.ContinueAsNew.TimerTasks {
+				s.timerProcessor.NotifyNewTimer(task.GetTaskID())
+			}
+		}
+		s.historyEventNotifier.NotifyNewHistoryEvent(newHistoryEventNotification(request.ExecutionInfo))
 	}
 	return err
 }
@@ -1455,6 +2600,30 @@ func validateActivityScheduleAttributes(attributes *workflow.ScheduleActivityTas
 	if !attributes.IsSetHeartbeatTimeoutSeconds() || attributes.GetHeartbeatTimeoutSeconds() < 0 {
 		return &workflow.BadRequestError{Message: "Ac valid HeartbeatTimeoutSeconds is not set on decision."}
 	}
+	if attributes.IsSetRetryPolicy() {
+		return validateRetryPolicy(attributes.GetRetryPolicy())
+	}
+
+	return nil
+}
+
+func validateRetryPolicy(policy *workflow.RetryPolicy) error {
+	if policy.GetInitialIntervalInSeconds() <= 0 {
+		return &workflow.BadRequestError{Message: "A valid InitialIntervalInSeconds is not set on retry policy."}
+	}
+	if policy.GetBackoffCoefficient() < 1 {
+		return &workflow.BadRequestError{Message: "BackoffCoefficient on retry policy cannot be less than 1."}
+	}
+	if policy.GetMaximumIntervalInSeconds() < 0 ||
+		(policy.GetMaximumIntervalInSeconds() > 0 && policy.GetMaximumIntervalInSeconds() < policy.GetInitialIntervalInSeconds()) {
+		return &workflow.BadRequestError{Message: "MaximumIntervalInSeconds on retry policy cannot be less than InitialIntervalInSeconds."}
+	}
+	if policy.GetMaximumAttempts() < 0 {
+		return &workflow.BadRequestError{Message: "MaximumAttempts on retry policy cannot be negative."}
+	}
+	if policy.GetExpirationIntervalInSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "ExpirationIntervalInSeconds on retry policy cannot be negative."}
+	}
 
 	return nil
 }
@@ -1502,6 +2671,40 @@ func validateRecordMarkerAttributes(attributes *workflow.RecordMarkerDecisionAtt
 	return nil
 }
 
+func validateUpsertWorkflowSearchAttributes(attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) error {
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "UpsertWorkflowSearchAttributesDecisionAttributes is not set on decision."}
+	}
+	if !attributes.IsSetSearchAttributes() || len(attributes.GetSearchAttributes().GetIndexedFields()) == 0 {
+		return &workflow.BadRequestError{Message: "SearchAttributes is not set on decision."}
+	}
+	return validateSearchAttributes(attributes.GetSearchAttributes())
+}
+
+// validateSearchAttributes makes sure every indexed field has a name and a JSON encoded string, number or bool value,
+// which is the type the field is indexed as
+func validateSearchAttributes(searchAttributes *workflow.SearchAttributes) error {
+	for key, value := range searchAttributes.GetIndexedFields() {
+		if key == "" {
+			return &workflow.BadRequestError{Message: "SearchAttributes contains a field without a name."}
+		}
+
+		var decoded interface{}
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return &workflow.BadRequestError{Message: fmt.Sprintf(
+				"SearchAttributes field %v is not valid JSON: %v", key, err)}
+		}
+		switch decoded.(type) {
+		case string, float64, bool:
+		default:
+			return &workflow.BadRequestError{Message: fmt.Sprintf(
+				"SearchAttributes field %v must be a string, a number or a bool.", key)}
+		}
+	}
+
+	return nil
+}
+
 func validateCompleteWorkflowExecutionAttributes(attributes *workflow.CompleteWorkflowExecutionDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "CompleteWorkflowExecutionDecisionAttributes is not set on decision."}
@@ -1545,6 +2748,34 @@ func validateCancelExternalWorkflowExecutionAttributes(attributes *workflow.Requ
 	return nil
 }
 
+func validateSignalExternalWorkflowExecutionAttributes(attributes *workflow.SignalExternalWorkflowExecutionDecisionAttributes) error {
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "SignalExternalWorkflowExecutionDecisionAttributes is not set on decision."}
+	}
+	if !attributes.IsSetExecution() || !attributes.GetExecution().IsSetWorkflowId() ||
+		attributes.GetExecution().GetWorkflowId() == "" {
+		return &workflow.BadRequestError{Message: "WorkflowId is not set on decision."}
+	}
+
+	runID := attributes.GetExecution().GetRunId()
+	if runID != "" && uuid.Parse(runID) == nil {
+		return &workflow.BadRequestError{Message: "Invalid RunId set on decision."}
+	}
+
+	if !attributes.IsSetSignalName() || attributes.GetSignalName() == "" {
+		return &workflow.BadRequestError{Message: "SignalName is not set on decision."}
+	}
+
+	return nil
+}
+
+// isChildOf returns true if the execution was started as a child of the given parent execution
+func isChildOf(msBuilder *mutableStateBuilder, parent *workflow.WorkflowExecution) bool {
+	executionInfo := msBuilder.executionInfo
+	return msBuilder.hasParentExecution() && executionInfo.ParentWorkflowID == parent.GetWorkflowId() &&
+		executionInfo.ParentRunID == parent.GetRunId()
+}
+
 func validateContinueAsNewWorkflowExecutionAttributes(attributes *workflow.ContinueAsNewWorkflowExecutionDecisionAttributes) error {
 	if attributes == nil {
 		return &workflow.BadRequestError{Message: "ContinueAsNewWorkflowExecutionDecisionAttributes is not set on decision."}
@@ -1566,5 +2797,94 @@ func validateContinueAsNewWorkflowExecutionAttributes(attributes *workflow.Conti
 		return &workflow.BadRequestError{Message: "A valid TaskStartToCloseTimeoutSeconds is not set on decision."}
 	}
 
+	if attributes.GetBackoffStartIntervalInSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "BackoffStartIntervalInSeconds on decision cannot be negative."}
+	}
+
+	if attributes.IsSetSearchAttributes() {
+		if err := validateSearchAttributes(attributes.GetSearchAttributes()); err != nil {
+			return err
+		}
+	}
+
+	if attributes.IsSetRetryPolicy() {
+		return validateRetryPolicy(attributes.GetRetryPolicy())
+	}
+
+	return nil
+}
+
+func validateWorkflowIDReusePolicy(currentExecution *persistence.GetCurrentExecutionResponse,
+	policy workflow.WorkflowIdReusePolicy, workflowID string) error {
+	alreadyStartedErr := func(reason string) error {
+		return &workflow.WorkflowExecutionAlreadyStartedError{
+			Message: common.StringPtr(fmt.Sprintf("%v WorkflowId: %v, RunId: %v.", reason, workflowID,
+				currentExecution.RunID)),
+			StartRequestId: common.StringPtr(currentExecution.StartRequestID),
+			RunId:          common.StringPtr(currentExecution.RunID),
+		}
+	}
+
+	if currentExecution.State != persistence.WorkflowStateCompleted {
+		return alreadyStartedErr("Workflow execution is already running.")
+	}
+
+	switch policy {
+	case workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE:
+		return nil
+	case workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE_FAILED_ONLY:
+		switch currentExecution.CloseStatus {
+		case persistence.WorkflowCloseStatusFailed, persistence.WorkflowCloseStatusCanceled,
+			persistence.WorkflowCloseStatusTerminated, persistence.WorkflowCloseStatusTimedOut:
+			return nil
+		}
+		return alreadyStartedErr("Workflow execution already completed successfully and the reuse policy only " +
+			"allows a new run after a failed run.")
+	case workflow.WorkflowIdReusePolicy_REJECT_DUPLICATE:
+		return alreadyStartedErr("Workflow execution already ran and the reuse policy rejects a new run.")
+	default:
+		return &workflow.BadRequestError{Message: fmt.Sprintf("Unknown WorkflowIdReusePolicy: %v", policy)}
+	}
+}
+
+func validateCronSchedule(cronSchedule string) error {
+	schedule, err := cron.Parse(cronSchedule)
+	if err != nil {
+		return &workflow.BadRequestError{Message: fmt.Sprintf("Invalid CronSchedule: %v", err)}
+	}
+	if schedule.Next(time.Now()).IsZero() {
+		return &workflow.BadRequestError{Message: "CronSchedule never fires."}
+	}
+
+	return nil
+}
+
+func validateStartChildExecutionAttributes(attributes *workflow.StartChildWorkflowExecutionDecisionAttributes) error {
+	if attributes == nil {
+		return &workflow.BadRequestError{Message: "StartChildWorkflowExecutionDecisionAttributes is not set on decision."}
+	}
+
+	if !attributes.IsSetWorkflowId() || attributes.GetWorkflowId() == "" {
+		return &workflow.BadRequestError{Message: "WorkflowId is not set on decision."}
+	}
+
+	if !attributes.IsSetWorkflowType() || !attributes.GetWorkflowType().IsSetName() || attributes.GetWorkflowType().GetName() == "" {
+		return &workflow.BadRequestError{Message: "WorkflowType is not set on decision."}
+	}
+
+	if attributes.GetDelayStartSeconds() < 0 {
+		return &workflow.BadRequestError{Message: "DelayStartSeconds on decision cannot be negative."}
+	}
+
+	if attributes.IsSetSearchAttributes() {
+		if err := validateSearchAttributes(attributes.GetSearchAttributes()); err != nil {
+			return err
+		}
+	}
+
+	if attributes.IsSetRetryPolicy() {
+		return validateRetryPolicy(attributes.GetRetryPolicy())
+	}
+
 	return nil
 }
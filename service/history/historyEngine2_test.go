@@ -25,6 +25,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/stretchr/testify/mock"
@@ -38,6 +39,7 @@ import (
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/mocks"
 	"github.com/uber/cadence/common/persistence"
+	"github.com/uber/tchannel-go/thrift"
 )
 
 type (
@@ -88,6 +90,13 @@ func (s *engine2Suite) SetupTest() {
 	s.mockExecutionMgr = &mocks.ExecutionManager{}
 	s.mockHistoryMgr = &mocks.HistoryManager{}
 	s.mockShardManager = &mocks.ShardManager{}
+
+	// Workflow execution contexts look up the limits of their domain on every update
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{},
+		Config: &persistence.DomainConfig{},
+	}, nil)
+
 	s.shardClosedCh = make(chan int, 100)
 	s.eventSerializer = newJSONHistoryEventSerializer()
 
@@ -103,19 +112,20 @@ func (s *engine2Suite) SetupTest() {
 		logger:                    s.logger,
 	}
 
-	historyCache := newHistoryCache(historyCacheMaxSize, mockShard, s.logger)
 	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
+	historyCache := newHistoryCache(historyCacheMaxSize, mockShard, domainCache, s.logger)
 	txProcessor := newTransferQueueProcessor(mockShard, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, historyCache, domainCache)
 	h := &historyEngineImpl{
-		shard:              mockShard,
-		executionManager:   s.mockExecutionMgr,
-		historyMgr:         s.mockHistoryMgr,
-		txProcessor:        txProcessor,
-		historyCache:       historyCache,
-		domainCache:        domainCache,
-		logger:             s.logger,
-		tokenSerializer:    common.NewJSONTaskTokenSerializer(),
-		hSerializerFactory: persistence.NewHistorySerializerFactory(),
+		shard:                mockShard,
+		executionManager:     s.mockExecutionMgr,
+		historyMgr:           s.mockHistoryMgr,
+		txProcessor:          txProcessor,
+		historyCache:         historyCache,
+		domainCache:          domainCache,
+		logger:               s.logger,
+		tokenSerializer:      common.NewJSONTaskTokenSerializer(),
+		hSerializerFactory:   persistence.NewHistorySerializerFactory(),
+		historyEventNotifier: newHistoryEventNotifier(),
 	}
 	h.timerProcessor = newTimerQueueProcessor(h, s.mockExecutionMgr, s.logger)
 	s.historyEngine = h
@@ -129,6 +139,56 @@ func (s *engine2Suite) TearDownTest() {
 	s.mockVisibilityMgr.AssertExpectations(s.T())
 }
 
+func (s *engine2Suite) TestGetWorkflowExecutionNextEventIDLongPoll() {
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+
+	msBuilder := s.createExecutionStartedState(workflowExecution, "testTaskList", "testIdentity", false)
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil)
+
+	getNextEventID := func(timeout time.Duration, expectedNextEventID *int64) *h.GetWorkflowExecutionNextEventIDResponse {
+		ctx, cancel := thrift.NewContext(timeout)
+		defer cancel()
+		response, err := s.historyEngine.GetWorkflowExecutionNextEventID(ctx, &h.GetWorkflowExecutionNextEventIDRequest{
+			DomainUUID:          common.StringPtr("domainId"),
+			Execution:           &workflowExecution,
+			ExpectedNextEventId: expectedNextEventID,
+		})
+		s.Nil(err)
+		return response
+	}
+
+	// No wait without an expected nextEventID, or when the history is already past it
+	response := getNextEventID(time.Second, nil)
+	s.Equal(int64(3), response.GetEventId())
+	s.True(response.GetIsWorkflowRunning())
+	response = getNextEventID(time.Second, common.Int64Ptr(2))
+	s.Equal(int64(3), response.GetEventId())
+
+	// Wait for an update of the execution
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		s.historyEngine.historyEventNotifier.NotifyNewHistoryEvent(&historyEventNotification{
+			runID:             workflowExecution.GetRunId(),
+			nextEventID:       5,
+			isWorkflowRunning: true,
+		})
+	}()
+	response = getNextEventID(10*time.Second, common.Int64Ptr(3))
+	s.Equal(int64(5), response.GetEventId())
+	s.True(response.GetIsWorkflowRunning())
+
+	// Return the current state right before the deadline of the caller when there is no update
+	start := time.Now()
+	response = getNextEventID(historyLongPollTailRoom+200*time.Millisecond, common.Int64Ptr(3))
+	s.Equal(int64(3), response.GetEventId())
+	s.True(time.Now().Sub(start) < historyLongPollTailRoom+200*time.Millisecond)
+}
+
 func (s *engine2Suite) TestRecordDecisionTaskStartedIfNoExecution() {
 	workflowExecution := &workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -672,6 +732,67 @@ func (s *engine2Suite) TestRespondDecisionTaskCompletedRecordMarkerDecision() {
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engine2Suite) TestRespondDecisionTaskCompletedUpsertSearchAttributesDecision() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	msBuilder.executionInfo.SearchAttributes = map[string][]byte{
+		"CustomerId": []byte(`"customer1"`),
+		"OrderId":    []byte(`1`),
+	}
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_UpsertWorkflowSearchAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes: &workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+			SearchAttributes: &workflow.SearchAttributes{
+				IndexedFields: map[string][]byte{"OrderId": []byte(`2`), "Shipped": []byte(`true`)},
+			},
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 1 &&
+			request.TransferTasks[0].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted
+	})).Return(nil).Once()
+
+	err := s.historyEngine.RespondDecisionTaskCompleted(&h.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: nil,
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.Equal(map[string][]byte{
+		"CustomerId": []byte(`"customer1"`),
+		"OrderId":    []byte(`2`),
+		"Shipped":    []byte(`true`),
+	}, executionBuilder.executionInfo.SearchAttributes)
+}
+
 func (s *engine2Suite) getBuilder(domainID string, we workflow.WorkflowExecution) *mutableStateBuilder {
 	context, release, err := s.historyEngine.historyCache.getOrCreateWorkflowExecution(domainID, we)
 	if err != nil {
@@ -24,6 +24,7 @@ import (
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/tchannel-go/thrift"
 )
 
 type (
@@ -33,20 +34,27 @@ type (
 		// TODO: Convert workflow.WorkflowExecution to pointer all over the place
 		StartWorkflowExecution(request *h.StartWorkflowExecutionRequest) (*workflow.StartWorkflowExecutionResponse,
 			error)
-		GetWorkflowExecutionNextEventID(
+		GetWorkflowExecutionNextEventID(ctx thrift.Context,
 			request *h.GetWorkflowExecutionNextEventIDRequest) (*h.GetWorkflowExecutionNextEventIDResponse, error)
 		RecordDecisionTaskStarted(request *h.RecordDecisionTaskStartedRequest) (*h.RecordDecisionTaskStartedResponse, error)
 		RecordActivityTaskStarted(request *h.RecordActivityTaskStartedRequest) (*h.RecordActivityTaskStartedResponse, error)
 		RespondDecisionTaskCompleted(request *h.RespondDecisionTaskCompletedRequest) error
+		RespondDecisionTaskFailed(request *h.RespondDecisionTaskFailedRequest) error
 		RespondActivityTaskCompleted(request *h.RespondActivityTaskCompletedRequest) error
 		RespondActivityTaskFailed(request *h.RespondActivityTaskFailedRequest) error
 		RespondActivityTaskCanceled(request *h.RespondActivityTaskCanceledRequest) error
 		RecordActivityTaskHeartbeat(request *h.RecordActivityTaskHeartbeatRequest) (*workflow.RecordActivityTaskHeartbeatResponse, error)
 		RequestCancelWorkflowExecution(request *h.RequestCancelWorkflowExecutionRequest) error
 		SignalWorkflowExecution(request *h.SignalWorkflowExecutionRequest) error
+		SignalWithStartWorkflowExecution(request *h.SignalWithStartWorkflowExecutionRequest) (
+			*workflow.StartWorkflowExecutionResponse, error)
 		TerminateWorkflowExecution(request *h.TerminateWorkflowExecutionRequest) error
 		ScheduleDecisionTask(request *h.ScheduleDecisionTaskRequest) error
 		RecordChildExecutionCompleted(request *h.RecordChildExecutionCompletedRequest) error
+		DescribeWorkflowExecution(
+			request *h.DescribeWorkflowExecutionRequest) (*workflow.DescribeWorkflowExecutionResponse, error)
+		ResetWorkflowExecution(
+			request *h.ResetWorkflowExecutionRequest) (*workflow.ResetWorkflowExecutionResponse, error)
 	}
 
 	// EngineFactory is used to create an instance of sharded history engine
@@ -68,4 +76,10 @@ type (
 		common.Daemon
 		NotifyNewTimer(taskID int64)
 	}
+
+	historyEventNotifier interface {
+		NotifyNewHistoryEvent(event *historyEventNotification)
+		WatchHistoryEvent(runID string) (int64, <-chan *historyEventNotification)
+		UnwatchHistoryEvent(runID string, subscriberID int64)
+	}
 )
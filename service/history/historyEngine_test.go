@@ -24,7 +24,9 @@ import (
 	"encoding/json"
 	"errors"
 	"os"
+	"reflect"
 	"testing"
+	"time"
 
 	log "github.com/Sirupsen/logrus"
 	"github.com/pborman/uuid"
@@ -94,6 +96,11 @@ func (s *engineSuite) SetupTest() {
 	s.mockShardManager = &mocks.ShardManager{}
 	s.shardClosedCh = make(chan int, 100)
 	s.eventSerializer = newJSONHistoryEventSerializer()
+	// Workflow execution contexts look up the limits of their domain on every update
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{},
+		Config: &persistence.DomainConfig{},
+	}, nil)
 
 	mockShard := &shardContextImpl{
 		shardInfo:                 &persistence.ShardInfo{ShardID: shardID, RangeID: 1, TransferAckLevel: 0},
@@ -105,10 +112,11 @@ func (s *engineSuite) SetupTest() {
 		maxTransferSequenceNumber: 100000,
 		closeCh:                   s.shardClosedCh,
 		logger:                    s.logger,
+		metricsClient:             metrics.NewClient(tally.NewTestScope("", nil), metrics.History),
 	}
 
-	historyCache := newHistoryCache(historyCacheMaxSize, mockShard, s.logger)
 	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
+	historyCache := newHistoryCache(historyCacheMaxSize, mockShard, domainCache, s.logger)
 	txProcessor := newTransferQueueProcessor(mockShard, s.mockVisibilityMgr, s.mockMatchingClient, s.mockHistoryClient, historyCache, domainCache)
 	h := &historyEngineImpl{
 		shard:              mockShard,
@@ -395,10 +403,10 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedConflictOnUpdate() {
 	s.Equal(context, ms2.ExecutionInfo.ExecutionContext)
 
 	executionBuilder := s.getBuilder(domainID, we)
-	activity3Attributes := s.getActivityScheduledEvent(executionBuilder, 14).GetActivityTaskScheduledEventAttributes()
+	activity3Attributes := s.getActivityScheduledEvent(executionBuilder, 13).GetActivityTaskScheduledEventAttributes()
 	s.Equal(activity3ID, activity3Attributes.GetActivityId())
 	s.Equal(activity3Type, activity3Attributes.GetActivityType().GetName())
-	s.Equal(int64(13), activity3Attributes.GetDecisionTaskCompletedEventId())
+	s.Equal(int64(12), activity3Attributes.GetDecisionTaskCompletedEventId())
 	s.Equal(tl, activity3Attributes.GetTaskList().GetName())
 	s.Equal(activity3Input, activity3Attributes.GetInput())
 	s.Equal(int32(100), activity3Attributes.GetScheduleToCloseTimeoutSeconds())
@@ -688,6 +696,123 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedBadDecisionAttributes() {
 	s.IsType(&workflow.BadRequestError{}, err)
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedBadSearchAttributes() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_UpsertWorkflowSearchAttributes),
+		UpsertWorkflowSearchAttributesDecisionAttributes: &workflow.UpsertWorkflowSearchAttributesDecisionAttributes{
+			SearchAttributes: &workflow.SearchAttributes{
+				IndexedFields: map[string][]byte{"OrderId": []byte(`{"nested": 1}`)},
+			},
+		},
+	}}
+
+	for i := 0; i < 2; i++ {
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	}
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: nil,
+			Identity:         &identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Nil(executionBuilder.executionInfo.SearchAttributes)
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondDecisionTaskCompletedBlobSizeLimitExceeded() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	metadataMgr := &mocks.MetadataManager{}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: domainID},
+		Config: &persistence.DomainConfig{BlobSizeLimitWarn: 5, BlobSizeLimitError: 10},
+	}, nil).Once()
+	s.mockHistoryEngine.domainCache = cache.NewDomainCache(metadataMgr, s.logger)
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_CompleteWorkflowExecution),
+		CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+			Result_: []byte("result larger than the limit"),
+		},
+	}}
+
+	for i := 0; i < 2; i++ {
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	}
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		batch, err := persistence.NewJSONHistorySerializer().Deserialize(request.Events)
+		if err != nil || len(batch.Events) != 1 {
+			return false
+		}
+		attributes := batch.Events[0].GetDecisionTaskFailedEventAttributes()
+		return attributes.GetCause() == workflow.DecisionTaskFailedCause_BLOB_SIZE_LIMIT_EXCEEDED
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	// The decision is failed, while the request completing it succeeds
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: nil,
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+	metadataMgr.AssertExpectations(s.T())
+	executionBuilder := s.getBuilder(domainID, we)
+	s.True(executionBuilder.isWorkflowExecutionRunning())
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedSingleActivityScheduledDecision() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
@@ -812,6 +937,93 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowSuccess()
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
+func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowChildPolicy() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	completedEvent := addDecisionTaskCompletedEvent(msBuilder, scheduleEvent.GetEventId(), startedEvent.GetEventId(),
+		nil, identity)
+	initiateChild := func(workflowID string, childPolicy workflow.ChildPolicy) *workflow.HistoryEvent {
+		event, _ := msBuilder.AddStartChildWorkflowExecutionInitiatedEvent(completedEvent.GetEventId(), uuid.New(),
+			&workflow.StartChildWorkflowExecutionDecisionAttributes{
+				WorkflowId:   common.StringPtr(workflowID),
+				WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("childType")},
+				TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+				ChildPolicy:  workflow.ChildPolicyPtr(childPolicy),
+			})
+		return event
+	}
+	terminatedChild := initiateChild("terminatedChild", workflow.ChildPolicy_TERMINATE)
+	msBuilder.AddChildWorkflowExecutionStartedEvent("", &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("terminatedChild"),
+		RunId:      common.StringPtr("terminatedChildRunId"),
+	}, &workflow.WorkflowType{Name: common.StringPtr("childType")}, terminatedChild.GetEventId())
+	cancelledChild := initiateChild("cancelledChild", workflow.ChildPolicy_REQUEST_CANCEL)
+	initiateChild("abandonedChild", workflow.ChildPolicy_ABANDON)
+	scheduleEvent2, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent2.GetEventId(), tl, identity)
+
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: scheduleEvent2.GetEventId(),
+	})
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_CompleteWorkflowExecution),
+		CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+			Result_: []byte("success"),
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	// The child policy is applied through transfer tasks written along with the close of the parent, including the
+	// child which is not started yet
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		var terminateTask *persistence.TerminateChildExecutionTask
+		var cancelTask *persistence.CancelChildExecutionTask
+		for _, task := range request.TransferTasks {
+			switch task := task.(type) {
+			case *persistence.TerminateChildExecutionTask:
+				terminateTask = task
+			case *persistence.CancelChildExecutionTask:
+				cancelTask = task
+			}
+		}
+		return len(request.TransferTasks) == 3 && terminateTask != nil && cancelTask != nil &&
+			terminateTask.InitiatedID == terminatedChild.GetEventId() &&
+			terminateTask.TargetRunID == "terminatedChildRunId" &&
+			terminateTask.TargetDomainID == domainID &&
+			cancelTask.InitiatedID == cancelledChild.GetEventId() &&
+			cancelTask.TargetWorkflowID == "cancelledChild" &&
+			cancelTask.TargetRunID == ""
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Decisions: decisions,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+}
+
 func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
@@ -867,68 +1079,82 @@ func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowSuccess() {
 	s.False(executionBuilder.HasPendingDecisionTask())
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedInvalidToken() {
-	domainID := "domainId"
-	invalidToken, _ := json.Marshal("bad token")
-	identity := "testIdentity"
-
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
-		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
-			TaskToken: invalidToken,
-			Result_:   nil,
-			Identity:  &identity,
-		},
-	})
-
-	s.NotNil(err)
-	s.IsType(&workflow.BadRequestError{}, err)
-}
-
-func (s *engineSuite) TestRespondActivityTaskCompletedIfNoExecution() {
+func (s *engineSuite) TestRespondDecisionTaskCompletedFailWorkflowRetry() {
 	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		RunID:      "rId",
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
 		ScheduleID: 2,
 	})
 	identity := "testIdentity"
+	details := []byte("fail workflow details")
+	reason := "fail workflow reason"
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
-
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
-		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
-			TaskToken: taskToken,
-			Identity:  &identity,
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	startedEvent := msBuilder.AddWorkflowExecutionStartedEvent(domainID, we, &workflow.StartWorkflowExecutionRequest{
+		WorkflowId:   common.StringPtr(we.GetWorkflowId()),
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:        []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+		Identity:                            common.StringPtr(identity),
+		RetryPolicy: &workflow.RetryPolicy{
+			InitialIntervalInSeconds: common.Int32Ptr(5),
+			BackoffCoefficient:       common.Float64Ptr(2),
+			MaximumAttempts:          common.Int32Ptr(3),
 		},
 	})
-	s.NotNil(err)
-	s.IsType(&workflow.EntityNotExistsError{}, err)
-}
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
 
-func (s *engineSuite) TestRespondActivityTaskCompletedIfGetExecutionFailed() {
-	domainID := "domainId"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		RunID:      "rId",
-		ScheduleID: 2,
-	})
-	identity := "testIdentity"
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_FailWorkflowExecution),
+		FailWorkflowExecutionDecisionAttributes: &workflow.FailWorkflowExecutionDecisionAttributes{
+			Reason:  &reason,
+			Details: details,
+		},
+	}}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, errors.New("FAILED")).Once()
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	serializedHistory, _ := persistence.NewJSONHistorySerializer().Serialize(
+		persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), []*workflow.HistoryEvent{startedEvent}))
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		continueAsNew := request.ContinueAsNew
+		return continueAsNew != nil && continueAsNew.Attempt == 1 && len(continueAsNew.TransferTasks) == 1 &&
+			continueAsNew.TransferTasks[0].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted &&
+			len(continueAsNew.TimerTasks) == 2 && continueAsNew.DecisionScheduleID == emptyEventID
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
+			Decisions: decisions,
 			Identity:  &identity,
 		},
 	})
-	s.EqualError(err, "FAILED")
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusContinuedAsNew, executionBuilder.executionInfo.CloseStatus)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedUpdateExecutionFailed() {
+func (s *engineSuite) TestRespondDecisionTaskCompletedCompleteWorkflowCron() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -938,44 +1164,65 @@ func (s *engineSuite) TestRespondActivityTaskCompletedUpdateExecutionFailed() {
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.GetWorkflowId(),
 		RunID:      we.GetRunId(),
-		ScheduleID: 5,
+		ScheduleID: 2,
 	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
-	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 5)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	startedEvent := msBuilder.AddWorkflowExecutionStartedEvent(domainID, we, &workflow.StartWorkflowExecutionRequest{
+		WorkflowId:   common.StringPtr(we.GetWorkflowId()),
+		WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+		Input:        []byte("input"),
+		ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+		TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+		Identity:                            common.StringPtr(identity),
+		CronSchedule:                        common.StringPtr("@hourly"),
+	})
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_CompleteWorkflowExecution),
+		CompleteWorkflowExecutionDecisionAttributes: &workflow.CompleteWorkflowExecutionDecisionAttributes{
+			Result_: []byte("success"),
+		},
+	}}
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	serializedHistory, _ := persistence.NewJSONHistorySerializer().Serialize(
+		persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), []*workflow.HistoryEvent{startedEvent}))
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(errors.New("FAILED")).Once()
-	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		continueAsNew := request.ContinueAsNew
+		return continueAsNew != nil && continueAsNew.CronSchedule == "@hourly" && continueAsNew.Attempt == 0 &&
+			len(continueAsNew.TransferTasks) == 1 &&
+			continueAsNew.TransferTasks[0].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted &&
+			len(continueAsNew.TimerTasks) == 2
+	})).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
-			Result_:   activityResult,
+			Decisions: decisions,
 			Identity:  &identity,
 		},
 	})
-	s.EqualError(err, "FAILED")
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusContinuedAsNew, executionBuilder.executionInfo.CloseStatus)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskCompleted() {
+func (s *engineSuite) TestRespondDecisionTaskCompletedSignalExternalWorkflow() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -985,45 +1232,65 @@ func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskCompleted() {
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.GetWorkflowId(),
 		RunID:      we.GetRunId(),
-		ScheduleID: 5,
+		ScheduleID: 2,
 	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 5)
-	activityStartedEvent := addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
-	addActivityTaskCompletedEvent(msBuilder, activityScheduledEvent.GetEventId(), activityStartedEvent.GetEventId(),
-		activityResult, identity)
-	addDecisionTaskScheduledEvent(msBuilder)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_SignalExternalWorkflowExecution),
+		SignalExternalWorkflowExecutionDecisionAttributes: &workflow.SignalExternalWorkflowExecutionDecisionAttributes{
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr("targetWId"),
+			},
+			SignalName: common.StringPtr("signal"),
+			Input:      []byte("signal input"),
+			Control:    []byte("control"),
+		},
+	}}
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		if len(request.UpsertSignalInfos) != 1 || request.UpsertSignalInfos[0].SignalName != "signal" {
+			return false
+		}
+		for _, task := range request.TransferTasks {
+			if signalTask, ok := task.(*persistence.SignalExecutionTask); ok {
+				return signalTask.TargetDomainID == domainID && signalTask.TargetWorkflowID == "targetWId" &&
+					signalTask.InitiatedID == request.UpsertSignalInfos[0].InitiatedID
+			}
+		}
+		return false
+	})).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
-			TaskToken: taskToken,
-			Result_:   activityResult,
-			Identity:  &identity,
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
 		},
 	})
-	s.NotNil(err)
-	s.IsType(&workflow.EntityNotExistsError{}, err)
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	si, ok := executionBuilder.GetSignalInfo(int64(5))
+	s.True(ok)
+	s.Equal([]byte("signal input"), si.Input)
+	s.Equal([]byte("control"), si.Control)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskNotStarted() {
+func (s *engineSuite) TestRespondDecisionTaskCompletedSignalExternalWorkflowBadAttributes() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1033,41 +1300,81 @@ func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskNotStarted() {
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: we.GetWorkflowId(),
 		RunID:      we.GetRunId(),
-		ScheduleID: 5,
+		ScheduleID: 2,
 	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 5)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_SignalExternalWorkflowExecution),
+		SignalExternalWorkflowExecutionDecisionAttributes: &workflow.SignalExternalWorkflowExecutionDecisionAttributes{
+			Execution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr("targetWId"),
+			},
+		},
+	}}
+
+	for i := 0; i < 2; i++ {
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	}
+
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRespondDecisionTaskFailedIfTaskNotStarted() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+	err := s.mockHistoryEngine.RespondDecisionTaskFailed(&history.RespondDecisionTaskFailedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+		FailedRequest: &workflow.RespondDecisionTaskFailedRequest{
 			TaskToken: taskToken,
-			Result_:   activityResult,
+			Cause:     workflow.DecisionTaskFailedCausePtr(workflow.DecisionTaskFailedCause_WORKFLOW_WORKER_UNHANDLED_FAILURE),
 			Identity:  &identity,
 		},
 	})
-	s.NotNil(err)
 	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedConflictOnUpdate() {
+func (s *engineSuite) TestRespondDecisionTaskFailedSuccess() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1075,69 +1382,48 @@ func (s *engineSuite) TestRespondActivityTaskCompletedConflictOnUpdate() {
 	}
 	tl := "testTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
 	})
 	identity := "testIdentity"
-	activity1ID := "activity1"
-	activity1Type := "activity_type1"
-	activity1Input := []byte("input1")
-	activity1Result := []byte("activity1_result")
-	activity2ID := "activity2"
-	activity2Type := "activity_type2"
-	activity2Input := []byte("input2")
+	details := []byte("panic: non-deterministic workflow")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
-	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 25, 200, identity)
-	decisionScheduledEvent1, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent1 := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent1.GetEventId(), tl, identity)
-	decisionCompletedEvent1 := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent1.GetEventId(),
-		decisionStartedEvent1.GetEventId(), nil, identity)
-	activity1ScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.GetEventId(), activity1ID,
-		activity1Type, tl, activity1Input, 100, 10, 5)
-	activity2ScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.GetEventId(), activity2ID,
-		activity2Type, tl, activity2Input, 100, 10, 5)
-	addActivityTaskStartedEvent(msBuilder, activity1ScheduledEvent.GetEventId(), tl, identity)
-	addActivityTaskStartedEvent(msBuilder, activity2ScheduledEvent.GetEventId(), tl, identity)
-
-	ms1 := createMutableState(msBuilder)
-	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
-
-	ms2 := createMutableState(msBuilder)
-	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: ms2}
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 1 && request.TransferTasks[0].GetType() == persistence.TransferTaskTypeDecisionTask
+	})).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+	err := s.mockHistoryEngine.RespondDecisionTaskFailed(&history.RespondDecisionTaskFailedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+		FailedRequest: &workflow.RespondDecisionTaskFailedRequest{
 			TaskToken: taskToken,
-			Result_:   activity1Result,
+			Cause:     workflow.DecisionTaskFailedCausePtr(workflow.DecisionTaskFailedCause_WORKFLOW_WORKER_UNHANDLED_FAILURE),
+			Details:   details,
 			Identity:  &identity,
 		},
 	})
 	s.Nil(err, s.printHistory(msBuilder))
 	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(int64(5), executionBuilder.executionInfo.NextEventID)
 	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-
 	s.True(executionBuilder.HasPendingDecisionTask())
-	di, ok := executionBuilder.GetPendingDecision(int64(10))
+	di, ok := executionBuilder.GetPendingDecision(5)
 	s.True(ok)
-	s.Equal(int32(200), di.DecisionTimeout)
-	s.Equal(int64(10), di.ScheduleID)
 	s.Equal(emptyEventID, di.StartedID)
+	s.Equal(int64(1), di.Attempt)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedMaxAttemptsExceeded() {
+func (s *engineSuite) TestRespondDecisionTaskFailedTransientDecision() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1145,47 +1431,50 @@ func (s *engineSuite) TestRespondActivityTaskCompletedMaxAttemptsExceeded() {
 	}
 	tl := "testTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
+		WorkflowID:      "wId",
+		RunID:           "rId",
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
 	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 5)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	msBuilder.AddDecisionTaskFailedEvent(scheduleEvent.GetEventId(), startedEvent.GetEventId(),
+		workflow.DecisionTaskFailedCause_WORKFLOW_WORKER_UNHANDLED_FAILURE, nil, identity)
+	scheduleEvent, _ = addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	s.Equal(int64(5), msBuilder.executionInfo.NextEventID)
 
-	for i := 0; i < conditionalRetryCount; i++ {
-		ms := createMutableState(msBuilder)
-		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
-		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-		s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
-	}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 1 && request.TransferTasks[0].GetType() == persistence.TransferTaskTypeDecisionTask
+	})).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+	err := s.mockHistoryEngine.RespondDecisionTaskFailed(&history.RespondDecisionTaskFailedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+		FailedRequest: &workflow.RespondDecisionTaskFailedRequest{
 			TaskToken: taskToken,
-			Result_:   activityResult,
+			Cause:     workflow.DecisionTaskFailedCausePtr(workflow.DecisionTaskFailedCause_WORKFLOW_WORKER_UNHANDLED_FAILURE),
 			Identity:  &identity,
 		},
 	})
-	s.Equal(ErrMaxAttemptsExceeded, err)
+	s.Nil(err, s.printHistory(msBuilder))
+	s.mockHistoryMgr.AssertNotCalled(s.T(), "AppendHistoryEvents", mock.Anything)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(5), executionBuilder.executionInfo.NextEventID)
+	di, ok := executionBuilder.GetPendingDecision(5)
+	s.True(ok)
+	s.Equal(emptyEventID, di.StartedID)
+	s.Equal(int64(2), di.Attempt)
 }
 
-func (s *engineSuite) TestRespondActivityTaskCompletedSuccess() {
+func (s *engineSuite) TestRespondDecisionTaskCompletedTransientDecision() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1193,74 +1482,99 @@ func (s *engineSuite) TestRespondActivityTaskCompletedSuccess() {
 	}
 	tl := "testTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
+		WorkflowID:      "wId",
+		RunID:           "rId",
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
 	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 5)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	msBuilder.AddDecisionTaskFailedEvent(scheduleEvent.GetEventId(), startedEvent.GetEventId(),
+		workflow.DecisionTaskFailedCause_WORKFLOW_WORKER_UNHANDLED_FAILURE, nil, identity)
+	scheduleEvent, _ = addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		// The scheduled and started events of the retried decision are written along with its completion
+		return request.FirstEventID == 5
+	})).Return(nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
 			TaskToken: taskToken,
-			Result_:   activityResult,
 			Identity:  &identity,
 		},
 	})
 	s.Nil(err, s.printHistory(msBuilder))
 	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-
-	s.True(executionBuilder.HasPendingDecisionTask())
-	di, ok := executionBuilder.GetPendingDecision(int64(8))
-	s.True(ok)
-	s.Equal(int32(200), di.DecisionTimeout)
-	s.Equal(int64(8), di.ScheduleID)
-	s.Equal(emptyEventID, di.StartedID)
+	s.Equal(int64(8), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(6), executionBuilder.executionInfo.LastProcessedEvent)
+	s.False(executionBuilder.HasPendingDecisionTask())
+	s.Equal(int64(0), executionBuilder.executionInfo.DecisionAttempt)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
+func (s *engineSuite) TestRespondDecisionTaskCompletedStaleAttempt() {
 	domainID := "domainId"
-	invalidToken, _ := json.Marshal("bad token")
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	// Token handed out for the first attempt, the retry is scheduled with the same ID
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 5,
+	})
 	identity := "testIdentity"
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	msBuilder.AddDecisionTaskFailedEvent(scheduleEvent.GetEventId(), startedEvent.GetEventId(),
+		workflow.DecisionTaskFailedCause_WORKFLOW_WORKER_UNHANDLED_FAILURE, nil, identity)
+	scheduleEvent, _ = addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
-			TaskToken: invalidToken,
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
 			Identity:  &identity,
 		},
 	})
-
-	s.NotNil(err)
-	s.IsType(&workflow.BadRequestError{}, err)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+	s.mockHistoryMgr.AssertNotCalled(s.T(), "AppendHistoryEvents", mock.Anything)
+	s.mockExecutionMgr.AssertNotCalled(s.T(), "UpdateWorkflowExecution", mock.Anything)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.True(executionBuilder.HasPendingDecisionTask())
+	s.Equal(int64(1), executionBuilder.executionInfo.DecisionAttempt)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
+func (s *engineSuite) TestRespondDecisionTaskCompletedStickyEnabled() {
 	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	stickyTl := "stickyTaskList"
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: "wId",
 		RunID:      "rId",
@@ -1268,12 +1582,114 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
 	})
 	identity := "testIdentity"
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
-		&workflow.EntityNotExistsError{}).Once()
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+			StickyAttributes: &workflow.StickyExecutionAttributes{
+				WorkerTaskList:                &workflow.TaskList{Name: common.StringPtr(stickyTl)},
+				ScheduleToStartTimeoutSeconds: common.Int32Ptr(5),
+			},
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(5), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.True(executionBuilder.isStickyTaskListEnabled())
+	s.Equal(stickyTl, executionBuilder.executionInfo.StickyTaskList)
+	s.Equal(int32(5), executionBuilder.executionInfo.StickyScheduleToStartTimeout)
+}
+
+func (s *engineSuite) TestRespondDecisionTaskFailedClearsSticky() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	msBuilder.executionInfo.StickyTaskList = "stickyTaskList"
+	msBuilder.executionInfo.StickyScheduleToStartTimeout = 5
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.ExecutionInfo.StickyTaskList == "" && len(request.TransferTasks) == 1 &&
+			request.TransferTasks[0].(*persistence.DecisionTask).TaskList == tl
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskFailed(&history.RespondDecisionTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondDecisionTaskFailedRequest{
+			TaskToken: taskToken,
+			Cause:     workflow.DecisionTaskFailedCausePtr(workflow.DecisionTaskFailedCause_WORKFLOW_WORKER_UNHANDLED_FAILURE),
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.False(executionBuilder.isStickyTaskListEnabled())
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedInvalidToken() {
+	domainID := "domainId"
+	invalidToken, _ := json.Marshal("bad token")
+	identity := "testIdentity"
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: invalidToken,
+			Result_:   nil,
+			Identity:  &identity,
+		},
+	})
+
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedIfNoExecution() {
+	domainID := "domainId"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
 			Identity:  &identity,
 		},
@@ -1282,7 +1698,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
 	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfGetExecutionFailed() {
+func (s *engineSuite) TestRespondActivityTaskCompletedIfGetExecutionFailed() {
 	domainID := "domainId"
 	taskToken, _ := json.Marshal(&common.TaskToken{
 		WorkflowID: "wId",
@@ -1291,12 +1707,11 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfGetExecutionFailed() {
 	})
 	identity := "testIdentity"
 
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
-		errors.New("FAILED")).Once()
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil, errors.New("FAILED")).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
 			Identity:  &identity,
 		},
@@ -1304,7 +1719,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfGetExecutionFailed() {
 	s.EqualError(err, "FAILED")
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
+func (s *engineSuite) TestRespondActivityTaskCompletedUpdateExecutionFailed() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1320,6 +1735,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
@@ -1339,17 +1755,18 @@ func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(errors.New("FAILED")).Once()
 	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
+			Result_:   activityResult,
 			Identity:  &identity,
 		},
 	})
 	s.EqualError(err, "FAILED")
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
+func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskCompleted() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1365,8 +1782,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
-	failReason := "fail reason"
-	details := []byte("fail details")
+	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
@@ -1377,8 +1793,8 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
 	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
 		activityType, tl, activityInput, 100, 10, 5)
 	activityStartedEvent := addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
-	addActivityTaskFailedEvent(msBuilder, activityScheduledEvent.GetEventId(), activityStartedEvent.GetEventId(),
-		failReason, details, identity)
+	addActivityTaskCompletedEvent(msBuilder, activityScheduledEvent.GetEventId(), activityStartedEvent.GetEventId(),
+		activityResult, identity)
 	addDecisionTaskScheduledEvent(msBuilder)
 
 	ms := createMutableState(msBuilder)
@@ -1386,12 +1802,11 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
-			Reason:    &failReason,
-			Details:   details,
+			Result_:   activityResult,
 			Identity:  &identity,
 		},
 	})
@@ -1399,7 +1814,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
 	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
+func (s *engineSuite) TestRespondActivityTaskCompletedIfTaskNotStarted() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1415,6 +1830,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
@@ -1430,10 +1846,11 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
+			Result_:   activityResult,
 			Identity:  &identity,
 		},
 	})
@@ -1441,7 +1858,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
 	s.IsType(&workflow.EntityNotExistsError{}, err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
+func (s *engineSuite) TestRespondActivityTaskCompletedConflictOnUpdate() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1457,12 +1874,10 @@ func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
 	activity1ID := "activity1"
 	activity1Type := "activity_type1"
 	activity1Input := []byte("input1")
-	failReason := "fail reason"
-	details := []byte("fail details.")
+	activity1Result := []byte("activity1_result")
 	activity2ID := "activity2"
 	activity2Type := "activity_type2"
 	activity2Input := []byte("input2")
-	activity2Result := []byte("activity2_result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 25, 200, identity)
@@ -1475,15 +1890,11 @@ func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
 	activity2ScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.GetEventId(), activity2ID,
 		activity2Type, tl, activity2Input, 100, 10, 5)
 	addActivityTaskStartedEvent(msBuilder, activity1ScheduledEvent.GetEventId(), tl, identity)
-	activity2StartedEvent := addActivityTaskStartedEvent(msBuilder, activity2ScheduledEvent.GetEventId(), tl, identity)
+	addActivityTaskStartedEvent(msBuilder, activity2ScheduledEvent.GetEventId(), tl, identity)
 
 	ms1 := createMutableState(msBuilder)
 	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
 
-	addActivityTaskCompletedEvent(msBuilder, activity2ScheduledEvent.GetEventId(),
-		activity2StartedEvent.GetEventId(), activity2Result, identity)
-	addDecisionTaskScheduledEvent(msBuilder)
-
 	ms2 := createMutableState(msBuilder)
 	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: ms2}
 
@@ -1495,18 +1906,17 @@ func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
-			Reason:    &failReason,
-			Details:   details,
+			Result_:   activity1Result,
 			Identity:  &identity,
 		},
 	})
 	s.Nil(err, s.printHistory(msBuilder))
 	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(12), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
 	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
 	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
 
@@ -1518,7 +1928,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
 	s.Equal(emptyEventID, di.StartedID)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
+func (s *engineSuite) TestRespondActivityTaskCompletedMaxAttemptsExceeded() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
@@ -1534,6 +1944,7 @@ func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
 	activityID := "activity1_id"
 	activityType := "activity_type1"
 	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
@@ -1554,400 +1965,2151 @@ func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
 		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
 	}
 
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
 			TaskToken: taskToken,
+			Result_:   activityResult,
 			Identity:  &identity,
 		},
 	})
 	s.Equal(ErrMaxAttemptsExceeded, err)
 }
 
-func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
+func (s *engineSuite) TestRespondActivityTaskCompletedSuccess() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result_:   activityResult,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+
+	s.True(executionBuilder.HasPendingDecisionTask())
+	di, ok := executionBuilder.GetPendingDecision(int64(8))
+	s.True(ok)
+	s.Equal(int32(200), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(emptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedByIDSuccess() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: common.EmptyEventID,
+		ActivityID: activityID,
+	})
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	activityResult := []byte("activity result")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result_:   activityResult,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+
+	s.True(executionBuilder.HasPendingDecisionTask())
+	di, ok := executionBuilder.GetPendingDecision(int64(8))
+	s.True(ok)
+	s.Equal(int32(200), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(emptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedByIDNotFound() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: common.EmptyEventID,
+		ActivityID: "unknown_activity_id",
+	})
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(),
+		"activity1_id", "activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result_:   []byte("activity result"),
+			Identity:  &identity,
+		},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedInvalidToken() {
+	domainID := "domainId"
+	invalidToken, _ := json.Marshal("bad token")
+	identity := "testIdentity"
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: invalidToken,
+			Identity:  &identity,
+		},
+	})
+
+	s.NotNil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfNoExecution() {
+	domainID := "domainId"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
+		&workflow.EntityNotExistsError{}).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfGetExecutionFailed() {
+	domainID := "domainId"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(nil,
+		errors.New("FAILED")).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+		},
+	})
+	s.EqualError(err, "FAILED")
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedUpdateExecutionFailed() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(errors.New("FAILED")).Once()
+	s.mockShardManager.On("UpdateShard", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+		},
+	})
+	s.EqualError(err, "FAILED")
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfTaskCompleted() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	failReason := "fail reason"
+	details := []byte("fail details")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	activityStartedEvent := addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	addActivityTaskFailedEvent(msBuilder, activityScheduledEvent.GetEventId(), activityStartedEvent.GetEventId(),
+		failReason, details, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   details,
+			Identity:  &identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedIfTaskNotStarted() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedConflictOnUpdate() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activity1ID := "activity1"
+	activity1Type := "activity_type1"
+	activity1Input := []byte("input1")
+	failReason := "fail reason"
+	details := []byte("fail details.")
+	activity2ID := "activity2"
+	activity2Type := "activity_type2"
+	activity2Input := []byte("input2")
+	activity2Result := []byte("activity2_result")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 25, 200, identity)
+	decisionScheduledEvent1, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent1 := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent1.GetEventId(), tl, identity)
+	decisionCompletedEvent1 := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent1.GetEventId(),
+		decisionStartedEvent1.GetEventId(), nil, identity)
+	activity1ScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.GetEventId(), activity1ID,
+		activity1Type, tl, activity1Input, 100, 10, 5)
+	activity2ScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent1.GetEventId(), activity2ID,
+		activity2Type, tl, activity2Input, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activity1ScheduledEvent.GetEventId(), tl, identity)
+	activity2StartedEvent := addActivityTaskStartedEvent(msBuilder, activity2ScheduledEvent.GetEventId(), tl, identity)
+
+	ms1 := createMutableState(msBuilder)
+	gwmsResponse1 := &persistence.GetWorkflowExecutionResponse{State: ms1}
+
+	addActivityTaskCompletedEvent(msBuilder, activity2ScheduledEvent.GetEventId(),
+		activity2StartedEvent.GetEventId(), activity2Result, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms2 := createMutableState(msBuilder)
+	gwmsResponse2 := &persistence.GetWorkflowExecutionResponse{State: ms2}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse1, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse2, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   details,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err, s.printHistory(msBuilder))
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(12), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+
+	s.True(executionBuilder.HasPendingDecisionTask())
+	di, ok := executionBuilder.GetPendingDecision(int64(10))
+	s.True(ok)
+	s.Equal(int32(200), di.DecisionTimeout)
+	s.Equal(int64(10), di.ScheduleID)
+	s.Equal(emptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedMaxAttemptsExceeded() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	for i := 0; i < conditionalRetryCount; i++ {
+		ms := createMutableState(msBuilder)
+		gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+		s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+		s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+		s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(&persistence.ConditionFailedError{}).Once()
+	}
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+		},
+	})
+	s.Equal(ErrMaxAttemptsExceeded, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedSuccess() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+	failReason := "failed"
+	failDetails := []byte("fail details.")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Details:   failDetails,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+
+	s.True(executionBuilder.HasPendingDecisionTask())
+	di, ok := executionBuilder.GetPendingDecision(int64(8))
+	s.True(ok)
+	s.Equal(int32(200), di.DecisionTimeout)
+	s.Equal(int64(8), di.ScheduleID)
+	s.Equal(emptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskFailedRetry() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	failReason := "failed"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := msBuilder.AddActivityTaskScheduledEvent(decisionCompletedEvent.GetEventId(),
+		&workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:   common.StringPtr(activityID),
+			ActivityType: &workflow.ActivityType{Name: common.StringPtr(activityType)},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			RetryPolicy: &workflow.RetryPolicy{
+				InitialIntervalInSeconds: common.Int32Ptr(1),
+				BackoffCoefficient:       common.Float64Ptr(2),
+				MaximumAttempts:          common.Int32Ptr(3),
+			},
+		})
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 0 && len(request.TimerTasks) == 1 &&
+			request.TimerTasks[0].GetType() == persistence.TaskTypeActivityRetryTimer
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
+			TaskToken: taskToken,
+			Reason:    &failReason,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	// The failed attempt is only kept in mutable state, so its started event is not written to the history
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.False(executionBuilder.HasPendingDecisionTask())
+
+	ai, ok := executionBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.Equal(int32(1), ai.Attempt)
+	s.Equal(emptyEventID, ai.StartedID)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedAfterRetry() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID:      we.GetWorkflowId(),
+		RunID:           we.GetRunId(),
+		ScheduleID:      5,
+		ScheduleAttempt: 1,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, ai := msBuilder.AddActivityTaskScheduledEvent(decisionCompletedEvent.GetEventId(),
+		&workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity1_id"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+			TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			RetryPolicy: &workflow.RetryPolicy{
+				InitialIntervalInSeconds: common.Int32Ptr(1),
+				BackoffCoefficient:       common.Float64Ptr(2),
+				MaximumAttempts:          common.Int32Ptr(3),
+			},
+		})
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	_, retry := msBuilder.RetryActivity(ai, "failed")
+	s.True(retry)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	s.Equal(transientEventID, ai.StartedID)
+	s.Equal(int64(6), msBuilder.GetNextEventID())
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	// Only the started event of the final attempt is written, ahead of the completion which refers to it
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		batch, err := persistence.NewJSONHistorySerializer().Deserialize(request.Events)
+		if err != nil || len(batch.Events) != 3 {
+			return false
+		}
+		started := batch.Events[0]
+		completed := batch.Events[1]
+		return started.GetEventId() == 6 && started.GetEventType() == workflow.EventType_ActivityTaskStarted &&
+			started.GetActivityTaskStartedEventAttributes().GetAttempt() == 1 &&
+			completed.GetEventType() == workflow.EventType_ActivityTaskCompleted &&
+			completed.GetActivityTaskCompletedEventAttributes().GetStartedEventId() == 6
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result_:   []byte("result"),
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
+	s.True(executionBuilder.HasPendingDecisionTask())
+	_, ok := executionBuilder.GetActivityInfo(5)
+	s.False(ok)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCompletedStaleAttempt() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	// Token handed out for the first attempt of the activity
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, ai := msBuilder.AddActivityTaskScheduledEvent(decisionCompletedEvent.GetEventId(),
+		&workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity1_id"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+			TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			RetryPolicy: &workflow.RetryPolicy{
+				InitialIntervalInSeconds: common.Int32Ptr(1),
+				BackoffCoefficient:       common.Float64Ptr(2),
+				MaximumAttempts:          common.Int32Ptr(3),
+			},
+		})
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	_, retry := msBuilder.RetryActivity(ai, "failed")
+	s.True(retry)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCompleted(&history.RespondActivityTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondActivityTaskCompletedRequest{
+			TaskToken: taskToken,
+			Result_:   []byte("result"),
+			Identity:  &identity,
+		},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+	executionBuilder := s.getBuilder(domainID, we)
+	ai, ok := executionBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.Equal(int32(1), ai.Attempt)
+	s.Equal(transientEventID, ai.StartedID)
+}
+
+func (s *engineSuite) TestRecordActivityTaskStartedStaleAttempt() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, ai := msBuilder.AddActivityTaskScheduledEvent(decisionCompletedEvent.GetEventId(),
+		&workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity1_id"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+			TaskList:                      &workflow.TaskList{Name: common.StringPtr(tl)},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			RetryPolicy: &workflow.RetryPolicy{
+				InitialIntervalInSeconds: common.Int32Ptr(1),
+				BackoffCoefficient:       common.Float64Ptr(2),
+				MaximumAttempts:          common.Int32Ptr(3),
+			},
+		})
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	_, retry := msBuilder.RetryActivity(ai, "failed")
+	s.True(retry)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	// Duplicate of the task which was added for the first attempt
+	_, err := s.mockHistoryEngine.RecordActivityTaskStarted(&history.RecordActivityTaskStartedRequest{
+		DomainUUID:        common.StringPtr(domainID),
+		WorkflowExecution: &we,
+		ScheduleId:        common.Int64Ptr(5),
+		ScheduleAttempt:   common.Int64Ptr(0),
+		TaskId:            common.Int64Ptr(100),
+		RequestId:         common.StringPtr("reqId"),
+		PollRequest: &workflow.PollForActivityTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+	executionBuilder := s.getBuilder(domainID, we)
+	ai, ok := executionBuilder.GetActivityInfo(5)
+	s.True(ok)
+	s.Equal(emptyEventID, ai.StartedID)
+}
+
+func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 0)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	// No HeartBeat timer running.
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	detais := []byte("details")
+
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+		DomainUUID: common.StringPtr(domainID),
+		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+			Details:   detais,
+		},
+	})
+	s.Nil(err)
+}
+
+func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 1)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// HeartBeat timer running.
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	detais := []byte("details")
+
+	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+		DomainUUID: common.StringPtr(domainID),
+		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+			Details:   detais,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRespondActivityTaskCanceled_Scheduled() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 1)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+			Details:   []byte("details"),
+		},
+	})
+	s.NotNil(err)
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+}
+
+func (s *engineSuite) TestRespondActivityTaskCanceled_Started() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 5,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 1)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	msBuilder.AddActivityTaskCancelRequestedEvent(decisionCompletedEvent.GetEventId(), activityID, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+			Details:   []byte("details"),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(10), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+
+	s.True(executionBuilder.HasPendingDecisionTask())
+	di, ok := executionBuilder.GetPendingDecision(int64(9))
+	s.True(ok)
+	s.Equal(int32(200), di.DecisionTimeout)
+	s.Equal(int64(9), di.ScheduleID)
+	s.Equal(emptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NotScheduled() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
+		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
+			ActivityId: common.StringPtr(activityID),
+		},
+	}}
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Scheduled() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 6,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 1)
+	decisionScheduled2Event, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduled2Event.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
+		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
+			ActivityId: common.StringPtr(activityID),
+		},
+	}}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(7), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NoHeartBeat() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 7,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 0)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	decisionScheduled2Event, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduled2Event.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
+		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
+			ActivityId: common.StringPtr(activityID),
+		},
+	}}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+
+	// Try recording activity heartbeat
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	activityTaskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 5,
+	})
+
+	hbResponse, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+		DomainUUID: common.StringPtr(domainID),
+		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
+			TaskToken: activityTaskToken,
+			Identity:  &identity,
+			Details:   []byte("details"),
+		},
+	})
+	s.Nil(err)
+	s.NotNil(hbResponse)
+	s.True(hbResponse.GetCancelRequested())
+
+	// Try cancelling the request.
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err = s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
+			TaskToken: activityTaskToken,
+			Identity:  &identity,
+			Details:   []byte("details"),
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder = s.getBuilder(domainID, we)
+	s.Equal(int64(13), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Success() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 7,
+	})
+	identity := "testIdentity"
+	activityID := "activity1_id"
+	activityType := "activity_type1"
+	activityInput := []byte("input1")
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
+		activityType, tl, activityInput, 100, 10, 1)
+	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	decisionScheduled2Event, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduled2Event.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
+		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
+			ActivityId: common.StringPtr(activityID),
+		},
+	}}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+
+	// Try recording activity heartbeat
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	activityTaskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: "wId",
+		RunID:      "rId",
+		ScheduleID: 5,
+	})
+
+	hbResponse, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+		DomainUUID: common.StringPtr(domainID),
+		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
+			TaskToken: activityTaskToken,
+			Identity:  &identity,
+			Details:   []byte("details"),
+		},
+	})
+	s.Nil(err)
+	s.NotNil(hbResponse)
+	s.True(hbResponse.GetCancelRequested())
+
+	// Try cancelling the request.
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err = s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
+			TaskToken: activityTaskToken,
+			Identity:  &identity,
+			Details:   []byte("details"),
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder = s.getBuilder(domainID, we)
+	s.Equal(int64(13), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.True(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestUserTimer_RespondDecisionTaskCompleted() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 6,
+	})
+	identity := "testIdentity"
+	timerID := "t1"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	// Verify cancel timer with a start event.
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	addTimerStartedEvent(msBuilder, decisionCompletedEvent.GetEventId(), timerID, 10)
+	decision2ScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decision2ScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_CancelTimer),
+		CancelTimerDecisionAttributes: &workflow.CancelTimerDecisionAttributes{
+			TimerId: common.StringPtr(timerID),
+		},
+	}}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(10), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(7), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestCancelTimer_RespondDecisionTaskCompleted_NoStartTimer() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: 2,
+	})
+	identity := "testIdentity"
+	timerID := "t1"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	// Verify cancel timer with a start event.
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	decisions := []*workflow.Decision{{
+		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_CancelTimer),
+		CancelTimerDecisionAttributes: &workflow.CancelTimerDecisionAttributes{
+			TimerId: common.StringPtr(timerID),
+		},
+	}}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+
+	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken:        taskToken,
+			Decisions:        decisions,
+			ExecutionContext: []byte("context"),
+			Identity:         &identity,
+		},
+	})
+	s.Nil(err)
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
+	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_ReuseClosedWorkflowID() {
+	domainID := "domainId"
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:          "prevRunId",
+		StartRequestID: "prevRequestId",
+		State:          persistence.WorkflowStateCompleted,
+		CloseStatus:    persistence.WorkflowCloseStatusFailed,
+	}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return request.PreviousRunID == "prevRunId"
+	})).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.StartWorkflowExecution(s.newStartWorkflowExecutionRequest(domainID,
+		workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE_FAILED_ONLY))
+	s.Nil(err)
+	s.NotEmpty(resp.GetRunId())
+	s.NotEqual("prevRunId", resp.GetRunId())
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_ReusePolicyRejected() {
+	domainID := "domainId"
+	testCases := []struct {
+		state       int
+		closeStatus int
+		policy      workflow.WorkflowIdReusePolicy
+	}{
+		{persistence.WorkflowStateRunning, persistence.WorkflowCloseStatusNone, workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE},
+		{persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusCompleted, workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE_FAILED_ONLY},
+		{persistence.WorkflowStateCompleted, persistence.WorkflowCloseStatusFailed, workflow.WorkflowIdReusePolicy_REJECT_DUPLICATE},
+	}
+
+	for _, tc := range testCases {
+		s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+			RunID:          "prevRunId",
+			StartRequestID: "prevRequestId",
+			State:          tc.state,
+			CloseStatus:    tc.closeStatus,
+		}, nil).Once()
+
+		_, err := s.mockHistoryEngine.StartWorkflowExecution(s.newStartWorkflowExecutionRequest(domainID, tc.policy))
+		s.IsType(&workflow.WorkflowExecutionAlreadyStartedError{}, err)
+		s.Equal("prevRunId", err.(*workflow.WorkflowExecutionAlreadyStartedError).GetRunId())
+	}
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_DuplicateRequest() {
+	domainID := "domainId"
+	request := s.newStartWorkflowExecutionRequest(domainID, workflow.WorkflowIdReusePolicy_REJECT_DUPLICATE)
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(&persistence.GetCurrentExecutionResponse{
+		RunID:          "prevRunId",
+		StartRequestID: request.StartRequest.GetRequestId(),
+		State:          persistence.WorkflowStateCompleted,
+		CloseStatus:    persistence.WorkflowCloseStatusCompleted,
+	}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.StartWorkflowExecution(request)
+	s.Nil(err)
+	s.Equal("prevRunId", resp.GetRunId())
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_DelayStart() {
+	domainID := "domainId"
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		batch, err := persistence.NewJSONHistorySerializer().Deserialize(request.Events)
+		if err != nil || len(batch.Events) != 1 {
+			return false
+		}
+		attributes := batch.Events[0].GetWorkflowExecutionStartedEventAttributes()
+		return attributes.GetFirstDecisionTaskBackoffSeconds() == 60
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return request.DecisionScheduleID == emptyEventID && !request.FirstDecisionBackoffTime.IsZero() &&
+			len(request.TransferTasks) == 1 &&
+			request.TransferTasks[0].GetType() == persistence.TransferTaskTypeRecordWorkflowStarted &&
+			len(request.TimerTasks) == 2 && request.TimerTasks[1].GetType() == persistence.TaskTypeWorkflowBackoffTimer
+	})).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+
+	request := s.newStartWorkflowExecutionRequest(domainID, workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE)
+	request.StartRequest.DelayStartSeconds = common.Int32Ptr(60)
+	resp, err := s.mockHistoryEngine.StartWorkflowExecution(request)
+	s.Nil(err)
+	s.NotEmpty(resp.GetRunId())
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_MemoAndSearchAttributes() {
+	domainID := "domainId"
+	memo := map[string][]byte{"Note": []byte("blob")}
+	searchAttributes := map[string][]byte{"CustomerId": []byte(`"customer1"`), "OrderId": []byte(`42`)}
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		batch, err := persistence.NewJSONHistorySerializer().Deserialize(request.Events)
+		if err != nil || len(batch.Events) != 2 {
+			return false
+		}
+		attributes := batch.Events[0].GetWorkflowExecutionStartedEventAttributes()
+		return reflect.DeepEqual(attributes.GetMemo().GetFields(), memo) &&
+			reflect.DeepEqual(attributes.GetSearchAttributes().GetIndexedFields(), searchAttributes)
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.MatchedBy(func(request *persistence.CreateWorkflowExecutionRequest) bool {
+		return reflect.DeepEqual(request.Memo, memo) && reflect.DeepEqual(request.SearchAttributes, searchAttributes)
+	})).Return(&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+
+	request := s.newStartWorkflowExecutionRequest(domainID, workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE)
+	request.StartRequest.Memo = &workflow.Memo{Fields: memo}
+	request.StartRequest.SearchAttributes = &workflow.SearchAttributes{IndexedFields: searchAttributes}
+	resp, err := s.mockHistoryEngine.StartWorkflowExecution(request)
+	s.Nil(err)
+	s.NotEmpty(resp.GetRunId())
+}
+
+func (s *engineSuite) TestStartWorkflowExecution_InvalidSearchAttributes() {
+	domainID := "domainId"
+	request := s.newStartWorkflowExecutionRequest(domainID, workflow.WorkflowIdReusePolicy_ALLOW_DUPLICATE)
+	request.StartRequest.SearchAttributes = &workflow.SearchAttributes{
+		IndexedFields: map[string][]byte{"CustomerId": []byte("customer1")},
+	}
+	_, err := s.mockHistoryEngine.StartWorkflowExecution(request)
+	s.IsType(&workflow.BadRequestError{}, err)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DelayedStart() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	msBuilder.executionInfo.FirstDecisionBackoffTime = time.Now().Add(time.Minute)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 0
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Input:             []byte("signal input"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(3), executionBuilder.executionInfo.NextEventID)
+	s.False(executionBuilder.HasPendingDecisionTask())
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DuplicateRequest() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	requestID := "sourceRunId:5"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.UpsertSignalRequestedIDs) == 1 && request.UpsertSignalRequestedIDs[0] == requestID
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.UpsertSignalRequestedIDs) == 0
+	})).Return(nil).Once()
+
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Input:             []byte("signal input"),
+			Identity:          common.StringPtr(identity),
+			RequestId:         common.StringPtr(requestID),
+		},
+	}
+	err := s.mockHistoryEngine.SignalWorkflowExecution(signalRequest)
+	s.Nil(err)
+	// The retried request is not recorded again
+	err = s.mockHistoryEngine.SignalWorkflowExecution(signalRequest)
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(4), executionBuilder.executionInfo.NextEventID)
+	s.True(executionBuilder.IsSignalRequested(requestID))
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_DecisionInFlight() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// The signal is buffered with the mutable state and not appended to the history
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.NewBufferedEvents != nil && !request.ClearBufferedEvents && len(request.TransferTasks) == 0
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Input:             []byte("signal input"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(4), executionBuilder.executionInfo.NextEventID)
+	s.True(executionBuilder.HasBufferedEvents())
+
+	// Completing the decision flushes the signal into the history and schedules a decision to deliver it
+	taskToken, _ := json.Marshal(&common.TaskToken{
+		WorkflowID: we.GetWorkflowId(),
+		RunID:      we.GetRunId(),
+		ScheduleID: decisionScheduledEvent.GetEventId(),
+	})
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		return request.FirstEventID == 4
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.NewBufferedEvents == nil && request.ClearBufferedEvents && len(request.TransferTasks) == 1
+	})).Return(nil).Once()
+
+	err = s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
+			TaskToken: taskToken,
+			Identity:  &identity,
+		},
+	})
+	s.Nil(err)
+	executionBuilder = s.getBuilder(domainID, we)
+	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
+	s.False(executionBuilder.HasBufferedEvents())
+	di, ok := executionBuilder.GetPendingDecision(6)
+	s.True(ok)
+	s.Equal(emptyEventID, di.StartedID)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_HistoryCountLimitExceeded() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+
+	metadataMgr := &mocks.MetadataManager{}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: domainID},
+		Config: &persistence.DomainConfig{HistoryCountLimitWarn: 1, HistoryCountLimitError: 2},
+	}, nil).Once()
+	s.mockHistoryEngine.historyCache.domainCache = cache.NewDomainCache(metadataMgr, s.logger)
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
+
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+
+	// The signal takes the history past the error limit, so the run is terminated within the same update
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.CloseExecution && len(request.TransferTasks) == 1 &&
+			request.TransferTasks[0].GetType() == persistence.TransferTaskTypeDeleteExecution
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Input:             []byte("signal input"),
+			Identity:          common.StringPtr(identity),
+		},
+	})
+	s.Nil(err)
+	metadataMgr.AssertExpectations(s.T())
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(5), executionBuilder.executionInfo.NextEventID)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusTerminated, executionBuilder.executionInfo.CloseStatus)
+	s.True(executionBuilder.executionInfo.HistorySize > 0)
+}
+
+func (s *engineSuite) TestSignalWorkflowExecution_HistorySizeLimitExceeded() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
-	failReason := "failed"
-	failDetails := []byte("fail details.")
+
+	metadataMgr := &mocks.MetadataManager{}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: domainID},
+		Config: &persistence.DomainConfig{HistorySizeLimitWarn: 50, HistorySizeLimitError: 100},
+	}, nil).Once()
+	s.mockHistoryEngine.historyCache.domainCache = cache.NewDomainCache(metadataMgr, s.logger)
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 5)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
+	// The history written so far is under the limit, but the signal batch takes it past the error limit
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
-
-	err := s.mockHistoryEngine.RespondActivityTaskFailed(&history.RespondActivityTaskFailedRequest{
+	// The termination is appended in the same batch as the signal
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		batch, err := persistence.NewJSONHistorySerializer().Deserialize(request.Events)
+		return err == nil && len(batch.Events) == 2 &&
+			batch.Events[0].GetEventType() == workflow.EventType_WorkflowExecutionSignaled &&
+			batch.Events[1].GetEventType() == workflow.EventType_WorkflowExecutionTerminated
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return request.CloseExecution && len(request.TransferTasks) == 1 &&
+			request.TransferTasks[0].GetType() == persistence.TransferTaskTypeDeleteExecution
+	})).Return(nil).Once()
+
+	err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		FailedRequest: &workflow.RespondActivityTaskFailedRequest{
-			TaskToken: taskToken,
-			Reason:    &failReason,
-			Details:   failDetails,
-			Identity:  &identity,
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Input:             []byte("signal input"),
+			Identity:          common.StringPtr(identity),
 		},
 	})
 	s.Nil(err)
+	metadataMgr.AssertExpectations(s.T())
 	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(9), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-
-	s.True(executionBuilder.HasPendingDecisionTask())
-	di, ok := executionBuilder.GetPendingDecision(int64(8))
-	s.True(ok)
-	s.Equal(int32(200), di.DecisionTimeout)
-	s.Equal(int64(8), di.ScheduleID)
-	s.Equal(emptyEventID, di.StartedID)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusTerminated, executionBuilder.executionInfo.CloseStatus)
 }
 
-func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_NoTimer() {
+func (s *engineSuite) TestSignalWorkflowExecution_HistorySizeLimitBufferedSignal() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
+
+	metadataMgr := &mocks.MetadataManager{}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: domainID},
+		Config: &persistence.DomainConfig{HistorySizeLimitWarn: 50, HistorySizeLimitError: 100},
+	}, nil).Once()
+	s.mockHistoryEngine.historyCache.domainCache = cache.NewDomainCache(metadataMgr, s.logger)
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
 	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 0)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
 
-	// No HeartBeat timer running.
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	detais := []byte("details")
+	// The signal is buffered while the decision is in flight, so it is not appended to the history yet
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return !request.CloseExecution && request.NewBufferedEvents != nil
+	})).Return(nil).Once()
 
-	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+	err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
-			TaskToken: taskToken,
-			Identity:  &identity,
-			Details:   detais,
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Input:             []byte("signal input"),
+			Identity:          common.StringPtr(identity),
 		},
 	})
 	s.Nil(err)
+	s.mockHistoryMgr.AssertNotCalled(s.T(), "AppendHistoryEvents", mock.Anything)
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
 }
 
-func (s *engineSuite) TestRecordActivityTaskHeartBeatSuccess_TimerRunning() {
+func (s *engineSuite) TestSignalWorkflowExecution_PendingLimitWarn() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
+
+	metadataMgr := &mocks.MetadataManager{}
+	metadataMgr.On("GetDomain", &persistence.GetDomainRequest{ID: domainID}).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{ID: domainID},
+		Config: &persistence.DomainConfig{PendingLimitWarn: 1, PendingLimitError: 2},
+	}, nil).Once()
+	s.mockHistoryEngine.historyCache.domainCache = cache.NewDomainCache(metadataMgr, s.logger)
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+	_, di := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, di.ScheduleID, tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, di.ScheduleID,
 		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 1)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1", "activity_type1", tl,
+		[]byte("input1"), 100, 10, 5)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity2", "activity_type1", tl,
+		[]byte("input2"), 100, 10, 5)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
-	// HeartBeat timer running.
+	// Two pending activities cross the warn limit only, so the signal is applied to the running workflow
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
-
-	detais := []byte("details")
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return !request.CloseExecution && len(request.TransferTasks) == 1
+	})).Return(nil).Once()
 
-	_, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+	err := s.mockHistoryEngine.SignalWorkflowExecution(&history.SignalWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
-			TaskToken: taskToken,
-			Identity:  &identity,
-			Details:   detais,
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			SignalName:        common.StringPtr("signal"),
+			Input:             []byte("signal input"),
+			Identity:          common.StringPtr(identity),
 		},
 	})
 	s.Nil(err)
+	metadataMgr.AssertExpectations(s.T())
 	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.False(executionBuilder.HasPendingDecisionTask())
+	s.True(executionBuilder.isWorkflowExecutionRunning())
+	s.True(executionBuilder.HasPendingDecisionTask())
 }
 
-func (s *engineSuite) TestRespondActivityTaskCanceled_Scheduled() {
+func (s *engineSuite) TestSignalWithStartWorkflowExecution_NotStarted() {
+	domainID := "domainId"
+	identity := "testIdentity"
+
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Twice()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.MatchedBy(func(request *persistence.AppendHistoryEventsRequest) bool {
+		batch, err := persistence.NewJSONHistorySerializer().Deserialize(request.Events)
+		if err != nil || len(batch.Events) != 3 {
+			return false
+		}
+		signaled := batch.Events[1]
+		return signaled.GetEventType() == workflow.EventType_WorkflowExecutionSignaled &&
+			signaled.WorkflowExecutionSignaledEventAttributes.GetSignalName() == "signal" &&
+			batch.Events[2].GetEventType() == workflow.EventType_DecisionTaskScheduled
+	})).Return(nil).Once()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(
+		&persistence.CreateWorkflowExecutionResponse{TaskID: uuid.New()}, nil).Once()
+
+	resp, err := s.mockHistoryEngine.SignalWithStartWorkflowExecution(&history.SignalWithStartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   common.StringPtr("wId"),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			Input:        []byte("input"),
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr(uuid.New()),
+			SignalName:                          common.StringPtr("signal"),
+			SignalInput:                         []byte("signal input"),
+		},
+	})
+	s.Nil(err)
+	s.NotEmpty(resp.GetRunId())
+}
+
+func (s *engineSuite) TestSignalWithStartWorkflowExecution_Running() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 1)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	addDecisionTaskCompletedEvent(msBuilder, scheduleEvent.GetEventId(), startedEvent.GetEventId(), nil, identity)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: we.GetRunId()}, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+	resp, err := s.mockHistoryEngine.SignalWithStartWorkflowExecution(&history.SignalWithStartWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
-			TaskToken: taskToken,
-			Identity:  &identity,
-			Details:   []byte("details"),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   common.StringPtr(we.GetWorkflowId()),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr(uuid.New()),
+			SignalName:                          common.StringPtr("signal"),
+			SignalInput:                         []byte("signal input"),
 		},
 	})
-	s.NotNil(err)
-	s.IsType(&workflow.EntityNotExistsError{}, err)
+	s.Nil(err)
+	s.Equal(we.GetRunId(), resp.GetRunId())
+
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
+	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.True(executionBuilder.HasPendingDecisionTask())
 }
 
-func (s *engineSuite) TestRespondActivityTaskCanceled_Started() {
+func (s *engineSuite) TestSignalWithStartWorkflowExecution_StartedConcurrently() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 5,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
+	requestID := uuid.New()
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 1)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
-	msBuilder.AddActivityTaskCancelRequestedEvent(decisionCompletedEvent.GetEventId(), activityID, identity)
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	addDecisionTaskCompletedEvent(msBuilder, scheduleEvent.GetEventId(), startedEvent.GetEventId(), nil, identity)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
+	// The start loses the race to another start, so the signal goes to the run created by it
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(nil, &workflow.EntityNotExistsError{}).Twice()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	s.mockExecutionMgr.On("CreateWorkflowExecution", mock.Anything).Return(nil,
+		&workflow.WorkflowExecutionAlreadyStartedError{
+			StartRequestId: common.StringPtr(uuid.New()),
+			RunId:          common.StringPtr(we.GetRunId()),
+		}).Once()
+	s.mockHistoryMgr.On("DeleteWorkflowExecutionHistory", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("GetCurrentExecution", mock.Anything).Return(
+		&persistence.GetCurrentExecutionResponse{RunID: we.GetRunId()}, nil).Once()
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
 	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+	resp, err := s.mockHistoryEngine.SignalWithStartWorkflowExecution(&history.SignalWithStartWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
-			TaskToken: taskToken,
-			Identity:  &identity,
-			Details:   []byte("details"),
+		SignalWithStartRequest: &workflow.SignalWithStartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   common.StringPtr(we.GetWorkflowId()),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(200),
+			Identity:                            common.StringPtr(identity),
+			RequestId:                           common.StringPtr(requestID),
+			SignalName:                          common.StringPtr("signal"),
+			SignalInput:                         []byte("signal input"),
 		},
 	})
 	s.Nil(err)
-	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(10), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
+	s.Equal(we.GetRunId(), resp.GetRunId())
 
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
 	s.True(executionBuilder.HasPendingDecisionTask())
-	di, ok := executionBuilder.GetPendingDecision(int64(9))
-	s.True(ok)
-	s.Equal(int32(200), di.DecisionTimeout)
-	s.Equal(int64(9), di.ScheduleID)
-	s.Equal(emptyEventID, di.StartedID)
 }
 
-func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NotScheduled() {
+func (s *engineSuite) TestRequestCancelWorkflowExecution_DuplicateRequestID() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 2,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-
-	decisions := []*workflow.Decision{{
-		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
-		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
-			ActivityId: common.StringPtr(activityID),
-		},
-	}}
+	scheduleEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	startedEvent := addDecisionTaskStartedEvent(msBuilder, scheduleEvent.GetEventId(), tl, identity)
+	addDecisionTaskCompletedEvent(msBuilder, scheduleEvent.GetEventId(), startedEvent.GetEventId(), nil, identity)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
 	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Twice()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	request := &history.RequestCancelWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
-			TaskToken:        taskToken,
-			Decisions:        decisions,
-			ExecutionContext: []byte("context"),
-			Identity:         &identity,
+		CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+			Domain:            common.StringPtr(domainID),
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			RequestId:         common.StringPtr(uuid.New()),
 		},
-	})
+	}
+	err := s.mockHistoryEngine.RequestCancelWorkflowExecution(request)
 	s.Nil(err)
+	// Retried request does not record the cancellation again
+	err = s.mockHistoryEngine.RequestCancelWorkflowExecution(request)
+	s.Nil(err)
+
 	executionBuilder := s.getBuilder(domainID, we)
 	s.Equal(int64(7), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.False(executionBuilder.HasPendingDecisionTask())
+	s.True(executionBuilder.executionInfo.CancelRequested)
+	s.Equal(request.CancelRequest.GetRequestId(), executionBuilder.executionInfo.CancelRequestID)
 }
 
-func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Scheduled() {
+func (s *engineSuite) TestTerminateWorkflowExecution_ChildWorkflowOnly() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 6,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
-	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 1)
-	decisionScheduled2Event, _ := addDecisionTaskScheduledEvent(msBuilder)
-	addDecisionTaskStartedEvent(msBuilder, decisionScheduled2Event.GetEventId(), tl, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
-	decisions := []*workflow.Decision{{
-		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
-		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
-			ActivityId: common.StringPtr(activityID),
-		},
-	}}
-
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	// Execution is not a child, so the terminate from the parent close policy does not apply to it
+	err := s.mockHistoryEngine.TerminateWorkflowExecution(&history.TerminateWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
-			TaskToken:        taskToken,
-			Decisions:        decisions,
-			ExecutionContext: []byte("context"),
-			Identity:         &identity,
+		TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+			WorkflowExecution: &we,
+			Reason:            common.StringPtr("by parent close policy"),
+			Identity:          common.StringPtr(identity),
 		},
+		ExternalWorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr("parentId"),
+			RunId:      common.StringPtr("parentRunId"),
+		},
+		ChildWorkflowOnly: common.BoolPtr(true),
 	})
-	s.Nil(err)
+	s.Equal(errNotChildOfParent, err)
 
 	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(7), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.False(executionBuilder.HasPendingDecisionTask())
+	s.True(executionBuilder.isWorkflowExecutionRunning())
 }
 
-func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NoHeartBeat() {
+func (s *engineSuite) TestDescribeWorkflowExecution() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 7,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
@@ -1955,101 +4117,128 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_NoHeartBeat
 	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
 	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
 		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 0)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
-	decisionScheduled2Event, _ := addDecisionTaskScheduledEvent(msBuilder)
-	addDecisionTaskStartedEvent(msBuilder, decisionScheduled2Event.GetEventId(), tl, identity)
+	activity1ScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(),
+		"activity1", "activity_type1", tl, []byte("input1"), 100, 10, 5)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity2", "activity_type2", tl,
+		[]byte("input2"), 100, 10, 5)
+	addActivityTaskStartedEvent(msBuilder, activity1ScheduledEvent.GetEventId(), tl, identity)
+	timerStartedEvent, _ := addTimerStartedEvent(msBuilder, decisionCompletedEvent.GetEventId(), "timer1", 60)
+	newDecisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-
-	decisions := []*workflow.Decision{{
-		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
-		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
-			ActivityId: common.StringPtr(activityID),
-		},
-	}}
-
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	resp, err := s.mockHistoryEngine.DescribeWorkflowExecution(&history.DescribeWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
-			TaskToken:        taskToken,
-			Decisions:        decisions,
-			ExecutionContext: []byte("context"),
-			Identity:         &identity,
+		Request: &workflow.DescribeWorkflowExecutionRequest{
+			Domain:    common.StringPtr(domainID),
+			Execution: &we,
 		},
 	})
 	s.Nil(err)
 
-	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.False(executionBuilder.HasPendingDecisionTask())
+	s.Equal(tl, resp.GetExecutionConfiguration().GetTaskList().GetName())
+	s.Equal(int32(100), resp.GetExecutionConfiguration().GetExecutionStartToCloseTimeoutSeconds())
+	s.Equal(int32(200), resp.GetExecutionConfiguration().GetTaskStartToCloseTimeoutSeconds())
+	s.Equal("wType", resp.GetWorkflowExecutionInfo().GetType().GetName())
+	s.False(resp.GetWorkflowExecutionInfo().IsSetCloseStatus())
+	s.Equal(msBuilder.GetNextEventID(), resp.GetNextEventId())
+	s.Equal(msBuilder.GetNextEventID()-1, resp.GetWorkflowExecutionInfo().GetHistoryLength())
+
+	s.Equal(2, len(resp.GetPendingActivities()))
+	for _, pa := range resp.GetPendingActivities() {
+		switch pa.GetActivityID() {
+		case "activity1":
+			s.Equal("activity_type1", pa.GetActivityType().GetName())
+			s.Equal(workflow.PendingActivityState_STARTED, pa.GetState())
+		case "activity2":
+			s.Equal("activity_type2", pa.GetActivityType().GetName())
+			s.Equal(workflow.PendingActivityState_SCHEDULED, pa.GetState())
+		default:
+			s.Fail("unexpected pending activity", pa.GetActivityID())
+		}
+	}
 
-	// Try recording activity heartbeat
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.Equal(1, len(resp.GetPendingTimers()))
+	s.Equal("timer1", resp.GetPendingTimers()[0].GetTimerId())
+	s.Equal(timerStartedEvent.GetEventId(), resp.GetPendingTimers()[0].GetStartedEventId())
+	s.Empty(resp.GetPendingChildren())
 
-	activityTaskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		RunID:      "rId",
-		ScheduleID: 5,
-	})
+	s.NotNil(resp.GetPendingDecision())
+	s.Equal(newDecisionScheduledEvent.GetEventId(), resp.GetPendingDecision().GetScheduledEventId())
+	s.Equal(emptyEventID, resp.GetPendingDecision().GetStartedEventId())
+}
 
-	hbResponse, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
-		DomainUUID: common.StringPtr(domainID),
-		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
-			TaskToken: activityTaskToken,
-			Identity:  &identity,
-			Details:   []byte("details"),
-		},
+func (s *engineSuite) TestResetWorkflowExecution() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	signalName := "signal1"
+
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1", "activity_type1", tl,
+		[]byte("input1"), 100, 10, 5)
+	msBuilder.AddWorkflowExecutionSignaled(&workflow.SignalWorkflowExecutionRequest{
+		SignalName: common.StringPtr(signalName),
+		Input:      []byte("signal input"),
+		Identity:   common.StringPtr(identity),
 	})
-	s.Nil(err)
-	s.NotNil(hbResponse)
-	s.True(hbResponse.GetCancelRequested())
+	addDecisionTaskScheduledEvent(msBuilder)
 
-	// Try cancelling the request.
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	serializedHistory, _ := msBuilder.hBuilder.Serialize()
 
-	err = s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		continueAsNew := request.ContinueAsNew
+		return continueAsNew != nil && continueAsNew.Execution.GetRunId() != we.GetRunId() &&
+			continueAsNew.NextEventID == 7 && continueAsNew.DecisionScheduleID == 6 &&
+			len(continueAsNew.TransferTasks) == 1 && len(continueAsNew.TimerTasks) == 1
+	})).Return(nil).Once()
+
+	resp, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
-			TaskToken: activityTaskToken,
-			Identity:  &identity,
-			Details:   []byte("details"),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("reset reason"),
+			DecisionFinishEventId: common.Int64Ptr(decisionCompletedEvent.GetEventId()),
+			RequestId:             common.StringPtr(uuid.New()),
+			Identity:              common.StringPtr(identity),
 		},
 	})
 	s.Nil(err)
+	s.NotEqual(we.GetRunId(), resp.GetRunId())
 
-	executionBuilder = s.getBuilder(domainID, we)
-	s.Equal(int64(13), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.True(executionBuilder.HasPendingDecisionTask())
+	executionBuilder := s.getBuilder(domainID, we)
+	s.Equal(persistence.WorkflowStateCompleted, executionBuilder.executionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusReset, executionBuilder.executionInfo.CloseStatus)
 }
 
-func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Success() {
+func (s *engineSuite) TestResetWorkflowExecutionWithPendingActivity() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 7,
-	})
 	identity := "testIdentity"
-	activityID := "activity1_id"
-	activityType := "activity_type1"
-	activityInput := []byte("input1")
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
@@ -2057,194 +4246,197 @@ func (s *engineSuite) TestRequestCancel_RespondDecisionTaskCompleted_Success() {
 	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
 	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
 		decisionStartedEvent.GetEventId(), nil, identity)
-	activityScheduledEvent, _ := addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), activityID,
-		activityType, tl, activityInput, 100, 10, 1)
-	addActivityTaskStartedEvent(msBuilder, activityScheduledEvent.GetEventId(), tl, identity)
-	decisionScheduled2Event, _ := addDecisionTaskScheduledEvent(msBuilder)
-	addDecisionTaskStartedEvent(msBuilder, decisionScheduled2Event.GetEventId(), tl, identity)
+	addActivityTaskScheduledEvent(msBuilder, decisionCompletedEvent.GetEventId(), "activity1", "activity_type1", tl,
+		[]byte("input1"), 100, 10, 5)
+	decisionScheduledEvent2, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent2 := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent2.GetEventId(), tl, identity)
+	decisionCompletedEvent2 := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent2.GetEventId(),
+		decisionStartedEvent2.GetEventId(), nil, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-
-	decisions := []*workflow.Decision{{
-		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_RequestCancelActivityTask),
-		RequestCancelActivityTaskDecisionAttributes: &workflow.RequestCancelActivityTaskDecisionAttributes{
-			ActivityId: common.StringPtr(activityID),
-		},
-	}}
+	serializedHistory, _ := msBuilder.hBuilder.Serialize()
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
-
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	// The activity of the new run, and the tasks to dispatch it, are written along with the creation of the run
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		continueAsNew := request.ContinueAsNew
+		return continueAsNew != nil && continueAsNew.Execution.GetRunId() != we.GetRunId() &&
+			len(continueAsNew.ActivityInfos) == 1 && len(continueAsNew.TransferTasks) == 2 &&
+			len(continueAsNew.TimerTasks) == 3
+	})).Return(nil).Once()
+
+	resp, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
-			TaskToken:        taskToken,
-			Decisions:        decisions,
-			ExecutionContext: []byte("context"),
-			Identity:         &identity,
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("reset reason"),
+			DecisionFinishEventId: common.Int64Ptr(decisionCompletedEvent2.GetEventId()),
+			RequestId:             common.StringPtr(uuid.New()),
+			Identity:              common.StringPtr(identity),
 		},
 	})
 	s.Nil(err)
+	s.NotEqual(we.GetRunId(), resp.GetRunId())
+	s.mockExecutionMgr.AssertNumberOfCalls(s.T(), "UpdateWorkflowExecution", 1)
+}
 
-	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(11), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.False(executionBuilder.HasPendingDecisionTask())
-
-	// Try recording activity heartbeat
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
-
-	activityTaskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: "wId",
-		RunID:      "rId",
-		ScheduleID: 5,
-	})
+func (s *engineSuite) TestResetWorkflowExecutionWithCancelRequested() {
+	domainID := "domainId"
+	we := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("wId"),
+		RunId:      common.StringPtr("rId"),
+	}
+	tl := "testTaskList"
+	identity := "testIdentity"
+	cancelRequestID := uuid.New()
 
-	hbResponse, err := s.mockHistoryEngine.RecordActivityTaskHeartbeat(&history.RecordActivityTaskHeartbeatRequest{
+	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
+	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(), decisionStartedEvent.GetEventId(),
+		nil, identity)
+	msBuilder.AddWorkflowExecutionCancelRequestedEvent("cancel reason", &history.RequestCancelWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		HeartbeatRequest: &workflow.RecordActivityTaskHeartbeatRequest{
-			TaskToken: activityTaskToken,
-			Identity:  &identity,
-			Details:   []byte("details"),
+		CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+			WorkflowExecution: &we,
+			Identity:          common.StringPtr(identity),
+			RequestId:         common.StringPtr(cancelRequestID),
 		},
 	})
-	s.Nil(err)
-	s.NotNil(hbResponse)
-	s.True(hbResponse.GetCancelRequested())
+	decisionScheduledEvent2, _ := addDecisionTaskScheduledEvent(msBuilder)
+	decisionStartedEvent2 := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent2.GetEventId(), tl, identity)
+	decisionCompletedEvent2 := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent2.GetEventId(),
+		decisionStartedEvent2.GetEventId(), nil, identity)
+	addDecisionTaskScheduledEvent(msBuilder)
 
-	// Try cancelling the request.
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	ms := createMutableState(msBuilder)
+	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	serializedHistory, _ := msBuilder.hBuilder.Serialize()
 
-	err = s.mockHistoryEngine.RespondActivityTaskCanceled(&history.RespondActivityTaskCanceledRequest{
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
+	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Twice()
+	// The cancel request, along with its request ID to dedupe retries, is carried over to the new run
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		continueAsNew := request.ContinueAsNew
+		return continueAsNew != nil && continueAsNew.CancelRequested &&
+			continueAsNew.CancelRequestID == cancelRequestID
+	})).Return(nil).Once()
+
+	resp, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CancelRequest: &workflow.RespondActivityTaskCanceledRequest{
-			TaskToken: activityTaskToken,
-			Identity:  &identity,
-			Details:   []byte("details"),
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			Reason:                common.StringPtr("reset reason"),
+			DecisionFinishEventId: common.Int64Ptr(decisionCompletedEvent2.GetEventId()),
+			RequestId:             common.StringPtr(uuid.New()),
+			Identity:              common.StringPtr(identity),
 		},
 	})
 	s.Nil(err)
-
-	executionBuilder = s.getBuilder(domainID, we)
-	s.Equal(int64(13), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(8), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.True(executionBuilder.HasPendingDecisionTask())
+	s.NotEqual(we.GetRunId(), resp.GetRunId())
 }
 
-func (s *engineSuite) TestUserTimer_RespondDecisionTaskCompleted() {
+func (s *engineSuite) TestResetWorkflowExecutionInvalidEvent() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 6,
-	})
 	identity := "testIdentity"
-	timerID := "t1"
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
-	// Verify cancel timer with a start event.
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
 	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
-	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
-		decisionStartedEvent.GetEventId(), nil, identity)
-	addTimerStartedEvent(msBuilder, decisionCompletedEvent.GetEventId(), timerID, 10)
-	decision2ScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	addDecisionTaskStartedEvent(msBuilder, decision2ScheduledEvent.GetEventId(), tl, identity)
+	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
-
-	decisions := []*workflow.Decision{{
-		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_CancelTimer),
-		CancelTimerDecisionAttributes: &workflow.CancelTimerDecisionAttributes{
-			TimerId: common.StringPtr(timerID),
-		},
-	}}
+	serializedHistory, _ := msBuilder.hBuilder.Serialize()
 
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
+	s.mockHistoryMgr.On("GetWorkflowExecutionHistory", mock.Anything).Return(
+		&persistence.GetWorkflowExecutionHistoryResponse{
+			Events: []persistence.SerializedHistoryEventBatch{*serializedHistory},
+		}, nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
-			TaskToken:        taskToken,
-			Decisions:        decisions,
-			ExecutionContext: []byte("context"),
-			Identity:         &identity,
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			DecisionFinishEventId: common.Int64Ptr(decisionScheduledEvent.GetEventId()),
+			Identity:              common.StringPtr(identity),
 		},
 	})
-	s.Nil(err)
-
-	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(10), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(7), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.False(executionBuilder.HasPendingDecisionTask())
+	s.IsType(&workflow.BadRequestError{}, err)
 }
 
-func (s *engineSuite) TestCancelTimer_RespondDecisionTaskCompleted_NoStartTimer() {
+func (s *engineSuite) TestResetWorkflowExecutionClosed() {
 	domainID := "domainId"
 	we := workflow.WorkflowExecution{
 		WorkflowId: common.StringPtr("wId"),
 		RunId:      common.StringPtr("rId"),
 	}
 	tl := "testTaskList"
-	taskToken, _ := json.Marshal(&common.TaskToken{
-		WorkflowID: we.GetWorkflowId(),
-		RunID:      we.GetRunId(),
-		ScheduleID: 2,
-	})
 	identity := "testIdentity"
-	timerID := "t1"
 
 	msBuilder := newMutableStateBuilder(bark.NewLoggerFromLogrus(log.New()))
-	// Verify cancel timer with a start event.
 	addWorkflowExecutionStartedEvent(msBuilder, we, "wType", tl, []byte("input"), 100, 200, identity)
 	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(msBuilder)
-	addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionStartedEvent := addDecisionTaskStartedEvent(msBuilder, decisionScheduledEvent.GetEventId(), tl, identity)
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(msBuilder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, identity)
+	addCompleteWorkflowEvent(msBuilder, decisionCompletedEvent.GetEventId(), []byte("result"))
 
 	ms := createMutableState(msBuilder)
 	gwmsResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
 
-	decisions := []*workflow.Decision{{
-		DecisionType: workflow.DecisionTypePtr(workflow.DecisionType_CancelTimer),
-		CancelTimerDecisionAttributes: &workflow.CancelTimerDecisionAttributes{
-			TimerId: common.StringPtr(timerID),
-		},
-	}}
-
 	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(gwmsResponse, nil).Once()
-	s.mockHistoryMgr.On("AppendHistoryEvents", mock.Anything).Return(nil).Once()
-	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.Anything).Return(nil).Once()
 
-	err := s.mockHistoryEngine.RespondDecisionTaskCompleted(&history.RespondDecisionTaskCompletedRequest{
+	_, err := s.mockHistoryEngine.ResetWorkflowExecution(&history.ResetWorkflowExecutionRequest{
 		DomainUUID: common.StringPtr(domainID),
-		CompleteRequest: &workflow.RespondDecisionTaskCompletedRequest{
-			TaskToken:        taskToken,
-			Decisions:        decisions,
-			ExecutionContext: []byte("context"),
-			Identity:         &identity,
+		ResetRequest: &workflow.ResetWorkflowExecutionRequest{
+			Domain:                common.StringPtr(domainID),
+			WorkflowExecution:     &we,
+			DecisionFinishEventId: common.Int64Ptr(decisionCompletedEvent.GetEventId()),
+			Identity:              common.StringPtr(identity),
 		},
 	})
-	s.Nil(err)
+	s.IsType(&workflow.BadRequestError{}, err)
+	s.mockHistoryMgr.AssertNotCalled(s.T(), "AppendHistoryEvents", mock.Anything)
+}
 
-	executionBuilder := s.getBuilder(domainID, we)
-	s.Equal(int64(6), executionBuilder.executionInfo.NextEventID)
-	s.Equal(int64(3), executionBuilder.executionInfo.LastProcessedEvent)
-	s.Equal(persistence.WorkflowStateRunning, executionBuilder.executionInfo.State)
-	s.False(executionBuilder.HasPendingDecisionTask())
+func (s *engineSuite) newStartWorkflowExecutionRequest(domainID string,
+	policy workflow.WorkflowIdReusePolicy) *history.StartWorkflowExecutionRequest {
+	return &history.StartWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(domainID),
+		StartRequest: &workflow.StartWorkflowExecutionRequest{
+			Domain:       common.StringPtr(domainID),
+			WorkflowId:   common.StringPtr("wId"),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("wType")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr("testTaskList")},
+			ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(100),
+			TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(10),
+			Identity:                            common.StringPtr("testIdentity"),
+			RequestId:                           common.StringPtr(uuid.New()),
+			WorkflowIdReusePolicy:               workflow.WorkflowIdReusePolicyPtr(policy),
+		},
+	}
 }
 
 func (s *engineSuite) getBuilder(domainID string, we workflow.WorkflowExecution) *mutableStateBuilder {
@@ -2317,7 +4509,8 @@ func addWorkflowExecutionStartedEvent(builder *mutableStateBuilder, workflowExec
 }
 
 func addDecisionTaskScheduledEvent(builder *mutableStateBuilder) (*workflow.HistoryEvent, *decisionInfo) {
-	return builder.AddDecisionTaskScheduledEvent()
+	e, di, _ := builder.AddDecisionTaskScheduledEvent()
+	return e, di
 }
 
 func addDecisionTaskStartedEvent(builder *mutableStateBuilder, scheduleID int64, taskList,
@@ -2417,37 +4610,87 @@ func createMutableState(builder *mutableStateBuilder) *persistence.WorkflowMutab
 	for id, info := range builder.pendingTimerInfoIDs {
 		timerInfos[id] = copyTimerInfo(info)
 	}
+	childInfos := make(map[int64]*persistence.ChildExecutionInfo)
+	for id, info := range builder.pendingChildExecutionInfoIDs {
+		childInfos[id] = copyChildInfo(info)
+	}
+	signalInfos := make(map[int64]*persistence.SignalInfo)
+	for id, info := range builder.pendingSignalInfoIDs {
+		signalInfos[id] = copySignalInfo(info)
+	}
+	signalRequestedIDs := make(map[string]struct{})
+	for id := range builder.pendingSignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	bufferedEvents := append([]*persistence.SerializedHistoryEventBatch{}, builder.bufferedEvents...)
+	var newBufferedEvents []*workflow.HistoryEvent
+	for _, event := range builder.hBuilder.history {
+		if event.GetEventId() == bufferedEventID {
+			newBufferedEvents = append(newBufferedEvents, event)
+		}
+	}
+	if len(newBufferedEvents) > 0 {
+		serializedEvents, _ := builder.hBuilder.serializer.Serialize(
+			persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), newBufferedEvents))
+		bufferedEvents = append(bufferedEvents, serializedEvents)
+	}
 	return &persistence.WorkflowMutableState{
-		ExecutionInfo: info,
-		ActivitInfos:  activityInfos,
-		TimerInfos:    timerInfos,
+		ExecutionInfo:       info,
+		ActivitInfos:        activityInfos,
+		TimerInfos:          timerInfos,
+		ChildExecutionInfos: childInfos,
+		SignalInfos:         signalInfos,
+		SignalRequestedIDs:  signalRequestedIDs,
+		BufferedEvents:      bufferedEvents,
 	}
 }
 
 func copyWorkflowExecutionInfo(sourceInfo *persistence.WorkflowExecutionInfo) *persistence.WorkflowExecutionInfo {
 	return &persistence.WorkflowExecutionInfo{
-		DomainID:             sourceInfo.DomainID,
-		WorkflowID:           sourceInfo.WorkflowID,
-		RunID:                sourceInfo.RunID,
-		ParentDomainID:       sourceInfo.ParentDomainID,
-		ParentWorkflowID:     sourceInfo.ParentWorkflowID,
-		ParentRunID:          sourceInfo.ParentRunID,
-		InitiatedID:          sourceInfo.InitiatedID,
-		CompletionEvent:      sourceInfo.CompletionEvent,
-		TaskList:             sourceInfo.TaskList,
-		WorkflowTypeName:     sourceInfo.WorkflowTypeName,
-		DecisionTimeoutValue: sourceInfo.DecisionTimeoutValue,
-		ExecutionContext:     sourceInfo.ExecutionContext,
-		State:                sourceInfo.State,
-		CloseStatus:          sourceInfo.CloseStatus,
-		NextEventID:          sourceInfo.NextEventID,
-		LastProcessedEvent:   sourceInfo.LastProcessedEvent,
-		LastUpdatedTimestamp: sourceInfo.LastUpdatedTimestamp,
-		CreateRequestID:      sourceInfo.CreateRequestID,
-		DecisionScheduleID:   sourceInfo.DecisionScheduleID,
-		DecisionStartedID:    sourceInfo.DecisionStartedID,
-		DecisionRequestID:    sourceInfo.DecisionRequestID,
-		DecisionTimeout:      sourceInfo.DecisionTimeout,
+		DomainID:                     sourceInfo.DomainID,
+		WorkflowID:                   sourceInfo.WorkflowID,
+		RunID:                        sourceInfo.RunID,
+		ParentDomainID:               sourceInfo.ParentDomainID,
+		ParentWorkflowID:             sourceInfo.ParentWorkflowID,
+		ParentRunID:                  sourceInfo.ParentRunID,
+		InitiatedID:                  sourceInfo.InitiatedID,
+		CompletionEvent:              sourceInfo.CompletionEvent,
+		TaskList:                     sourceInfo.TaskList,
+		WorkflowTypeName:             sourceInfo.WorkflowTypeName,
+		DecisionTimeoutValue:         sourceInfo.DecisionTimeoutValue,
+		ExecutionContext:             sourceInfo.ExecutionContext,
+		State:                        sourceInfo.State,
+		CloseStatus:                  sourceInfo.CloseStatus,
+		NextEventID:                  sourceInfo.NextEventID,
+		LastProcessedEvent:           sourceInfo.LastProcessedEvent,
+		LastUpdatedTimestamp:         sourceInfo.LastUpdatedTimestamp,
+		CreateRequestID:              sourceInfo.CreateRequestID,
+		DecisionScheduleID:           sourceInfo.DecisionScheduleID,
+		DecisionStartedID:            sourceInfo.DecisionStartedID,
+		DecisionRequestID:            sourceInfo.DecisionRequestID,
+		DecisionTimeout:              sourceInfo.DecisionTimeout,
+		Attempt:                      sourceInfo.Attempt,
+		HasRetryPolicy:               sourceInfo.HasRetryPolicy,
+		InitialInterval:              sourceInfo.InitialInterval,
+		BackoffCoefficient:           sourceInfo.BackoffCoefficient,
+		MaximumInterval:              sourceInfo.MaximumInterval,
+		MaximumAttempts:              sourceInfo.MaximumAttempts,
+		ExpirationSeconds:            sourceInfo.ExpirationSeconds,
+		ExpirationTime:               sourceInfo.ExpirationTime,
+		NonRetriableErrors:           sourceInfo.NonRetriableErrors,
+		CronSchedule:                 sourceInfo.CronSchedule,
+		WorkflowTimeout:              sourceInfo.WorkflowTimeout,
+		StickyTaskList:               sourceInfo.StickyTaskList,
+		StickyScheduleToStartTimeout: sourceInfo.StickyScheduleToStartTimeout,
+		FirstDecisionBackoffTime:     sourceInfo.FirstDecisionBackoffTime,
+		Memo:                         sourceInfo.Memo,
+		SearchAttributes:             sourceInfo.SearchAttributes,
+		DecisionAttempt:              sourceInfo.DecisionAttempt,
+		DecisionTimestamp:            sourceInfo.DecisionTimestamp,
+		StickyTimerScheduleID:        sourceInfo.StickyTimerScheduleID,
+		StickyTimerAttempt:           sourceInfo.StickyTimerAttempt,
+		CancelRequested:              sourceInfo.CancelRequested,
+		CancelRequestID:              sourceInfo.CancelRequestID,
 	}
 }
 
@@ -2466,6 +4709,14 @@ func copyActivityInfo(sourceInfo *persistence.ActivityInfo) *persistence.Activit
 		HeartbeatTimeout:       sourceInfo.HeartbeatTimeout,
 		CancelRequested:        sourceInfo.CancelRequested,
 		CancelRequestID:        sourceInfo.CancelRequestID,
+		Attempt:                sourceInfo.Attempt,
+		HasRetryPolicy:         sourceInfo.HasRetryPolicy,
+		InitialInterval:        sourceInfo.InitialInterval,
+		BackoffCoefficient:     sourceInfo.BackoffCoefficient,
+		MaximumInterval:        sourceInfo.MaximumInterval,
+		MaximumAttempts:        sourceInfo.MaximumAttempts,
+		ExpirationTime:         sourceInfo.ExpirationTime,
+		NonRetriableErrors:     sourceInfo.NonRetriableErrors,
 	}
 }
 
@@ -2477,3 +4728,22 @@ func copyTimerInfo(sourceInfo *persistence.TimerInfo) *persistence.TimerInfo {
 		TaskID:     sourceInfo.TaskID,
 	}
 }
+
+func copyChildInfo(sourceInfo *persistence.ChildExecutionInfo) *persistence.ChildExecutionInfo {
+	return &persistence.ChildExecutionInfo{
+		InitiatedID:     sourceInfo.InitiatedID,
+		InitiatedEvent:  sourceInfo.InitiatedEvent,
+		StartedID:       sourceInfo.StartedID,
+		StartedEvent:    sourceInfo.StartedEvent,
+		CreateRequestID: sourceInfo.CreateRequestID,
+	}
+}
+
+func copySignalInfo(sourceInfo *persistence.SignalInfo) *persistence.SignalInfo {
+	return &persistence.SignalInfo{
+		InitiatedID: sourceInfo.InitiatedID,
+		SignalName:  sourceInfo.SignalName,
+		Input:       sourceInfo.Input,
+		Control:     sourceInfo.Control,
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"sync"
+
+	"github.com/uber/cadence/common/persistence"
+)
+
+type (
+	historyEventNotification struct {
+		runID             string
+		nextEventID       int64
+		isWorkflowRunning bool
+	}
+
+	// historyEventNotifierImpl fans out the updates of the mutable state of executions to the long polls waiting on
+	// their history.  Subscribers only ever see the latest update, as a newer notification replaces one which was
+	// not received yet.
+	historyEventNotifierImpl struct {
+		sync.Mutex
+		nextSubscriberID int64
+		subscribers      map[string]map[int64]chan *historyEventNotification
+	}
+)
+
+func newHistoryEventNotifier() *historyEventNotifierImpl {
+	return &historyEventNotifierImpl{
+		subscribers: make(map[string]map[int64]chan *historyEventNotification),
+	}
+}
+
+func newHistoryEventNotification(executionInfo *persistence.WorkflowExecutionInfo) *historyEventNotification {
+	return &historyEventNotification{
+		runID:             executionInfo.RunID,
+		nextEventID:       executionInfo.NextEventID,
+		isWorkflowRunning: executionInfo.State != persistence.WorkflowStateCompleted,
+	}
+}
+
+func (n *historyEventNotifierImpl) NotifyNewHistoryEvent(event *historyEventNotification) {
+	n.Lock()
+	defer n.Unlock()
+
+	for _, channel := range n.subscribers[event.runID] {
+		select {
+		case channel <- event:
+		default:
+			// Drop the notification the subscriber has not received yet, only one goroutine sends on the channel
+			// so the send below never blocks
+			select {
+			case <-channel:
+			default:
+			}
+			channel <- event
+		}
+	}
+}
+
+func (n *historyEventNotifierImpl) WatchHistoryEvent(runID string) (int64, <-chan *historyEventNotification) {
+	n.Lock()
+	defer n.Unlock()
+
+	n.nextSubscriberID++
+	channel := make(chan *historyEventNotification, 1)
+	subscribers, ok := n.subscribers[runID]
+	if !ok {
+		subscribers = make(map[int64]chan *historyEventNotification)
+		n.subscribers[runID] = subscribers
+	}
+	subscribers[n.nextSubscriberID] = channel
+	return n.nextSubscriberID, channel
+}
+
+func (n *historyEventNotifierImpl) UnwatchHistoryEvent(runID string, subscriberID int64) {
+	n.Lock()
+	defer n.Unlock()
+
+	subscribers := n.subscribers[runID]
+	delete(subscribers, subscriberID)
+	if len(subscribers) == 0 {
+		delete(n.subscribers, runID)
+	}
+}
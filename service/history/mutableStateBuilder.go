@@ -28,6 +28,8 @@ import (
 	h "github.com/uber/cadence/.gen/go/history"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cron"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/persistence"
 
@@ -37,6 +39,10 @@ import (
 
 const (
 	emptyUUID = "emptyUuid"
+
+	// timeoutFailureReasonPrefix prefixes the timeout type to form the failure reason of a timed out activity
+	// attempt, so timeouts can be listed as non-retriable reasons of a retry policy.
+	timeoutFailureReasonPrefix = "cadenceInternal:Timeout "
 )
 
 type (
@@ -54,6 +60,16 @@ type (
 		updateChildExecutionInfos    []*persistence.ChildExecutionInfo         // Modified ChildExecution Infos since last update
 		deleteChildExecutionInfo     *int64                                    // Deleted ChildExecution Info since last update
 
+		pendingSignalInfoIDs map[int64]*persistence.SignalInfo // Initiated Event ID -> Signal Info
+		updateSignalInfos    []*persistence.SignalInfo         // Modified Signal Infos since last update
+		deleteSignalInfo     *int64                            // Deleted Signal Info since last update
+
+		pendingSignalRequestedIDs map[string]struct{} // Request IDs of signals sent by other executions
+		updateSignalRequestedIDs  []string            // Signal request IDs added since last update
+
+		bufferedEvents      []*persistence.SerializedHistoryEventBatch // Persisted events received while a decision is in flight
+		clearBufferedEvents bool                                       // Buffered events flushed into the history since last update
+
 		executionInfo   *persistence.WorkflowExecutionInfo // Workflow mutable state info.
 		continueAsNew   *persistence.CreateWorkflowExecutionRequest
 		hBuilder        *historyBuilder
@@ -69,6 +85,11 @@ type (
 		deleteTimerInfos          []string
 		updateChildExecutionInfos []*persistence.ChildExecutionInfo
 		deleteChildExecutionInfo  *int64
+		updateSignalInfos         []*persistence.SignalInfo
+		deleteSignalInfo          *int64
+		updateSignalRequestedIDs  []string
+		newBufferedEvents         *persistence.SerializedHistoryEventBatch
+		clearBufferedEvents       bool
 		continueAsNew             *persistence.CreateWorkflowExecutionRequest
 	}
 
@@ -78,6 +99,8 @@ type (
 		StartedID       int64
 		RequestID       string
 		DecisionTimeout int32
+		Attempt         int64
+		Timestamp       int64
 	}
 )
 
@@ -91,6 +114,10 @@ func newMutableStateBuilder(logger bark.Logger) *mutableStateBuilder {
 		deleteTimerInfos:                []string{},
 		updateChildExecutionInfos:       []*persistence.ChildExecutionInfo{},
 		pendingChildExecutionInfoIDs:    make(map[int64]*persistence.ChildExecutionInfo),
+		updateSignalInfos:               []*persistence.SignalInfo{},
+		pendingSignalInfoIDs:            make(map[int64]*persistence.SignalInfo),
+		pendingSignalRequestedIDs:       make(map[string]struct{}),
+		updateSignalRequestedIDs:        []string{},
 		eventSerializer:                 newJSONHistoryEventSerializer(),
 		logger:                          logger,
 	}
@@ -100,6 +127,8 @@ func newMutableStateBuilder(logger bark.Logger) *mutableStateBuilder {
 		State:              persistence.WorkflowStateCreated,
 		CloseStatus:        persistence.WorkflowCloseStatusNone,
 		LastProcessedEvent: emptyEventID,
+		DecisionScheduleID: emptyEventID,
+		DecisionStartedID:  emptyEventID,
 	}
 
 	return s
@@ -109,13 +138,30 @@ func (e *mutableStateBuilder) Load(state *persistence.WorkflowMutableState) {
 	e.pendingActivityInfoIDs = state.ActivitInfos
 	e.pendingTimerInfoIDs = state.TimerInfos
 	e.pendingChildExecutionInfoIDs = state.ChildExecutionInfos
+	e.pendingSignalInfoIDs = state.SignalInfos
+	if state.SignalRequestedIDs != nil {
+		e.pendingSignalRequestedIDs = state.SignalRequestedIDs
+	}
 	e.executionInfo = state.ExecutionInfo
+	e.bufferedEvents = state.BufferedEvents
 	for _, ai := range state.ActivitInfos {
 		e.pendingActivityInfoByActivityID[ai.ActivityID] = ai.ScheduleID
 	}
 }
 
-func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
+func (e *mutableStateBuilder) CloseUpdateSession() (*mutableStateSessionUpdates, error) {
+	// Buffered events can only be held back while the decision is in flight
+	if !e.isDecisionInFlight() || !e.isWorkflowExecutionRunning() {
+		if err := e.FlushBufferedEvents(); err != nil {
+			return nil, err
+		}
+	}
+
+	newBufferedEvents, err := e.takeNewBufferedEvents()
+	if err != nil {
+		return nil, err
+	}
+
 	updates := &mutableStateSessionUpdates{
 		newEventsBuilder:          e.hBuilder,
 		updateActivityInfos:       e.updateActivityInfos,
@@ -124,9 +170,18 @@ func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
 		deleteTimerInfos:          e.deleteTimerInfos,
 		updateChildExecutionInfos: e.updateChildExecutionInfos,
 		deleteChildExecutionInfo:  e.deleteChildExecutionInfo,
+		updateSignalInfos:         e.updateSignalInfos,
+		deleteSignalInfo:          e.deleteSignalInfo,
+		updateSignalRequestedIDs:  e.updateSignalRequestedIDs,
+		newBufferedEvents:         newBufferedEvents,
+		clearBufferedEvents:       e.clearBufferedEvents,
 		continueAsNew:             e.continueAsNew,
 	}
 
+	if newBufferedEvents != nil {
+		e.bufferedEvents = append(e.bufferedEvents, newBufferedEvents)
+	}
+
 	// Clear all updates to prepare for the next session
 	e.hBuilder = newHistoryBuilder(e, e.logger)
 	e.updateActivityInfos = []*persistence.ActivityInfo{}
@@ -135,23 +190,244 @@ func (e *mutableStateBuilder) CloseUpdateSession() *mutableStateSessionUpdates {
 	e.deleteTimerInfos = []string{}
 	e.updateChildExecutionInfos = []*persistence.ChildExecutionInfo{}
 	e.deleteChildExecutionInfo = nil
+	e.updateSignalInfos = []*persistence.SignalInfo{}
+	e.deleteSignalInfo = nil
+	e.updateSignalRequestedIDs = []string{}
+	e.clearBufferedEvents = false
 	e.continueAsNew = nil
 
-	return updates
+	return updates, nil
+}
+
+// merge adds the updates of a later session, which are written in the same update, to the ones of this session
+func (u *mutableStateSessionUpdates) merge(next *mutableStateSessionUpdates) {
+	u.newEventsBuilder.history = append(u.newEventsBuilder.history, next.newEventsBuilder.history...)
+	u.updateActivityInfos = append(u.updateActivityInfos, next.updateActivityInfos...)
+	if next.deleteActivityInfo != nil {
+		u.deleteActivityInfo = next.deleteActivityInfo
+	}
+	u.updateTimerInfos = append(u.updateTimerInfos, next.updateTimerInfos...)
+	u.deleteTimerInfos = append(u.deleteTimerInfos, next.deleteTimerInfos...)
+	u.updateChildExecutionInfos = append(u.updateChildExecutionInfos, next.updateChildExecutionInfos...)
+	if next.deleteChildExecutionInfo != nil {
+		u.deleteChildExecutionInfo = next.deleteChildExecutionInfo
+	}
+	u.updateSignalInfos = append(u.updateSignalInfos, next.updateSignalInfos...)
+	if next.deleteSignalInfo != nil {
+		u.deleteSignalInfo = next.deleteSignalInfo
+	}
+	u.updateSignalRequestedIDs = append(u.updateSignalRequestedIDs, next.updateSignalRequestedIDs...)
+	if next.clearBufferedEvents {
+		// The events buffered by this session were flushed into the history by the later one
+		u.newBufferedEvents = nil
+		u.clearBufferedEvents = true
+	}
+	if next.newBufferedEvents != nil {
+		u.newBufferedEvents = next.newBufferedEvents
+	}
+	if next.continueAsNew != nil {
+		u.continueAsNew = next.continueAsNew
+	}
+}
+
+// takeNewBufferedEvents removes the events buffered during this session from its history, and serializes them so they
+// are persisted with the mutable state instead
+func (e *mutableStateBuilder) takeNewBufferedEvents() (*persistence.SerializedHistoryEventBatch, error) {
+	var newEvents, bufferedEvents []*workflow.HistoryEvent
+	for _, event := range e.hBuilder.history {
+		if event.GetEventId() == bufferedEventID {
+			bufferedEvents = append(bufferedEvents, event)
+		} else {
+			newEvents = append(newEvents, event)
+		}
+	}
+	if len(bufferedEvents) == 0 {
+		return nil, nil
+	}
+
+	eventBatch := persistence.NewHistoryEventBatch(persistence.GetDefaultHistoryVersion(), bufferedEvents)
+	serializedEvents, err := e.hBuilder.serializer.Serialize(eventBatch)
+	if err != nil {
+		logging.LogHistorySerializationErrorEvent(e.logger, err, "Unable to serialize buffered events.")
+		return nil, err
+	}
+
+	e.hBuilder.history = newEvents
+	return serializedEvents, nil
+}
+
+// FlushBufferedEvents moves the events received while the decision was in flight into the history, after the events
+// added so far in this session, and assigns them their event IDs
+func (e *mutableStateBuilder) FlushBufferedEvents() error {
+	var newEvents, bufferedEvents []*workflow.HistoryEvent
+	for _, serializedEvents := range e.bufferedEvents {
+		eventBatch, err := e.hBuilder.serializer.Deserialize(serializedEvents)
+		if err != nil {
+			logging.LogHistorySerializationErrorEvent(e.logger, err, "Unable to deserialize buffered events.")
+			return err
+		}
+		bufferedEvents = append(bufferedEvents, eventBatch.Events...)
+	}
+	for _, event := range e.hBuilder.history {
+		if event.GetEventId() == bufferedEventID {
+			bufferedEvents = append(bufferedEvents, event)
+		} else {
+			newEvents = append(newEvents, event)
+		}
+	}
+	if len(bufferedEvents) == 0 {
+		return nil
+	}
+
+	// Scheduled or initiated event ID -> ID of the started event flushed along
+	startedIDs := make(map[int64]int64)
+	for _, event := range bufferedEvents {
+		if err := e.assignEventIDToBufferedEvent(event, startedIDs); err != nil {
+			return err
+		}
+		newEvents = append(newEvents, event)
+	}
+
+	e.hBuilder.history = newEvents
+	if len(e.bufferedEvents) > 0 {
+		e.bufferedEvents = nil
+		e.clearBufferedEvents = true
+	}
+	return nil
+}
+
+// assignEventIDToBufferedEvent gives the next event ID to a buffered event, and updates the mutable state and the
+// events flushed after it which refer to it by its placeholder ID
+func (e *mutableStateBuilder) assignEventIDToBufferedEvent(event *workflow.HistoryEvent, startedIDs map[int64]int64) error {
+	eventID := e.executionInfo.NextEventID
+	event.EventId = common.Int64Ptr(eventID)
+	e.executionInfo.NextEventID++
+
+	switch event.GetEventType() {
+	case workflow.EventType_ActivityTaskStarted:
+		scheduleID := event.ActivityTaskStartedEventAttributes.GetScheduledEventId()
+		startedIDs[scheduleID] = eventID
+		if ai, ok := e.GetActivityInfo(scheduleID); ok {
+			ai.StartedID = eventID
+			e.updateActivityInfos = append(e.updateActivityInfos, ai)
+		}
+	case workflow.EventType_ActivityTaskCompleted:
+		attributes := event.ActivityTaskCompletedEventAttributes
+		if startedID, ok := startedIDs[attributes.GetScheduledEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ActivityTaskFailed:
+		attributes := event.ActivityTaskFailedEventAttributes
+		if startedID, ok := startedIDs[attributes.GetScheduledEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ActivityTaskTimedOut:
+		attributes := event.ActivityTaskTimedOutEventAttributes
+		if startedID, ok := startedIDs[attributes.GetScheduledEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ActivityTaskCanceled:
+		attributes := event.ActivityTaskCanceledEventAttributes
+		if startedID, ok := startedIDs[attributes.GetScheduledEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ChildWorkflowExecutionStarted:
+		initiatedID := event.ChildWorkflowExecutionStartedEventAttributes.GetInitiatedEventId()
+		startedIDs[initiatedID] = eventID
+		if ci, ok := e.GetChildExecutionInfo(initiatedID); ok {
+			startedEvent, err := e.eventSerializer.Serialize(event)
+			if err != nil {
+				return err
+			}
+			ci.StartedID = eventID
+			ci.StartedEvent = startedEvent
+			e.updateChildExecutionInfos = append(e.updateChildExecutionInfos, ci)
+		}
+	case workflow.EventType_ChildWorkflowExecutionCompleted:
+		attributes := event.ChildWorkflowExecutionCompletedEventAttributes
+		if startedID, ok := startedIDs[attributes.GetInitiatedEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ChildWorkflowExecutionFailed:
+		attributes := event.ChildWorkflowExecutionFailedEventAttributes
+		if startedID, ok := startedIDs[attributes.GetInitiatedEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ChildWorkflowExecutionCanceled:
+		attributes := event.ChildWorkflowExecutionCanceledEventAttributes
+		if startedID, ok := startedIDs[attributes.GetInitiatedEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ChildWorkflowExecutionTerminated:
+		attributes := event.ChildWorkflowExecutionTerminatedEventAttributes
+		if startedID, ok := startedIDs[attributes.GetInitiatedEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	case workflow.EventType_ChildWorkflowExecutionTimedOut:
+		attributes := event.ChildWorkflowExecutionTimedOutEventAttributes
+		if startedID, ok := startedIDs[attributes.GetInitiatedEventId()]; ok {
+			attributes.StartedEventId = common.Int64Ptr(startedID)
+		}
+	}
+
+	return nil
+}
+
+// HasBufferedEvents returns true if events were received while the decision was in flight
+func (e *mutableStateBuilder) HasBufferedEvents() bool {
+	if len(e.bufferedEvents) > 0 {
+		return true
+	}
+
+	for _, event := range e.hBuilder.history {
+		if event.GetEventId() == bufferedEventID {
+			return true
+		}
+	}
+	return false
 }
 
 func (e *mutableStateBuilder) createNewHistoryEvent(eventType workflow.EventType) *workflow.HistoryEvent {
 	eventID := e.executionInfo.NextEventID
+	if e.shouldBufferEvent(eventType) {
+		eventID = bufferedEventID
+	} else {
+		e.executionInfo.NextEventID++
+	}
+
 	ts := common.Int64Ptr(time.Now().UnixNano())
 	historyEvent := workflow.NewHistoryEvent()
 	historyEvent.EventId = common.Int64Ptr(eventID)
 	historyEvent.Timestamp = ts
 	historyEvent.EventType = workflow.EventTypePtr(eventType)
 
-	e.executionInfo.NextEventID++
 	return historyEvent
 }
 
+// shouldBufferEvent returns true if the event is held back from the history until the decision in flight is closed,
+// so the history handed to the worker is not appended to while it is making the decision
+func (e *mutableStateBuilder) shouldBufferEvent(eventType workflow.EventType) bool {
+	if !e.isDecisionInFlight() {
+		return false
+	}
+
+	switch eventType {
+	case workflow.EventType_WorkflowExecutionStarted,
+		workflow.EventType_DecisionTaskCompleted,
+		workflow.EventType_DecisionTaskFailed,
+		workflow.EventType_DecisionTaskTimedOut,
+		workflow.EventType_WorkflowExecutionCompleted,
+		workflow.EventType_WorkflowExecutionFailed,
+		workflow.EventType_WorkflowExecutionTimedOut,
+		workflow.EventType_WorkflowExecutionCanceled,
+		workflow.EventType_WorkflowExecutionTerminated,
+		workflow.EventType_WorkflowExecutionContinuedAsNew:
+		// Events which start or close the decision or the workflow are never buffered
+		return false
+	}
+	return true
+}
+
 func (e *mutableStateBuilder) getWorkflowType() *workflow.WorkflowType {
 	wType := workflow.NewWorkflowType()
 	wType.Name = common.StringPtr(e.executionInfo.WorkflowTypeName)
@@ -204,6 +480,24 @@ func (e *mutableStateBuilder) GetChildExecutionInfo(initiatedEventID int64) (*pe
 	return ci, ok
 }
 
+// GetSignalInfo gives details about a signal request that is currently in progress.
+func (e *mutableStateBuilder) GetSignalInfo(initiatedEventID int64) (*persistence.SignalInfo, bool) {
+	si, ok := e.pendingSignalInfoIDs[initiatedEventID]
+	return si, ok
+}
+
+// IsSignalRequested checks whether the signal with the given request ID was already recorded.
+func (e *mutableStateBuilder) IsSignalRequested(requestID string) bool {
+	_, ok := e.pendingSignalRequestedIDs[requestID]
+	return ok
+}
+
+// AddSignalRequested remembers the request ID of a recorded signal, so a retried request is not recorded again.
+func (e *mutableStateBuilder) AddSignalRequested(requestID string) {
+	e.pendingSignalRequestedIDs[requestID] = struct{}{}
+	e.updateSignalRequestedIDs = append(e.updateSignalRequestedIDs, requestID)
+}
+
 // GetChildExecutionInitiatedEvent reads out the ChildExecutionInitiatedEvent from mutable state for in-progress child
 // executions
 func (e *mutableStateBuilder) GetChildExecutionInitiatedEvent(initiatedEventID int64) (*workflow.HistoryEvent, bool) {
@@ -251,6 +545,21 @@ func (e *mutableStateBuilder) DeletePendingChildExecution(initiatedEventID int64
 	return nil
 }
 
+// DeletePendingSignal deletes details about a signal request.
+func (e *mutableStateBuilder) DeletePendingSignal(initiatedEventID int64) error {
+	_, ok := e.pendingSignalInfoIDs[initiatedEventID]
+	if !ok {
+		errorMsg := fmt.Sprintf("Unable to find signal request with initiated event id: %v in mutable state",
+			initiatedEventID)
+		logging.LogMutableStateInvalidAction(e.logger, errorMsg)
+		return errors.New(errorMsg)
+	}
+	delete(e.pendingSignalInfoIDs, initiatedEventID)
+
+	e.deleteSignalInfo = common.Int64Ptr(initiatedEventID)
+	return nil
+}
+
 func (e *mutableStateBuilder) writeCompletionEventToMutableState(completionEvent *workflow.HistoryEvent) error {
 	// First check to see if this is a Child Workflow
 	if e.hasParentExecution() {
@@ -282,6 +591,111 @@ func (e *mutableStateBuilder) updateActivityProgress(ai *persistence.ActivityInf
 	e.updateActivityInfos = append(e.updateActivityInfos, ai)
 }
 
+// RetryActivity prepares the activity for its next attempt if the retry policy allows another one.  It returns the
+// backoff interval to wait before the activity is scheduled again, or false if the failure should be recorded.
+func (e *mutableStateBuilder) RetryActivity(ai *persistence.ActivityInfo, failureReason string) (time.Duration, bool) {
+	if !ai.HasRetryPolicy || ai.CancelRequested {
+		return 0, false
+	}
+
+	backoffInterval, ok := getBackoffInterval(ai.Attempt, ai.MaximumAttempts, ai.InitialInterval, ai.MaximumInterval,
+		ai.BackoffCoefficient, ai.ExpirationTime, failureReason, ai.NonRetriableErrors)
+	if !ok {
+		return 0, false
+	}
+
+	ai.Attempt++
+	ai.StartedID = emptyEventID
+	ai.StartedEvent = nil
+	ai.RequestID = ""
+	ai.LastHeartBeatUpdatedTime = time.Time{}
+	e.updateActivityInfos = append(e.updateActivityInfos, ai)
+
+	return backoffInterval, true
+}
+
+// GetWorkflowRetryBackoff returns the backoff interval to wait before the next run of the workflow is started, or
+// false if the retry policy of the workflow does not allow another attempt for the failure.
+func (e *mutableStateBuilder) GetWorkflowRetryBackoff(failureReason string) (time.Duration, bool) {
+	info := e.executionInfo
+	if !info.HasRetryPolicy {
+		return 0, false
+	}
+
+	return getBackoffInterval(info.Attempt, info.MaximumAttempts, info.InitialInterval, info.MaximumInterval,
+		info.BackoffCoefficient, info.ExpirationTime, failureReason, info.NonRetriableErrors)
+}
+
+// GetFailureBackoff returns the time to wait before the next run of a workflow which failed with the given reason, and
+// whether the retry policy or the cron schedule starts that run.  It returns false if the workflow should be closed.
+func (e *mutableStateBuilder) GetFailureBackoff(failureReason string) (time.Duration, workflow.ContinueAsNewInitiator,
+	bool) {
+	if backoffInterval, ok := e.GetWorkflowRetryBackoff(failureReason); ok {
+		return backoffInterval, workflow.ContinueAsNewInitiator_RetryPolicy, true
+	}
+	if backoffInterval, ok := e.GetCronBackoffDuration(); ok {
+		return backoffInterval, workflow.ContinueAsNewInitiator_CronSchedule, true
+	}
+
+	return 0, workflow.ContinueAsNewInitiator_Decider, false
+}
+
+// GetCronBackoffDuration returns the time to wait before the next run of a workflow with a cron schedule is started,
+// or false if the workflow does not have a cron schedule.
+func (e *mutableStateBuilder) GetCronBackoffDuration() (time.Duration, bool) {
+	if e.executionInfo.CronSchedule == "" {
+		return 0, false
+	}
+
+	return getCronBackoffDuration(e.executionInfo.CronSchedule, time.Now())
+}
+
+func getCronBackoffDuration(cronSchedule string, now time.Time) (time.Duration, bool) {
+	schedule, err := cron.Parse(cronSchedule)
+	if err != nil {
+		return 0, false
+	}
+
+	next := schedule.Next(now)
+	if next.IsZero() {
+		return 0, false
+	}
+
+	return next.Sub(now), true
+}
+
+func getBackoffInterval(currAttempt, maxAttempts, initInterval, maxInterval int32, backoffCoefficient float64,
+	expirationTime time.Time, failureReason string, nonRetriableErrors []string) (time.Duration, bool) {
+	for _, reason := range nonRetriableErrors {
+		if reason == failureReason {
+			return 0, false
+		}
+	}
+
+	if maxAttempts > 0 && currAttempt+1 >= maxAttempts {
+		return 0, false
+	}
+
+	now := time.Now()
+	policy := backoff.NewExponentialRetryPolicy(time.Duration(initInterval) * time.Second)
+	policy.SetBackoffCoefficient(backoffCoefficient)
+	policy.SetMaximumInterval(time.Duration(maxInterval) * time.Second)
+	policy.SetExpirationInterval(backoff.NoInterval)
+	if !expirationTime.IsZero() {
+		if !now.Before(expirationTime) {
+			return 0, false
+		}
+		policy.SetExpirationInterval(expirationTime.Sub(now))
+	}
+
+	backoffInterval := policy.ComputeNextDelay(0, int(currAttempt))
+	if backoffInterval <= 0 {
+		return 0, false
+	}
+
+	return backoffInterval, true
+}
+
 // DeleteActivity deletes details about an activity.
 func (e *mutableStateBuilder) DeleteActivity(scheduleEventID int64) error {
 	a, ok := e.pendingActivityInfoIDs[scheduleEventID]
@@ -337,6 +751,8 @@ func (e *mutableStateBuilder) GetPendingDecision(scheduleEventID int64) (*decisi
 		StartedID:       e.executionInfo.DecisionStartedID,
 		RequestID:       e.executionInfo.DecisionRequestID,
 		DecisionTimeout: e.executionInfo.DecisionTimeout,
+		Attempt:         e.executionInfo.DecisionAttempt,
+		Timestamp:       e.executionInfo.DecisionTimestamp,
 	}
 	if scheduleEventID == di.ScheduleID {
 		return di, true
@@ -348,12 +764,19 @@ func (e *mutableStateBuilder) HasPendingDecisionTask() bool {
 	return e.executionInfo.DecisionScheduleID != emptyEventID
 }
 
+// isDecisionInFlight returns true if the decision was started and the worker has not responded yet
+func (e *mutableStateBuilder) isDecisionInFlight() bool {
+	return e.executionInfo.DecisionStartedID != emptyEventID
+}
+
 // UpdateDecision updates a decision task.
 func (e *mutableStateBuilder) UpdateDecision(di *decisionInfo) {
 	e.executionInfo.DecisionScheduleID = di.ScheduleID
 	e.executionInfo.DecisionStartedID = di.StartedID
 	e.executionInfo.DecisionRequestID = di.RequestID
 	e.executionInfo.DecisionTimeout = di.DecisionTimeout
+	e.executionInfo.DecisionAttempt = di.Attempt
+	e.executionInfo.DecisionTimestamp = di.Timestamp
 }
 
 // DeleteDecision deletes a decision task.
@@ -363,10 +786,45 @@ func (e *mutableStateBuilder) DeleteDecision() {
 		StartedID:       emptyEventID,
 		RequestID:       emptyUUID,
 		DecisionTimeout: 0,
+		Attempt:         0,
+		Timestamp:       0,
 	}
 	e.UpdateDecision(emptyDecisionInfo)
 }
 
+// failDecision deletes the decision which failed or timed out.  The attempt of the next decision is kept, so it stays
+// transient, and is incremented if the failure counts as a retry of the decision.
+func (e *mutableStateBuilder) failDecision(incrementAttempt bool) {
+	attempt := e.executionInfo.DecisionAttempt
+	if incrementAttempt {
+		attempt++
+	}
+
+	e.clearStickyness()
+	e.DeleteDecision()
+	e.executionInfo.DecisionAttempt = attempt
+}
+
+// isTransientDecision returns true if the pending decision is a retry of a failed decision, whose events are only
+// written to the history once it completes
+func (e *mutableStateBuilder) isTransientDecision() bool {
+	return e.HasPendingDecisionTask() && e.executionInfo.DecisionAttempt > 0
+}
+
+// getTransientDecisionInfo returns the scheduled and started events of the transient decision in flight, which are
+// appended to the history handed to the worker
+func (e *mutableStateBuilder) getTransientDecisionInfo(identity string) *workflow.TransientDecisionInfo {
+	di := &workflow.TransientDecisionInfo{
+		ScheduledEvent: e.hBuilder.newTransientDecisionTaskScheduledEvent(e.executionInfo.DecisionScheduleID,
+			e.executionInfo.DecisionTimestamp, e.executionInfo.TaskList, e.executionInfo.DecisionTimeout,
+			e.executionInfo.DecisionAttempt),
+		StartedEvent: e.hBuilder.newTransientDecisionTaskStartedEvent(e.executionInfo.DecisionStartedID,
+			e.executionInfo.DecisionTimestamp, e.executionInfo.DecisionScheduleID, e.executionInfo.DecisionRequestID,
+			identity),
+	}
+	return di
+}
+
 // GetNextEventID returns next event ID
 func (e *mutableStateBuilder) GetNextEventID() int64 {
 	return e.executionInfo.NextEventID
@@ -376,6 +834,22 @@ func (e *mutableStateBuilder) isWorkflowExecutionRunning() bool {
 	return e.executionInfo.State != persistence.WorkflowStateCompleted
 }
 
+// isFirstDecisionDelayed returns true while the run waits for the backoff it was started with to elapse.  Its first
+// decision is scheduled by the backoff timer, and everything recorded in the meantime is delivered with it.
+func (e *mutableStateBuilder) isFirstDecisionDelayed() bool {
+	return !e.HasPendingDecisionTask() && e.executionInfo.LastProcessedEvent == emptyEventID &&
+		time.Now().Before(e.executionInfo.FirstDecisionBackoffTime)
+}
+
+func (e *mutableStateBuilder) isStickyTaskListEnabled() bool {
+	return e.executionInfo.StickyTaskList != ""
+}
+
+func (e *mutableStateBuilder) clearStickyness() {
+	e.executionInfo.StickyTaskList = ""
+	e.executionInfo.StickyScheduleToStartTimeout = 0
+}
+
 func (e *mutableStateBuilder) getHistoryEvent(serializedEvent []byte) (*workflow.HistoryEvent, bool) {
 	event, err := e.eventSerializer.Deserialize(serializedEvent)
 	if err != nil {
@@ -419,7 +893,50 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEventForContinueAsNew(d
 		Identity: nil,
 	}
 
-	return e.AddWorkflowExecutionStartedEvent(domainID, execution, createRequest)
+	// The retry policy carries over to the new run unless the decider explicitly sets a different one
+	createRequest.RetryPolicy = previousExecutionState.getRetryPolicy()
+	if attributes.IsSetRetryPolicy() {
+		createRequest.RetryPolicy = attributes.GetRetryPolicy()
+	}
+	if previousExecutionState.executionInfo.CronSchedule != "" {
+		createRequest.CronSchedule = common.StringPtr(previousExecutionState.executionInfo.CronSchedule)
+	}
+	// Memo and search attributes carry over to the new run as well unless the decider sets new ones
+	createRequest.Memo = attributes.GetMemo()
+	if !attributes.IsSetMemo() && previousExecutionState.executionInfo.Memo != nil {
+		createRequest.Memo = &workflow.Memo{Fields: previousExecutionState.executionInfo.Memo}
+	}
+	createRequest.SearchAttributes = attributes.GetSearchAttributes()
+	if !attributes.IsSetSearchAttributes() && previousExecutionState.executionInfo.SearchAttributes != nil {
+		createRequest.SearchAttributes = &workflow.SearchAttributes{
+			IndexedFields: previousExecutionState.executionInfo.SearchAttributes,
+		}
+	}
+
+	event := e.AddWorkflowExecutionStartedEvent(domainID, execution, createRequest)
+	if event == nil {
+		return nil
+	}
+
+	startedAttributes := event.GetWorkflowExecutionStartedEventAttributes()
+	startedAttributes.ContinuedExecutionRunId = common.StringPtr(previousExecutionState.executionInfo.RunID)
+	startedAttributes.Initiator = workflow.ContinueAsNewInitiatorPtr(attributes.GetInitiator())
+	startedAttributes.ContinuedFailureReason = attributes.FailureReason
+	startedAttributes.ContinuedFailureDetails = attributes.FailureDetails
+	startedAttributes.FirstDecisionTaskBackoffSeconds = common.Int32Ptr(attributes.GetBackoffStartIntervalInSeconds())
+
+	if attributes.GetInitiator() == workflow.ContinueAsNewInitiator_RetryPolicy {
+		// A retry is the next attempt of the same execution, so it keeps counting against the original expiration
+		e.executionInfo.Attempt = previousExecutionState.executionInfo.Attempt + 1
+		e.executionInfo.ExpirationTime = previousExecutionState.executionInfo.ExpirationTime
+		startedAttributes.Attempt = common.Int32Ptr(e.executionInfo.Attempt)
+		startedAttributes.ExpirationTimestamp = nil
+		if !e.executionInfo.ExpirationTime.IsZero() {
+			startedAttributes.ExpirationTimestamp = common.Int64Ptr(e.executionInfo.ExpirationTime.UnixNano())
+		}
+	}
+
+	return event
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(domainID string, execution workflow.WorkflowExecution,
@@ -436,6 +953,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(domainID string,
 	e.executionInfo.TaskList = request.GetTaskList().GetName()
 	e.executionInfo.WorkflowTypeName = request.GetWorkflowType().GetName()
 	e.executionInfo.DecisionTimeoutValue = request.GetTaskStartToCloseTimeoutSeconds()
+	e.executionInfo.WorkflowTimeout = request.GetExecutionStartToCloseTimeoutSeconds()
 
 	e.executionInfo.State = persistence.WorkflowStateCreated
 	e.executionInfo.CloseStatus = persistence.WorkflowCloseStatusNone
@@ -445,30 +963,87 @@ func (e *mutableStateBuilder) AddWorkflowExecutionStartedEvent(domainID string,
 	e.executionInfo.DecisionStartedID = emptyEventID
 	e.executionInfo.DecisionRequestID = emptyUUID
 	e.executionInfo.DecisionTimeout = 0
+	e.executionInfo.CronSchedule = request.GetCronSchedule()
+	if request.IsSetMemo() {
+		e.executionInfo.Memo = request.GetMemo().GetFields()
+	}
+	if request.IsSetSearchAttributes() {
+		e.executionInfo.SearchAttributes = request.GetSearchAttributes().GetIndexedFields()
+	}
+
+	if request.IsSetRetryPolicy() {
+		policy := request.GetRetryPolicy()
+		e.executionInfo.HasRetryPolicy = true
+		e.executionInfo.InitialInterval = policy.GetInitialIntervalInSeconds()
+		e.executionInfo.BackoffCoefficient = policy.GetBackoffCoefficient()
+		e.executionInfo.MaximumInterval = policy.GetMaximumIntervalInSeconds()
+		e.executionInfo.MaximumAttempts = policy.GetMaximumAttempts()
+		e.executionInfo.ExpirationSeconds = policy.GetExpirationIntervalInSeconds()
+		e.executionInfo.NonRetriableErrors = policy.GetNonRetriableErrorReasons()
+		if policy.GetExpirationIntervalInSeconds() > 0 {
+			e.executionInfo.ExpirationTime = time.Now().Add(time.Duration(policy.GetExpirationIntervalInSeconds()) * time.Second)
+		}
+	}
 
 	return e.hBuilder.AddWorkflowExecutionStartedEvent(request)
 }
 
-func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() (*workflow.HistoryEvent, *decisionInfo) {
+func (e *mutableStateBuilder) getRetryPolicy() *workflow.RetryPolicy {
+	if !e.executionInfo.HasRetryPolicy {
+		return nil
+	}
+
+	return &workflow.RetryPolicy{
+		InitialIntervalInSeconds:    common.Int32Ptr(e.executionInfo.InitialInterval),
+		BackoffCoefficient:          common.Float64Ptr(e.executionInfo.BackoffCoefficient),
+		MaximumIntervalInSeconds:    common.Int32Ptr(e.executionInfo.MaximumInterval),
+		MaximumAttempts:             common.Int32Ptr(e.executionInfo.MaximumAttempts),
+		NonRetriableErrorReasons:    e.executionInfo.NonRetriableErrors,
+		ExpirationIntervalInSeconds: common.Int32Ptr(e.executionInfo.ExpirationSeconds),
+	}
+}
+
+func (e *mutableStateBuilder) AddDecisionTaskScheduledEvent() (*workflow.HistoryEvent, *decisionInfo, error) {
 	// Tasklist and decision timeout should already be set from workflow execution started event
 	taskList := e.executionInfo.TaskList
 	startToCloseTimeoutSeconds := e.executionInfo.DecisionTimeoutValue
 	if e.HasPendingDecisionTask() {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskScheduled, e.GetNextEventID(), fmt.Sprintf(
 			"{Pending Decision ScheduleID: %v}", e.executionInfo.DecisionScheduleID))
-		return nil, nil
+		return nil, nil, nil
+	}
+
+	// Events received while the previous decision was in flight go before the new decision
+	nextEventID := e.GetNextEventID()
+	if err := e.FlushBufferedEvents(); err != nil {
+		return nil, nil, err
+	}
+
+	// The decision is no longer a retry once there are new events for the worker to process
+	attempt := e.executionInfo.DecisionAttempt
+	if e.GetNextEventID() != nextEventID {
+		attempt = 0
 	}
 
-	newDecisionEvent := e.hBuilder.AddDecisionTaskScheduledEvent(taskList, startToCloseTimeoutSeconds)
+	var newDecisionEvent *workflow.HistoryEvent
+	if attempt == 0 {
+		newDecisionEvent = e.hBuilder.AddDecisionTaskScheduledEvent(taskList, startToCloseTimeoutSeconds, attempt)
+	} else {
+		// A retried decision takes the next event ID, but its events are only written once it completes
+		newDecisionEvent = e.hBuilder.newTransientDecisionTaskScheduledEvent(e.GetNextEventID(), time.Now().UnixNano(),
+			taskList, startToCloseTimeoutSeconds, attempt)
+	}
 	di := &decisionInfo{
 		ScheduleID:      newDecisionEvent.GetEventId(),
 		StartedID:       emptyEventID,
 		RequestID:       emptyUUID,
 		DecisionTimeout: startToCloseTimeoutSeconds,
+		Attempt:         attempt,
+		Timestamp:       0,
 	}
 	e.UpdateDecision(di)
 
-	return newDecisionEvent, di
+	return newDecisionEvent, di, nil
 }
 
 func (e *mutableStateBuilder) AddDecisionTaskStartedEvent(scheduleEventID int64, requestID string,
@@ -481,11 +1056,33 @@ func (e *mutableStateBuilder) AddDecisionTaskStartedEvent(scheduleEventID int64,
 		return nil
 	}
 
-	event := e.hBuilder.AddDecisionTaskStartedEvent(scheduleEventID, requestID, request)
+	scheduleID := pendingDecisionTask.ScheduleID
+	attempt := pendingDecisionTask.Attempt
+	// Events were added to the history since the retried decision was scheduled, so it is written to the history
+	// like a new decision
+	if attempt > 0 && scheduleID != e.GetNextEventID() {
+		attempt = 0
+		scheduledEvent := e.hBuilder.AddDecisionTaskScheduledEvent(e.executionInfo.TaskList,
+			pendingDecisionTask.DecisionTimeout, attempt)
+		scheduleID = scheduledEvent.GetEventId()
+	}
+
+	var event *workflow.HistoryEvent
+	timestamp := int64(0)
+	if attempt == 0 {
+		event = e.hBuilder.AddDecisionTaskStartedEvent(scheduleID, requestID, request)
+	} else {
+		timestamp = time.Now().UnixNano()
+		event = e.hBuilder.newTransientDecisionTaskStartedEvent(scheduleID+1, timestamp, scheduleID, requestID,
+			request.GetIdentity())
+	}
 
 	// Update mutable decision state
+	e.executionInfo.DecisionScheduleID = scheduleID
 	e.executionInfo.DecisionStartedID = event.GetEventId()
 	e.executionInfo.DecisionRequestID = requestID
+	e.executionInfo.DecisionAttempt = attempt
+	e.executionInfo.DecisionTimestamp = timestamp
 	e.executionInfo.State = persistence.WorkflowStateRunning
 
 	return event
@@ -501,10 +1098,32 @@ func (e *mutableStateBuilder) AddDecisionTaskCompletedEvent(scheduleEventID, sta
 			startedEventID, ok))
 		return nil
 	}
+
+	// The retried decision made progress, so its scheduled and started events are written ahead of the completion
+	if pendingDecisionTask.Attempt > 0 {
+		if scheduleEventID != e.GetNextEventID() {
+			logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskCompleted, e.GetNextEventID(),
+				fmt.Sprintf("{Transient Decision ScheduleID: %v, Attempt: %v}", scheduleEventID,
+					pendingDecisionTask.Attempt))
+			return nil
+		}
+		e.addTransientDecisionEventsToHistory(request.GetIdentity())
+	}
+
 	event := e.hBuilder.AddDecisionTaskCompletedEvent(scheduleEventID, startedEventID, request)
 
 	e.executionInfo.LastProcessedEvent = startedEventID
 	e.DeleteDecision()
+
+	// The worker asks for the next decision to be dispatched to it, as long as it keeps the workflow cached
+	stickyAttributes := request.GetStickyAttributes()
+	if stickyAttributes != nil && stickyAttributes.IsSetWorkerTaskList() &&
+		stickyAttributes.GetWorkerTaskList().GetName() != "" && stickyAttributes.GetScheduleToStartTimeoutSeconds() > 0 {
+		e.executionInfo.StickyTaskList = stickyAttributes.GetWorkerTaskList().GetName()
+		e.executionInfo.StickyScheduleToStartTimeout = stickyAttributes.GetScheduleToStartTimeoutSeconds()
+	} else {
+		e.clearStickyness()
+	}
 	return event
 }
 
@@ -519,15 +1138,47 @@ func (e *mutableStateBuilder) AddDecisionTaskTimedOutEvent(scheduleEventID int64
 		return nil
 	}
 
-	event := e.hBuilder.AddDecisionTaskTimedOutEvent(scheduleEventID, startedEventID)
+	var event *workflow.HistoryEvent
+	if pendingDecisionTask.Attempt == 0 {
+		event = e.hBuilder.AddDecisionTaskTimedOutEvent(scheduleEventID, startedEventID,
+			workflow.TimeoutType_START_TO_CLOSE)
+	} else {
+		event = e.hBuilder.newTransientDecisionTaskTimedOutEvent(startedEventID+1, scheduleEventID, startedEventID,
+			workflow.TimeoutType_START_TO_CLOSE)
+	}
 
-	e.DeleteDecision()
+	e.failDecision(true)
+	return event
+}
+
+// AddDecisionTaskScheduleToStartTimeoutEvent times out a decision which was not picked up from the sticky task list in
+// time, so the next decision falls back to the task list of the execution.
+func (e *mutableStateBuilder) AddDecisionTaskScheduleToStartTimeoutEvent(scheduleEventID int64) *workflow.HistoryEvent {
+	hasPendingDecision := e.HasPendingDecisionTask()
+	pendingDecisionTask, ok := e.GetPendingDecision(scheduleEventID)
+	if !hasPendingDecision || !ok || pendingDecisionTask.StartedID != emptyEventID {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionDecisionTaskTimedOut, e.GetNextEventID(), fmt.Sprintf(
+			"{HasPending: %v, ScheduleID: %v, Exist: %v}", hasPendingDecision, scheduleEventID, ok))
+		return nil
+	}
+
+	var event *workflow.HistoryEvent
+	if pendingDecisionTask.Attempt == 0 {
+		event = e.hBuilder.AddDecisionTaskTimedOutEvent(scheduleEventID, emptyEventID,
+			workflow.TimeoutType_SCHEDULE_TO_START)
+	} else {
+		event = e.hBuilder.newTransientDecisionTaskTimedOutEvent(scheduleEventID+1, scheduleEventID, emptyEventID,
+			workflow.TimeoutType_SCHEDULE_TO_START)
+	}
+
+	// The worker never got the decision, so it does not count as a retry
+	e.failDecision(false)
 	return event
 }
 
 func (e *mutableStateBuilder) AddDecisionTaskFailedEvent(scheduleEventID int64,
-	startedEventID int64, cause workflow.DecisionTaskFailedCause,
-	request *workflow.RespondDecisionTaskCompletedRequest) *workflow.HistoryEvent {
+	startedEventID int64, cause workflow.DecisionTaskFailedCause, details []byte,
+	identity string) *workflow.HistoryEvent {
 	hasPendingDecision := e.HasPendingDecisionTask()
 	pendingDecisionTask, ok := e.GetPendingDecision(scheduleEventID)
 	if !hasPendingDecision || !ok || pendingDecisionTask.StartedID != startedEventID {
@@ -537,12 +1188,27 @@ func (e *mutableStateBuilder) AddDecisionTaskFailedEvent(scheduleEventID int64,
 		return nil
 	}
 
-	event := e.hBuilder.AddDecisionTaskFailedEvent(scheduleEventID, startedEventID, cause, request)
+	var event *workflow.HistoryEvent
+	if pendingDecisionTask.Attempt == 0 {
+		event = e.hBuilder.AddDecisionTaskFailedEvent(scheduleEventID, startedEventID, cause, details, identity)
+	} else {
+		event = e.hBuilder.newTransientDecisionTaskFailedEvent(startedEventID+1, scheduleEventID, startedEventID, cause,
+			details, identity)
+	}
 
-	e.DeleteDecision()
+	// Resetting the workflow fails the decision on purpose, it does not count as a retry
+	e.failDecision(cause != workflow.DecisionTaskFailedCause_RESET_WORKFLOW)
 	return event
 }
 
+// addTransientDecisionEventsToHistory writes the scheduled and started events of the transient decision in flight
+func (e *mutableStateBuilder) addTransientDecisionEventsToHistory(identity string) {
+	di := e.getTransientDecisionInfo(identity)
+	e.hBuilder.addEventToHistory(di.ScheduledEvent)
+	e.hBuilder.addEventToHistory(di.StartedEvent)
+	e.executionInfo.NextEventID = di.StartedEvent.GetEventId() + 1
+}
+
 func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(decisionCompletedEventID int64,
 	attributes *workflow.ScheduleActivityTaskDecisionAttributes) (*workflow.HistoryEvent, *persistence.ActivityInfo) {
 	if ai, ok := e.GetActivityInfo(e.GetNextEventID()); ok {
@@ -587,6 +1253,19 @@ func (e *mutableStateBuilder) AddActivityTaskScheduledEvent(decisionCompletedEve
 		LastHeartBeatUpdatedTime: time.Time{},
 	}
 
+	if attributes.IsSetRetryPolicy() {
+		policy := attributes.GetRetryPolicy()
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = policy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = policy.GetBackoffCoefficient()
+		ai.MaximumInterval = policy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = policy.GetMaximumAttempts()
+		ai.NonRetriableErrors = policy.GetNonRetriableErrorReasons()
+		if policy.GetExpirationIntervalInSeconds() > 0 {
+			ai.ExpirationTime = time.Now().Add(time.Duration(policy.GetExpirationIntervalInSeconds()) * time.Second)
+		}
+	}
+
 	e.pendingActivityInfoIDs[scheduleEventID] = ai
 	e.pendingActivityInfoByActivityID[ai.ActivityID] = scheduleEventID
 	e.updateActivityInfos = append(e.updateActivityInfos, ai)
@@ -602,23 +1281,63 @@ func (e *mutableStateBuilder) AddActivityTaskStartedEvent(ai *persistence.Activi
 		return nil
 	}
 
-	event := e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, requestID, request)
+	var event *workflow.HistoryEvent
+	if !ai.HasRetryPolicy {
+		event = e.hBuilder.AddActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, request)
+		ai.StartedID = event.GetEventId()
+	} else {
+		// Attempts of an activity with a retry policy are only kept in mutable state, the started event of the final
+		// attempt is written to the history along with the event which closes the activity
+		event = e.hBuilder.newTransientActivityTaskStartedEvent(scheduleEventID, ai.Attempt, requestID, request)
+		ai.StartedID = transientEventID
+	}
 
-	ai.StartedID = event.GetEventId()
+	startedEvent, err := e.eventSerializer.Serialize(event)
+	if err != nil {
+		return nil
+	}
+
+	ai.StartedEvent = startedEvent
 	ai.RequestID = requestID
 	e.updateActivityInfos = append(e.updateActivityInfos, ai)
 
 	return event
 }
 
+// addTransientActivityStartedEvent writes the started event of the final attempt of an activity with a retry policy
+// ahead of the event which closes the activity.  It returns the started event ID the closing event refers to.
+func (e *mutableStateBuilder) addTransientActivityStartedEvent(ai *persistence.ActivityInfo) (int64, bool) {
+	if ai.StartedID != transientEventID {
+		return ai.StartedID, true
+	}
+
+	startedEvent, ok := e.getHistoryEvent(ai.StartedEvent)
+	if !ok {
+		return emptyEventID, false
+	}
+
+	attributes := startedEvent.GetActivityTaskStartedEventAttributes()
+	event := e.hBuilder.AddActivityTaskStartedEvent(ai.ScheduleID, ai.Attempt, attributes.GetRequestId(),
+		&workflow.PollForActivityTaskRequest{Identity: attributes.Identity})
+	event.Timestamp = startedEvent.Timestamp
+
+	return event.GetEventId(), true
+}
+
 func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(scheduleEventID, startedEventID int64,
 	request *workflow.RespondActivityTaskCompletedRequest) *workflow.HistoryEvent {
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskCompleted, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
 		return nil
 	}
 
+	startedEventID, ok = e.addTransientActivityStartedEvent(ai)
+	if !ok {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -628,12 +1347,18 @@ func (e *mutableStateBuilder) AddActivityTaskCompletedEvent(scheduleEventID, sta
 
 func (e *mutableStateBuilder) AddActivityTaskFailedEvent(scheduleEventID, startedEventID int64,
 	request *workflow.RespondActivityTaskFailedRequest) *workflow.HistoryEvent {
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID {
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskFailed, e.GetNextEventID(), fmt.Sprintf(
 			"{ScheduleID: %v, StartedID: %v, Exist: %v}", scheduleEventID, startedEventID, ok))
 		return nil
 	}
 
+	startedEventID, ok = e.addTransientActivityStartedEvent(ai)
+	if !ok {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -643,7 +1368,8 @@ func (e *mutableStateBuilder) AddActivityTaskFailedEvent(scheduleEventID, starte
 
 func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(scheduleEventID, startedEventID int64,
 	timeoutType workflow.TimeoutType, lastHeartBeatDetails []byte) *workflow.HistoryEvent {
-	if ai, ok := e.GetActivityInfo(scheduleEventID); !ok || ai.StartedID != startedEventID ||
+	ai, ok := e.GetActivityInfo(scheduleEventID)
+	if !ok || ai.StartedID != startedEventID ||
 		((timeoutType == workflow.TimeoutType_START_TO_CLOSE || timeoutType == workflow.TimeoutType_HEARTBEAT) &&
 			ai.StartedID == emptyEventID) {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionActivityTaskTimedOut, e.GetNextEventID(), fmt.Sprintf(
@@ -652,6 +1378,11 @@ func (e *mutableStateBuilder) AddActivityTaskTimedOutEvent(scheduleEventID, star
 		return nil
 	}
 
+	startedEventID, ok = e.addTransientActivityStartedEvent(ai)
+	if !ok {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -702,6 +1433,11 @@ func (e *mutableStateBuilder) AddActivityTaskCanceledEvent(scheduleEventID, star
 		return nil
 	}
 
+	startedEventID, ok = e.addTransientActivityStartedEvent(ai)
+	if !ok {
+		return nil
+	}
+
 	if err := e.DeleteActivity(scheduleEventID); err != nil {
 		return nil
 	}
@@ -740,9 +1476,35 @@ func (e *mutableStateBuilder) AddFailWorkflowEvent(decisionCompletedEventID int6
 	return event
 }
 
+func (e *mutableStateBuilder) AddTimeoutWorkflowEvent() (*workflow.HistoryEvent, error) {
+	if e.executionInfo.State == persistence.WorkflowStateCompleted {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowTimeout, e.GetNextEventID(), fmt.Sprintf(
+			"{State: %v}", e.executionInfo.State))
+		return nil, nil
+	}
+
+	if err := e.FlushBufferedEvents(); err != nil {
+		return nil, err
+	}
+
+	e.executionInfo.State = persistence.WorkflowStateCompleted
+	e.executionInfo.CloseStatus = persistence.WorkflowCloseStatusTimedOut
+	event := e.hBuilder.AddTimeoutWorkflowEvent()
+	e.writeCompletionEventToMutableState(event)
+
+	return event, nil
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionCancelRequestedEvent(cause string,
 	request *h.RequestCancelWorkflowExecutionRequest) *workflow.HistoryEvent {
-	return e.hBuilder.AddWorkflowExecutionCancelRequestedEvent(cause, request)
+	event := e.hBuilder.AddWorkflowExecutionCancelRequestedEvent(cause, request)
+	if event == nil {
+		return nil
+	}
+
+	e.executionInfo.CancelRequested = true
+	e.executionInfo.CancelRequestID = request.GetCancelRequest().GetRequestId()
+	return event
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionCanceledEvent(decisionTaskCompletedEventID int64,
@@ -778,6 +1540,59 @@ func (e *mutableStateBuilder) AddExternalWorkflowExecutionCancelRequested(initia
 	return e.hBuilder.AddExternalWorkflowExecutionCancelRequested(initiatedEventID, domain, workflowID, runID)
 }
 
+func (e *mutableStateBuilder) AddSignalExternalWorkflowExecutionInitiatedEvent(decisionCompletedEventID int64,
+	request *workflow.SignalExternalWorkflowExecutionDecisionAttributes) (*workflow.HistoryEvent,
+	*persistence.SignalInfo) {
+	event := e.hBuilder.AddSignalExternalWorkflowExecutionInitiatedEvent(decisionCompletedEventID, request)
+
+	initiatedEventID := event.GetEventId()
+	si := &persistence.SignalInfo{
+		InitiatedID: initiatedEventID,
+		SignalName:  request.GetSignalName(),
+		Input:       request.Input,
+		Control:     request.Control,
+	}
+
+	e.pendingSignalInfoIDs[initiatedEventID] = si
+	e.updateSignalInfos = append(e.updateSignalInfos, si)
+
+	return event, si
+}
+
+func (e *mutableStateBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
+	decisionTaskCompletedEventID, initiatedID int64, domain, workflowID, runID string, control []byte,
+	cause workflow.SignalExternalWorkflowExecutionFailedCause) *workflow.HistoryEvent {
+	_, ok := e.GetSignalInfo(initiatedID)
+	if !ok {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionSignalExternalWorkflowFailed,
+			e.GetNextEventID(), fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
+		return nil
+	}
+
+	if err := e.DeletePendingSignal(initiatedID); err == nil {
+		return e.hBuilder.AddSignalExternalWorkflowExecutionFailedEvent(decisionTaskCompletedEventID, initiatedID,
+			domain, workflowID, runID, control, cause)
+	}
+
+	return nil
+}
+
+func (e *mutableStateBuilder) AddExternalWorkflowExecutionSignaled(initiatedID int64,
+	domain, workflowID, runID string, control []byte) *workflow.HistoryEvent {
+	_, ok := e.GetSignalInfo(initiatedID)
+	if !ok {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionExternalWorkflowSignaled,
+			e.GetNextEventID(), fmt.Sprintf("{InitiatedID: %v, Exist: %v}", initiatedID, ok))
+		return nil
+	}
+
+	if err := e.DeletePendingSignal(initiatedID); err == nil {
+		return e.hBuilder.AddExternalWorkflowExecutionSignaled(initiatedID, domain, workflowID, runID, control)
+	}
+
+	return nil
+}
+
 func (e *mutableStateBuilder) AddTimerStartedEvent(decisionCompletedEventID int64,
 	request *workflow.StartTimerDecisionAttributes) (*workflow.HistoryEvent, *persistence.TimerInfo) {
 	timerID := request.GetTimerId()
@@ -855,12 +1670,40 @@ func (e *mutableStateBuilder) AddRecordMarkerEvent(decisionCompletedEventID int6
 	return e.hBuilder.AddMarkerRecordedEvent(decisionCompletedEventID, attributes)
 }
 
+func (e *mutableStateBuilder) AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID int64,
+	attributes *workflow.UpsertWorkflowSearchAttributesDecisionAttributes) *workflow.HistoryEvent {
+	e.mergeSearchAttributes(attributes.GetSearchAttributes().GetIndexedFields())
+
+	return e.hBuilder.AddUpsertWorkflowSearchAttributesEvent(decisionCompletedEventID, attributes)
+}
+
+// mergeSearchAttributes adds the upserted fields to the search attributes of the execution, overwriting the value of
+// any field which is already set
+func (e *mutableStateBuilder) mergeSearchAttributes(fields map[string][]byte) {
+	if len(fields) == 0 {
+		return
+	}
+
+	searchAttributes := make(map[string][]byte, len(e.executionInfo.SearchAttributes)+len(fields))
+	for k, v := range e.executionInfo.SearchAttributes {
+		searchAttributes[k] = v
+	}
+	for k, v := range fields {
+		searchAttributes[k] = v
+	}
+	e.executionInfo.SearchAttributes = searchAttributes
+}
+
 func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
-	request *workflow.TerminateWorkflowExecutionRequest) *workflow.HistoryEvent {
+	request *workflow.TerminateWorkflowExecutionRequest) (*workflow.HistoryEvent, error) {
 	if e.executionInfo.State == persistence.WorkflowStateCompleted {
 		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionWorkflowTerminated, e.GetNextEventID(), fmt.Sprintf(
 			"{State: %v}", e.executionInfo.State))
-		return nil
+		return nil, nil
+	}
+
+	if err := e.FlushBufferedEvents(); err != nil {
+		return nil, err
 	}
 
 	e.executionInfo.State = persistence.WorkflowStateCompleted
@@ -868,7 +1711,7 @@ func (e *mutableStateBuilder) AddWorkflowExecutionTerminatedEvent(
 	event := e.hBuilder.AddWorkflowExecutionTerminatedEvent(request)
 	e.writeCompletionEventToMutableState(event)
 
-	return event
+	return event, nil
 }
 
 func (e *mutableStateBuilder) AddWorkflowExecutionSignaled(
@@ -905,9 +1748,30 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add workflow execution started event."}
 	}
 
-	_, di := newStateBuilder.AddDecisionTaskScheduledEvent()
-	if di == nil {
-		return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+	// The first decision of a run started with a backoff is scheduled by a timer once the backoff elapses
+	var transferTasks []persistence.Task
+	decisionScheduleID := emptyEventID
+	decisionStartID := emptyEventID
+	decisionTimeout := int32(0)
+	if attributes.GetBackoffStartIntervalInSeconds() <= 0 {
+		_, di, err := newStateBuilder.AddDecisionTaskScheduledEvent()
+		if err != nil {
+			return nil, nil, err
+		}
+		if di == nil {
+			return nil, nil, &workflow.InternalServiceError{Message: "Failed to add decision started event."}
+		}
+
+		transferTasks = []persistence.Task{&persistence.DecisionTask{
+			DomainID: domainID, TaskList: newStateBuilder.executionInfo.TaskList, ScheduleID: di.ScheduleID,
+		}}
+		decisionScheduleID = di.ScheduleID
+		decisionStartID = di.StartedID
+		decisionTimeout = di.DecisionTimeout
+	} else {
+		newStateBuilder.executionInfo.FirstDecisionBackoffTime = time.Now().Add(
+			time.Duration(attributes.GetBackoffStartIntervalInSeconds()) * time.Second)
+		transferTasks = []persistence.Task{&persistence.RecordWorkflowStartedTask{}}
 	}
 
 	parentDomainID := ""
@@ -923,25 +1787,37 @@ func (e *mutableStateBuilder) AddContinueAsNewEvent(decisionCompletedEventID int
 	}
 
 	e.continueAsNew = &persistence.CreateWorkflowExecutionRequest{
-		RequestID:            uuid.New(),
-		DomainID:             domainID,
-		Execution:            newExecution,
-		ParentDomainID:       parentDomainID,
-		ParentExecution:      parentExecution,
-		InitiatedID:          initiatedID,
-		TaskList:             newStateBuilder.executionInfo.TaskList,
-		WorkflowTypeName:     newStateBuilder.executionInfo.WorkflowTypeName,
-		DecisionTimeoutValue: newStateBuilder.executionInfo.DecisionTimeoutValue,
-		ExecutionContext:     nil,
-		NextEventID:          newStateBuilder.GetNextEventID(),
-		LastProcessedEvent:   common.EmptyEventID,
-		TransferTasks: []persistence.Task{&persistence.DecisionTask{
-			DomainID: domainID, TaskList: newStateBuilder.executionInfo.TaskList, ScheduleID: di.ScheduleID,
-		}},
-		DecisionScheduleID:          di.ScheduleID,
-		DecisionStartedID:           di.StartedID,
-		DecisionStartToCloseTimeout: di.DecisionTimeout,
+		RequestID:                   uuid.New(),
+		DomainID:                    domainID,
+		Execution:                   newExecution,
+		ParentDomainID:              parentDomainID,
+		ParentExecution:             parentExecution,
+		InitiatedID:                 initiatedID,
+		TaskList:                    newStateBuilder.executionInfo.TaskList,
+		WorkflowTypeName:            newStateBuilder.executionInfo.WorkflowTypeName,
+		DecisionTimeoutValue:        newStateBuilder.executionInfo.DecisionTimeoutValue,
+		ExecutionContext:            nil,
+		NextEventID:                 newStateBuilder.GetNextEventID(),
+		LastProcessedEvent:          common.EmptyEventID,
+		TransferTasks:               transferTasks,
+		DecisionScheduleID:          decisionScheduleID,
+		DecisionStartedID:           decisionStartID,
+		DecisionStartToCloseTimeout: decisionTimeout,
 		ContinueAsNew:               true,
+		Attempt:                     newStateBuilder.executionInfo.Attempt,
+		HasRetryPolicy:              newStateBuilder.executionInfo.HasRetryPolicy,
+		InitialInterval:             newStateBuilder.executionInfo.InitialInterval,
+		BackoffCoefficient:          newStateBuilder.executionInfo.BackoffCoefficient,
+		MaximumInterval:             newStateBuilder.executionInfo.MaximumInterval,
+		MaximumAttempts:             newStateBuilder.executionInfo.MaximumAttempts,
+		ExpirationSeconds:           newStateBuilder.executionInfo.ExpirationSeconds,
+		ExpirationTime:              newStateBuilder.executionInfo.ExpirationTime,
+		NonRetriableErrors:          newStateBuilder.executionInfo.NonRetriableErrors,
+		CronSchedule:                newStateBuilder.executionInfo.CronSchedule,
+		WorkflowTimeout:             newStateBuilder.executionInfo.WorkflowTimeout,
+		FirstDecisionBackoffTime:    newStateBuilder.executionInfo.FirstDecisionBackoffTime,
+		Memo:                        newStateBuilder.executionInfo.Memo,
+		SearchAttributes:            newStateBuilder.executionInfo.SearchAttributes,
 	}
 
 	return e.hBuilder.AddContinuedAsNewEvent(decisionCompletedEventID, newRunID, attributes), newStateBuilder, nil
@@ -1102,3 +1978,26 @@ func (e *mutableStateBuilder) AddChildWorkflowExecutionTerminatedEvent(initiated
 
 	return nil
 }
+
+func (e *mutableStateBuilder) AddChildWorkflowExecutionTimedOutEvent(initiatedID int64,
+	childExecution *workflow.WorkflowExecution,
+	attributes *workflow.WorkflowExecutionTimedOutEventAttributes) *workflow.HistoryEvent {
+	ci, ok := e.GetChildExecutionInfo(initiatedID)
+	if !ok || ci.StartedID == emptyEventID {
+		logging.LogInvalidHistoryActionEvent(e.logger, logging.TagValueActionChildExecutionTimedOut, e.GetNextEventID(), fmt.Sprintf(
+			"{InitiatedID: %v, Exist: %v}", initiatedID, ok))
+		return nil
+	}
+
+	startedEvent, _ := e.getHistoryEvent(ci.StartedEvent)
+
+	domain := startedEvent.GetChildWorkflowExecutionStartedEventAttributes().GetDomain()
+	workflowType := startedEvent.GetChildWorkflowExecutionStartedEventAttributes().GetWorkflowType()
+
+	if err := e.DeletePendingChildExecution(initiatedID); err == nil {
+		return e.hBuilder.AddChildWorkflowExecutionTimedOutEvent(domain, childExecution, workflowType, ci.InitiatedID,
+			ci.StartedID, attributes)
+	}
+
+	return nil
+}
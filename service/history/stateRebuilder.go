@@ -0,0 +1,352 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package history
+
+import (
+	"fmt"
+	"time"
+
+	workflow "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common/persistence"
+
+	"github.com/pborman/uuid"
+)
+
+type (
+	// stateRebuilder derives the mutable state of a run by replaying history events through a mutableStateBuilder.
+	// It is used to reset a workflow execution, where the new run starts off with a copy of the history of the
+	// previous run.
+	stateRebuilder struct {
+		msBuilder *mutableStateBuilder
+	}
+)
+
+func newStateRebuilder(msBuilder *mutableStateBuilder) *stateRebuilder {
+	return &stateRebuilder{
+		msBuilder: msBuilder,
+	}
+}
+
+// applyEvents replays the events in order on top of the mutable state of the given run.  Replayed events are added
+// to the history builder as is, so they are persisted as the history of the run along with any event added after.
+func (r *stateRebuilder) applyEvents(domainID, requestID string, execution workflow.WorkflowExecution,
+	events []*workflow.HistoryEvent) error {
+	for _, event := range events {
+		if event.GetEventId() != r.msBuilder.GetNextEventID() {
+			return &workflow.InternalServiceError{Message: fmt.Sprintf(
+				"Unable to replay history event.  Expected EventID: %v, EventID: %v", r.msBuilder.GetNextEventID(),
+				event.GetEventId())}
+		}
+
+		if err := r.applyEvent(domainID, requestID, execution, event); err != nil {
+			return err
+		}
+
+		r.msBuilder.executionInfo.NextEventID = event.GetEventId() + 1
+		r.msBuilder.hBuilder.addEventToHistory(event)
+	}
+
+	return nil
+}
+
+func (r *stateRebuilder) applyEvent(domainID, requestID string, execution workflow.WorkflowExecution,
+	event *workflow.HistoryEvent) error {
+	msBuilder := r.msBuilder
+	executionInfo := msBuilder.executionInfo
+	switch event.GetEventType() {
+	case workflow.EventType_WorkflowExecutionStarted:
+		attributes := event.GetWorkflowExecutionStartedEventAttributes()
+		executionInfo.DomainID = domainID
+		executionInfo.WorkflowID = execution.GetWorkflowId()
+		executionInfo.RunID = execution.GetRunId()
+		executionInfo.TaskList = attributes.GetTaskList().GetName()
+		executionInfo.WorkflowTypeName = attributes.GetWorkflowType().GetName()
+		executionInfo.DecisionTimeoutValue = attributes.GetTaskStartToCloseTimeoutSeconds()
+		executionInfo.WorkflowTimeout = attributes.GetExecutionStartToCloseTimeoutSeconds()
+		executionInfo.State = persistence.WorkflowStateCreated
+		executionInfo.CloseStatus = persistence.WorkflowCloseStatusNone
+		executionInfo.LastProcessedEvent = emptyEventID
+		executionInfo.CreateRequestID = requestID
+		executionInfo.CronSchedule = attributes.GetCronSchedule()
+		executionInfo.Attempt = attributes.GetAttempt()
+		if attributes.IsSetExpirationTimestamp() {
+			executionInfo.ExpirationTime = time.Unix(0, attributes.GetExpirationTimestamp())
+		}
+		if attributes.IsSetRetryPolicy() {
+			policy := attributes.GetRetryPolicy()
+			executionInfo.HasRetryPolicy = true
+			executionInfo.InitialInterval = policy.GetInitialIntervalInSeconds()
+			executionInfo.BackoffCoefficient = policy.GetBackoffCoefficient()
+			executionInfo.MaximumInterval = policy.GetMaximumIntervalInSeconds()
+			executionInfo.MaximumAttempts = policy.GetMaximumAttempts()
+			executionInfo.ExpirationSeconds = policy.GetExpirationIntervalInSeconds()
+			executionInfo.NonRetriableErrors = policy.GetNonRetriableErrorReasons()
+		}
+		if attributes.IsSetMemo() {
+			executionInfo.Memo = attributes.GetMemo().GetFields()
+		}
+		if attributes.IsSetSearchAttributes() {
+			executionInfo.SearchAttributes = attributes.GetSearchAttributes().GetIndexedFields()
+		}
+		msBuilder.DeleteDecision()
+
+	case workflow.EventType_DecisionTaskScheduled:
+		attributes := event.GetDecisionTaskScheduledEventAttributes()
+		msBuilder.UpdateDecision(&decisionInfo{
+			ScheduleID:      event.GetEventId(),
+			StartedID:       emptyEventID,
+			RequestID:       emptyUUID,
+			DecisionTimeout: attributes.GetStartToCloseTimeoutSeconds(),
+		})
+
+	case workflow.EventType_DecisionTaskStarted:
+		attributes := event.GetDecisionTaskStartedEventAttributes()
+		executionInfo.DecisionStartedID = event.GetEventId()
+		executionInfo.DecisionRequestID = attributes.GetRequestId()
+		executionInfo.State = persistence.WorkflowStateRunning
+
+	case workflow.EventType_DecisionTaskCompleted:
+		attributes := event.GetDecisionTaskCompletedEventAttributes()
+		executionInfo.LastProcessedEvent = attributes.GetStartedEventId()
+		msBuilder.DeleteDecision()
+
+	case workflow.EventType_DecisionTaskTimedOut, workflow.EventType_DecisionTaskFailed:
+		msBuilder.DeleteDecision()
+
+	case workflow.EventType_ActivityTaskScheduled:
+		return r.applyActivityTaskScheduledEvent(event)
+
+	case workflow.EventType_ActivityTaskStarted:
+		attributes := event.GetActivityTaskStartedEventAttributes()
+		ai, ok := msBuilder.GetActivityInfo(attributes.GetScheduledEventId())
+		if !ok {
+			return r.createMissingStateError(event)
+		}
+		ai.StartedID = event.GetEventId()
+		ai.RequestID = attributes.GetRequestId()
+		ai.Attempt = attributes.GetAttempt()
+
+	case workflow.EventType_ActivityTaskCompleted:
+		return msBuilder.DeleteActivity(event.GetActivityTaskCompletedEventAttributes().GetScheduledEventId())
+
+	case workflow.EventType_ActivityTaskFailed:
+		return msBuilder.DeleteActivity(event.GetActivityTaskFailedEventAttributes().GetScheduledEventId())
+
+	case workflow.EventType_ActivityTaskTimedOut:
+		return msBuilder.DeleteActivity(event.GetActivityTaskTimedOutEventAttributes().GetScheduledEventId())
+
+	case workflow.EventType_ActivityTaskCanceled:
+		return msBuilder.DeleteActivity(event.GetActivityTaskCanceledEventAttributes().GetScheduledEventId())
+
+	case workflow.EventType_ActivityTaskCancelRequested:
+		attributes := event.GetActivityTaskCancelRequestedEventAttributes()
+		if ai, ok := msBuilder.GetActivityByActivityID(attributes.GetActivityId()); ok {
+			ai.CancelRequested = true
+			ai.CancelRequestID = event.GetEventId()
+		}
+
+	case workflow.EventType_TimerStarted:
+		attributes := event.GetTimerStartedEventAttributes()
+		fireTimeout := time.Duration(attributes.GetStartToFireTimeoutSeconds()) * time.Second
+		msBuilder.pendingTimerInfoIDs[attributes.GetTimerId()] = &persistence.TimerInfo{
+			TimerID:    attributes.GetTimerId(),
+			ExpiryTime: time.Unix(0, event.GetTimestamp()).Add(fireTimeout),
+			StartedID:  event.GetEventId(),
+			TaskID:     emptyTimerID,
+		}
+
+	case workflow.EventType_TimerFired:
+		return msBuilder.DeleteUserTimer(event.GetTimerFiredEventAttributes().GetTimerId())
+
+	case workflow.EventType_TimerCanceled:
+		return msBuilder.DeleteUserTimer(event.GetTimerCanceledEventAttributes().GetTimerId())
+
+	case workflow.EventType_StartChildWorkflowExecutionInitiated:
+		initiatedEvent, err := msBuilder.eventSerializer.Serialize(event)
+		if err != nil {
+			return err
+		}
+		msBuilder.pendingChildExecutionInfoIDs[event.GetEventId()] = &persistence.ChildExecutionInfo{
+			InitiatedID:     event.GetEventId(),
+			InitiatedEvent:  initiatedEvent,
+			StartedID:       emptyEventID,
+			CreateRequestID: uuid.New(),
+		}
+
+	case workflow.EventType_ChildWorkflowExecutionStarted:
+		attributes := event.GetChildWorkflowExecutionStartedEventAttributes()
+		ci, ok := msBuilder.GetChildExecutionInfo(attributes.GetInitiatedEventId())
+		if !ok {
+			return r.createMissingStateError(event)
+		}
+		startedEvent, err := msBuilder.eventSerializer.Serialize(event)
+		if err != nil {
+			return err
+		}
+		ci.StartedID = event.GetEventId()
+		ci.StartedEvent = startedEvent
+
+	case workflow.EventType_StartChildWorkflowExecutionFailed:
+		return msBuilder.DeletePendingChildExecution(
+			event.GetStartChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_ChildWorkflowExecutionCompleted:
+		return msBuilder.DeletePendingChildExecution(
+			event.GetChildWorkflowExecutionCompletedEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_ChildWorkflowExecutionFailed:
+		return msBuilder.DeletePendingChildExecution(
+			event.GetChildWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_ChildWorkflowExecutionCanceled:
+		return msBuilder.DeletePendingChildExecution(
+			event.GetChildWorkflowExecutionCanceledEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_ChildWorkflowExecutionTerminated:
+		return msBuilder.DeletePendingChildExecution(
+			event.GetChildWorkflowExecutionTerminatedEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_ChildWorkflowExecutionTimedOut:
+		return msBuilder.DeletePendingChildExecution(
+			event.GetChildWorkflowExecutionTimedOutEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_SignalExternalWorkflowExecutionInitiated:
+		attributes := event.GetSignalExternalWorkflowExecutionInitiatedEventAttributes()
+		msBuilder.pendingSignalInfoIDs[event.GetEventId()] = &persistence.SignalInfo{
+			InitiatedID: event.GetEventId(),
+			SignalName:  attributes.GetSignalName(),
+			Input:       attributes.Input,
+			Control:     attributes.Control,
+		}
+
+	case workflow.EventType_SignalExternalWorkflowExecutionFailed:
+		return msBuilder.DeletePendingSignal(
+			event.GetSignalExternalWorkflowExecutionFailedEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_ExternalWorkflowExecutionSignaled:
+		return msBuilder.DeletePendingSignal(
+			event.GetExternalWorkflowExecutionSignaledEventAttributes().GetInitiatedEventId())
+
+	case workflow.EventType_WorkflowExecutionCancelRequested:
+		executionInfo.CancelRequested = true
+		executionInfo.CancelRequestID = event.GetWorkflowExecutionCancelRequestedEventAttributes().GetRequestId()
+
+	case workflow.EventType_UpsertWorkflowSearchAttributes:
+		msBuilder.mergeSearchAttributes(
+			event.GetUpsertWorkflowSearchAttributesEventAttributes().GetSearchAttributes().GetIndexedFields())
+
+	case workflow.EventType_WorkflowExecutionCompleted, workflow.EventType_WorkflowExecutionFailed,
+		workflow.EventType_WorkflowExecutionTimedOut, workflow.EventType_WorkflowExecutionCanceled,
+		workflow.EventType_WorkflowExecutionTerminated, workflow.EventType_WorkflowExecutionContinuedAsNew:
+		return &workflow.InternalServiceError{Message: fmt.Sprintf(
+			"Unable to replay history event past the close of the execution.  EventID: %v", event.GetEventId())}
+
+	default:
+		// The remaining events, like signals and markers, are only recorded in history and have no mutable state
+	}
+
+	return nil
+}
+
+func (r *stateRebuilder) applyActivityTaskScheduledEvent(event *workflow.HistoryEvent) error {
+	msBuilder := r.msBuilder
+	attributes := event.GetActivityTaskScheduledEventAttributes()
+	scheduledEvent, err := msBuilder.eventSerializer.Serialize(event)
+	if err != nil {
+		return err
+	}
+
+	scheduleToStartTimeout := attributes.GetScheduleToStartTimeoutSeconds()
+	if scheduleToStartTimeout <= 0 {
+		scheduleToStartTimeout = DefaultScheduleToStartActivityTimeoutInSecs
+	}
+	scheduleToCloseTimeout := attributes.GetScheduleToCloseTimeoutSeconds()
+	if scheduleToCloseTimeout <= 0 {
+		scheduleToCloseTimeout = DefaultScheduleToCloseActivityTimeoutInSecs
+	}
+	startToCloseTimeout := attributes.GetStartToCloseTimeoutSeconds()
+	if startToCloseTimeout <= 0 {
+		startToCloseTimeout = DefaultStartToCloseActivityTimeoutInSecs
+	}
+
+	ai := &persistence.ActivityInfo{
+		ScheduleID:             event.GetEventId(),
+		ScheduledEvent:         scheduledEvent,
+		StartedID:              emptyEventID,
+		ActivityID:             attributes.GetActivityId(),
+		ScheduleToStartTimeout: scheduleToStartTimeout,
+		ScheduleToCloseTimeout: scheduleToCloseTimeout,
+		StartToCloseTimeout:    startToCloseTimeout,
+		HeartbeatTimeout:       attributes.GetHeartbeatTimeoutSeconds(),
+		CancelRequestID:        emptyEventID,
+	}
+	if attributes.IsSetRetryPolicy() {
+		policy := attributes.GetRetryPolicy()
+		ai.HasRetryPolicy = true
+		ai.InitialInterval = policy.GetInitialIntervalInSeconds()
+		ai.BackoffCoefficient = policy.GetBackoffCoefficient()
+		ai.MaximumInterval = policy.GetMaximumIntervalInSeconds()
+		ai.MaximumAttempts = policy.GetMaximumAttempts()
+		ai.NonRetriableErrors = policy.GetNonRetriableErrorReasons()
+		if policy.GetExpirationIntervalInSeconds() > 0 {
+			ai.ExpirationTime = time.Unix(0, event.GetTimestamp()).Add(
+				time.Duration(policy.GetExpirationIntervalInSeconds()) * time.Second)
+		}
+	}
+
+	msBuilder.pendingActivityInfoIDs[ai.ScheduleID] = ai
+	msBuilder.pendingActivityInfoByActivityID[ai.ActivityID] = ai.ScheduleID
+	return nil
+}
+
+func (r *stateRebuilder) createMissingStateError(event *workflow.HistoryEvent) error {
+	return &workflow.InternalServiceError{Message: fmt.Sprintf(
+		"Unable to replay history event.  EventID: %v, EventType: %v, no matching mutable state found.",
+		event.GetEventId(), event.GetEventType())}
+}
+
+// getPendingActivityInfos returns all the activities of the rebuilt mutable state, so they can be persisted along with
+// the creation of the run.
+func (r *stateRebuilder) getPendingActivityInfos() []*persistence.ActivityInfo {
+	activityInfos := []*persistence.ActivityInfo{}
+	for _, ai := range r.msBuilder.pendingActivityInfoIDs {
+		activityInfos = append(activityInfos, ai)
+	}
+
+	return activityInfos
+}
+
+// getPendingTimerInfos returns all the user timers of the rebuilt mutable state, so they can be persisted along with
+// the creation of the run.
+func (r *stateRebuilder) getPendingTimerInfos() []*persistence.TimerInfo {
+	timerInfos := []*persistence.TimerInfo{}
+	for _, ti := range r.msBuilder.pendingTimerInfoIDs {
+		timerInfos = append(timerInfos, ti)
+	}
+
+	return timerInfos
+}
+
+// hasPendingExternalRequests returns true if the rebuilt mutable state is waiting on child executions or signals sent
+// to other executions.  Those report back to the run which initiated them, so the new run would never hear back.
+func (r *stateRebuilder) hasPendingExternalRequests() bool {
+	return len(r.msBuilder.pendingChildExecutionInfoIDs) > 0 || len(r.msBuilder.pendingSignalInfoIDs) > 0
+}
@@ -148,27 +148,44 @@ func (tb *timerBuilder) UserTimer(taskID SequenceID) (bool, *persistence.TimerIn
 }
 
 // AddDecisionTimeoutTask - Add a decision timeout task.
-func (tb *timerBuilder) AddDecisionTimoutTask(scheduleID int64,
+func (tb *timerBuilder) AddDecisionTimoutTask(scheduleID, scheduleAttempt int64,
 	startToCloseTimeout int32) *persistence.DecisionTimeoutTask {
-	timeOutTask := tb.createDecisionTimeoutTask(startToCloseTimeout, scheduleID)
+	timeOutTask := tb.createDecisionTimeoutTask(startToCloseTimeout, scheduleID, scheduleAttempt)
 	tb.logger.Debugf("Adding Decision Timeout: SequenceID: %v, EventID: %v",
 		SequenceID(timeOutTask.TaskID), timeOutTask.EventID)
 	return timeOutTask
 }
 
+// AddScheduleToStartDecisionTimoutTask - Add a schedule to start timeout task for a decision on a sticky task list.
+func (tb *timerBuilder) AddScheduleToStartDecisionTimoutTask(scheduleID, scheduleAttempt int64,
+	scheduleToStartTimeout int32) *persistence.DecisionTimeoutTask {
+	timeOutTask := tb.createDecisionTimeoutTask(scheduleToStartTimeout, scheduleID, scheduleAttempt)
+	timeOutTask.TimeoutType = int(w.TimeoutType_SCHEDULE_TO_START)
+	tb.logger.Debugf("Adding Decision Schedule To Start Timeout: SequenceID: %v, EventID: %v",
+		SequenceID(timeOutTask.TaskID), timeOutTask.EventID)
+	return timeOutTask
+}
+
+// AddWorkflowTimeoutTask - Add a workflow timeout task.
+func (tb *timerBuilder) AddWorkflowTimeoutTask(startToCloseTimeout int32) *persistence.WorkflowTimeoutTask {
+	timeOutTask := tb.createWorkflowTimeoutTask(startToCloseTimeout)
+	tb.logger.Debugf("Adding Workflow Timeout: SequenceID: %v", SequenceID(timeOutTask.TaskID))
+	return timeOutTask
+}
+
 func (tb *timerBuilder) AddScheduleToStartActivityTimeout(
 	ai *persistence.ActivityInfo) *persistence.ActivityTimeoutTask {
-	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_SCHEDULE_TO_START, ai.ScheduleToStartTimeout, nil)
+	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_SCHEDULE_TO_START, ai.ScheduleToStartTimeout, ai.Attempt, nil)
 }
 
 func (tb *timerBuilder) AddScheduleToCloseActivityTimeout(
 	ai *persistence.ActivityInfo) (*persistence.ActivityTimeoutTask, error) {
-	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_SCHEDULE_TO_CLOSE, ai.ScheduleToCloseTimeout, nil), nil
+	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_SCHEDULE_TO_CLOSE, ai.ScheduleToCloseTimeout, ai.Attempt, nil), nil
 }
 
 func (tb *timerBuilder) AddStartToCloseActivityTimeout(ai *persistence.ActivityInfo) (*persistence.ActivityTimeoutTask,
 	error) {
-	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_START_TO_CLOSE, ai.StartToCloseTimeout, nil), nil
+	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_START_TO_CLOSE, ai.StartToCloseTimeout, ai.Attempt, nil), nil
 }
 
 func (tb *timerBuilder) AddHeartBeatActivityTimeout(ai *persistence.ActivityInfo) (*persistence.ActivityTimeoutTask,
@@ -177,24 +194,50 @@ func (tb *timerBuilder) AddHeartBeatActivityTimeout(ai *persistence.ActivityInfo
 	// avoid creating timers before the current timer frame.
 	targetTime := common.AddSecondsToBaseTime(ai.LastHeartBeatUpdatedTime.UnixNano(), int64(ai.HeartbeatTimeout))
 	if targetTime > time.Now().UnixNano() {
-		return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_HEARTBEAT, ai.HeartbeatTimeout, &ai.LastHeartBeatUpdatedTime), nil
+		return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_HEARTBEAT, ai.HeartbeatTimeout, ai.Attempt, &ai.LastHeartBeatUpdatedTime), nil
 	}
-	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_HEARTBEAT, ai.HeartbeatTimeout, nil), nil
+	return tb.AddActivityTimeoutTask(ai.ScheduleID, w.TimeoutType_HEARTBEAT, ai.HeartbeatTimeout, ai.Attempt, nil), nil
 }
 
 // AddActivityTimeoutTask - Adds an activity timeout task.
 func (tb *timerBuilder) AddActivityTimeoutTask(scheduleID int64,
-	timeoutType w.TimeoutType, fireTimeout int32, baseTime *time.Time) *persistence.ActivityTimeoutTask {
+	timeoutType w.TimeoutType, fireTimeout int32, attempt int32, baseTime *time.Time) *persistence.ActivityTimeoutTask {
 	if fireTimeout <= 0 {
 		return nil
 	}
 
-	timeOutTask := tb.createActivityTimeoutTask(fireTimeout, timeoutType, scheduleID, baseTime)
+	timeOutTask := tb.createActivityTimeoutTask(fireTimeout, timeoutType, scheduleID, attempt, baseTime)
 	tb.logger.Debugf("Adding Activity Timeout: SequenceID: %v, TimeoutType: %v, EventID: %v",
 		SequenceID(timeOutTask.TaskID), timeoutType.String(), timeOutTask.EventID)
 	return timeOutTask
 }
 
+// AddActivityRetryTimer - Adds a timer to schedule the next attempt of an activity after the backoff interval.
+func (tb *timerBuilder) AddActivityRetryTimer(ai *persistence.ActivityInfo,
+	backoffInterval time.Duration) *persistence.ActivityRetryTimerTask {
+	expiryTime := time.Now().Add(backoffInterval).UnixNano()
+	seqID := ConstructTimerKey(expiryTime, tb.seqNumGen.NextSeq())
+	retryTask := &persistence.ActivityRetryTimerTask{
+		TaskID:  int64(seqID),
+		EventID: ai.ScheduleID,
+		Attempt: int64(ai.Attempt),
+	}
+	tb.logger.Debugf("Adding Activity Retry Timer: SequenceID: %v, Attempt: %v, EventID: %v",
+		SequenceID(retryTask.TaskID), retryTask.Attempt, retryTask.EventID)
+	return retryTask
+}
+
+// AddWorkflowBackoffTimer - Adds a timer to schedule the first decision of a run after the backoff interval.
+func (tb *timerBuilder) AddWorkflowBackoffTimer(backoffInterval time.Duration) *persistence.WorkflowBackoffTimerTask {
+	expiryTime := time.Now().Add(backoffInterval).UnixNano()
+	seqID := ConstructTimerKey(expiryTime, tb.seqNumGen.NextSeq())
+	backoffTask := &persistence.WorkflowBackoffTimerTask{
+		TaskID: int64(seqID),
+	}
+	tb.logger.Debugf("Adding Workflow Backoff Timer: SequenceID: %v", SequenceID(backoffTask.TaskID))
+	return backoffTask
+}
+
 // AddUserTimer - Adds an user timeout request.
 func (tb *timerBuilder) AddUserTimer(ti *persistence.TimerInfo, msBuilder *mutableStateBuilder) persistence.Task {
 	tb.logger.Debugf("Adding User Timeout: %s", ti.TimerID)
@@ -232,18 +275,29 @@ func (tb *timerBuilder) IsTimerExpired(td *timerDetails, referenceTime int64) bo
 }
 
 // createDecisionTimeoutTask - Creates a decision timeout task.
-func (tb *timerBuilder) createDecisionTimeoutTask(fireTimeOut int32, eventID int64) *persistence.DecisionTimeoutTask {
+func (tb *timerBuilder) createDecisionTimeoutTask(fireTimeOut int32, eventID,
+	attempt int64) *persistence.DecisionTimeoutTask {
 	expiryTime := common.AddSecondsToBaseTime(time.Now().UnixNano(), int64(fireTimeOut))
 	seqID := ConstructTimerKey(expiryTime, tb.seqNumGen.NextSeq())
 	return &persistence.DecisionTimeoutTask{
-		TaskID:  int64(seqID),
-		EventID: eventID,
+		TaskID:          int64(seqID),
+		EventID:         eventID,
+		ScheduleAttempt: attempt,
+	}
+}
+
+// createWorkflowTimeoutTask - Creates a workflow timeout task.
+func (tb *timerBuilder) createWorkflowTimeoutTask(fireTimeOut int32) *persistence.WorkflowTimeoutTask {
+	expiryTime := common.AddSecondsToBaseTime(time.Now().UnixNano(), int64(fireTimeOut))
+	seqID := ConstructTimerKey(expiryTime, tb.seqNumGen.NextSeq())
+	return &persistence.WorkflowTimeoutTask{
+		TaskID: int64(seqID),
 	}
 }
 
 // createActivityTimeoutTask - Creates a activity timeout task.
 func (tb *timerBuilder) createActivityTimeoutTask(fireTimeOut int32, timeoutType w.TimeoutType,
-	eventID int64, baseTime *time.Time) *persistence.ActivityTimeoutTask {
+	eventID int64, attempt int32, baseTime *time.Time) *persistence.ActivityTimeoutTask {
 	var expiryTime int64
 	if baseTime != nil {
 		expiryTime = common.AddSecondsToBaseTime(baseTime.UnixNano(), int64(fireTimeOut))
@@ -256,6 +310,7 @@ func (tb *timerBuilder) createActivityTimeoutTask(fireTimeOut int32, timeoutType
 		TaskID:      int64(seqID),
 		TimeoutType: int(timeoutType),
 		EventID:     eventID,
+		Attempt:     int64(attempt),
 	}
 }
 
@@ -67,7 +67,7 @@ func (s *timerBuilderProcessorSuite) TestTimerBuilderSingleUserTimer() {
 	// Add one timer.
 	msb := newMutableStateBuilder(s.logger)
 	msb.Load(&persistence.WorkflowMutableState{
-		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(201)},
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(201), DecisionStartedID: emptyEventID},
 		TimerInfos:    make(map[string]*persistence.TimerInfo),
 	})
 	_, ti1 := msb.AddTimerStartedEvent(int64(3), &workflow.StartTimerDecisionAttributes{
@@ -97,7 +97,7 @@ func (s *timerBuilderProcessorSuite) TestTimerBuilderMulitpleUserTimer() {
 	timerInfos := map[string]*persistence.TimerInfo{"tid1": tp}
 	msb := newMutableStateBuilder(s.logger)
 	msb.Load(&persistence.WorkflowMutableState{
-		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(202)},
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(202), DecisionStartedID: emptyEventID},
 		TimerInfos:    timerInfos,
 	})
 	_, ti1 := msb.AddTimerStartedEvent(int64(3), &workflow.StartTimerDecisionAttributes{
@@ -111,7 +111,7 @@ func (s *timerBuilderProcessorSuite) TestTimerBuilderMulitpleUserTimer() {
 	timerInfos = map[string]*persistence.TimerInfo{"tid1": tp}
 	msb = newMutableStateBuilder(s.logger)
 	msb.Load(&persistence.WorkflowMutableState{
-		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(203)},
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(203), DecisionStartedID: emptyEventID},
 		TimerInfos:    timerInfos,
 	})
 	_, ti2 := msb.AddTimerStartedEvent(int64(3), &workflow.StartTimerDecisionAttributes{
@@ -126,7 +126,7 @@ func (s *timerBuilderProcessorSuite) TestTimerBuilderMulitpleUserTimer() {
 	timerInfos = map[string]*persistence.TimerInfo{"tid1": tp2}
 	msb = newMutableStateBuilder(s.logger)
 	msb.Load(&persistence.WorkflowMutableState{
-		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(203)},
+		ExecutionInfo: &persistence.WorkflowExecutionInfo{NextEventID: int64(203), DecisionStartedID: emptyEventID},
 		TimerInfos:    timerInfos,
 	})
 	_, ti3 := msb.AddTimerStartedEvent(int64(3), &workflow.StartTimerDecisionAttributes{
@@ -429,6 +429,12 @@ func (t *timerQueueProcessorImpl) processTimerTask(key SequenceID) error {
 		err = t.processActivityTimeout(context, timerTask)
 	case persistence.TaskTypeDecisionTimeout:
 		err = t.processDecisionTimeout(context, timerTask)
+	case persistence.TaskTypeWorkflowTimeout:
+		err = t.processWorkflowTimeout(context, timerTask)
+	case persistence.TaskTypeActivityRetryTimer:
+		err = t.processActivityRetryTimer(context, timerTask)
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		err = t.processWorkflowBackoffTimer(context, timerTask)
 	}
 
 	if err != nil {
@@ -502,7 +508,6 @@ Update_History_Loop:
 					// Update the task ID tracking the corresponding timer task.
 					ti.TaskID = nextTask.GetTaskID()
 					msBuilder.UpdateUserTimer(ti.TimerID, ti)
-					defer t.NotifyNewTimer(ti.TaskID)
 				}
 
 				// Done!
@@ -556,6 +561,11 @@ Update_History_Loop:
 			t.logger.Debugf("Activity TimeoutType: %v, scheduledID: %v, startedId: %v. \n",
 				timeoutType, scheduleID, ai.StartedID)
 
+			if timeoutType != workflow.TimeoutType_SCHEDULE_TO_CLOSE && timerTask.Attempt != int64(ai.Attempt) {
+				// Timer was created for a previous attempt of a retried activity.
+				return nil
+			}
+
 			switch timeoutType {
 			case workflow.TimeoutType_SCHEDULE_TO_CLOSE:
 				{
@@ -570,6 +580,12 @@ Update_History_Loop:
 			case workflow.TimeoutType_START_TO_CLOSE:
 				{
 					if ai.StartedID != emptyEventID {
+						if retryTask := t.retryActivity(context, msBuilder, ai, timeoutType); retryTask != nil {
+							timerTasks = append(timerTasks, retryTask)
+							updateHistory = true
+							break
+						}
+
 						if msBuilder.AddActivityTaskTimedOutEvent(scheduleID, ai.StartedID, timeoutType, nil) == nil {
 							return errFailedToAddTimeoutEvent
 						}
@@ -588,6 +604,12 @@ Update_History_Loop:
 
 					if timerTaskExpiryTime > l {
 						t.logger.Debugf("Activity Heartbeat expired: %+v", *ai)
+						if retryTask := t.retryActivity(context, msBuilder, ai, timeoutType); retryTask != nil {
+							timerTasks = append(timerTasks, retryTask)
+							updateHistory = true
+							break
+						}
+
 						if msBuilder.AddActivityTaskTimedOutEvent(scheduleID, ai.StartedID, timeoutType, nil) == nil {
 							return errFailedToAddTimeoutEvent
 						}
@@ -602,7 +624,6 @@ Update_History_Loop:
 						}
 						if hbTimeoutTask != nil {
 							timerTasks = append(timerTasks, hbTimeoutTask)
-							defer t.NotifyNewTimer(hbTimeoutTask.GetTaskID())
 						}
 					}
 				}
@@ -610,6 +631,12 @@ Update_History_Loop:
 			case workflow.TimeoutType_SCHEDULE_TO_START:
 				{
 					if ai.StartedID == emptyEventID {
+						if retryTask := t.retryActivity(context, msBuilder, ai, timeoutType); retryTask != nil {
+							timerTasks = append(timerTasks, retryTask)
+							updateHistory = true
+							break
+						}
+
 						if msBuilder.AddActivityTaskTimedOutEvent(scheduleID, ai.StartedID, timeoutType, nil) == nil {
 							return errFailedToAddTimeoutEvent
 						}
@@ -639,7 +666,18 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
-func (t *timerQueueProcessorImpl) processDecisionTimeout(
+// retryActivity creates the timer for the next attempt of a timed out activity if its retry policy allows one.
+func (t *timerQueueProcessorImpl) retryActivity(context *workflowExecutionContext, msBuilder *mutableStateBuilder,
+	ai *persistence.ActivityInfo, timeoutType workflow.TimeoutType) persistence.Task {
+	backoffInterval, retry := msBuilder.RetryActivity(ai, timeoutFailureReasonPrefix+timeoutType.String())
+	if !retry {
+		return nil
+	}
+
+	return context.tBuilder.AddActivityRetryTimer(ai, backoffInterval)
+}
+
+func (t *timerQueueProcessorImpl) processActivityRetryTimer(
 	context *workflowExecutionContext, task *persistence.TimerTaskInfo) error {
 Update_History_Loop:
 	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
@@ -649,7 +687,6 @@ Update_History_Loop:
 		}
 
 		scheduleID := task.EventID
-
 		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
 		// some extreme cassandra failure cases.
 		if scheduleID >= msBuilder.GetNextEventID() {
@@ -658,13 +695,102 @@ Update_History_Loop:
 			continue Update_History_Loop
 		}
 
+		ai, isRunning := msBuilder.GetActivityInfo(scheduleID)
+		if !isRunning || !msBuilder.isWorkflowExecutionRunning() || int64(ai.Attempt) != task.Attempt ||
+			ai.StartedID != emptyEventID {
+			// Activity is already completed or the retry was already scheduled.
+			return nil
+		}
+
+		scheduledEvent, ok := msBuilder.GetActivityScheduledEvent(scheduleID)
+		if !ok {
+			return &workflow.InternalServiceError{Message: "Corrupted workflow execution state."}
+		}
+		scheduleAttributes := scheduledEvent.GetActivityTaskScheduledEventAttributes()
+
+		targetDomainID := msBuilder.executionInfo.DomainID
+		if scheduleAttributes.IsSetDomain() {
+			info, _, err := t.historyService.domainCache.GetDomain(scheduleAttributes.GetDomain())
+			if err != nil {
+				return err
+			}
+			targetDomainID = info.ID
+		}
+
+		transferTasks := []persistence.Task{&persistence.ActivityTask{
+			DomainID:   targetDomainID,
+			TaskList:   scheduleAttributes.GetTaskList().GetName(),
+			ScheduleID: scheduleID,
+		}}
+		scheduleToStartTimeoutTask := context.tBuilder.AddScheduleToStartActivityTimeout(ai)
+		timerTasks := []persistence.Task{scheduleToStartTimeoutTask}
+		clearTimerTask := &persistence.ActivityRetryTimerTask{TaskID: task.TaskID}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := t.historyService.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return err2
+		}
+
+		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
+		// the history and try the operation again.
+		err := context.updateWorkflowExecutionWithDeleteTask(transferTasks, timerTasks, clearTimerTask, transactionID)
+		if err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+
+			if isShardOwnershiptLostError(err) {
+				// Shard is stolen.  Stop timer processing to reduce duplicates
+				t.Stop()
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+func (t *timerQueueProcessorImpl) processDecisionTimeout(
+	context *workflowExecutionContext, task *persistence.TimerTaskInfo) error {
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+
+		scheduleID := task.EventID
+		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
+
+		// First check to see if cache needs to be refreshed as we could potentially have stale workflow execution in
+		// some extreme cassandra failure cases.  A retried decision is scheduled with the next event ID.
+		if !isRunning && scheduleID >= msBuilder.GetNextEventID() {
+			// Reload workflow execution history
+			context.clear()
+			continue Update_History_Loop
+		}
+
+		// The timer belongs to an earlier attempt of the decision, which is not the one pending
+		if isRunning && di.Attempt != task.Attempt {
+			return nil
+		}
+
 		scheduleNewDecision := false
 		clearTimerTask := &persistence.DecisionTimeoutTask{TaskID: task.TaskID}
 
-		di, isRunning := msBuilder.GetPendingDecision(scheduleID)
 		if isRunning && msBuilder.isWorkflowExecutionRunning() {
-			// Add a decision task timeout event.
-			timeoutEvent := msBuilder.AddDecisionTaskTimedOutEvent(scheduleID, di.StartedID)
+			var timeoutEvent *workflow.HistoryEvent
+			switch workflow.TimeoutType(task.TimeoutType) {
+			case workflow.TimeoutType_SCHEDULE_TO_START:
+				// Decision was not picked up from the sticky task list in time.  Nothing to do if it was started.
+				if di.StartedID != emptyEventID {
+					return nil
+				}
+				timeoutEvent = msBuilder.AddDecisionTaskScheduleToStartTimeoutEvent(scheduleID)
+			default:
+				// Add a decision task timeout event.
+				timeoutEvent = msBuilder.AddDecisionTaskTimedOutEvent(scheduleID, di.StartedID)
+			}
 			if timeoutEvent == nil {
 				// Unable to add DecisionTaskTimedout event to history
 				return &workflow.InternalServiceError{Message: "Unable to add DecisionTaskTimedout event to history."}
@@ -691,13 +817,110 @@ Update_History_Loop:
 	return ErrMaxAttemptsExceeded
 }
 
+func (t *timerQueueProcessorImpl) processWorkflowTimeout(
+	context *workflowExecutionContext, task *persistence.TimerTaskInfo) error {
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() {
+			// Workflow is already completed or continued as new.
+			return nil
+		}
+
+		// Start the next run of the execution instead of timing it out if the retry policy or cron schedule allows it
+		var continueAsNewBuilder *mutableStateBuilder
+		failureReason := timeoutFailureReasonPrefix + workflow.TimeoutType_START_TO_CLOSE.String()
+		if backoffInterval, initiator, ok := msBuilder.GetFailureBackoff(failureReason); ok {
+			newStateBuilder, err := t.historyService.continueAsNewWithBackoff(context, msBuilder, emptyEventID,
+				backoffInterval, initiator, common.StringPtr(failureReason), nil)
+			if err != nil {
+				return err
+			}
+			continueAsNewBuilder = newStateBuilder
+		} else if event, err := msBuilder.AddTimeoutWorkflowEvent(); err != nil {
+			return err
+		} else if event == nil {
+			return errFailedToAddTimeoutEvent
+		}
+
+		// Generate a transfer task to delete workflow execution, which also notifies parent and visibility.
+		transferTasks := []persistence.Task{&persistence.DeleteExecutionTask{}}
+		clearTimerTask := &persistence.WorkflowTimeoutTask{TaskID: task.TaskID}
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := t.historyService.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return err2
+		}
+
+		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
+		// the history and try the operation again.
+		var err error
+		if continueAsNewBuilder != nil {
+			context.deleteTimerTask = clearTimerTask
+			err = context.continueAsNewWorkflowExecution(msBuilder.executionInfo.ExecutionContext, continueAsNewBuilder,
+				transferTasks, transactionID)
+		} else {
+			err = context.updateWorkflowExecutionWithDeleteTask(transferTasks, nil, clearTimerTask, transactionID)
+		}
+		if err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+
+			if isShardOwnershiptLostError(err) {
+				// Shard is stolen.  Stop timer processing to reduce duplicates
+				t.Stop()
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
+func (t *timerQueueProcessorImpl) processWorkflowBackoffTimer(
+	context *workflowExecutionContext, task *persistence.TimerTaskInfo) error {
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return err1
+		}
+
+		if !msBuilder.isWorkflowExecutionRunning() || msBuilder.HasPendingDecisionTask() ||
+			msBuilder.executionInfo.LastProcessedEvent != emptyEventID {
+			// The first decision of the run is already scheduled or the run is closed.
+			return nil
+		}
+
+		// We apply the update to execution using optimistic concurrency.  If it fails due to a conflict than reload
+		// the history and try the operation again.
+		clearTimerTask := &persistence.WorkflowBackoffTimerTask{TaskID: task.TaskID}
+		err := t.updateWorkflowExecution(context, msBuilder, true, nil, clearTimerTask)
+		if err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+		}
+		return err
+	}
+	return ErrMaxAttemptsExceeded
+}
+
 func (t *timerQueueProcessorImpl) updateWorkflowExecution(context *workflowExecutionContext,
 	msBuilder *mutableStateBuilder, scheduleNewDecision bool, timerTasks []persistence.Task,
 	clearTimerTask persistence.Task) error {
 	var transferTasks []persistence.Task
 	if scheduleNewDecision {
 		// Schedule a new decision.
-		newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+		newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+		if err != nil {
+			return err
+		}
 		transferTasks = []persistence.Task{&persistence.DecisionTask{
 			DomainID:   msBuilder.executionInfo.DomainID,
 			TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
@@ -729,6 +952,12 @@ func (t *timerQueueProcessorImpl) getTimerTaskType(taskType int) string {
 		return "ActivityTimeout"
 	case persistence.TaskTypeDecisionTimeout:
 		return "DecisionTimeout"
+	case persistence.TaskTypeWorkflowTimeout:
+		return "WorkflowTimeout"
+	case persistence.TaskTypeActivityRetryTimer:
+		return "ActivityRetryTimer"
+	case persistence.TaskTypeWorkflowBackoffTimer:
+		return "WorkflowBackoffTimer"
 	}
 	return "UnKnown"
 }
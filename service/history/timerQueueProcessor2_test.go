@@ -77,8 +77,15 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 	s.mockShardManager = &mocks.ShardManager{}
 	s.mockHistoryMgr = &mocks.HistoryManager{}
 	s.mockVisibilityMgr = &mocks.VisibilityManager{}
+	s.mockMetadataMgr = &mocks.MetadataManager{}
 	s.shardClosedCh = make(chan int, 100)
 
+	// Workflow execution contexts look up the limits of their domain on every update
+	s.mockMetadataMgr.On("GetDomain", mock.Anything).Return(&persistence.GetDomainResponse{
+		Info:   &persistence.DomainInfo{},
+		Config: &persistence.DomainConfig{},
+	}, nil)
+
 	mockShard := &shardContextImpl{
 		shardInfo:                 &persistence.ShardInfo{ShardID: shardID, RangeID: 1, TransferAckLevel: 0},
 		transferSequenceNumber:    1,
@@ -91,8 +98,8 @@ func (s *timerQueueProcessor2Suite) SetupTest() {
 		logger:                    s.logger,
 	}
 
-	historyCache := newHistoryCache(historyCacheMaxSize, mockShard, s.logger)
 	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
+	historyCache := newHistoryCache(historyCacheMaxSize, mockShard, domainCache, s.logger)
 	txProcessor := newTransferQueueProcessor(mockShard, s.mockVisibilityMgr, s.mockMatchingClient, &mocks.HistoryClient{}, historyCache, domainCache)
 	h := &historyEngineImpl{
 		shard:              mockShard,
@@ -175,3 +182,65 @@ func (s *timerQueueProcessor2Suite) TestTimerUpdateTimesOut() {
 	<-waitCh
 	processor.Stop()
 }
+
+func (s *timerQueueProcessor2Suite) TestActivityScheduleToStartTimeoutRetry() {
+	domainID := "7f3a0c2e-5b8d-4e61-9d2a-3c6b1f0e8a47"
+	we := workflow.WorkflowExecution{WorkflowId: common.StringPtr("activity-schedule-to-start-retry-test"),
+		RunId: common.StringPtr("c2d9e4f1-6a7b-4c8d-9e0f-1a2b3c4d5e6f")}
+	taskList := "activity-schedule-to-start-retry"
+
+	builder := newMutableStateBuilder(s.logger)
+	builder.AddWorkflowExecutionStartedEvent(domainID, we, &workflow.StartWorkflowExecutionRequest{
+		WorkflowType:                   &workflow.WorkflowType{Name: common.StringPtr("wType")},
+		TaskList:                       common.TaskListPtr(workflow.TaskList{Name: common.StringPtr(taskList)}),
+		TaskStartToCloseTimeoutSeconds: common.Int32Ptr(1),
+	})
+	decisionScheduledEvent, _ := addDecisionTaskScheduledEvent(builder)
+	decisionStartedEvent := addDecisionTaskStartedEvent(builder, decisionScheduledEvent.GetEventId(), taskList, uuid.New())
+	decisionCompletedEvent := addDecisionTaskCompletedEvent(builder, decisionScheduledEvent.GetEventId(),
+		decisionStartedEvent.GetEventId(), nil, "identity")
+	activityScheduledEvent, _ := builder.AddActivityTaskScheduledEvent(decisionCompletedEvent.GetEventId(),
+		&workflow.ScheduleActivityTaskDecisionAttributes{
+			ActivityId:                    common.StringPtr("activity1_id"),
+			ActivityType:                  &workflow.ActivityType{Name: common.StringPtr("activity_type1")},
+			TaskList:                      &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ScheduleToCloseTimeoutSeconds: common.Int32Ptr(100),
+			ScheduleToStartTimeoutSeconds: common.Int32Ptr(10),
+			StartToCloseTimeoutSeconds:    common.Int32Ptr(50),
+			RetryPolicy: &workflow.RetryPolicy{
+				InitialIntervalInSeconds: common.Int32Ptr(1),
+				BackoffCoefficient:       common.Float64Ptr(2),
+				MaximumAttempts:          common.Int32Ptr(3),
+			},
+		})
+
+	ms := createMutableState(builder)
+	wfResponse := &persistence.GetWorkflowExecutionResponse{State: ms}
+	s.mockExecutionMgr.On("GetWorkflowExecution", mock.Anything).Return(wfResponse, nil).Once()
+	// Timeout is retried without recording it in the history
+	s.mockExecutionMgr.On("UpdateWorkflowExecution", mock.MatchedBy(func(request *persistence.UpdateWorkflowExecutionRequest) bool {
+		return len(request.TransferTasks) == 0 && len(request.TimerTasks) == 1 &&
+			request.TimerTasks[0].GetType() == persistence.TaskTypeActivityRetryTimer
+	})).Return(nil).Once()
+
+	context, release, err0 := s.mockHistoryEngine.historyCache.getOrCreateWorkflowExecution(domainID, we)
+	s.Nil(err0)
+	defer release()
+
+	processor := newTimerQueueProcessor(s.mockHistoryEngine, s.mockExecutionMgr, s.logger).(*timerQueueProcessorImpl)
+	err := processor.processActivityTimeout(context, &persistence.TimerTaskInfo{
+		DomainID:    domainID,
+		WorkflowID:  we.GetWorkflowId(),
+		RunID:       we.GetRunId(),
+		TaskID:      int64(100),
+		TaskType:    persistence.TaskTypeActivityTimeout,
+		TimeoutType: int(workflow.TimeoutType_SCHEDULE_TO_START),
+		EventID:     activityScheduledEvent.GetEventId(),
+	})
+	s.Nil(err)
+
+	ai, ok := context.msBuilder.GetActivityInfo(activityScheduledEvent.GetEventId())
+	s.True(ok)
+	s.Equal(int32(1), ai.Attempt)
+	s.Equal(emptyEventID, ai.StartedID)
+}
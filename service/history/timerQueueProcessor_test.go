@@ -86,7 +86,8 @@ func (s *timerQueueProcessorSuite) SetupSuite() {
 		closeCh:                   s.shardClosedCh,
 		logger:                    s.logger,
 	}
-	historyCache := newHistoryCache(historyCacheMaxSize, shard, s.logger)
+	historyCache := newHistoryCache(historyCacheMaxSize, shard, cache.NewDomainCache(s.MetadataManager, s.logger),
+		s.logger)
 	historyCache.disabled = true
 	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
 	txProcessor := newTransferQueueProcessor(shard, s.mockVisibilityMgr, &mocks.MatchingClient{}, &mocks.HistoryClient{}, historyCache, domainCache)
@@ -167,7 +168,7 @@ func (s *timerQueueProcessorSuite) addDecisionTimer(domainID string, we workflow
 	scheduledEvent, _ := addDecisionTaskScheduledEvent(builder)
 	addDecisionTaskStartedEvent(builder, scheduledEvent.GetEventId(), state.ExecutionInfo.TaskList, "identity")
 
-	timeOutTask := tb.AddDecisionTimoutTask(scheduledEvent.GetEventId(), 1)
+	timeOutTask := tb.AddDecisionTimoutTask(scheduledEvent.GetEventId(), 0, 1)
 	timerTasks := []persistence.Task{timeOutTask}
 
 	err2 := s.UpdateWorkflowExecution(state.ExecutionInfo, nil, nil, condition, timerTasks, nil, nil, nil, nil, nil)
@@ -217,6 +218,20 @@ func (s *timerQueueProcessorSuite) addHeartBeatTimer(domainID string,
 	return ase, t
 }
 
+func (s *timerQueueProcessorSuite) addWorkflowTimeoutTimer(domainID string, we workflow.WorkflowExecution,
+	tb *timerBuilder) *persistence.WorkflowTimeoutTask {
+	state, err := s.GetWorkflowExecutionInfo(domainID, we)
+	s.Nil(err)
+
+	timeOutTask := tb.AddWorkflowTimeoutTask(1)
+	timerTasks := []persistence.Task{timeOutTask}
+
+	err2 := s.UpdateWorkflowExecution(state.ExecutionInfo, nil, nil, state.ExecutionInfo.NextEventID, timerTasks, nil,
+		nil, nil, nil, nil)
+	s.Nil(err2, "No error expected.")
+	return timeOutTask
+}
+
 func (s *timerQueueProcessorSuite) closeWorkflow(domainID string, we workflow.WorkflowExecution) {
 	state, err := s.GetWorkflowExecutionInfo(domainID, we)
 	s.Nil(err)
@@ -753,6 +768,29 @@ func (s *timerQueueProcessorSuite) TestTimerUserTimersSameExpiry() {
 	s.False(running)
 }
 
+func (s *timerQueueProcessorSuite) TestTimerWorkflowTimeout() {
+	domainID := "5bb49df8-71bc-4c63-b57f-05f2a508e7b5"
+	workflowExecution := workflow.WorkflowExecution{WorkflowId: common.StringPtr("workflow-timeout-test"),
+		RunId: common.StringPtr("0d00698f-08e1-4d36-a3e2-3bf109f5d2d6")}
+
+	taskList := "workflow-timeout-queue"
+	s.createExecutionWithTimers(domainID, workflowExecution, taskList, "identity", []int32{})
+
+	p := newTimerQueueProcessor(s.engineImpl, s.WorkflowMgr, s.logger).(*timerQueueProcessorImpl)
+	p.Start()
+
+	tBuilder := newTimerBuilder(&localSeqNumGenerator{counter: 1}, s.logger)
+	t := s.addWorkflowTimeoutTimer(domainID, workflowExecution, tBuilder)
+	p.NotifyNewTimer(t.GetTaskID())
+	s.waitForTimerTasksToProcess(p)
+	s.Equal(uint64(1), p.timerFiredCount)
+
+	state, err := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	s.Nil(err)
+	s.Equal(persistence.WorkflowStateCompleted, state.ExecutionInfo.State)
+	s.Equal(persistence.WorkflowCloseStatusTimedOut, state.ExecutionInfo.CloseStatus)
+}
+
 func (s *timerQueueProcessorSuite) TestTimersOnClosedWorkflow() {
 	domainID := "5bb49df8-71bc-4c63-b57f-05f2a508e7b5"
 	workflowExecution := workflow.WorkflowExecution{WorkflowId: common.StringPtr("closed-workflow-test"),
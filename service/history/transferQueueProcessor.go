@@ -21,6 +21,7 @@
 package history
 
 import (
+	"fmt"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -33,6 +34,7 @@ import (
 	hc "github.com/uber/cadence/client/history"
 	"github.com/uber/cadence/client/matching"
 	"github.com/uber/cadence/common"
+	"github.com/uber/cadence/common/backoff"
 	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
 	"github.com/uber/cadence/common/metrics"
@@ -47,6 +49,10 @@ const (
 	taskWorkerCount                    = 10
 )
 
+var (
+	errPendingChildExecutionNotFound = &workflow.EntityNotExistsError{Message: "Pending child execution not found."}
+)
+
 type (
 	transferQueueProcessorImpl struct {
 		shard             ShardContext
@@ -267,6 +273,14 @@ ProcessRetryLoop:
 				err = t.processCancelExecution(task)
 			case persistence.TransferTaskTypeStartChildExecution:
 				err = t.processStartChildExecution(task)
+			case persistence.TransferTaskTypeSignalExecution:
+				err = t.processSignalExecution(task)
+			case persistence.TransferTaskTypeRecordWorkflowStarted:
+				err = t.processRecordWorkflowStarted(task)
+			case persistence.TransferTaskTypeTerminateChildExecution:
+				err = t.processTerminateChildExecution(task)
+			case persistence.TransferTaskTypeCancelChildExecution:
+				err = t.processCancelChildExecution(task)
 			}
 
 			if err != nil {
@@ -303,6 +317,7 @@ func (t *transferQueueProcessorImpl) processActivityTask(task *persistence.Trans
 	var mb *mutableStateBuilder
 	mb, err = context.loadWorkflowExecution()
 	timeout := int32(0)
+	attempt := int64(0)
 	if err != nil {
 		release()
 		return err
@@ -310,6 +325,7 @@ func (t *transferQueueProcessorImpl) processActivityTask(task *persistence.Trans
 
 	if ai, found := mb.GetActivityInfo(task.ScheduleID); found {
 		timeout = ai.ScheduleToStartTimeout
+		attempt = int64(ai.Attempt)
 	} else {
 		logging.LogDuplicateTransferTaskEvent(t.logger, persistence.TransferTaskTypeActivityTask, task.TaskID, task.ScheduleID)
 	}
@@ -323,6 +339,7 @@ func (t *transferQueueProcessorImpl) processActivityTask(task *persistence.Trans
 			TaskList:                      taskList,
 			ScheduleId:                    &task.ScheduleID,
 			ScheduleToStartTimeoutSeconds: common.Int32Ptr(timeout),
+			ScheduleAttempt:               common.Int64Ptr(attempt),
 		})
 	}
 	return err
@@ -345,16 +362,87 @@ func (t *transferQueueProcessorImpl) processDecisionTask(task *persistence.Trans
 	taskList := &workflow.TaskList{
 		Name: &task.TaskList,
 	}
-	err = t.matchingClient.AddDecisionTask(nil, &m.AddDecisionTaskRequest{
-		DomainUUID: common.StringPtr(domainID),
-		Execution:  &execution,
-		TaskList:   taskList,
-		ScheduleId: &task.ScheduleID,
-	})
+	stickyTaskList, scheduleToStartTimeout, scheduleAttempt, err := t.getStickyTaskList(execution, task)
+	if err != nil {
+		return err
+	}
+
+	request := &m.AddDecisionTaskRequest{
+		DomainUUID:      common.StringPtr(domainID),
+		Execution:       &execution,
+		TaskList:        taskList,
+		ScheduleId:      &task.ScheduleID,
+		ScheduleAttempt: common.Int64Ptr(scheduleAttempt),
+	}
+	if stickyTaskList != "" {
+		request.TaskList = &workflow.TaskList{Name: common.StringPtr(stickyTaskList)}
+		request.ScheduleToStartTimeoutSeconds = common.Int32Ptr(scheduleToStartTimeout)
+	}
+	err = t.matchingClient.AddDecisionTask(nil, request)
 
 	return err
 }
 
+// getStickyTaskList returns the sticky task list the decision needs to be dispatched to, if the worker which
+// completed the previous decision asked for it.  A schedule to start timer is created for the decision, so it falls
+// back to the task list of the execution if the worker does not pick it up in time.  The timer is only created once
+// for each attempt of the decision, a retried transfer task reuses the one already recorded.  The attempt of the
+// decision is returned as well, retries of a failed decision are all scheduled with the same ID.
+func (t *transferQueueProcessorImpl) getStickyTaskList(execution workflow.WorkflowExecution,
+	task *persistence.TransferTaskInfo) (string, int32, int64, error) {
+	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	defer release()
+
+Update_History_Loop:
+	for attempt := 0; attempt < conditionalRetryCount; attempt++ {
+		msBuilder, err1 := context.loadWorkflowExecution()
+		if err1 != nil {
+			return "", 0, 0, err1
+		}
+
+		di, isPending := msBuilder.GetPendingDecision(task.ScheduleID)
+		if !msBuilder.isWorkflowExecutionRunning() || !isPending || di.StartedID != emptyEventID {
+			return "", 0, 0, nil
+		}
+
+		if !msBuilder.isStickyTaskListEnabled() {
+			return "", 0, di.Attempt, nil
+		}
+
+		executionInfo := msBuilder.executionInfo
+		stickyTaskList := executionInfo.StickyTaskList
+		scheduleToStartTimeout := executionInfo.StickyScheduleToStartTimeout
+		if executionInfo.StickyTimerScheduleID == task.ScheduleID && executionInfo.StickyTimerAttempt == di.Attempt {
+			return stickyTaskList, scheduleToStartTimeout, di.Attempt, nil
+		}
+
+		timeOutTask := context.tBuilder.AddScheduleToStartDecisionTimoutTask(task.ScheduleID, di.Attempt,
+			scheduleToStartTimeout)
+		executionInfo.StickyTimerScheduleID = task.ScheduleID
+		executionInfo.StickyTimerAttempt = di.Attempt
+
+		// Generate a transaction ID for appending events to history
+		transactionID, err2 := t.shard.GetNextTransferTaskID()
+		if err2 != nil {
+			return "", 0, 0, err2
+		}
+
+		if err := context.updateWorkflowExecution(nil, []persistence.Task{timeOutTask}, transactionID); err != nil {
+			if err == ErrConflict {
+				continue Update_History_Loop
+			}
+			return "", 0, 0, err
+		}
+
+		return stickyTaskList, scheduleToStartTimeout, di.Attempt, nil
+	}
+
+	return "", 0, 0, ErrMaxAttemptsExceeded
+}
+
 func (t *transferQueueProcessorImpl) processDeleteExecution(task *persistence.TransferTaskInfo) error {
 	var err error
 	domainID := task.DomainID
@@ -380,8 +468,11 @@ func (t *transferQueueProcessorImpl) processDeleteExecution(task *persistence.Tr
 		return err
 	}
 
-	// Communicate the result to parent execution if this is Child Workflow execution
-	if mb.hasParentExecution() && mb.executionInfo.CloseStatus != persistence.WorkflowCloseStatusContinuedAsNew {
+	// Communicate the result to parent execution if this is Child Workflow execution.  A run which continued as new
+	// or was reset is succeeded by a new run, which reports to the parent once it completes.
+	closeStatus := mb.executionInfo.CloseStatus
+	if mb.hasParentExecution() && closeStatus != persistence.WorkflowCloseStatusContinuedAsNew &&
+		closeStatus != persistence.WorkflowCloseStatusReset {
 		completionEvent, _ := mb.GetCompletionEvent()
 		err = t.historyClient.RecordChildExecutionCompleted(nil, &history.RecordChildExecutionCompletedRequest{
 			DomainUUID: common.StringPtr(mb.executionInfo.ParentDomainID),
@@ -427,6 +518,8 @@ func (t *transferQueueProcessorImpl) processDeleteExecution(task *persistence.Tr
 		CloseTimestamp:   mb.executionInfo.LastUpdatedTimestamp.UnixNano(),
 		Status:           getWorkflowExecutionCloseStatus(mb.executionInfo.CloseStatus),
 		RetentionSeconds: retention,
+		Memo:             mb.executionInfo.Memo,
+		SearchAttributes: mb.executionInfo.SearchAttributes,
 	})
 	if err != nil {
 		return err
@@ -500,6 +593,10 @@ func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistenc
 	var msBuilder *mutableStateBuilder
 	msBuilder, err = context.loadWorkflowExecution()
 	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// Parent execution is already closed and deleted, so the child execution is not started
+			return nil
+		}
 		return err
 	}
 
@@ -508,6 +605,11 @@ func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistenc
 	if isRunning {
 		initiatedEvent, ok := msBuilder.GetChildExecutionInitiatedEvent(initiatedEventID)
 		attributes := initiatedEvent.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+		if !msBuilder.isWorkflowExecutionRunning() && attributes.GetChildPolicy() != workflow.ChildPolicy_ABANDON {
+			// Parent execution is closed and its child policy does not let the child execution outlive it
+			return nil
+		}
+
 		if ok && ci.StartedID == emptyEventID {
 			// Found pending child execution and it is not marked as started
 			// Let's try and start the child execution
@@ -520,6 +622,10 @@ func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistenc
 					Input:        attributes.GetInput(),
 					ExecutionStartToCloseTimeoutSeconds: common.Int32Ptr(attributes.GetExecutionStartToCloseTimeoutSeconds()),
 					TaskStartToCloseTimeoutSeconds:      common.Int32Ptr(attributes.GetTaskStartToCloseTimeoutSeconds()),
+					RetryPolicy:                         attributes.GetRetryPolicy(),
+					DelayStartSeconds:                   common.Int32Ptr(attributes.GetDelayStartSeconds()),
+					Memo:                                attributes.GetMemo(),
+					SearchAttributes:                    attributes.GetSearchAttributes(),
 					// Use the same request ID to dedupe StartWorkflowExecution calls
 					RequestId: common.StringPtr(ci.CreateRequestID),
 				},
@@ -553,8 +659,21 @@ func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistenc
 			// Child execution is successfully started, record ChildExecutionStartedEvent in parent execution
 			err = t.recordChildExecutionStarted(task, context, attributes, startResponse.GetRunId())
 
-			if err != nil {
-				return err
+			if err != nil && err != errPendingChildExecutionNotFound {
+				if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+					return err
+				}
+
+				// Parent execution closed while the child execution was starting, so its child policy could not find
+				// the child.  Apply the policy to the new run, which still needs its first decision unless terminated.
+				switch attributes.GetChildPolicy() {
+				case workflow.ChildPolicy_TERMINATE:
+					return t.terminateChildExecution(task, startResponse.GetRunId())
+				case workflow.ChildPolicy_REQUEST_CANCEL:
+					if err = t.requestCancelChildExecution(task, startResponse.GetRunId()); err != nil {
+						return err
+					}
+				}
 			}
 			// Finally create first decision task for Child execution so it is really started
 			err = t.historyClient.ScheduleDecisionTask(nil, &history.ScheduleDecisionTaskRequest{
@@ -578,6 +697,189 @@ func (t *transferQueueProcessorImpl) processStartChildExecution(task *persistenc
 	return err
 }
 
+// processTerminateChildExecution applies the TERMINATE child policy of a closed parent to one of its children.  The
+// child is only terminated if it was started by this parent, so the task is safe to process more than once.
+func (t *transferQueueProcessorImpl) processTerminateChildExecution(task *persistence.TransferTaskInfo) error {
+	return t.terminateChildExecution(task, task.TargetRunID)
+}
+
+// terminateChildExecution terminates the given run of the child execution of the task.  An empty run ID targets the
+// current run of the child.
+func (t *transferQueueProcessorImpl) terminateChildExecution(task *persistence.TransferTaskInfo, runID string) error {
+	err := t.historyClient.TerminateWorkflowExecution(nil, &history.TerminateWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(task.TargetDomainID),
+		TerminateRequest: &workflow.TerminateWorkflowExecutionRequest{
+			WorkflowExecution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(task.TargetWorkflowID),
+				RunId:      common.StringPtr(runID),
+			},
+			Reason:   common.StringPtr("by parent close policy"),
+			Identity: common.StringPtr("history-service"),
+		},
+		ExternalWorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		},
+		ChildWorkflowOnly: common.BoolPtr(true),
+	})
+
+	// Child execution could already be completed, or not be started yet in which case the start task applies the
+	// policy once it has started the child
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		return nil
+	}
+	return err
+}
+
+// processCancelChildExecution applies the REQUEST_CANCEL child policy of a closed parent to one of its children.  The
+// request ID is derived from the parent run and initiated event so a retried task does not record the cancel twice.
+func (t *transferQueueProcessorImpl) processCancelChildExecution(task *persistence.TransferTaskInfo) error {
+	return t.requestCancelChildExecution(task, task.TargetRunID)
+}
+
+// requestCancelChildExecution requests cancellation of the given run of the child execution of the task.  An empty
+// run ID targets the current run of the child.
+func (t *transferQueueProcessorImpl) requestCancelChildExecution(task *persistence.TransferTaskInfo,
+	runID string) error {
+	err := t.historyClient.RequestCancelWorkflowExecution(nil, &history.RequestCancelWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(task.TargetDomainID),
+		CancelRequest: &workflow.RequestCancelWorkflowExecutionRequest{
+			WorkflowExecution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(task.TargetWorkflowID),
+				RunId:      common.StringPtr(runID),
+			},
+			Identity:  common.StringPtr("history-service"),
+			RequestId: common.StringPtr(fmt.Sprintf("%v:%v", task.RunID, task.ScheduleID)),
+		},
+		ExternalInitiatedEventId: common.Int64Ptr(task.ScheduleID),
+		ExternalWorkflowExecution: &workflow.WorkflowExecution{
+			WorkflowId: common.StringPtr(task.WorkflowID),
+			RunId:      common.StringPtr(task.RunID),
+		},
+		ChildWorkflowOnly: common.BoolPtr(true),
+	})
+
+	// Child execution could already be completed, or not be started yet in which case the start task applies the
+	// policy once it has started the child
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		return nil
+	}
+	return err
+}
+
+func (t *transferQueueProcessorImpl) processSignalExecution(task *persistence.TransferTaskInfo) error {
+	domainID := task.DomainID
+	targetDomainID := task.TargetDomainID
+	execution := workflow.WorkflowExecution{WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId: common.StringPtr(task.RunID)}
+	initiatedEventID := task.ScheduleID
+
+	si, err := t.getPendingSignalInfo(domainID, execution, initiatedEventID)
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); ok {
+			// Signal request was already processed or the execution is gone, complete the transfer task.
+			return nil
+		}
+		return err
+	}
+
+	signalRequest := &history.SignalWorkflowExecutionRequest{
+		DomainUUID: common.StringPtr(targetDomainID),
+		SignalRequest: &workflow.SignalWorkflowExecutionRequest{
+			WorkflowExecution: &workflow.WorkflowExecution{
+				WorkflowId: common.StringPtr(task.TargetWorkflowID),
+				RunId:      common.StringPtr(task.TargetRunID),
+			},
+			SignalName: common.StringPtr(si.SignalName),
+			Input:      si.Input,
+			Identity:   common.StringPtr("history-service"),
+			RequestId:  common.StringPtr(fmt.Sprintf("%v:%v", task.RunID, initiatedEventID)),
+		},
+	}
+
+	// The signal is delivered without holding the lock on the source execution, as a workflow is allowed to
+	// signal itself.  The request ID is derived from the initiated event, so a retried task does not signal twice.
+	op := func() error {
+		return t.historyClient.SignalWorkflowExecution(nil, signalRequest)
+	}
+	err = backoff.Retry(op, persistenceOperationRetryPolicy, common.IsPersistenceTransientError)
+	if err != nil {
+		if _, ok := err.(*workflow.EntityNotExistsError); !ok {
+			return err
+		}
+	}
+	signalErr := err
+
+	context, release, err := t.cache.getOrCreateWorkflowExecution(domainID, execution)
+	defer release()
+	if err != nil {
+		return err
+	}
+
+	err = t.updateWorkflowExecution(domainID, context, true,
+		func(msBuilder *mutableStateBuilder) error {
+			if !msBuilder.isWorkflowExecutionRunning() {
+				return &workflow.EntityNotExistsError{Message: "Workflow execution already completed."}
+			}
+
+			if _, ok := msBuilder.GetSignalInfo(initiatedEventID); !ok {
+				return &workflow.EntityNotExistsError{Message: "Pending signal request not found."}
+			}
+
+			if signalErr == nil {
+				if msBuilder.AddExternalWorkflowExecutionSignaled(initiatedEventID, targetDomainID,
+					task.TargetWorkflowID, task.TargetRunID, si.Control) == nil {
+					return &workflow.InternalServiceError{
+						Message: "Unable to write event to complete request of external signal workflow execution."}
+				}
+				return nil
+			}
+
+			t.logger.Debugf("Failed to signal external workflow execution. Error: %v", signalErr)
+			if msBuilder.AddSignalExternalWorkflowExecutionFailedEvent(emptyEventID, initiatedEventID, targetDomainID,
+				task.TargetWorkflowID, task.TargetRunID, si.Control,
+				workflow.SignalExternalWorkflowExecutionFailedCause_UNKNOWN_EXTERNAL_WORKFLOW_EXECUTION) == nil {
+				return &workflow.InternalServiceError{
+					Message: "Unable to write failure event of external signal workflow execution."}
+			}
+			return nil
+		})
+
+	if _, ok := err.(*workflow.EntityNotExistsError); ok {
+		return nil
+	}
+	return err
+}
+
+func (t *transferQueueProcessorImpl) getPendingSignalInfo(domainID string, execution workflow.WorkflowExecution,
+	initiatedEventID int64) (*persistence.SignalInfo, error) {
+	context, release, err := t.cache.getOrCreateWorkflowExecution(domainID, execution)
+	defer release()
+	if err != nil {
+		return nil, err
+	}
+
+	msBuilder, err := context.loadWorkflowExecution()
+	if err != nil {
+		return nil, err
+	}
+
+	si, ok := msBuilder.GetSignalInfo(initiatedEventID)
+	if !ok || !msBuilder.isWorkflowExecutionRunning() {
+		return nil, &workflow.EntityNotExistsError{Message: "Pending signal request not found."}
+	}
+
+	return si, nil
+}
+
+// processRecordWorkflowStarted records a run started with a backoff in visibility, as it is created without a decision.
+func (t *transferQueueProcessorImpl) processRecordWorkflowStarted(task *persistence.TransferTaskInfo) error {
+	execution := workflow.WorkflowExecution{WorkflowId: common.StringPtr(task.WorkflowID),
+		RunId: common.StringPtr(task.RunID)}
+
+	return t.recordWorkflowExecutionStarted(execution, task)
+}
+
 func (t *transferQueueProcessorImpl) recordWorkflowExecutionStarted(
 	execution workflow.WorkflowExecution, task *persistence.TransferTaskInfo) error {
 	context, release, err := t.cache.getOrCreateWorkflowExecution(task.DomainID, execution)
@@ -590,12 +892,28 @@ func (t *transferQueueProcessorImpl) recordWorkflowExecutionStarted(
 		return err
 	}
 
-	err = t.visibilityManager.RecordWorkflowExecutionStarted(&persistence.RecordWorkflowExecutionStartedRequest{
+	if task.TaskType == persistence.TransferTaskTypeDecisionTask && !mb.executionInfo.FirstDecisionBackoffTime.IsZero() {
+		// Run started with a backoff is already recorded when it was created
+		return nil
+	}
+
+	request := &persistence.RecordWorkflowExecutionStartedRequest{
 		DomainUUID:       task.DomainID,
 		Execution:        execution,
 		WorkflowTypeName: mb.executionInfo.WorkflowTypeName,
 		StartTimestamp:   mb.executionInfo.StartTimestamp.UnixNano(),
-	})
+		Memo:             mb.executionInfo.Memo,
+		SearchAttributes: mb.executionInfo.SearchAttributes,
+	}
+	if task.TaskType == persistence.TransferTaskTypeRecordWorkflowStarted {
+		if !mb.isWorkflowExecutionRunning() {
+			// Closed record carries the latest search attributes and must not be overwritten by the open one
+			return nil
+		}
+		request.UpdateTimestamp = time.Now().UnixNano()
+	}
+
+	err = t.visibilityManager.RecordWorkflowExecutionStarted(request)
 
 	return err
 }
@@ -614,7 +932,7 @@ func (t *transferQueueProcessorImpl) recordChildExecutionStarted(task *persisten
 			initiatedEventID := task.ScheduleID
 			ci, isRunning := msBuilder.GetChildExecutionInfo(initiatedEventID)
 			if !isRunning || ci.StartedID != emptyEventID {
-				return &workflow.EntityNotExistsError{Message: "Pending child execution not found."}
+				return errPendingChildExecutionNotFound
 			}
 
 			msBuilder.AddChildWorkflowExecutionStartedEvent(domain,
@@ -667,7 +985,10 @@ Update_History_Loop:
 		if createDecisionTask {
 			// Create a transfer task to schedule a decision task
 			if !msBuilder.HasPendingDecisionTask() {
-				newDecisionEvent, _ := msBuilder.AddDecisionTaskScheduledEvent()
+				newDecisionEvent, _, err := msBuilder.AddDecisionTaskScheduledEvent()
+				if err != nil {
+					return err
+				}
 				transferTasks = append(transferTasks, &persistence.DecisionTask{
 					DomainID:   domainID,
 					TaskList:   newDecisionEvent.GetDecisionTaskScheduledEventAttributes().GetTaskList().GetName(),
@@ -786,7 +1107,7 @@ func getWorkflowExecutionCloseStatus(status int) workflow.WorkflowExecutionClose
 		return workflow.WorkflowExecutionCloseStatus_FAILED
 	case persistence.WorkflowCloseStatusCanceled:
 		return workflow.WorkflowExecutionCloseStatus_CANCELED
-	case persistence.WorkflowCloseStatusTerminated:
+	case persistence.WorkflowCloseStatusTerminated, persistence.WorkflowCloseStatusReset:
 		return workflow.WorkflowExecutionCloseStatus_TERMINATED
 	case persistence.WorkflowCloseStatusContinuedAsNew:
 		return workflow.WorkflowExecutionCloseStatus_CONTINUED_AS_NEW
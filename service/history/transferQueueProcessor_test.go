@@ -29,6 +29,7 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/uber-common/bark"
+	"github.com/uber/cadence/.gen/go/history"
 	m "github.com/uber/cadence/.gen/go/matching"
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
@@ -69,7 +70,8 @@ func (s *transferQueueProcessorSuite) SetupSuite() {
 	s.mockHistoryClient = &mocks.HistoryClient{}
 	s.mockVisibilityMgr = &mocks.VisibilityManager{}
 	s.mockMetadataMgr = &mocks.MetadataManager{}
-	historyCache := newHistoryCache(historyCacheMaxSize, s.ShardContext, s.logger)
+	historyCache := newHistoryCache(historyCacheMaxSize, s.ShardContext, cache.NewDomainCache(s.MetadataManager, s.logger),
+		s.logger)
 	domainCache := cache.NewDomainCache(s.mockMetadataMgr, s.logger)
 	s.processor = newTransferQueueProcessor(s.ShardContext, s.mockVisibilityMgr, s.mockMatching, s.mockHistoryClient, historyCache, domainCache).(*transferQueueProcessorImpl)
 }
@@ -281,6 +283,92 @@ workerPump:
 	s.mockVisibilityMgr.AssertExpectations(s.T())
 }
 
+func (s *transferQueueProcessorSuite) TestDeleteExecutionTransferTasksChildPolicyTerminate() {
+	domainID := "b27dd2a0-5d3e-4a2e-8a8f-4f0e9a1c0b8a"
+	workflowExecution := workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("delete-execution-child-policy-test"),
+		RunId:      common.StringPtr("5b5a3b7c-4bd1-4f3e-9c2c-2ab2b63c7d0e"),
+	}
+	taskList := "delete-execution-child-policy-queue"
+	identity := "delete-execution-child-policy-test"
+	task0, err0 := s.CreateWorkflowExecution(domainID, workflowExecution, taskList, "wType", 10, nil, 3, 0, 2, nil)
+	s.Nil(err0, "No error expected.")
+	s.NotEmpty(task0, "Expected non empty task identifier.")
+
+	builder := newMutableStateBuilder(s.logger)
+	info1, _ := s.GetWorkflowExecutionInfo(domainID, workflowExecution)
+	builder.Load(info1)
+	startedEvent := addDecisionTaskStartedEvent(builder, int64(2), taskList, identity)
+	completeDecisionEvent := addDecisionTaskCompletedEvent(builder, int64(2), startedEvent.GetEventId(), nil, identity)
+	initiatedEvent, ci := builder.AddStartChildWorkflowExecutionInitiatedEvent(completeDecisionEvent.GetEventId(),
+		"child-request-id", &workflow.StartChildWorkflowExecutionDecisionAttributes{
+			WorkflowId:   common.StringPtr("child-workflow-id"),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr("child-workflow-type")},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(taskList)},
+			ChildPolicy:  workflow.ChildPolicyPtr(workflow.ChildPolicy_TERMINATE),
+		})
+	childExecution := &workflow.WorkflowExecution{
+		WorkflowId: common.StringPtr("child-workflow-id"),
+		RunId:      common.StringPtr("9a7e6c1f-7a4b-4d0e-b8a4-73a3f2f6c1d2"),
+	}
+	builder.AddChildWorkflowExecutionStartedEvent("", childExecution,
+		&workflow.WorkflowType{Name: common.StringPtr("child-workflow-type")}, initiatedEvent.GetEventId())
+
+	updatedInfo1 := copyWorkflowExecutionInfo(builder.executionInfo)
+	err1 := s.UpsertChildExecutionsState(updatedInfo1, int64(3), []*persistence.ChildExecutionInfo{ci})
+	s.Nil(err1, "No error expected.")
+
+	addCompleteWorkflowEvent(builder, completeDecisionEvent.GetEventId(), []byte("result"))
+	updatedInfo2 := copyWorkflowExecutionInfo(builder.executionInfo)
+	err2 := s.UpdateWorkflowExecutionWithTransferTasks(updatedInfo2, updatedInfo1.NextEventID, []persistence.Task{
+		&persistence.DeleteExecutionTask{TaskID: s.GetNextSequenceNumber()},
+		&persistence.TerminateChildExecutionTask{
+			TaskID:           s.GetNextSequenceNumber(),
+			TargetDomainID:   domainID,
+			TargetWorkflowID: childExecution.GetWorkflowId(),
+			TargetRunID:      childExecution.GetRunId(),
+			InitiatedID:      initiatedEvent.GetEventId(),
+		},
+	}, nil)
+	s.Nil(err2, "No error expected.")
+
+	tasksCh := make(chan *persistence.TransferTaskInfo, 10)
+	s.processor.processTransferTasks(tasksCh)
+workerPump:
+	for {
+		select {
+		case task := <-tasksCh:
+			if task.TaskType == persistence.TransferTaskTypeDecisionTask {
+				s.mockMatching.On("AddDecisionTask", mock.Anything, createAddRequestFromTask(task, 0)).Once().Return(nil)
+				if task.ScheduleID == firstEventID+1 {
+					s.mockVisibilityMgr.On("RecordWorkflowExecutionStarted", mock.Anything).Once().Return(nil)
+				}
+			} else if task.TaskType == persistence.TransferTaskTypeDeleteExecution {
+				s.mockMetadataMgr.On("GetDomain", mock.Anything).Once().Return(&persistence.GetDomainResponse{
+					Config: &persistence.DomainConfig{
+						Retention: 3600,
+					},
+				}, nil)
+				s.mockVisibilityMgr.On("RecordWorkflowExecutionClosed", mock.Anything).Once().Return(nil)
+			} else if task.TaskType == persistence.TransferTaskTypeTerminateChildExecution {
+				s.mockHistoryClient.On("TerminateWorkflowExecution", mock.Anything, mock.MatchedBy(
+					func(request *history.TerminateWorkflowExecutionRequest) bool {
+						return request.GetChildWorkflowOnly() &&
+							request.GetTerminateRequest().GetWorkflowExecution().GetRunId() == childExecution.GetRunId() &&
+							request.GetExternalWorkflowExecution().GetRunId() == workflowExecution.GetRunId()
+					})).Return(nil).Once()
+			}
+			s.processor.processTransferTask(task)
+		default:
+			break workerPump
+		}
+	}
+
+	s.mockMatching.AssertExpectations(s.T())
+	s.mockVisibilityMgr.AssertExpectations(s.T())
+	s.mockHistoryClient.AssertExpectations(s.T())
+}
+
 func (s *transferQueueProcessorSuite) TestCancelRemoteExecutionTransferTasks() {
 	domainID := "f5f1ece7-000d-495d-81c3-918ac29006ed"
 	workflowExecution := workflow.WorkflowExecution{
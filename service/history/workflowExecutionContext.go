@@ -29,7 +29,9 @@ import (
 	workflow "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
+	"github.com/uber/cadence/common/cache"
 	"github.com/uber/cadence/common/logging"
+	"github.com/uber/cadence/common/metrics"
 	"github.com/uber/cadence/common/persistence"
 
 	"github.com/uber-common/bark"
@@ -42,6 +44,7 @@ type (
 		workflowExecution workflow.WorkflowExecution
 		shard             ShardContext
 		executionManager  persistence.ExecutionManager
+		domainCache       cache.DomainCache
 		logger            bark.Logger
 
 		sync.Mutex
@@ -50,6 +53,26 @@ type (
 		updateCondition int64
 		deleteTimerTask persistence.Task
 	}
+
+	workflowLimit struct {
+		name         string
+		reason       string
+		value        int64
+		warnLimit    int64
+		errorLimit   int64
+		warnCounter  int
+		errorCounter int
+	}
+)
+
+// Limits used for executions of domains which do not override them
+const (
+	defaultHistorySizeLimitWarn   = 50 * 1024 * 1024
+	defaultHistorySizeLimitError  = 200 * 1024 * 1024
+	defaultHistoryCountLimitWarn  = 50000
+	defaultHistoryCountLimitError = 200000
+	defaultPendingLimitWarn       = 1000
+	defaultPendingLimitError      = 10000
 )
 
 var (
@@ -57,7 +80,8 @@ var (
 )
 
 func newWorkflowExecutionContext(domainID string, execution workflow.WorkflowExecution, shard ShardContext,
-	executionManager persistence.ExecutionManager, logger bark.Logger) *workflowExecutionContext {
+	executionManager persistence.ExecutionManager, domainCache cache.DomainCache,
+	logger bark.Logger) *workflowExecutionContext {
 	lg := logger.WithFields(bark.Fields{
 		logging.TagWorkflowExecutionID: execution.GetWorkflowId(),
 		logging.TagWorkflowRunID:       execution.GetRunId(),
@@ -69,6 +93,7 @@ func newWorkflowExecutionContext(domainID string, execution workflow.WorkflowExe
 		workflowExecution: execution,
 		shard:             shard,
 		executionManager:  executionManager,
+		domainCache:       domainCache,
 		tBuilder:          tBuilder,
 		logger:            lg,
 	}
@@ -117,17 +142,28 @@ func (c *workflowExecutionContext) updateWorkflowExecutionWithDeleteTask(transfe
 func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persistence.Task,
 	timerTasks []persistence.Task, transactionID int64) error {
 	// Take a snapshot of all updates we have accumulated for this execution
-	updates := c.msBuilder.CloseUpdateSession()
+	updates, err := c.msBuilder.CloseUpdateSession()
+	if err != nil {
+		return err
+	}
 
-	builder := updates.newEventsBuilder
-	if builder.history != nil && len(builder.history) > 0 {
-		// Some operations only update the mutable state. For example RecordActivityTaskHeartbeat.
-		firstEvent := builder.history[0]
-		serializedHistory, err := builder.Serialize()
-		if err != nil {
-			logging.LogHistorySerializationErrorEvent(c.logger, err, "Unable to serialize execution history for update.")
-			return err
-		}
+	// The new events are serialized once, their size counts against the history size limit before they are appended
+	serializedHistory, err := c.serializeNewEvents(updates)
+	if err != nil {
+		return err
+	}
+	transferTasks, serializedHistory, err = c.enforceWorkflowLimits(transferTasks, updates, serializedHistory)
+	if err != nil {
+		return err
+	}
+	transferTasks, err = c.applyChildPolicy(transferTasks)
+	if err != nil {
+		return err
+	}
+
+	if serializedHistory != nil {
+		firstEvent := updates.newEventsBuilder.history[0]
+		c.msBuilder.executionInfo.HistorySize += int64(len(serializedHistory.Data))
 
 		if err0 := c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
 			DomainID:      c.domainID,
@@ -148,7 +184,6 @@ func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persi
 				fmt.Sprintf("{updateCondition: %v}", c.updateCondition))
 			return err0
 		}
-
 	}
 
 	continueAsNew := updates.continueAsNew
@@ -170,6 +205,11 @@ func (c *workflowExecutionContext) updateWorkflowExecution(transferTasks []persi
 		DeleteTimerInfos:          updates.deleteTimerInfos,
 		UpsertChildExecutionInfos: updates.updateChildExecutionInfos,
 		DeleteChildExecutionInfo:  updates.deleteChildExecutionInfo,
+		UpsertSignalInfos:         updates.updateSignalInfos,
+		DeleteSignalInfo:          updates.deleteSignalInfo,
+		UpsertSignalRequestedIDs:  updates.updateSignalRequestedIDs,
+		NewBufferedEvents:         updates.newBufferedEvents,
+		ClearBufferedEvents:       updates.clearBufferedEvents,
 		ContinueAsNew:             continueAsNew,
 		CloseExecution:            deleteExecution,
 	}); err1 != nil {
@@ -210,6 +250,7 @@ func (c *workflowExecutionContext) continueAsNewWorkflowExecution(context []byte
 			newExecution.GetRunId()))
 		return serializedError
 	}
+	c.msBuilder.continueAsNew.HistorySize = int64(len(serializedHistory.Data))
 
 	err1 := c.shard.AppendHistoryEvents(&persistence.AppendHistoryEventsRequest{
 		DomainID:  domainID,
@@ -233,6 +274,223 @@ func (c *workflowExecutionContext) continueAsNewWorkflowExecution(context []byte
 	return err2
 }
 
+// serializeNewEvents serializes the events of an update session, it returns nil if the session has no events.
+func (c *workflowExecutionContext) serializeNewEvents(
+	updates *mutableStateSessionUpdates) (*persistence.SerializedHistoryEventBatch, error) {
+	builder := updates.newEventsBuilder
+	if len(builder.history) == 0 {
+		// Some operations only update the mutable state. For example RecordActivityTaskHeartbeat.
+		return nil, nil
+	}
+
+	serializedHistory, err := builder.Serialize()
+	if err != nil {
+		logging.LogHistorySerializationErrorEvent(c.logger, err, "Unable to serialize execution history for update.")
+		return nil, err
+	}
+	return serializedHistory, nil
+}
+
+// enforceWorkflowLimits checks the execution against the history size, history count and pending limits of its
+// domain.  Crossing a warn limit is only reported, while crossing an error limit terminates the execution as part
+// of the update.  The history size includes the serialized events appended by the update.  It returns the transfer
+// tasks and serialized events to write, the termination is added to the updates of the session.
+func (c *workflowExecutionContext) enforceWorkflowLimits(transferTasks []persistence.Task,
+	updates *mutableStateSessionUpdates, serializedHistory *persistence.SerializedHistoryEventBatch) (
+	[]persistence.Task, *persistence.SerializedHistoryEventBatch, error) {
+	if !c.msBuilder.isWorkflowExecutionRunning() {
+		return transferTasks, serializedHistory, nil
+	}
+
+	pendingHistorySize := int64(0)
+	if serializedHistory != nil {
+		pendingHistorySize = int64(len(serializedHistory.Data))
+	}
+
+	for _, limit := range c.getWorkflowLimits(pendingHistorySize) {
+		if limit.value > limit.errorLimit {
+			c.shard.GetMetricsClient().IncCounter(metrics.HistoryWorkflowLimitsScope, limit.errorCounter)
+			logging.LogWorkflowLimitErrorEvent(c.logger, limit.name, limit.value, limit.errorLimit)
+			event, err := c.msBuilder.AddWorkflowExecutionTerminatedEvent(&workflow.TerminateWorkflowExecutionRequest{
+				Reason:   common.StringPtr(limit.reason),
+				Details:  []byte(fmt.Sprintf("%v: %v, limit: %v", limit.name, limit.value, limit.errorLimit)),
+				Identity: common.StringPtr("history-service"),
+			})
+			if err != nil {
+				return nil, nil, err
+			}
+			if event == nil {
+				return nil, nil, &workflow.InternalServiceError{Message: "Unable to terminate workflow execution."}
+			}
+
+			// The termination is written along with the session which crossed the limit
+			terminateUpdates, err := c.msBuilder.CloseUpdateSession()
+			if err != nil {
+				return nil, nil, err
+			}
+			updates.merge(terminateUpdates)
+			if serializedHistory, err = c.serializeNewEvents(updates); err != nil {
+				return nil, nil, err
+			}
+
+			// Create a transfer task to delete workflow execution
+			return append(transferTasks, &persistence.DeleteExecutionTask{}), serializedHistory, nil
+		}
+
+		if limit.value > limit.warnLimit {
+			c.shard.GetMetricsClient().IncCounter(metrics.HistoryWorkflowLimitsScope, limit.warnCounter)
+			logging.LogWorkflowLimitWarnEvent(c.logger, limit.name, limit.value, limit.warnLimit)
+		}
+	}
+
+	return transferTasks, serializedHistory, nil
+}
+
+// applyChildPolicy adds a transfer task for each pending child execution which has to be terminated or cancelled
+// when the execution closes as part of this update, so the child policy is applied in the same write as the close.
+func (c *workflowExecutionContext) applyChildPolicy(transferTasks []persistence.Task) ([]persistence.Task, error) {
+	if !isClosingExecution(transferTasks) ||
+		c.msBuilder.executionInfo.CloseStatus == persistence.WorkflowCloseStatusContinuedAsNew {
+		return transferTasks, nil
+	}
+
+	for initiatedID, ci := range c.msBuilder.pendingChildExecutionInfoIDs {
+		initiatedEvent, ok := c.msBuilder.GetChildExecutionInitiatedEvent(initiatedID)
+		if !ok {
+			return nil, &workflow.InternalServiceError{Message: "Unable to load child execution initiated event."}
+		}
+		attributes := initiatedEvent.GetStartChildWorkflowExecutionInitiatedEventAttributes()
+
+		childPolicy := attributes.GetChildPolicy()
+		if childPolicy != workflow.ChildPolicy_TERMINATE && childPolicy != workflow.ChildPolicy_REQUEST_CANCEL {
+			// Child execution keeps running after parent is closed
+			continue
+		}
+
+		targetDomainID, err := c.getTargetDomainID(attributes.GetDomain())
+		if err != nil {
+			return nil, err
+		}
+
+		// Child execution which is not started yet is targeted by its workflow ID only
+		targetRunID := ""
+		if ci.StartedID != emptyEventID {
+			startedEvent, ok := c.msBuilder.GetChildExecutionStartedEvent(initiatedID)
+			if !ok {
+				return nil, &workflow.InternalServiceError{Message: "Unable to load child execution started event."}
+			}
+			targetRunID = startedEvent.GetChildWorkflowExecutionStartedEventAttributes().GetWorkflowExecution().GetRunId()
+		}
+
+		if childPolicy == workflow.ChildPolicy_TERMINATE {
+			transferTasks = append(transferTasks, &persistence.TerminateChildExecutionTask{
+				TargetDomainID:   targetDomainID,
+				TargetWorkflowID: attributes.GetWorkflowId(),
+				TargetRunID:      targetRunID,
+				InitiatedID:      initiatedID,
+			})
+		} else {
+			transferTasks = append(transferTasks, &persistence.CancelChildExecutionTask{
+				TargetDomainID:   targetDomainID,
+				TargetWorkflowID: attributes.GetWorkflowId(),
+				TargetRunID:      targetRunID,
+				InitiatedID:      initiatedID,
+			})
+		}
+	}
+
+	return transferTasks, nil
+}
+
+func (c *workflowExecutionContext) getTargetDomainID(domain string) (string, error) {
+	if domain == "" {
+		return c.domainID, nil
+	}
+
+	info, _, err := c.domainCache.GetDomain(domain)
+	if err != nil {
+		return "", err
+	}
+	return info.ID, nil
+}
+
+func isClosingExecution(transferTasks []persistence.Task) bool {
+	for _, task := range transferTasks {
+		if task.GetType() == persistence.TransferTaskTypeDeleteExecution {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *workflowExecutionContext) getWorkflowLimits(pendingHistorySize int64) []workflowLimit {
+	config := &persistence.DomainConfig{}
+	_, domainConfig, err := c.domainCache.GetDomainByID(c.domainID)
+	if err != nil {
+		// Do not fail the update because of the domain lookup, fallback to the default limits instead
+		c.logger.Warnf("Unable to load limits of domain, using default limits.  DomainID: %v, Error: %v",
+			c.domainID, err)
+	} else {
+		config = domainConfig
+	}
+
+	msBuilder := c.msBuilder
+	return []workflowLimit{
+		{
+			name:         "history size",
+			reason:       "History size limit exceeded",
+			value:        msBuilder.executionInfo.HistorySize + pendingHistorySize,
+			warnLimit:    getLimitOrDefault(config.HistorySizeLimitWarn, defaultHistorySizeLimitWarn),
+			errorLimit:   getLimitOrDefault(config.HistorySizeLimitError, defaultHistorySizeLimitError),
+			warnCounter:  metrics.HistorySizeLimitWarnCounter,
+			errorCounter: metrics.HistorySizeLimitErrorCounter,
+		},
+		{
+			name:         "history count",
+			reason:       "History count limit exceeded",
+			value:        msBuilder.GetNextEventID() - 1,
+			warnLimit:    getLimitOrDefault(config.HistoryCountLimitWarn, defaultHistoryCountLimitWarn),
+			errorLimit:   getLimitOrDefault(config.HistoryCountLimitError, defaultHistoryCountLimitError),
+			warnCounter:  metrics.HistoryCountLimitWarnCounter,
+			errorCounter: metrics.HistoryCountLimitErrorCounter,
+		},
+		{
+			name:         "pending activities",
+			reason:       "Pending activities limit exceeded",
+			value:        int64(len(msBuilder.pendingActivityInfoIDs)),
+			warnLimit:    getLimitOrDefault(config.PendingLimitWarn, defaultPendingLimitWarn),
+			errorLimit:   getLimitOrDefault(config.PendingLimitError, defaultPendingLimitError),
+			warnCounter:  metrics.PendingLimitWarnCounter,
+			errorCounter: metrics.PendingLimitErrorCounter,
+		},
+		{
+			name:         "pending timers",
+			reason:       "Pending timers limit exceeded",
+			value:        int64(len(msBuilder.pendingTimerInfoIDs)),
+			warnLimit:    getLimitOrDefault(config.PendingLimitWarn, defaultPendingLimitWarn),
+			errorLimit:   getLimitOrDefault(config.PendingLimitError, defaultPendingLimitError),
+			warnCounter:  metrics.PendingLimitWarnCounter,
+			errorCounter: metrics.PendingLimitErrorCounter,
+		},
+		{
+			name:         "pending child workflows",
+			reason:       "Pending child workflows limit exceeded",
+			value:        int64(len(msBuilder.pendingChildExecutionInfoIDs)),
+			warnLimit:    getLimitOrDefault(config.PendingLimitWarn, defaultPendingLimitWarn),
+			errorLimit:   getLimitOrDefault(config.PendingLimitError, defaultPendingLimitError),
+			warnCounter:  metrics.PendingLimitWarnCounter,
+			errorCounter: metrics.PendingLimitErrorCounter,
+		},
+	}
+}
+
+func getLimitOrDefault(limit int32, defaultLimit int64) int64 {
+	if limit > 0 {
+		return int64(limit)
+	}
+	return defaultLimit
+}
+
 func (c *workflowExecutionContext) deleteWorkflowExecution() error {
 	err := c.deleteWorkflowExecutionWithRetry(&persistence.DeleteWorkflowExecutionRequest{
 		ExecutionInfo: c.msBuilder.executionInfo,
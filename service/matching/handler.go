@@ -108,3 +108,26 @@ func (h *Handler) PollForDecisionTask(ctx thrift.Context,
 	h.Service.GetLogger().Debug("Engine returned from PollForDecisionTask")
 	return response, error
 }
+
+// QueryWorkflow queries a given workflow synchronously and return the query result.
+func (h *Handler) QueryWorkflow(ctx thrift.Context,
+	queryRequest *m.QueryWorkflowRequest) (*gen.QueryWorkflowResponse, error) {
+	h.Service.GetLogger().Debug("Engine Received QueryWorkflow")
+	h.startWG.Wait()
+	return h.engine.QueryWorkflow(ctx, queryRequest)
+}
+
+// RespondQueryTaskCompleted responds a query task completed
+func (h *Handler) RespondQueryTaskCompleted(ctx thrift.Context, request *m.RespondQueryTaskCompletedRequest) error {
+	h.Service.GetLogger().Debug("Engine Received RespondQueryTaskCompleted")
+	h.startWG.Wait()
+	return h.engine.RespondQueryTaskCompleted(ctx, request)
+}
+
+// DescribeTaskList returns information about the target task list
+func (h *Handler) DescribeTaskList(ctx thrift.Context, request *m.DescribeTaskListRequest) (*gen.DescribeTaskListResponse,
+	error) {
+	h.Service.GetLogger().Debug("Engine Received DescribeTaskList")
+	h.startWG.Wait()
+	return h.engine.DescribeTaskList(ctx, request)
+}
@@ -22,6 +22,7 @@ package matching
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -53,6 +54,8 @@ type matchingEngineImpl struct {
 	longPollExpirationInterval time.Duration
 	taskListsLock              sync.RWMutex                   // locks mutation of taskLists
 	taskLists                  map[taskListID]taskListManager // Convert to LRU cache
+	queryResultsLock           sync.Mutex                     // locks mutation of queryResults
+	queryResults               map[string]chan *queryResult   // query tasks waiting for the worker response
 }
 
 type taskListID struct {
@@ -61,6 +64,12 @@ type taskListID struct {
 	taskType     int
 }
 
+// queryResult is the worker answer to a query task, delivered to the QueryWorkflow call waiting on it
+type queryResult struct {
+	workerResponse *m.RespondQueryTaskCompletedRequest
+	err            error
+}
+
 const (
 	defaultLongPollExpirationInterval = time.Minute
 	emptyGetRetryInitialInterval      = 100 * time.Millisecond
@@ -78,6 +87,8 @@ var (
 	// ErrNoTasks is exported temporarily for integration test
 	ErrNoTasks    = errors.New("No tasks")
 	errPumpClosed = errors.New("Task list pump closed its channel")
+
+	errQueryTaskNotFound = &workflow.EntityNotExistsError{Message: "Query task not found, or already expired."}
 )
 
 func (t *taskListID) String() string {
@@ -102,6 +113,7 @@ func NewEngine(taskManager persistence.TaskManager, historyService history.Clien
 		historyService:             historyService,
 		tokenSerializer:            common.NewJSONTaskTokenSerializer(),
 		taskLists:                  make(map[taskListID]taskListManager),
+		queryResults:               make(map[string]chan *queryResult),
 		rangeSize:                  defaultRangeSize,
 		longPollExpirationInterval: defaultLongPollExpirationInterval,
 		logger: logger.WithFields(bark.Fields{
@@ -189,10 +201,12 @@ func (e *matchingEngineImpl) AddDecisionTask(addRequest *m.AddDecisionTaskReques
 		return err
 	}
 	taskInfo := &persistence.TaskInfo{
-		DomainID:   domainID,
-		RunID:      addRequest.GetExecution().GetRunId(),
-		WorkflowID: addRequest.GetExecution().GetWorkflowId(),
-		ScheduleID: addRequest.GetScheduleId(),
+		DomainID:               domainID,
+		RunID:                  addRequest.GetExecution().GetRunId(),
+		WorkflowID:             addRequest.GetExecution().GetWorkflowId(),
+		ScheduleID:             addRequest.GetScheduleId(),
+		ScheduleAttempt:        addRequest.GetScheduleAttempt(),
+		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 	}
 	return tlMgr.AddTask(addRequest.GetExecution(), taskInfo)
 }
@@ -214,6 +228,7 @@ func (e *matchingEngineImpl) AddActivityTask(addRequest *m.AddActivityTaskReques
 		RunID:                  addRequest.GetExecution().GetRunId(),
 		WorkflowID:             addRequest.GetExecution().GetWorkflowId(),
 		ScheduleID:             addRequest.GetScheduleId(),
+		ScheduleAttempt:        addRequest.GetScheduleAttempt(),
 		ScheduleToStartTimeout: addRequest.GetScheduleToStartTimeoutSeconds(),
 	}
 	return tlMgr.AddTask(addRequest.GetExecution(), taskInfo)
@@ -234,7 +249,7 @@ pollLoop:
 		}
 
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
-		tCtx, err := e.getTask(ctx, taskList)
+		tCtx, err := e.getTask(ctx, taskList, request.GetIdentity())
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -243,12 +258,27 @@ pollLoop:
 			return nil, err
 		}
 
+		if tCtx.queryTask != nil {
+			// Query tasks are not persisted and do not change the workflow, so nothing is recorded in history
+			resp, err := e.historyService.GetWorkflowExecutionNextEventID(nil, &h.GetWorkflowExecutionNextEventIDRequest{
+				DomainUUID: common.StringPtr(domainID),
+				Execution:  &tCtx.workflowExecution,
+			})
+			if err != nil {
+				// Fail the query right away instead of letting the caller wait for its timeout
+				e.deliverQueryResult(tCtx.queryTask.taskID, &queryResult{err: err})
+				continue pollLoop
+			}
+			return e.createPollForDecisionTaskResponseForQuery(tCtx, resp), nil
+		}
+
 		// Generate a unique requestId for this task which will be used for all retries
 		requestID := uuid.New()
 		resp, err := tCtx.RecordDecisionTaskStartedWithRetry(&h.RecordDecisionTaskStartedRequest{
 			DomainUUID:        common.StringPtr(domainID),
 			WorkflowExecution: &tCtx.workflowExecution,
 			ScheduleId:        &tCtx.info.ScheduleID,
+			ScheduleAttempt:   &tCtx.info.ScheduleAttempt,
 			TaskId:            &tCtx.info.TaskID,
 			RequestId:         common.StringPtr(requestID),
 			PollRequest:       request,
@@ -285,7 +315,7 @@ pollLoop:
 		}
 
 		taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeActivity)
-		tCtx, err := e.getTask(ctx, taskList)
+		tCtx, err := e.getTask(ctx, taskList, request.GetIdentity())
 		if err != nil {
 			// TODO: Is empty poll the best reply for errPumpClosed?
 			if err == ErrNoTasks || err == errPumpClosed {
@@ -299,6 +329,7 @@ pollLoop:
 			DomainUUID:        common.StringPtr(domainID),
 			WorkflowExecution: &tCtx.workflowExecution,
 			ScheduleId:        &tCtx.info.ScheduleID,
+			ScheduleAttempt:   &tCtx.info.ScheduleAttempt,
 			TaskId:            &tCtx.info.TaskID,
 			RequestId:         common.StringPtr(requestID),
 			PollRequest:       request,
@@ -318,13 +349,108 @@ pollLoop:
 	}
 }
 
+// QueryWorkflow dispatches a query task to a poller on the decision task list of the workflow and waits for the worker
+// to respond with the query result.  Query tasks are only handed out through sync match and are never persisted.
+func (e *matchingEngineImpl) QueryWorkflow(ctx thrift.Context, queryRequest *m.QueryWorkflowRequest) (
+	*workflow.QueryWorkflowResponse, error) {
+	domainID := queryRequest.GetDomainUUID()
+	taskListName := queryRequest.GetTaskList().GetName()
+	e.logger.Debugf("Received QueryWorkflow for taskList=%v", taskListName)
+	taskList := newTaskListID(domainID, taskListName, persistence.TaskListTypeDecision)
+	tlMgr, err := e.getTaskListManager(taskList)
+	if err != nil {
+		return nil, err
+	}
+
+	queryTask := &queryTaskInfo{
+		taskID:       uuid.New(),
+		queryRequest: queryRequest,
+	}
+	resultCh := make(chan *queryResult, 1)
+	e.queryResultsLock.Lock()
+	e.queryResults[queryTask.taskID] = resultCh
+	e.queryResultsLock.Unlock()
+	defer func() {
+		e.queryResultsLock.Lock()
+		delete(e.queryResults, queryTask.taskID)
+		e.queryResultsLock.Unlock()
+	}()
+
+	if err := tlMgr.SyncMatchQueryTask(ctx, queryTask); err != nil {
+		return nil, err
+	}
+
+	select {
+	case result := <-resultCh:
+		if result.err != nil {
+			return nil, result.err
+		}
+
+		completedRequest := result.workerResponse.GetCompletedRequest()
+		switch completedRequest.GetCompletedType() {
+		case workflow.QueryTaskCompletedType_COMPLETED:
+			return &workflow.QueryWorkflowResponse{QueryResult_: completedRequest.GetQueryResult_()}, nil
+		case workflow.QueryTaskCompletedType_FAILED:
+			return nil, &workflow.QueryFailedError{Message: completedRequest.GetErrorMessage()}
+		default:
+			return nil, &workflow.InternalServiceError{
+				Message: fmt.Sprintf("Unknown query task completed type: %v", completedRequest.GetCompletedType()),
+			}
+		}
+	case <-ctx.Done():
+		return nil, &workflow.QueryFailedError{Message: "Timed out waiting for the worker to answer the query."}
+	}
+}
+
+// RespondQueryTaskCompleted delivers the query result from the worker to the waiting QueryWorkflow call.
+func (e *matchingEngineImpl) RespondQueryTaskCompleted(ctx thrift.Context,
+	request *m.RespondQueryTaskCompletedRequest) error {
+	return e.deliverQueryResult(request.GetTaskID(), &queryResult{workerResponse: request})
+}
+
+func (e *matchingEngineImpl) deliverQueryResult(taskID string, result *queryResult) error {
+	e.queryResultsLock.Lock()
+	resultCh, ok := e.queryResults[taskID]
+	e.queryResultsLock.Unlock()
+	if !ok {
+		return errQueryTaskNotFound
+	}
+
+	select {
+	case resultCh <- result:
+		return nil
+	default: // query task is already answered
+		return errQueryTaskNotFound
+	}
+}
+
+// DescribeTaskList returns the recent pollers and the backlog status of the task list.
+func (e *matchingEngineImpl) DescribeTaskList(ctx thrift.Context, request *m.DescribeTaskListRequest) (
+	*workflow.DescribeTaskListResponse, error) {
+	domainID := request.GetDomainUUID()
+	descRequest := request.GetDescRequest()
+	taskListType := persistence.TaskListTypeDecision
+	if descRequest.GetTaskListType() == workflow.TaskListType_ACTIVITY {
+		taskListType = persistence.TaskListTypeActivity
+	}
+
+	taskList := newTaskListID(domainID, descRequest.GetTaskList().GetName(), taskListType)
+	tlMgr, err := e.getTaskListManager(taskList)
+	if err != nil {
+		return nil, err
+	}
+
+	return tlMgr.DescribeTaskList(), nil
+}
+
 // Loads a task from persistence and wraps it in a task context
-func (e *matchingEngineImpl) getTask(ctx thrift.Context, taskList *taskListID) (*taskContext, error) {
+func (e *matchingEngineImpl) getTask(ctx thrift.Context, taskList *taskListID, pollerIdentity string) (*taskContext,
+	error) {
 	tlMgr, err := e.getTaskListManager(taskList)
 	if err != nil {
 		return nil, err
 	}
-	return tlMgr.GetTaskContext(ctx)
+	return tlMgr.GetTaskContext(ctx, pollerIdentity)
 }
 
 func (e *matchingEngineImpl) unloadTaskList(id *taskListID) {
@@ -344,13 +470,20 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(context *taskCont
 	historyResponse *h.RecordDecisionTaskStartedResponse) *m.PollForDecisionTaskResponse {
 	task := context.info
 
+	// History schedules a retried decision again when new events arrived before it was started
+	scheduleID := task.ScheduleID
+	if historyResponse.IsSetScheduledEventId() {
+		scheduleID = historyResponse.GetScheduledEventId()
+	}
+
 	response := m.NewPollForDecisionTaskResponse()
 	response.WorkflowExecution = workflowExecutionPtr(context.workflowExecution)
 	token := &common.TaskToken{
-		DomainID:   task.DomainID,
-		WorkflowID: task.WorkflowID,
-		RunID:      task.RunID,
-		ScheduleID: task.ScheduleID,
+		DomainID:        task.DomainID,
+		WorkflowID:      task.WorkflowID,
+		RunID:           task.RunID,
+		ScheduleID:      scheduleID,
+		ScheduleAttempt: historyResponse.GetAttempt(),
 	}
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
 	response.WorkflowType = historyResponse.GetWorkflowType()
@@ -358,6 +491,30 @@ func (e *matchingEngineImpl) createPollForDecisionTaskResponse(context *taskCont
 		response.PreviousStartedEventId = historyResponse.PreviousStartedEventId
 	}
 	response.StartedEventId = historyResponse.StartedEventId
+	response.StickyExecutionEnabled = historyResponse.StickyExecutionEnabled
+	response.Attempt = historyResponse.Attempt
+	response.DecisionInfo = historyResponse.DecisionInfo
+
+	return response
+}
+
+// Populate the decision task response for a query task.  The started event is set to the last event in history so the
+// worker replays the whole history before answering the query.
+func (e *matchingEngineImpl) createPollForDecisionTaskResponseForQuery(context *taskContext,
+	historyResponse *h.GetWorkflowExecutionNextEventIDResponse) *m.PollForDecisionTaskResponse {
+	queryTask := context.queryTask
+
+	response := m.NewPollForDecisionTaskResponse()
+	response.WorkflowExecution = workflowExecutionPtr(context.workflowExecution)
+	token := &common.QueryTaskToken{
+		DomainID: queryTask.queryRequest.GetDomainUUID(),
+		TaskList: queryTask.queryRequest.GetTaskList().GetName(),
+		TaskID:   queryTask.taskID,
+	}
+	response.TaskToken, _ = e.tokenSerializer.SerializeQueryTaskToken(token)
+	response.WorkflowType = historyResponse.GetWorkflowType()
+	response.StartedEventId = common.Int64Ptr(historyResponse.GetEventId() - 1)
+	response.Query = queryTask.queryRequest.GetQueryRequest().GetQuery()
 
 	return response
 }
@@ -388,12 +545,14 @@ func (e *matchingEngineImpl) createPollForActivityTaskResponse(context *taskCont
 	response.StartedTimestamp = common.Int64Ptr(startedEvent.GetTimestamp())
 	response.StartToCloseTimeoutSeconds = common.Int32Ptr(attributes.GetStartToCloseTimeoutSeconds())
 	response.HeartbeatTimeoutSeconds = common.Int32Ptr(attributes.GetHeartbeatTimeoutSeconds())
+	response.Attempt = common.Int32Ptr(historyResponse.GetAttempt())
 
 	token := &common.TaskToken{
-		DomainID:   task.DomainID,
-		WorkflowID: task.WorkflowID,
-		RunID:      task.RunID,
-		ScheduleID: task.ScheduleID,
+		DomainID:        task.DomainID,
+		WorkflowID:      task.WorkflowID,
+		RunID:           task.RunID,
+		ScheduleID:      task.ScheduleID,
+		ScheduleAttempt: int64(historyResponse.GetAttempt()),
 	}
 	response.TaskToken, _ = e.tokenSerializer.Serialize(token)
 	return response
@@ -33,5 +33,8 @@ type (
 		AddActivityTask(addRequest *m.AddActivityTaskRequest) error
 		PollForDecisionTask(ctx thrift.Context, request *m.PollForDecisionTaskRequest) (*m.PollForDecisionTaskResponse, error)
 		PollForActivityTask(ctx thrift.Context, request *m.PollForActivityTaskRequest) (*workflow.PollForActivityTaskResponse, error)
+		QueryWorkflow(ctx thrift.Context, request *m.QueryWorkflowRequest) (*workflow.QueryWorkflowResponse, error)
+		RespondQueryTaskCompleted(ctx thrift.Context, request *m.RespondQueryTaskCompletedRequest) error
+		DescribeTaskList(ctx thrift.Context, request *m.DescribeTaskListRequest) (*workflow.DescribeTaskListResponse, error)
 	}
 )
@@ -108,6 +108,7 @@ func (s *matchingEngineSuite) newMatchingEngine(rangeSize int64) *matchingEngine
 		taskManager:                s.taskManager,
 		historyService:             s.historyClient,
 		taskLists:                  make(map[taskListID]taskListManager),
+		queryResults:               make(map[string]chan *queryResult),
 		logger:                     s.logger,
 		tokenSerializer:            common.NewJSONTaskTokenSerializer(),
 		longPollExpirationInterval: 100 * time.Second, //time.Millisecond,
@@ -266,6 +267,67 @@ func (s *matchingEngineSuite) AddTasksTest(taskType int) {
 
 }
 
+func (s *matchingEngineSuite) TestDescribeTaskList() {
+	s.matchingEngine.longPollExpirationInterval = 10 * time.Millisecond
+	domainID := "domainId"
+	tl := "makeToast"
+	identity := "selfDrivingToaster"
+
+	taskList := workflow.NewTaskList()
+	taskList.Name = &tl
+
+	runID := "run1"
+	workflowID := "workflow1"
+	execution := workflow.WorkflowExecution{RunId: &runID, WorkflowId: &workflowID}
+
+	const taskCount = 10
+	for i := int64(0); i < taskCount; i++ {
+		scheduleID := i * 3
+		addRequest := matching.AddActivityTaskRequest{
+			SourceDomainUUID: common.StringPtr(domainID),
+			DomainUUID:       common.StringPtr(domainID),
+			Execution:        &execution,
+			ScheduleId:       &scheduleID,
+			TaskList:         taskList}
+		err := s.matchingEngine.AddActivityTask(&addRequest)
+		s.NoError(err)
+	}
+
+	resp, err := s.matchingEngine.DescribeTaskList(s.callContext, &matching.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:     taskList,
+			TaskListType: workflow.TaskListTypePtr(workflow.TaskListType_ACTIVITY),
+		},
+	})
+	s.NoError(err)
+	s.Empty(resp.GetPollers())
+	s.EqualValues(taskCount, resp.GetTaskListStatus().GetBacklogCountHint())
+
+	// Only the decision task list has seen the poller
+	pollResp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: taskList,
+			Identity: &identity},
+	})
+	s.NoError(err)
+	s.Equal(emptyPollForDecisionTaskResponse, pollResp)
+
+	resp, err = s.matchingEngine.DescribeTaskList(s.callContext, &matching.DescribeTaskListRequest{
+		DomainUUID: common.StringPtr(domainID),
+		DescRequest: &workflow.DescribeTaskListRequest{
+			TaskList:     taskList,
+			TaskListType: workflow.TaskListTypePtr(workflow.TaskListType_DECISION),
+		},
+	})
+	s.NoError(err)
+	s.Equal(1, len(resp.GetPollers()))
+	s.Equal(identity, resp.GetPollers()[0].GetIdentity())
+	s.True(resp.GetPollers()[0].GetLastAccessTime() > 0)
+	s.EqualValues(0, resp.GetTaskListStatus().GetBacklogCountHint())
+}
+
 func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 	s.matchingEngine.longPollExpirationInterval = 10 * time.Millisecond
 
@@ -328,7 +390,8 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 				StartedEvent: newActivityTaskStartedEvent(startedID, 0, &workflow.PollForActivityTaskRequest{
 					TaskList: &workflow.TaskList{Name: taskList.Name},
 					Identity: &identity,
-				})}
+				}),
+				Attempt: common.Int32Ptr(2)}
 		}, nil)
 
 	for i := int64(0); i < taskCount; {
@@ -357,11 +420,13 @@ func (s *matchingEngineSuite) TestAddThenConsumeActivities() {
 		s.Equal(true, validateTimeRange(time.Unix(0, result.GetStartedTimestamp()), time.Minute))
 		s.Equal(int32(50), result.GetStartToCloseTimeoutSeconds())
 		s.Equal(int32(10), result.GetHeartbeatTimeoutSeconds())
+		s.Equal(int32(2), result.GetAttempt())
 		token := &common.TaskToken{
-			DomainID:   domainID,
-			WorkflowID: workflowID,
-			RunID:      runID,
-			ScheduleID: scheduleID,
+			DomainID:        domainID,
+			WorkflowID:      workflowID,
+			RunID:           runID,
+			ScheduleID:      scheduleID,
+			ScheduleAttempt: 2,
 		}
 
 		taskToken, _ := s.matchingEngine.tokenSerializer.Serialize(token)
@@ -1068,12 +1133,12 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.NoError(err)
 	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
 
-	ctx, err := s.matchingEngine.getTask(common.BackgroundThriftContext(), tlID)
+	ctx, err := s.matchingEngine.getTask(common.BackgroundThriftContext(), tlID, "nobody")
 	s.NoError(err)
 
 	ctx.completeTask(errors.New("test error"))
 	s.EqualValues(1, s.taskManager.getTaskCount(tlID))
-	ctx2, err := s.matchingEngine.getTask(common.BackgroundThriftContext(), tlID)
+	ctx2, err := s.matchingEngine.getTask(common.BackgroundThriftContext(), tlID, "nobody")
 	s.NoError(err)
 
 	s.NotEqual(ctx.info.TaskID, ctx2.info.TaskID)
@@ -1085,6 +1150,114 @@ func (s *matchingEngineSuite) TestAddTaskAfterStartFailure() {
 	s.EqualValues(0, s.taskManager.getTaskCount(tlID))
 }
 
+func (s *matchingEngineSuite) TestQueryWorkflowNoPoller() {
+	domainID := "domainId"
+	tl := "makeToast"
+	workflowID := "workflow1"
+	runID := "run1"
+
+	ctx, cancel := thrift.NewContext(100 * time.Millisecond)
+	defer cancel()
+	_, err := s.matchingEngine.QueryWorkflow(ctx, &matching.QueryWorkflowRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr(tl)},
+		QueryRequest: &workflow.QueryWorkflowRequest{
+			Domain:    common.StringPtr(domainID),
+			Execution: &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)},
+			Query:     &workflow.WorkflowQuery{QueryType: common.StringPtr("state")},
+		},
+	})
+	s.IsType(&workflow.QueryFailedError{}, err)
+	s.Empty(s.matchingEngine.queryResults)
+}
+
+func (s *matchingEngineSuite) TestQueryWorkflow() {
+	domainID := "domainId"
+	tl := "makeToast"
+	workflowID := "workflow1"
+	runID := "run1"
+	workflowType := "workflowType1"
+	identity := "nobody"
+	workflowExecution := &workflow.WorkflowExecution{WorkflowId: common.StringPtr(workflowID), RunId: common.StringPtr(runID)}
+	query := &workflow.WorkflowQuery{QueryType: common.StringPtr("state"), QueryArgs_: []byte("args")}
+
+	s.historyClient.On("GetWorkflowExecutionNextEventID", mock.Anything, mock.Anything).Return(
+		&gohistory.GetWorkflowExecutionNextEventIDResponse{
+			EventId:      common.Int64Ptr(10),
+			RunId:        common.StringPtr(runID),
+			WorkflowType: &workflow.WorkflowType{Name: common.StringPtr(workflowType)},
+			TaskList:     &workflow.TaskList{Name: common.StringPtr(tl)},
+		}, nil).Once()
+
+	type queryResponse struct {
+		resp *workflow.QueryWorkflowResponse
+		err  error
+	}
+	queryCh := make(chan queryResponse, 1)
+	go func() {
+		ctx, cancel := thrift.NewContext(10 * time.Second)
+		defer cancel()
+		resp, err := s.matchingEngine.QueryWorkflow(ctx, &matching.QueryWorkflowRequest{
+			DomainUUID: common.StringPtr(domainID),
+			TaskList:   &workflow.TaskList{Name: common.StringPtr(tl)},
+			QueryRequest: &workflow.QueryWorkflowRequest{
+				Domain:    common.StringPtr(domainID),
+				Execution: workflowExecution,
+				Query:     query,
+			},
+		})
+		queryCh <- queryResponse{resp: resp, err: err}
+	}()
+
+	pollResp, err := s.matchingEngine.PollForDecisionTask(s.callContext, &matching.PollForDecisionTaskRequest{
+		DomainUUID: common.StringPtr(domainID),
+		PollRequest: &workflow.PollForDecisionTaskRequest{
+			TaskList: &workflow.TaskList{Name: common.StringPtr(tl)},
+			Identity: common.StringPtr(identity),
+		},
+	})
+	s.NoError(err)
+	s.Equal(workflowExecution, pollResp.GetWorkflowExecution())
+	s.Equal(workflowType, pollResp.GetWorkflowType().GetName())
+	s.Equal(int64(9), pollResp.GetStartedEventId())
+	s.Equal(query, pollResp.GetQuery())
+
+	token, err := s.matchingEngine.tokenSerializer.DeserializeQueryTaskToken(pollResp.GetTaskToken())
+	s.NoError(err)
+	s.Equal(domainID, token.DomainID)
+	s.Equal(tl, token.TaskList)
+
+	err = s.matchingEngine.RespondQueryTaskCompleted(s.callContext, &matching.RespondQueryTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr(tl)},
+		TaskID:     common.StringPtr(token.TaskID),
+		CompletedRequest: &workflow.RespondQueryTaskCompletedRequest{
+			TaskToken:     pollResp.GetTaskToken(),
+			CompletedType: workflow.QueryTaskCompletedTypePtr(workflow.QueryTaskCompletedType_COMPLETED),
+			QueryResult_:  []byte("result"),
+		},
+	})
+	s.NoError(err)
+
+	result := <-queryCh
+	s.NoError(result.err)
+	s.Equal([]byte("result"), result.resp.GetQueryResult_())
+
+	// The query task is gone once the query returns
+	err = s.matchingEngine.RespondQueryTaskCompleted(s.callContext, &matching.RespondQueryTaskCompletedRequest{
+		DomainUUID: common.StringPtr(domainID),
+		TaskList:   &workflow.TaskList{Name: common.StringPtr(tl)},
+		TaskID:     common.StringPtr(token.TaskID),
+		CompletedRequest: &workflow.RespondQueryTaskCompletedRequest{
+			TaskToken:     pollResp.GetTaskToken(),
+			CompletedType: workflow.QueryTaskCompletedTypePtr(workflow.QueryTaskCompletedType_FAILED),
+			ErrorMessage:  common.StringPtr("too late"),
+		},
+	})
+	s.IsType(&workflow.EntityNotExistsError{}, err)
+	s.historyClient.AssertExpectations(s.T())
+}
+
 func newActivityTaskScheduledEvent(eventID int64, decisionTaskCompletedEventID int64,
 	scheduleAttributes *workflow.ScheduleActivityTaskDecisionAttributes) *workflow.HistoryEvent {
 	historyEvent := newHistoryEvent(eventID, workflow.EventType_ActivityTaskScheduled)
@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package matching
+
+import (
+	"sync"
+	"time"
+
+	s "github.com/uber/cadence/.gen/go/shared"
+	"github.com/uber/cadence/common"
+)
+
+const (
+	// pollerHistoryTTL is how long a poller is reported after its last poll
+	pollerHistoryTTL = 5 * time.Minute
+)
+
+// pollerHistory tracks the identity and last access time of the pollers of a task list
+type pollerHistory struct {
+	sync.Mutex
+	history map[string]time.Time // poller identity -> last access time
+}
+
+func newPollerHistory() *pollerHistory {
+	return &pollerHistory{
+		history: make(map[string]time.Time),
+	}
+}
+
+func (p *pollerHistory) updatePollerInfo(identity string) {
+	p.Lock()
+	defer p.Unlock()
+	p.history[identity] = time.Now()
+}
+
+// getAllPollerInfo returns the pollers seen within pollerHistoryTTL and forgets the older ones
+func (p *pollerHistory) getAllPollerInfo() []*s.PollerInfo {
+	p.Lock()
+	defer p.Unlock()
+
+	result := []*s.PollerInfo{}
+	now := time.Now()
+	for identity, lastAccessTime := range p.history {
+		if now.Sub(lastAccessTime) > pollerHistoryTTL {
+			delete(p.history, identity)
+			continue
+		}
+		result = append(result, &s.PollerInfo{
+			Identity:       common.StringPtr(identity),
+			LastAccessTime: common.Int64Ptr(lastAccessTime.UnixNano()),
+		})
+	}
+	return result
+}
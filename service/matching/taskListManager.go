@@ -28,6 +28,7 @@ import (
 
 	"github.com/uber-common/bark"
 	h "github.com/uber/cadence/.gen/go/history"
+	m "github.com/uber/cadence/.gen/go/matching"
 	s "github.com/uber/cadence/.gen/go/shared"
 	"github.com/uber/cadence/common"
 	"github.com/uber/cadence/common/backoff"
@@ -51,7 +52,9 @@ type taskListManager interface {
 	Start() error
 	Stop()
 	AddTask(execution *s.WorkflowExecution, taskInfo *persistence.TaskInfo) error
-	GetTaskContext(ctx thrift.Context) (*taskContext, error)
+	GetTaskContext(ctx thrift.Context, pollerIdentity string) (*taskContext, error)
+	SyncMatchQueryTask(ctx thrift.Context, queryTask *queryTaskInfo) error
+	DescribeTaskList() *s.DescribeTaskListResponse
 	String() string
 }
 
@@ -68,6 +71,7 @@ func newTaskListManager(e *matchingEngineImpl, taskList *taskListID) taskListMan
 		}),
 		taskAckManager: newAckManager(e.logger),
 		syncMatch:      make(chan *getTaskResult),
+		pollerHistory:  newPollerHistory(),
 	}
 	tlMgr.taskWriter = newTaskWriter(tlMgr, tlMgr.shutdownCh)
 	return tlMgr
@@ -79,6 +83,13 @@ type taskContext struct {
 	info              *persistence.TaskInfo
 	syncResponseCh    chan<- *syncMatchResponse
 	workflowExecution s.WorkflowExecution
+	queryTask         *queryTaskInfo // non-nil for query tasks
+}
+
+// queryTaskInfo contains a query task which is only dispatched to pollers through sync match
+type queryTaskInfo struct {
+	taskID       string
+	queryRequest *m.QueryWorkflowRequest
 }
 
 // Single task list in memory state
@@ -95,6 +106,8 @@ type taskListManagerImpl struct {
 	notifyCh   chan struct{} // Used as signal to notify pump of new tasks
 	shutdownCh chan struct{} // Delivers stop to the pump that populates taskBuffer
 	stopped    int32
+	// pollerHistory tracks the pollers of this task list seen in the last few minutes
+	pollerHistory *pollerHistory
 
 	sync.Mutex
 	taskAckManager          ackManager // tracks ackLevel for delivered messages
@@ -106,8 +119,9 @@ type taskListManagerImpl struct {
 // getTaskResult contains task info and optional channel to notify createTask caller
 // that task is successfully started and returned to a poller
 type getTaskResult struct {
-	task *persistence.TaskInfo
-	C    chan *syncMatchResponse
+	task      *persistence.TaskInfo
+	C         chan *syncMatchResponse
+	queryTask *queryTaskInfo
 }
 
 // syncMatchResponse result of sync match delivered to a createTask caller
@@ -156,11 +170,20 @@ func (c *taskListManagerImpl) AddTask(execution *s.WorkflowExecution, taskInfo *
 }
 
 // Loads a task from DB or from sync match and wraps it in a task context
-func (c *taskListManagerImpl) GetTaskContext(ctx thrift.Context) (*taskContext, error) {
+func (c *taskListManagerImpl) GetTaskContext(ctx thrift.Context, pollerIdentity string) (*taskContext, error) {
+	c.pollerHistory.updatePollerInfo(pollerIdentity)
 	result, err := c.getTask(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if result.queryTask != nil {
+		return &taskContext{
+			workflowExecution: *result.queryTask.queryRequest.GetQueryRequest().GetExecution(),
+			tlMgr:             c,
+			queryTask:         result.queryTask,
+		}, nil
+	}
+
 	task := result.task
 	workflowExecution := s.WorkflowExecution{
 		WorkflowId: common.StringPtr(task.WorkflowID),
@@ -175,6 +198,43 @@ func (c *taskListManagerImpl) GetTaskContext(ctx thrift.Context) (*taskContext,
 	return tCtx, nil
 }
 
+// SyncMatchQueryTask hands the query task to a waiting poller.  Query tasks are never persisted, so the call fails
+// if no poller picks up the task before the context expires.
+func (c *taskListManagerImpl) SyncMatchQueryTask(ctx thrift.Context, queryTask *queryTaskInfo) error {
+	request := &getTaskResult{queryTask: queryTask}
+	select {
+	case c.syncMatch <- request:
+		return nil
+	case <-ctx.Done():
+		return &s.QueryFailedError{Message: "Timed out waiting for a worker to poll for the query task."}
+	}
+}
+
+// DescribeTaskList returns the recent pollers of the task list and its backlog status.  The backlog is approximated
+// from the IDs of the tasks written to and acked from the tasks table.
+func (c *taskListManagerImpl) DescribeTaskList() *s.DescribeTaskListResponse {
+	c.Lock()
+	ackLevel := c.taskAckManager.getAckLevel()
+	readLevel := c.taskAckManager.getReadLevel()
+	c.Unlock()
+	maxReadLevel := c.taskWriter.GetMaxReadLevel()
+
+	backlogCountHint := maxReadLevel - ackLevel
+	if backlogCountHint < 0 {
+		backlogCountHint = 0
+	}
+
+	response := s.NewDescribeTaskListResponse()
+	response.Pollers = c.pollerHistory.getAllPollerInfo()
+	response.TaskListStatus = &s.TaskListStatus{
+		BacklogCountHint: common.Int64Ptr(backlogCountHint),
+		ReadLevel:        common.Int64Ptr(readLevel),
+		AckLevel:         common.Int64Ptr(ackLevel),
+		MaxReadLevel:     common.Int64Ptr(maxReadLevel),
+	}
+	return response
+}
+
 func (c *taskListManagerImpl) getRangeID() int64 {
 	c.Lock()
 	defer c.Unlock()